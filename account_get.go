@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -83,6 +84,14 @@ func (r *AccountGetRequest) Method() string {
 	return r.method
 }
 
+func (r *AccountGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *AccountGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r AccountGetRequest) NewRequestBody() AccountGetRequestBody {
 	return AccountGetRequestBody{}
 }
@@ -113,9 +122,9 @@ func (r *AccountGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *AccountGetRequest) Do() (AccountGetResponseBody, error) {
+func (r *AccountGetRequest) Do(ctx context.Context) (AccountGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -9,7 +10,7 @@ import (
 func TestAccountGet(t *testing.T) {
 	req := client.NewAccountGetRequest()
 	// req.QueryParams().Account = "FLD"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,153 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IsOpen reports whether transactions can be posted to the period. A period
+// can also be locked for payables or receivables only, see APLocked and
+// ARLocked.
+func (p AccountingPeriod) IsOpen() bool {
+	return !bool(p.Closed) && !bool(p.AllLocked)
+}
+
+// Contains reports whether the date of t falls within the period.
+func (p AccountingPeriod) Contains(t time.Time) bool {
+	d := NewDate(t.Year(), t.Month(), t.Day())
+	return !d.Before(p.StartDate.Time) && !d.After(p.EndDate.Time)
+}
+
+// PeriodForDate returns the posting period (a month, not a quarter, year or
+// adjustment period) the date of t falls in, open or not. Check IsOpen before
+// posting a transaction dated t. fiscalCalendar is the internal id of the
+// fiscal calendar of the period, for OneWorld accounts with a calendar per
+// subsidiary; empty works when the account has a single calendar.
+func (c *Client) PeriodForDate(ctx context.Context, t time.Time, fiscalCalendar string) (AccountingPeriod, error) {
+	date := t.Format("2006-01-02")
+	periods, err := c.queryPostingPeriods(ctx, fmt.Sprintf(
+		"startdate <= TO_DATE('%[1]s', 'YYYY-MM-DD') AND enddate >= TO_DATE('%[1]s', 'YYYY-MM-DD')", date,
+	), fiscalCalendar)
+	if err != nil {
+		return AccountingPeriod{}, err
+	}
+	if len(periods) == 0 {
+		return AccountingPeriod{}, notFoundError("no posting period for %s", date)
+	}
+	return periods[0], nil
+}
+
+// CurrentOpenPeriod returns the open posting period of today or, when that
+// period is closed or locked, the first open posting period after it: the
+// period NetSuite posts a transaction of today to. loc is the time zone of
+// the account, which decides what today is; nil is UTC. fiscalCalendar is
+// as for PeriodForDate.
+func (c *Client) CurrentOpenPeriod(ctx context.Context, loc *time.Location, fiscalCalendar string) (AccountingPeriod, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	date := time.Now().In(loc).Format("2006-01-02")
+	periods, err := c.queryPostingPeriods(ctx, fmt.Sprintf(
+		"enddate >= TO_DATE('%s', 'YYYY-MM-DD') AND closed = 'F' AND alllocked = 'F'", date,
+	), fiscalCalendar)
+	if err != nil {
+		return AccountingPeriod{}, err
+	}
+	if len(periods) == 0 {
+		return AccountingPeriod{}, notFoundError("no open posting period on or after %s", date)
+	}
+	return periods[0], nil
+}
+
+// queryPostingPeriods returns the active posting periods matching where,
+// ordered by start date. Without a fiscal calendar, it fails when the periods
+// are of several calendars: the first one would be of an arbitrary calendar.
+func (c *Client) queryPostingPeriods(ctx context.Context, where string, fiscalCalendar string) (AccountingPeriods, error) {
+	conditions := []string{"isposting = 'T'", "isquarter = 'F'", "isyear = 'F'", "isadjust = 'F'", "isinactive = 'F'", where}
+	if fiscalCalendar != "" {
+		conditions = append(conditions, fmt.Sprintf("fiscalcalendar = '%s'", strings.Replace(fiscalCalendar, "'", "''", -1)))
+	}
+
+	req := c.NewSuiteqlPostRequest()
+	req.RequestBody().Q = "SELECT id, periodname, TO_CHAR(startdate, 'YYYY-MM-DD') AS startdate, TO_CHAR(enddate, 'YYYY-MM-DD') AS enddate, " +
+		"closed, alllocked, aplocked, arlocked, isposting, isadjust, isquarter, isyear, isinactive, parent, fiscalcalendar " +
+		"FROM accountingperiod WHERE " + strings.Join(conditions, " AND ") + " ORDER BY startdate, id"
+	req.QueryParams().Limit = 1000
+
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []accountingPeriodRow{}
+	err = json.Unmarshal(resp.Items, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(AccountingPeriods, len(rows))
+	for i, row := range rows {
+		periods[i], err = row.accountingPeriod()
+		if err != nil {
+			return nil, err
+		}
+		if fiscalCalendar == "" && periods[i].FiscalCalendar.ID != periods[0].FiscalCalendar.ID {
+			return nil, fmt.Errorf("posting periods of fiscal calendars %s and %s, pass a fiscal calendar", periods[0].FiscalCalendar.ID, periods[i].FiscalCalendar.ID)
+		}
+	}
+	return periods, nil
+}
+
+// accountingPeriodRow is an accounting period as SuiteQL returns it:
+// references as ids, booleans as T/F.
+type accountingPeriodRow struct {
+	Links          Links  `json:"links"`
+	ID             string `json:"id"`
+	PeriodName     string `json:"periodname"`
+	StartDate      string `json:"startdate"`
+	EndDate        string `json:"enddate"`
+	Closed         string `json:"closed"`
+	AllLocked      string `json:"alllocked"`
+	APLocked       string `json:"aplocked"`
+	ARLocked       string `json:"arlocked"`
+	IsPosting      string `json:"isposting"`
+	IsAdjust       string `json:"isadjust"`
+	IsQuarter      string `json:"isquarter"`
+	IsYear         string `json:"isyear"`
+	IsInactive     string `json:"isinactive"`
+	Parent         string `json:"parent"`
+	FiscalCalendar string `json:"fiscalcalendar"`
+}
+
+func (r accountingPeriodRow) accountingPeriod() (AccountingPeriod, error) {
+	p := AccountingPeriod{
+		AllLocked:      Bool(r.AllLocked == "T"),
+		APLocked:       Bool(r.APLocked == "T"),
+		ARLocked:       Bool(r.ARLocked == "T"),
+		Closed:         Bool(r.Closed == "T"),
+		FiscalCalendar: NewRecordRef(r.FiscalCalendar),
+		ID:             r.ID,
+		IsAdjust:       Bool(r.IsAdjust == "T"),
+		IsInactive:     Bool(r.IsInactive == "T"),
+		IsPosting:      Bool(r.IsPosting == "T"),
+		IsQuarter:      Bool(r.IsQuarter == "T"),
+		IsYear:         Bool(r.IsYear == "T"),
+		Parent:         NewRecordRef(r.Parent),
+		PeriodName:     r.PeriodName,
+		RefName:        r.PeriodName,
+	}
+
+	var err error
+	p.StartDate, err = ParseDate(r.StartDate)
+	if err != nil {
+		return p, fmt.Errorf("start date of period %s: %w", r.ID, err)
+	}
+	p.EndDate, err = ParseDate(r.EndDate)
+	if err != nil {
+		return p, fmt.Errorf("end date of period %s: %w", r.ID, err)
+	}
+	return p, nil
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewAccountingPeriodGetRequest() AccountingPeriodGetRequest {
+	r := AccountingPeriodGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type AccountingPeriodGetRequest struct {
+	client      *Client
+	queryParams *AccountingPeriodGetRequestQueryParams
+	pathParams  *AccountingPeriodGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody AccountingPeriodGetRequestBody
+}
+
+func (r AccountingPeriodGetRequest) NewQueryParams() *AccountingPeriodGetRequestQueryParams {
+	return &AccountingPeriodGetRequestQueryParams{}
+}
+
+type AccountingPeriodGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p AccountingPeriodGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *AccountingPeriodGetRequest) QueryParams() *AccountingPeriodGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r AccountingPeriodGetRequest) NewPathParams() *AccountingPeriodGetRequestPathParams {
+	return &AccountingPeriodGetRequestPathParams{}
+}
+
+type AccountingPeriodGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *AccountingPeriodGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *AccountingPeriodGetRequest) PathParams() *AccountingPeriodGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *AccountingPeriodGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *AccountingPeriodGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *AccountingPeriodGetRequest) Method() string {
+	return r.method
+}
+
+func (r *AccountingPeriodGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *AccountingPeriodGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r AccountingPeriodGetRequest) NewRequestBody() AccountingPeriodGetRequestBody {
+	return AccountingPeriodGetRequestBody{}
+}
+
+type AccountingPeriodGetRequestBody struct {
+}
+
+func (r *AccountingPeriodGetRequest) RequestBody() *AccountingPeriodGetRequestBody {
+	return nil
+}
+
+func (r *AccountingPeriodGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *AccountingPeriodGetRequest) SetRequestBody(body AccountingPeriodGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *AccountingPeriodGetRequest) NewResponseBody() *AccountingPeriodGetResponseBody {
+	return &AccountingPeriodGetResponseBody{}
+}
+
+type AccountingPeriodGetResponseBody struct {
+	Links Links `json:"links"`
+	AccountingPeriod
+}
+
+func (r *AccountingPeriodGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/accountingPeriod/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *AccountingPeriodGetRequest) Do(ctx context.Context) (AccountingPeriodGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
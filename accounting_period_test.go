@@ -0,0 +1,95 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestPeriodForDate(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("TO_DATE('2024-03-15'",
+		map[string]interface{}{"id": "120", "periodname": "Mar 2024", "startdate": "2024-03-01", "enddate": "2024-03-31", "closed": "T", "alllocked": "T", "aplocked": "T", "arlocked": "T", "isposting": "T", "isadjust": "F", "isquarter": "F", "isyear": "F", "isinactive": "F", "parent": "119", "fiscalcalendar": "1"},
+	)
+
+	period, err := srv.Client().PeriodForDate(context.Background(), time.Date(2024, 3, 15, 23, 0, 0, 0, time.UTC), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if period.ID != "120" || period.PeriodName != "Mar 2024" || period.Parent.ID != "119" {
+		t.Errorf("unexpected period %+v", period)
+	}
+	if period.IsOpen() {
+		t.Error("expected a closed period")
+	}
+	if !period.Contains(time.Date(2024, 3, 31, 23, 59, 0, 0, time.UTC)) || period.Contains(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the period to contain March only, got %s - %s", period.StartDate, period.EndDate)
+	}
+
+	body := struct{ Q string }{}
+	json.Unmarshal(srv.Requests()[0].Body, &body)
+	for _, e := range []string{"FROM accountingperiod", "isposting = 'T'", "isquarter = 'F'", "startdate <= TO_DATE('2024-03-15', 'YYYY-MM-DD')", "fiscalcalendar = '1'"} {
+		if !strings.Contains(body.Q, e) {
+			t.Errorf("expected %q in query %s", e, body.Q)
+		}
+	}
+
+	_, err = srv.Client().PeriodForDate(context.Background(), time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), "")
+	if !netsuite.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestCurrentOpenPeriod(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("alllocked = 'F'",
+		map[string]interface{}{"id": "121", "periodname": "Apr 2024", "startdate": "2024-04-01", "enddate": "2024-04-30", "closed": "F", "alllocked": "F", "isposting": "T"},
+		map[string]interface{}{"id": "122", "periodname": "May 2024", "startdate": "2024-05-01", "enddate": "2024-05-31", "closed": "F", "alllocked": "F", "isposting": "T"},
+	)
+
+	// it's tomorrow already east of the date line
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	period, err := srv.Client().CurrentOpenPeriod(context.Background(), loc, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if period.ID != "121" || !period.IsOpen() {
+		t.Errorf("expected the first open period, got %+v", period)
+	}
+
+	body := struct{ Q string }{}
+	json.Unmarshal(srv.Requests()[0].Body, &body)
+	if today := time.Now().In(loc).Format("2006-01-02"); !strings.Contains(body.Q, "enddate >= TO_DATE('"+today+"'") {
+		t.Errorf("expected today in %s in query %s", loc, body.Q)
+	}
+}
+
+func TestPeriodForDateFiscalCalendars(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("TO_DATE('2024-03-15'",
+		map[string]interface{}{"id": "120", "periodname": "Mar 2024", "startdate": "2024-03-01", "enddate": "2024-03-31", "isposting": "T", "fiscalcalendar": "1"},
+		map[string]interface{}{"id": "220", "periodname": "P12 FY2024", "startdate": "2024-03-01", "enddate": "2024-03-31", "isposting": "T", "fiscalcalendar": "2"},
+	)
+	srv.AddSuiteQL("fiscalcalendar = '2'",
+		map[string]interface{}{"id": "220", "periodname": "P12 FY2024", "startdate": "2024-03-01", "enddate": "2024-03-31", "isposting": "T", "fiscalcalendar": "2"},
+	)
+
+	date := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	_, err := srv.Client().PeriodForDate(context.Background(), date, "")
+	if err == nil || !strings.Contains(err.Error(), "fiscal calendar") {
+		t.Errorf("expected the periods of several calendars to fail, got %v", err)
+	}
+
+	period, err := srv.Client().PeriodForDate(context.Background(), date, "2")
+	if err != nil || period.ID != "220" {
+		t.Errorf("expected the period of calendar 2, got %+v (%v)", period, err)
+	}
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewAccountingPeriodsGetRequest() AccountingPeriodsGetRequest {
+	r := AccountingPeriodsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type AccountingPeriodsGetRequest struct {
+	client      *Client
+	queryParams *AccountingPeriodsGetRequestQueryParams
+	pathParams  *AccountingPeriodsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody AccountingPeriodsGetRequestBody
+}
+
+func (r AccountingPeriodsGetRequest) NewQueryParams() *AccountingPeriodsGetRequestQueryParams {
+	return &AccountingPeriodsGetRequestQueryParams{}
+}
+
+type AccountingPeriodsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p AccountingPeriodsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *AccountingPeriodsGetRequest) QueryParams() *AccountingPeriodsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r AccountingPeriodsGetRequest) NewPathParams() *AccountingPeriodsGetRequestPathParams {
+	return &AccountingPeriodsGetRequestPathParams{}
+}
+
+type AccountingPeriodsGetRequestPathParams struct {
+}
+
+func (p *AccountingPeriodsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *AccountingPeriodsGetRequest) PathParams() *AccountingPeriodsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *AccountingPeriodsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *AccountingPeriodsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *AccountingPeriodsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *AccountingPeriodsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *AccountingPeriodsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r AccountingPeriodsGetRequest) NewRequestBody() AccountingPeriodsGetRequestBody {
+	return AccountingPeriodsGetRequestBody{}
+}
+
+type AccountingPeriodsGetRequestBody struct {
+}
+
+func (r *AccountingPeriodsGetRequest) RequestBody() *AccountingPeriodsGetRequestBody {
+	return nil
+}
+
+func (r *AccountingPeriodsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *AccountingPeriodsGetRequest) SetRequestBody(body AccountingPeriodsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *AccountingPeriodsGetRequest) NewResponseBody() *AccountingPeriodsGetResponseBody {
+	return &AccountingPeriodsGetResponseBody{}
+}
+
+type AccountingPeriodsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *AccountingPeriodsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/accountingPeriod", r.PathParams())
+	return &u, err
+}
+
+func (r *AccountingPeriodsGetRequest) Do(ctx context.Context) (AccountingPeriodsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,19 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestAccountingPeriodsGet(t *testing.T) {
+	req := client.NewAccountingPeriodsGetRequest()
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,175 @@
+package netsuite
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// DefaultBilling returns the default billing address of the address book.
+func (a AddressBook) DefaultBilling() (AddressBookItem, bool) {
+	for _, item := range a.Items {
+		if item.DefaultBilling {
+			return item, true
+		}
+	}
+	return AddressBookItem{}, false
+}
+
+// DefaultShipping returns the default shipping address of the address book.
+func (a AddressBook) DefaultShipping() (AddressBookItem, bool) {
+	for _, item := range a.Items {
+		if item.DefaultShipping {
+			return item, true
+		}
+	}
+	return AddressBookItem{}, false
+}
+
+// Label returns the address with the given label, compared case
+// insensitively.
+func (a AddressBook) Label(label string) (AddressBookItem, bool) {
+	i := a.labelIndex(label)
+	if i < 0 {
+		return AddressBookItem{}, false
+	}
+	return a.Items[i], true
+}
+
+// Upsert replaces the address with the label of item, or adds item when
+// there is none. The replaced address keeps its internal id, so NetSuite
+// updates it instead of adding a new one. When item is the default billing or
+// shipping address, the other addresses no longer are.
+func (a *AddressBook) Upsert(item AddressBookItem) {
+	if item.DefaultBilling || item.DefaultShipping {
+		for i := range a.Items {
+			if item.DefaultBilling {
+				a.Items[i].DefaultBilling = false
+			}
+			if item.DefaultShipping {
+				a.Items[i].DefaultShipping = false
+			}
+		}
+	}
+
+	i := a.labelIndex(item.Label)
+	if i < 0 {
+		a.Items = append(a.Items, item)
+		return
+	}
+
+	if item.InternalID == 0 {
+		item.InternalID = a.Items[i].InternalID
+	}
+	if item.ID == 0 {
+		item.ID = a.Items[i].ID
+	}
+	a.Items[i] = item
+}
+
+func (a AddressBook) labelIndex(label string) int {
+	if label == "" {
+		return -1
+	}
+	for i, item := range a.Items {
+		if strings.EqualFold(strings.TrimSpace(item.Label), strings.TrimSpace(label)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Normalize returns a copy of the address with the country and the state
+// written the way NetSuite expects them: the country as its ISO 3166-1
+// alpha-2 code and, in countries with abbreviated states, the state as its
+// abbreviation. The country can be given as its id or its name (RefName).
+func (a AddressBookAddress) Normalize() AddressBookAddress {
+	country := a.Country.ID
+	if country == "" {
+		country = a.Country.RefName
+	}
+	if code, ok := NormalizeCountry(country); ok {
+		a.Country = NewRecordRef(code)
+	}
+	a.State = NormalizeState(a.Country.ID, a.State)
+	return a
+}
+
+// NormalizeCountry returns the ISO 3166-1 alpha-2 code NetSuite uses as the id
+// of the country given by its alpha-2 code, alpha-3 code or English name, e.g.
+// "NLD" and "the Netherlands" both return "NL". Unknown countries are returned
+// as is and false.
+func NormalizeCountry(country string) (string, bool) {
+	loadAddressCodes()
+	code, ok := countryIndex[normalizeAddressName(country)]
+	if !ok {
+		return country, false
+	}
+	return code, true
+}
+
+// NormalizeState returns the abbreviation of the state in the countries where
+// NetSuite expects one (the United States, Canada and Australia), e.g.
+// "New York" returns "NY". Other states are returned trimmed.
+func NormalizeState(country, state string) string {
+	state = strings.TrimSpace(state)
+	loadAddressCodes()
+	states, ok := stateIndex[country]
+	if !ok {
+		return state
+	}
+	if code, ok := states[normalizeAddressName(state)]; ok {
+		return code
+	}
+	return state
+}
+
+var (
+	addressCodesOnce sync.Once
+	countryIndex     map[string]string
+	stateIndex       map[string]map[string]string
+)
+
+func loadAddressCodes() {
+	addressCodesOnce.Do(func() {
+		countryIndex = map[string]string{}
+		for _, c := range countryCodes {
+			countryIndex[normalizeAddressName(c.alpha2)] = c.alpha2
+			countryIndex[normalizeAddressName(c.alpha3)] = c.alpha2
+			for _, name := range c.names {
+				countryIndex[normalizeAddressName(name)] = c.alpha2
+			}
+		}
+
+		stateIndex = map[string]map[string]string{}
+		for country, states := range stateCodes {
+			index := map[string]string{}
+			for name, code := range states {
+				index[normalizeAddressName(name)] = code
+				index[normalizeAddressName(code)] = code
+			}
+			stateIndex[country] = index
+		}
+	})
+}
+
+// normalizeAddressName lowercases s and drops everything but letters, digits
+// and single spaces, so "U.S.A." matches "usa".
+func normalizeAddressName(s string) string {
+	s = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "the ")
+	b := strings.Builder{}
+	space := false
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteRune(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == ',':
+			space = true
+		}
+	}
+	return b.String()
+}
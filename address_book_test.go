@@ -0,0 +1,107 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestAddressBook(t *testing.T) {
+	customer := netsuite.Customer{}
+	err := json.Unmarshal([]byte(`{"addressBook": {
+		"links": [],
+		"items": [
+			{"links": [], "id": 1, "internalId": 11, "label": "Office", "defaultBilling": true, "defaultShipping": false, "addressBookAddress": {"addr1": "Main St 1", "city": "Amsterdam", "country": {"id": "NL"}}, "addressBookAddress_text": "Main St 1\nAmsterdam"},
+			{"links": [], "id": 2, "internalId": 12, "label": "Warehouse", "defaultBilling": false, "defaultShipping": true, "addressBookAddress": {"addr1": "Dock 4", "city": "Rotterdam", "country": {"id": "NL"}}}
+		],
+		"totalResults": 2
+	}}`), &customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	book := customer.AddressBook
+	if billing, ok := book.DefaultBilling(); !ok || billing.Label != "Office" {
+		t.Errorf("expected the office to be the default billing address, got %+v", billing)
+	}
+	if shipping, ok := book.DefaultShipping(); !ok || shipping.Label != "Warehouse" {
+		t.Errorf("expected the warehouse to be the default shipping address, got %+v", shipping)
+	}
+
+	book.Upsert(netsuite.AddressBookItem{
+		Label:           "warehouse",
+		DefaultShipping: true,
+		DefaultBilling:  true,
+		AddressBookAddress: netsuite.AddressBookAddress{
+			Addr1:   "Dock 5",
+			City:    "Rotterdam",
+			Country: netsuite.RecordRef{RefName: "the Netherlands"},
+		}.Normalize(),
+	})
+	book.Upsert(netsuite.AddressBookItem{Label: "Store", AddressBookAddress: netsuite.AddressBookAddress{City: "Utrecht"}})
+
+	if len(book.Items) != 3 {
+		t.Fatalf("expected 3 addresses, got %d", len(book.Items))
+	}
+	warehouse, ok := book.Label("Warehouse")
+	if !ok || warehouse.InternalID != 12 || warehouse.AddressBookAddress.Addr1 != "Dock 5" {
+		t.Errorf("expected the warehouse to be updated in place, got %+v", warehouse)
+	}
+	if billing, _ := book.DefaultBilling(); billing.Label != "warehouse" {
+		t.Errorf("expected the warehouse to be the default billing address, got %+v", billing)
+	}
+
+	b, err := json.Marshal(book)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":[{"addressBookAddress":{"addr1":"Main St 1","city":"Amsterdam","country":{"id":"NL"}},"defaultBilling":false,"defaultShipping":false,"id":1,"internalId":11,"isResidential":false,"label":"Office"},` +
+		`{"addressBookAddress":{"addr1":"Dock 5","city":"Rotterdam","country":{"id":"NL"}},"defaultBilling":true,"defaultShipping":true,"id":2,"internalId":12,"isResidential":false,"label":"warehouse"},` +
+		`{"addressBookAddress":{"city":"Utrecht"},"defaultBilling":false,"defaultShipping":false,"isResidential":false,"label":"Store"}]}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestNormalizeCountry(t *testing.T) {
+	tests := map[string]string{
+		"NL":                       "NL",
+		"nld":                      "NL",
+		"The Netherlands":          "NL",
+		"U.S.A.":                   "US",
+		"United States of America": "US",
+		"united kingdom":           "GB",
+		"Korea, Republic of":       "KR",
+		"South Korea":              "KR",
+	}
+	for in, want := range tests {
+		got, ok := netsuite.NormalizeCountry(in)
+		if !ok || got != want {
+			t.Errorf("NormalizeCountry(%q): expected %s, got %s (%t)", in, want, got, ok)
+		}
+	}
+
+	if got, ok := netsuite.NormalizeCountry("Atlantis"); ok || got != "Atlantis" {
+		t.Errorf("expected unknown country to be returned as is, got %s (%t)", got, ok)
+	}
+}
+
+func TestNormalizeState(t *testing.T) {
+	tests := []struct {
+		country, state, want string
+	}{
+		{"US", "New York", "NY"},
+		{"US", "ny", "NY"},
+		{"CA", "Québec", "QC"},
+		{"CA", "quebec", "QC"},
+		{"AU", "New South Wales", "NSW"},
+		{"NL", " Noord-Holland ", "Noord-Holland"},
+	}
+	for _, tt := range tests {
+		got := netsuite.NormalizeState(tt.country, tt.state)
+		if got != tt.want {
+			t.Errorf("NormalizeState(%q, %q): expected %s, got %s", tt.country, tt.state, tt.want, got)
+		}
+	}
+}
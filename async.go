@@ -0,0 +1,169 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+// DefaultAsyncPollInterval is the interval WaitForAsyncJob polls the job
+// status with when no interval has been set on the client.
+var DefaultAsyncPollInterval = 2 * time.Second
+
+const (
+	AsyncJobProgressPending   = "pending"
+	AsyncJobProgressSucceeded = "succeeded"
+	AsyncJobProgressFailed    = "failed"
+)
+
+type AsyncJob struct {
+	Links     Links  `json:"links"`
+	ID        string `json:"id"`
+	Completed bool   `json:"completed"`
+	Progress  string `json:"progress"`
+}
+
+type AsyncJobTasks struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links Links  `json:"links"`
+		ID    string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+// AsyncJobResult is the outcome of the request that was executed
+// asynchronously.
+type AsyncJobResult struct {
+	Job AsyncJob
+	// StatusCode is the status code of the original request
+	StatusCode int
+	// Location is the link to the created record, if any
+	Location string
+	// Body is the response body of the original request, if any
+	Body json.RawMessage
+}
+
+// Unmarshal decodes the result body into v.
+func (r AsyncJobResult) Unmarshal(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Body, v)
+}
+
+func (c *Client) SetAsyncPollInterval(interval time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.asyncPollInterval = interval
+}
+
+func (c *Client) AsyncPollInterval() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.asyncPollInterval == 0 {
+		return DefaultAsyncPollInterval
+	}
+	return c.asyncPollInterval
+}
+
+// DoAsync sends the request with "Prefer: respond-async" and returns the
+// location of the job NetSuite created for it. Use WaitForAsyncJob to wait for
+// the job and fetch its result.
+func (c *Client) DoAsync(ctx context.Context, r Request) (string, error) {
+	req, err := c.NewRequest(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	// requests don't expose their query params through the Request interface
+	if m := reflect.ValueOf(r).MethodByName("QueryParams"); m.IsValid() && m.Type().NumIn() == 0 {
+		if qp, ok := m.Call(nil)[0].Interface().(QueryParams); ok {
+			err = utils.AddQueryParamsToRequest(qp, req, false)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	req.Header.Set("Prefer", "respond-async")
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return "", err
+	}
+
+	location := resp.Header.Get("Location")
+	if resp.StatusCode != http.StatusAccepted || location == "" {
+		return "", fmt.Errorf("expected 202 Accepted with a job location, got %s", resp.Status)
+	}
+
+	return location, nil
+}
+
+// WaitForAsyncJob polls the job at location (as returned by DoAsync) until it
+// has completed and returns the result of the original request.
+func (c *Client) WaitForAsyncJob(ctx context.Context, location string) (AsyncJobResult, error) {
+	result := AsyncJobResult{}
+	location = strings.TrimSuffix(location, "/")
+
+	for {
+		job := AsyncJob{}
+		_, err := c.getAsync(ctx, location, &job)
+		if err != nil {
+			return result, err
+		}
+		result.Job = job
+
+		if job.Completed {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(c.AsyncPollInterval()):
+		}
+	}
+
+	tasks := AsyncJobTasks{}
+	_, err := c.getAsync(ctx, location+"/task", &tasks)
+	if err != nil {
+		return result, err
+	}
+	if len(tasks.Items) == 0 {
+		return result, fmt.Errorf("async job %s has no tasks", result.Job.ID)
+	}
+
+	body := json.RawMessage{}
+	resp, err := c.getAsync(ctx, location+"/task/"+tasks.Items[0].ID+"/result", &body)
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+		result.Location = resp.Header.Get("Location")
+	}
+	result.Body = body
+	return result, err
+}
+
+func (c *Client) getAsync(ctx context.Context, u string, body interface{}) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	req.Header.Add("Accept", c.MediaType())
+	req.Header.Add("User-Agent", c.UserAgent())
+	return c.Do(req, body)
+}
@@ -0,0 +1,29 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomerPostAsync(t *testing.T) {
+	req := client.NewCustomerPostRequest()
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().IsPerson = true
+	req.RequestBody().FirstName = "Kees"
+	req.RequestBody().LastName = "Zorge"
+
+	location, err := client.DoAsync(context.Background(), &req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.WaitForAsyncJob(context.Background(), location)
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(result, "", "  ")
+	log.Println(string(b))
+}
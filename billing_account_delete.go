@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBillingAccountDeleteRequest() BillingAccountDeleteRequest {
+	r := BillingAccountDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BillingAccountDeleteRequest struct {
+	client      *Client
+	queryParams *BillingAccountDeleteRequestQueryParams
+	pathParams  *BillingAccountDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BillingAccountDeleteRequestBody
+}
+
+func (r BillingAccountDeleteRequest) NewQueryParams() *BillingAccountDeleteRequestQueryParams {
+	return &BillingAccountDeleteRequestQueryParams{}
+}
+
+type BillingAccountDeleteRequestQueryParams struct {
+}
+
+func (p BillingAccountDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BillingAccountDeleteRequest) QueryParams() *BillingAccountDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BillingAccountDeleteRequest) NewPathParams() *BillingAccountDeleteRequestPathParams {
+	return &BillingAccountDeleteRequestPathParams{}
+}
+
+type BillingAccountDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BillingAccountDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BillingAccountDeleteRequest) PathParams() *BillingAccountDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BillingAccountDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *BillingAccountDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BillingAccountDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BillingAccountDeleteRequest) NewRequestBody() BillingAccountDeleteRequestBody {
+	return BillingAccountDeleteRequestBody{}
+}
+
+type BillingAccountDeleteRequestBody struct {
+}
+
+func (r *BillingAccountDeleteRequest) RequestBody() *BillingAccountDeleteRequestBody {
+	return nil
+}
+
+func (r *BillingAccountDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BillingAccountDeleteRequest) SetRequestBody(body BillingAccountDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BillingAccountDeleteRequest) NewResponseBody() *BillingAccountDeleteResponseBody {
+	return &BillingAccountDeleteResponseBody{}
+}
+
+type BillingAccountDeleteResponseBody struct {
+}
+
+func (r *BillingAccountDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/billingAccount/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BillingAccountDeleteRequest) Do(ctx context.Context) (BillingAccountDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
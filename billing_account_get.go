@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBillingAccountGetRequest() BillingAccountGetRequest {
+	r := BillingAccountGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BillingAccountGetRequest struct {
+	client      *Client
+	queryParams *BillingAccountGetRequestQueryParams
+	pathParams  *BillingAccountGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BillingAccountGetRequestBody
+}
+
+func (r BillingAccountGetRequest) NewQueryParams() *BillingAccountGetRequestQueryParams {
+	return &BillingAccountGetRequestQueryParams{}
+}
+
+type BillingAccountGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BillingAccountGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BillingAccountGetRequest) QueryParams() *BillingAccountGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BillingAccountGetRequest) NewPathParams() *BillingAccountGetRequestPathParams {
+	return &BillingAccountGetRequestPathParams{}
+}
+
+type BillingAccountGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BillingAccountGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BillingAccountGetRequest) PathParams() *BillingAccountGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BillingAccountGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BillingAccountGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BillingAccountGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BillingAccountGetRequest) NewRequestBody() BillingAccountGetRequestBody {
+	return BillingAccountGetRequestBody{}
+}
+
+type BillingAccountGetRequestBody struct {
+}
+
+func (r *BillingAccountGetRequest) RequestBody() *BillingAccountGetRequestBody {
+	return nil
+}
+
+func (r *BillingAccountGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BillingAccountGetRequest) SetRequestBody(body BillingAccountGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BillingAccountGetRequest) NewResponseBody() *BillingAccountGetResponseBody {
+	return &BillingAccountGetResponseBody{}
+}
+
+type BillingAccountGetResponseBody struct {
+	Links Links `json:"links"`
+	BillingAccount
+}
+
+func (r *BillingAccountGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/billingAccount/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BillingAccountGetRequest) Do(ctx context.Context) (BillingAccountGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
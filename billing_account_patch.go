@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBillingAccountPatchRequest() BillingAccountPatchRequest {
+	r := BillingAccountPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BillingAccountPatchRequest struct {
+	client      *Client
+	queryParams *BillingAccountPatchRequestQueryParams
+	pathParams  *BillingAccountPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BillingAccountPatchRequestBody
+}
+
+func (r BillingAccountPatchRequest) NewQueryParams() *BillingAccountPatchRequestQueryParams {
+	return &BillingAccountPatchRequestQueryParams{}
+}
+
+type BillingAccountPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p BillingAccountPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BillingAccountPatchRequest) QueryParams() *BillingAccountPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BillingAccountPatchRequest) NewPathParams() *BillingAccountPatchRequestPathParams {
+	return &BillingAccountPatchRequestPathParams{}
+}
+
+type BillingAccountPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BillingAccountPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BillingAccountPatchRequest) PathParams() *BillingAccountPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BillingAccountPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *BillingAccountPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BillingAccountPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BillingAccountPatchRequest) NewRequestBody() BillingAccountPatchRequestBody {
+	return BillingAccountPatchRequestBody{}
+}
+
+type BillingAccountPatchRequestBody struct {
+	BillingAccount
+}
+
+func (r *BillingAccountPatchRequest) RequestBody() *BillingAccountPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *BillingAccountPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BillingAccountPatchRequest) SetRequestBody(body BillingAccountPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BillingAccountPatchRequest) NewResponseBody() *BillingAccountPatchResponseBody {
+	return &BillingAccountPatchResponseBody{}
+}
+
+type BillingAccountPatchResponseBody struct {
+}
+
+func (r *BillingAccountPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/billingAccount/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BillingAccountPatchRequest) Do(ctx context.Context) (BillingAccountPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
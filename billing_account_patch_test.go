@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBillingAccountPatch(t *testing.T) {
+	req := client.NewBillingAccountPatchRequest()
+	req.PathParams().ID = 5
+	req.RequestBody().Memo = "Moved to quarterly billing"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
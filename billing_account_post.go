@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBillingAccountPostRequest() BillingAccountPostRequest {
+	r := BillingAccountPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BillingAccountPostRequest struct {
+	client      *Client
+	queryParams *BillingAccountPostRequestQueryParams
+	pathParams  *BillingAccountPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BillingAccountPostRequestBody
+}
+
+func (r BillingAccountPostRequest) NewQueryParams() *BillingAccountPostRequestQueryParams {
+	return &BillingAccountPostRequestQueryParams{}
+}
+
+type BillingAccountPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BillingAccountPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BillingAccountPostRequest) QueryParams() *BillingAccountPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BillingAccountPostRequest) NewPathParams() *BillingAccountPostRequestPathParams {
+	return &BillingAccountPostRequestPathParams{}
+}
+
+type BillingAccountPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BillingAccountPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BillingAccountPostRequest) PathParams() *BillingAccountPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BillingAccountPostRequest) Method() string {
+	return r.method
+}
+
+func (r *BillingAccountPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BillingAccountPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BillingAccountPostRequest) NewRequestBody() BillingAccountPostRequestBody {
+	return BillingAccountPostRequestBody{}
+}
+
+type BillingAccountPostRequestBody struct {
+	BillingAccount
+}
+
+func (r *BillingAccountPostRequest) RequestBody() *BillingAccountPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *BillingAccountPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BillingAccountPostRequest) SetRequestBody(body BillingAccountPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BillingAccountPostRequest) NewResponseBody() *BillingAccountPostResponseBody {
+	return &BillingAccountPostResponseBody{}
+}
+
+type BillingAccountPostResponseBody struct {
+}
+
+func (r *BillingAccountPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/billingAccount", r.PathParams())
+	return &u, err
+}
+
+func (r *BillingAccountPostRequest) Do(ctx context.Context) (BillingAccountPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
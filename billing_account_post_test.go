@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBillingAccountPost(t *testing.T) {
+	req := client.NewBillingAccountPostRequest()
+	req.RequestBody().BillingAccount.ID = "70202"
+	req.RequestBody().Name = "Omniboost monthly"
+	req.RequestBody().BillingSchedule.ID = "1"
+	req.RequestBody().Subsidiary.ID = "46"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBillingAccountsGetRequest() BillingAccountsGetRequest {
+	r := BillingAccountsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BillingAccountsGetRequest struct {
+	client      *Client
+	queryParams *BillingAccountsGetRequestQueryParams
+	pathParams  *BillingAccountsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BillingAccountsGetRequestBody
+}
+
+func (r BillingAccountsGetRequest) NewQueryParams() *BillingAccountsGetRequestQueryParams {
+	return &BillingAccountsGetRequestQueryParams{}
+}
+
+type BillingAccountsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p BillingAccountsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BillingAccountsGetRequest) QueryParams() *BillingAccountsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BillingAccountsGetRequest) NewPathParams() *BillingAccountsGetRequestPathParams {
+	return &BillingAccountsGetRequestPathParams{}
+}
+
+type BillingAccountsGetRequestPathParams struct {
+}
+
+func (p *BillingAccountsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *BillingAccountsGetRequest) PathParams() *BillingAccountsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BillingAccountsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BillingAccountsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BillingAccountsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BillingAccountsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BillingAccountsGetRequest) NewRequestBody() BillingAccountsGetRequestBody {
+	return BillingAccountsGetRequestBody{}
+}
+
+type BillingAccountsGetRequestBody struct {
+}
+
+func (r *BillingAccountsGetRequest) RequestBody() *BillingAccountsGetRequestBody {
+	return nil
+}
+
+func (r *BillingAccountsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BillingAccountsGetRequest) SetRequestBody(body BillingAccountsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BillingAccountsGetRequest) NewResponseBody() *BillingAccountsGetResponseBody {
+	return &BillingAccountsGetResponseBody{}
+}
+
+type BillingAccountsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *BillingAccountsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/billingAccount", r.PathParams())
+	return &u, err
+}
+
+func (r *BillingAccountsGetRequest) Do(ctx context.Context) (BillingAccountsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
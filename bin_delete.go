@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinDeleteRequest() BinDeleteRequest {
+	r := BinDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinDeleteRequest struct {
+	client      *Client
+	queryParams *BinDeleteRequestQueryParams
+	pathParams  *BinDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinDeleteRequestBody
+}
+
+func (r BinDeleteRequest) NewQueryParams() *BinDeleteRequestQueryParams {
+	return &BinDeleteRequestQueryParams{}
+}
+
+type BinDeleteRequestQueryParams struct {
+}
+
+func (p BinDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinDeleteRequest) QueryParams() *BinDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinDeleteRequest) NewPathParams() *BinDeleteRequestPathParams {
+	return &BinDeleteRequestPathParams{}
+}
+
+type BinDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinDeleteRequest) PathParams() *BinDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *BinDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinDeleteRequest) NewRequestBody() BinDeleteRequestBody {
+	return BinDeleteRequestBody{}
+}
+
+type BinDeleteRequestBody struct {
+}
+
+func (r *BinDeleteRequest) RequestBody() *BinDeleteRequestBody {
+	return nil
+}
+
+func (r *BinDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinDeleteRequest) SetRequestBody(body BinDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinDeleteRequest) NewResponseBody() *BinDeleteResponseBody {
+	return &BinDeleteResponseBody{}
+}
+
+type BinDeleteResponseBody struct {
+}
+
+func (r *BinDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/bin/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinDeleteRequest) Do(ctx context.Context) (BinDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinGetRequest() BinGetRequest {
+	r := BinGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinGetRequest struct {
+	client      *Client
+	queryParams *BinGetRequestQueryParams
+	pathParams  *BinGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinGetRequestBody
+}
+
+func (r BinGetRequest) NewQueryParams() *BinGetRequestQueryParams {
+	return &BinGetRequestQueryParams{}
+}
+
+type BinGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinGetRequest) QueryParams() *BinGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinGetRequest) NewPathParams() *BinGetRequestPathParams {
+	return &BinGetRequestPathParams{}
+}
+
+type BinGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinGetRequest) PathParams() *BinGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinGetRequest) NewRequestBody() BinGetRequestBody {
+	return BinGetRequestBody{}
+}
+
+type BinGetRequestBody struct {
+}
+
+func (r *BinGetRequest) RequestBody() *BinGetRequestBody {
+	return nil
+}
+
+func (r *BinGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinGetRequest) SetRequestBody(body BinGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinGetRequest) NewResponseBody() *BinGetResponseBody {
+	return &BinGetResponseBody{}
+}
+
+type BinGetResponseBody struct {
+	Links Links `json:"links"`
+	Bin
+}
+
+func (r *BinGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/bin/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinGetRequest) Do(ctx context.Context) (BinGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinPatchRequest() BinPatchRequest {
+	r := BinPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinPatchRequest struct {
+	client      *Client
+	queryParams *BinPatchRequestQueryParams
+	pathParams  *BinPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinPatchRequestBody
+}
+
+func (r BinPatchRequest) NewQueryParams() *BinPatchRequestQueryParams {
+	return &BinPatchRequestQueryParams{}
+}
+
+type BinPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p BinPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinPatchRequest) QueryParams() *BinPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinPatchRequest) NewPathParams() *BinPatchRequestPathParams {
+	return &BinPatchRequestPathParams{}
+}
+
+type BinPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinPatchRequest) PathParams() *BinPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *BinPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinPatchRequest) NewRequestBody() BinPatchRequestBody {
+	return BinPatchRequestBody{}
+}
+
+type BinPatchRequestBody struct {
+	Bin
+}
+
+func (r *BinPatchRequest) RequestBody() *BinPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *BinPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BinPatchRequest) SetRequestBody(body BinPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinPatchRequest) NewResponseBody() *BinPatchResponseBody {
+	return &BinPatchResponseBody{}
+}
+
+type BinPatchResponseBody struct {
+}
+
+func (r *BinPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/bin/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinPatchRequest) Do(ctx context.Context) (BinPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
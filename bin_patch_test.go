@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBinPatch(t *testing.T) {
+	req := client.NewBinPatchRequest()
+	req.PathParams().ID = 12
+	req.RequestBody().IsInactive = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
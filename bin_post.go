@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinPostRequest() BinPostRequest {
+	r := BinPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinPostRequest struct {
+	client      *Client
+	queryParams *BinPostRequestQueryParams
+	pathParams  *BinPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinPostRequestBody
+}
+
+func (r BinPostRequest) NewQueryParams() *BinPostRequestQueryParams {
+	return &BinPostRequestQueryParams{}
+}
+
+type BinPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinPostRequest) QueryParams() *BinPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinPostRequest) NewPathParams() *BinPostRequestPathParams {
+	return &BinPostRequestPathParams{}
+}
+
+type BinPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinPostRequest) PathParams() *BinPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinPostRequest) Method() string {
+	return r.method
+}
+
+func (r *BinPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinPostRequest) NewRequestBody() BinPostRequestBody {
+	return BinPostRequestBody{}
+}
+
+type BinPostRequestBody struct {
+	Bin
+}
+
+func (r *BinPostRequest) RequestBody() *BinPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *BinPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BinPostRequest) SetRequestBody(body BinPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinPostRequest) NewResponseBody() *BinPostResponseBody {
+	return &BinPostResponseBody{}
+}
+
+type BinPostResponseBody struct {
+}
+
+func (r *BinPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/bin", r.PathParams())
+	return &u, err
+}
+
+func (r *BinPostRequest) Do(ctx context.Context) (BinPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
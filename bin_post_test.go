@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBinPost(t *testing.T) {
+	req := client.NewBinPostRequest()
+	req.RequestBody().BinNumber = "A-01-03"
+	req.RequestBody().Location.ID = "5"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
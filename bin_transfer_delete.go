@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinTransferDeleteRequest() BinTransferDeleteRequest {
+	r := BinTransferDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinTransferDeleteRequest struct {
+	client      *Client
+	queryParams *BinTransferDeleteRequestQueryParams
+	pathParams  *BinTransferDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinTransferDeleteRequestBody
+}
+
+func (r BinTransferDeleteRequest) NewQueryParams() *BinTransferDeleteRequestQueryParams {
+	return &BinTransferDeleteRequestQueryParams{}
+}
+
+type BinTransferDeleteRequestQueryParams struct {
+}
+
+func (p BinTransferDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinTransferDeleteRequest) QueryParams() *BinTransferDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinTransferDeleteRequest) NewPathParams() *BinTransferDeleteRequestPathParams {
+	return &BinTransferDeleteRequestPathParams{}
+}
+
+type BinTransferDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinTransferDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinTransferDeleteRequest) PathParams() *BinTransferDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinTransferDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *BinTransferDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinTransferDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinTransferDeleteRequest) NewRequestBody() BinTransferDeleteRequestBody {
+	return BinTransferDeleteRequestBody{}
+}
+
+type BinTransferDeleteRequestBody struct {
+}
+
+func (r *BinTransferDeleteRequest) RequestBody() *BinTransferDeleteRequestBody {
+	return nil
+}
+
+func (r *BinTransferDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinTransferDeleteRequest) SetRequestBody(body BinTransferDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinTransferDeleteRequest) NewResponseBody() *BinTransferDeleteResponseBody {
+	return &BinTransferDeleteResponseBody{}
+}
+
+type BinTransferDeleteResponseBody struct {
+}
+
+func (r *BinTransferDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binTransfer/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinTransferDeleteRequest) Do(ctx context.Context) (BinTransferDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
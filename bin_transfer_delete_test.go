@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBinTransferDelete(t *testing.T) {
+	req := client.NewBinTransferDeleteRequest()
+	req.PathParams().ID = 9320
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinTransferGetRequest() BinTransferGetRequest {
+	r := BinTransferGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinTransferGetRequest struct {
+	client      *Client
+	queryParams *BinTransferGetRequestQueryParams
+	pathParams  *BinTransferGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinTransferGetRequestBody
+}
+
+func (r BinTransferGetRequest) NewQueryParams() *BinTransferGetRequestQueryParams {
+	return &BinTransferGetRequestQueryParams{}
+}
+
+type BinTransferGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinTransferGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinTransferGetRequest) QueryParams() *BinTransferGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinTransferGetRequest) NewPathParams() *BinTransferGetRequestPathParams {
+	return &BinTransferGetRequestPathParams{}
+}
+
+type BinTransferGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinTransferGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinTransferGetRequest) PathParams() *BinTransferGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinTransferGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinTransferGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinTransferGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinTransferGetRequest) NewRequestBody() BinTransferGetRequestBody {
+	return BinTransferGetRequestBody{}
+}
+
+type BinTransferGetRequestBody struct {
+}
+
+func (r *BinTransferGetRequest) RequestBody() *BinTransferGetRequestBody {
+	return nil
+}
+
+func (r *BinTransferGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinTransferGetRequest) SetRequestBody(body BinTransferGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinTransferGetRequest) NewResponseBody() *BinTransferGetResponseBody {
+	return &BinTransferGetResponseBody{}
+}
+
+type BinTransferGetResponseBody struct {
+	Links Links `json:"links"`
+	BinTransfer
+}
+
+func (r *BinTransferGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binTransfer/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinTransferGetRequest) Do(ctx context.Context) (BinTransferGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
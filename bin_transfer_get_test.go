@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBinTransferGet(t *testing.T) {
+	req := client.NewBinTransferGetRequest()
+	req.PathParams().ID = 9320
+	// req.QueryParams().Fields = netsuite.Fields{"line"}
+	req.QueryParams().ExpandSubResources = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinTransferPostRequest() BinTransferPostRequest {
+	r := BinTransferPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinTransferPostRequest struct {
+	client      *Client
+	queryParams *BinTransferPostRequestQueryParams
+	pathParams  *BinTransferPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinTransferPostRequestBody
+}
+
+func (r BinTransferPostRequest) NewQueryParams() *BinTransferPostRequestQueryParams {
+	return &BinTransferPostRequestQueryParams{}
+}
+
+type BinTransferPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinTransferPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinTransferPostRequest) QueryParams() *BinTransferPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinTransferPostRequest) NewPathParams() *BinTransferPostRequestPathParams {
+	return &BinTransferPostRequestPathParams{}
+}
+
+type BinTransferPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinTransferPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinTransferPostRequest) PathParams() *BinTransferPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinTransferPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinTransferPostRequest) Method() string {
+	return r.method
+}
+
+func (r *BinTransferPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinTransferPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinTransferPostRequest) NewRequestBody() BinTransferPostRequestBody {
+	return BinTransferPostRequestBody{}
+}
+
+type BinTransferPostRequestBody struct {
+	BinTransfer
+}
+
+func (r *BinTransferPostRequest) RequestBody() *BinTransferPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *BinTransferPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BinTransferPostRequest) SetRequestBody(body BinTransferPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinTransferPostRequest) NewResponseBody() *BinTransferPostResponseBody {
+	return &BinTransferPostResponseBody{}
+}
+
+type BinTransferPostResponseBody struct {
+}
+
+func (r *BinTransferPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binTransfer", r.PathParams())
+	return &u, err
+}
+
+func (r *BinTransferPostRequest) Do(ctx context.Context) (BinTransferPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,42 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestBinTransferPost(t *testing.T) {
+	req := client.NewBinTransferPostRequest()
+	req.RequestBody().Location.ID = "5"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Inventory = netsuite.BinTransferInventory{
+		Items: netsuite.BinTransferInventoryItems{
+			{
+				Item:     netsuite.RecordRef{ID: "131"},
+				Quantity: 3,
+				InventoryDetail: netsuite.InventoryDetail{
+					InventoryAssignment: netsuite.InventoryDetailAssignments{
+						Items: netsuite.InventoryDetailAssignmentItems{
+							{
+								BinNumber:   netsuite.RecordRef{ID: "12"},
+								ToBinNumber: netsuite.RecordRef{ID: "13"},
+								Quantity:    3,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinTransfersGetRequest() BinTransfersGetRequest {
+	r := BinTransfersGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinTransfersGetRequest struct {
+	client      *Client
+	queryParams *BinTransfersGetRequestQueryParams
+	pathParams  *BinTransfersGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinTransfersGetRequestBody
+}
+
+func (r BinTransfersGetRequest) NewQueryParams() *BinTransfersGetRequestQueryParams {
+	return &BinTransfersGetRequestQueryParams{}
+}
+
+type BinTransfersGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p BinTransfersGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinTransfersGetRequest) QueryParams() *BinTransfersGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinTransfersGetRequest) NewPathParams() *BinTransfersGetRequestPathParams {
+	return &BinTransfersGetRequestPathParams{}
+}
+
+type BinTransfersGetRequestPathParams struct {
+}
+
+func (p *BinTransfersGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *BinTransfersGetRequest) PathParams() *BinTransfersGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinTransfersGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinTransfersGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinTransfersGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinTransfersGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinTransfersGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinTransfersGetRequest) NewRequestBody() BinTransfersGetRequestBody {
+	return BinTransfersGetRequestBody{}
+}
+
+type BinTransfersGetRequestBody struct {
+}
+
+func (r *BinTransfersGetRequest) RequestBody() *BinTransfersGetRequestBody {
+	return nil
+}
+
+func (r *BinTransfersGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinTransfersGetRequest) SetRequestBody(body BinTransfersGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinTransfersGetRequest) NewResponseBody() *BinTransfersGetResponseBody {
+	return &BinTransfersGetResponseBody{}
+}
+
+type BinTransfersGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *BinTransfersGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binTransfer", r.PathParams())
+	return &u, err
+}
+
+func (r *BinTransfersGetRequest) Do(ctx context.Context) (BinTransfersGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
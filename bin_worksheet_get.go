@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinWorksheetGetRequest() BinWorksheetGetRequest {
+	r := BinWorksheetGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinWorksheetGetRequest struct {
+	client      *Client
+	queryParams *BinWorksheetGetRequestQueryParams
+	pathParams  *BinWorksheetGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinWorksheetGetRequestBody
+}
+
+func (r BinWorksheetGetRequest) NewQueryParams() *BinWorksheetGetRequestQueryParams {
+	return &BinWorksheetGetRequestQueryParams{}
+}
+
+type BinWorksheetGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinWorksheetGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinWorksheetGetRequest) QueryParams() *BinWorksheetGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinWorksheetGetRequest) NewPathParams() *BinWorksheetGetRequestPathParams {
+	return &BinWorksheetGetRequestPathParams{}
+}
+
+type BinWorksheetGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinWorksheetGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinWorksheetGetRequest) PathParams() *BinWorksheetGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinWorksheetGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinWorksheetGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinWorksheetGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinWorksheetGetRequest) NewRequestBody() BinWorksheetGetRequestBody {
+	return BinWorksheetGetRequestBody{}
+}
+
+type BinWorksheetGetRequestBody struct {
+}
+
+func (r *BinWorksheetGetRequest) RequestBody() *BinWorksheetGetRequestBody {
+	return nil
+}
+
+func (r *BinWorksheetGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinWorksheetGetRequest) SetRequestBody(body BinWorksheetGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinWorksheetGetRequest) NewResponseBody() *BinWorksheetGetResponseBody {
+	return &BinWorksheetGetResponseBody{}
+}
+
+type BinWorksheetGetResponseBody struct {
+	Links Links `json:"links"`
+	BinWorksheet
+}
+
+func (r *BinWorksheetGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binWorksheet/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BinWorksheetGetRequest) Do(ctx context.Context) (BinWorksheetGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinWorksheetPostRequest() BinWorksheetPostRequest {
+	r := BinWorksheetPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinWorksheetPostRequest struct {
+	client      *Client
+	queryParams *BinWorksheetPostRequestQueryParams
+	pathParams  *BinWorksheetPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinWorksheetPostRequestBody
+}
+
+func (r BinWorksheetPostRequest) NewQueryParams() *BinWorksheetPostRequestQueryParams {
+	return &BinWorksheetPostRequestQueryParams{}
+}
+
+type BinWorksheetPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BinWorksheetPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinWorksheetPostRequest) QueryParams() *BinWorksheetPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinWorksheetPostRequest) NewPathParams() *BinWorksheetPostRequestPathParams {
+	return &BinWorksheetPostRequestPathParams{}
+}
+
+type BinWorksheetPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BinWorksheetPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BinWorksheetPostRequest) PathParams() *BinWorksheetPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinWorksheetPostRequest) Method() string {
+	return r.method
+}
+
+func (r *BinWorksheetPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinWorksheetPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinWorksheetPostRequest) NewRequestBody() BinWorksheetPostRequestBody {
+	return BinWorksheetPostRequestBody{}
+}
+
+type BinWorksheetPostRequestBody struct {
+	BinWorksheet
+}
+
+func (r *BinWorksheetPostRequest) RequestBody() *BinWorksheetPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *BinWorksheetPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BinWorksheetPostRequest) SetRequestBody(body BinWorksheetPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinWorksheetPostRequest) NewResponseBody() *BinWorksheetPostResponseBody {
+	return &BinWorksheetPostResponseBody{}
+}
+
+type BinWorksheetPostResponseBody struct {
+}
+
+func (r *BinWorksheetPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binWorksheet", r.PathParams())
+	return &u, err
+}
+
+func (r *BinWorksheetPostRequest) Do(ctx context.Context) (BinWorksheetPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBinWorksheetPost(t *testing.T) {
+	req := client.NewBinWorksheetPostRequest()
+	req.RequestBody().Location.ID = "5"
+	req.RequestBody().Subsidiary.ID = "46"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
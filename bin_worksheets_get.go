@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinWorksheetsGetRequest() BinWorksheetsGetRequest {
+	r := BinWorksheetsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinWorksheetsGetRequest struct {
+	client      *Client
+	queryParams *BinWorksheetsGetRequestQueryParams
+	pathParams  *BinWorksheetsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinWorksheetsGetRequestBody
+}
+
+func (r BinWorksheetsGetRequest) NewQueryParams() *BinWorksheetsGetRequestQueryParams {
+	return &BinWorksheetsGetRequestQueryParams{}
+}
+
+type BinWorksheetsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p BinWorksheetsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinWorksheetsGetRequest) QueryParams() *BinWorksheetsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinWorksheetsGetRequest) NewPathParams() *BinWorksheetsGetRequestPathParams {
+	return &BinWorksheetsGetRequestPathParams{}
+}
+
+type BinWorksheetsGetRequestPathParams struct {
+}
+
+func (p *BinWorksheetsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *BinWorksheetsGetRequest) PathParams() *BinWorksheetsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinWorksheetsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinWorksheetsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinWorksheetsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinWorksheetsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinWorksheetsGetRequest) NewRequestBody() BinWorksheetsGetRequestBody {
+	return BinWorksheetsGetRequestBody{}
+}
+
+type BinWorksheetsGetRequestBody struct {
+}
+
+func (r *BinWorksheetsGetRequest) RequestBody() *BinWorksheetsGetRequestBody {
+	return nil
+}
+
+func (r *BinWorksheetsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinWorksheetsGetRequest) SetRequestBody(body BinWorksheetsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinWorksheetsGetRequest) NewResponseBody() *BinWorksheetsGetResponseBody {
+	return &BinWorksheetsGetResponseBody{}
+}
+
+type BinWorksheetsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *BinWorksheetsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/binWorksheet", r.PathParams())
+	return &u, err
+}
+
+func (r *BinWorksheetsGetRequest) Do(ctx context.Context) (BinWorksheetsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBinsGetRequest() BinsGetRequest {
+	r := BinsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BinsGetRequest struct {
+	client      *Client
+	queryParams *BinsGetRequestQueryParams
+	pathParams  *BinsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BinsGetRequestBody
+}
+
+func (r BinsGetRequest) NewQueryParams() *BinsGetRequestQueryParams {
+	return &BinsGetRequestQueryParams{}
+}
+
+type BinsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p BinsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BinsGetRequest) QueryParams() *BinsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BinsGetRequest) NewPathParams() *BinsGetRequestPathParams {
+	return &BinsGetRequestPathParams{}
+}
+
+type BinsGetRequestPathParams struct {
+}
+
+func (p *BinsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *BinsGetRequest) PathParams() *BinsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BinsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BinsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BinsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BinsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BinsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BinsGetRequest) NewRequestBody() BinsGetRequestBody {
+	return BinsGetRequestBody{}
+}
+
+type BinsGetRequestBody struct {
+}
+
+func (r *BinsGetRequest) RequestBody() *BinsGetRequestBody {
+	return nil
+}
+
+func (r *BinsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BinsGetRequest) SetRequestBody(body BinsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BinsGetRequest) NewResponseBody() *BinsGetResponseBody {
+	return &BinsGetResponseBody{}
+}
+
+type BinsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *BinsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/bin", r.PathParams())
+	return &u, err
+}
+
+func (r *BinsGetRequest) Do(ctx context.Context) (BinsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
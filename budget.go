@@ -0,0 +1,283 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/omniboost/go-netsuite-rest/omitempty"
+)
+
+// budgetPeriodAmountPrefix prefixes the numbered period amount fields of the
+// budget record: periodAmount1, periodAmount2...
+const budgetPeriodAmountPrefix = "periodAmount"
+
+// MarshalJSON writes the period amounts as the numbered periodAmount fields.
+// Every period in PeriodAmounts is written, zero amounts included, so a
+// period can be cleared.
+func (b Budget) MarshalJSON() ([]byte, error) {
+	type alias Budget
+	data, err := omitempty.MarshalJSON(alias(b))
+	if err != nil {
+		return nil, err
+	}
+	if len(b.PeriodAmounts) == 0 {
+		return data, nil
+	}
+
+	props := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &props)
+	if err != nil {
+		return nil, err
+	}
+	for period, amount := range b.PeriodAmounts {
+		props[budgetPeriodAmountPrefix+strconv.Itoa(period)] = json.RawMessage(amount.String())
+	}
+	return json.Marshal(props)
+}
+
+func (b *Budget) UnmarshalJSON(data []byte) error {
+	type alias Budget
+	a := alias{}
+	err := json.Unmarshal(data, &a)
+	if err != nil {
+		return err
+	}
+
+	props := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &props)
+	if err != nil {
+		return err
+	}
+	for k, raw := range props {
+		period, err := strconv.Atoi(strings.TrimPrefix(k, budgetPeriodAmountPrefix))
+		if !strings.HasPrefix(k, budgetPeriodAmountPrefix) || err != nil {
+			continue
+		}
+
+		amount := Decimal{}
+		err = amount.UnmarshalJSON(raw)
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+		if a.PeriodAmounts == nil {
+			a.PeriodAmounts = map[int]Decimal{}
+		}
+		a.PeriodAmounts[period] = amount
+	}
+
+	*b = Budget(a)
+	return nil
+}
+
+// isExtraField reports whether name is one of the periodAmount fields
+// UnmarshalJSON takes.
+func (b Budget) isExtraField(name string) bool {
+	_, err := strconv.Atoi(strings.TrimPrefix(name, budgetPeriodAmountPrefix))
+	return strings.HasPrefix(name, budgetPeriodAmountPrefix) && err == nil
+}
+
+// SetPeriodAmount sets the amount of a period of the year, 1 based.
+func (b *Budget) SetPeriodAmount(period int, amount Decimal) {
+	if b.PeriodAmounts == nil {
+		b.PeriodAmounts = map[int]Decimal{}
+	}
+	b.PeriodAmounts[period] = amount
+}
+
+// BudgetFilter selects budgets by internal id. Empty fields match every
+// budget.
+type BudgetFilter struct {
+	ID         string
+	Year       string
+	Category   string
+	Subsidiary string
+	Account    string
+}
+
+// NewBudgetsQueryRequest returns a SuiteQL request that selects the budgets
+// matching filter with their amounts per accounting period, one row per
+// budget and period. Decode the response with ToBudgets.
+func (c *Client) NewBudgetsQueryRequest(filter BudgetFilter) SuiteqlPostRequest {
+	r := c.NewSuiteqlPostRequest()
+	r.RequestBody().Q = budgetsQuery(filter)
+	return r
+}
+
+func budgetsQuery(filter BudgetFilter) string {
+	conditions := []string{}
+	for _, c := range []struct{ column, value string }{
+		{"b.id", filter.ID},
+		{"b.year", filter.Year},
+		{"b.category", filter.Category},
+		{"b.subsidiary", filter.Subsidiary},
+		{"b.account", filter.Account},
+	} {
+		if c.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s = '%s'", c.column, strings.Replace(c.value, "'", "''", -1)))
+		}
+	}
+
+	q := "SELECT b.id, b.year, BUILTIN.DF(b.year) AS yearname, b.category, BUILTIN.DF(b.category) AS categoryname, " +
+		"b.subsidiary, BUILTIN.DF(b.subsidiary) AS subsidiaryname, b.account, BUILTIN.DF(b.account) AS accountname, " +
+		"b.department, b.class, b.location, b.customer, b.item, b.currency, b.total, " +
+		"m.period, BUILTIN.DF(m.period) AS periodname, m.amount " +
+		"FROM budgets b LEFT JOIN budgetsmachine m ON m.budget = b.id"
+	if len(conditions) > 0 {
+		q += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return q + " ORDER BY b.id, m.period"
+}
+
+// budgetRow is a budget and the amount of one of its periods as SuiteQL
+// returns them.
+type budgetRow struct {
+	Links          Links   `json:"links"`
+	ID             string  `json:"id"`
+	Year           string  `json:"year"`
+	YearName       string  `json:"yearname"`
+	Category       string  `json:"category"`
+	CategoryName   string  `json:"categoryname"`
+	Subsidiary     string  `json:"subsidiary"`
+	SubsidiaryName string  `json:"subsidiaryname"`
+	Account        string  `json:"account"`
+	AccountName    string  `json:"accountname"`
+	Department     string  `json:"department"`
+	Class          string  `json:"class"`
+	Location       string  `json:"location"`
+	Customer       string  `json:"customer"`
+	Item           string  `json:"item"`
+	Currency       string  `json:"currency"`
+	Total          Decimal `json:"total"`
+	Period         string  `json:"period"`
+	PeriodName     string  `json:"periodname"`
+	Amount         Decimal `json:"amount"`
+}
+
+// ToBudgets groups the rows of a NewBudgetsQueryRequest into budgets with
+// their lines. The rows of a budget can span pages, so decode all pages at
+// once or merge budgets with the same id.
+func (r *SuiteqlPostResponseBody) ToBudgets(client *Client) (Budgets, error) {
+	rows := []budgetRow{}
+
+	reader := bytes.NewReader(r.Items)
+	dec := json.NewDecoder(reader)
+	if client.DisallowUnknownFields() {
+		dec.DisallowUnknownFields()
+	}
+	err := dec.Decode(&rows)
+	if err != nil {
+		return Budgets{}, err
+	}
+	return budgetsFromRows(Budgets{}, rows), nil
+}
+
+func budgetsFromRows(budgets Budgets, rows []budgetRow) Budgets {
+	index := map[string]int{}
+	for i, b := range budgets {
+		index[b.ID] = i
+	}
+
+	for _, row := range rows {
+		i, ok := index[row.ID]
+		if !ok {
+			i = len(budgets)
+			index[row.ID] = i
+			budgets = append(budgets, Budget{
+				Account:    RecordRef{ID: row.Account, RefName: row.AccountName},
+				Category:   RecordRef{ID: row.Category, RefName: row.CategoryName},
+				Class:      NewRecordRef(row.Class),
+				Currency:   NewRecordRef(row.Currency),
+				Customer:   NewRecordRef(row.Customer),
+				Department: NewRecordRef(row.Department),
+				ID:         row.ID,
+				Item:       NewRecordRef(row.Item),
+				Location:   NewRecordRef(row.Location),
+				Subsidiary: RecordRef{ID: row.Subsidiary, RefName: row.SubsidiaryName},
+				Total:      row.Total,
+				Year:       RecordRef{ID: row.Year, RefName: row.YearName},
+			})
+		}
+
+		// budgets without amounts are joined without a period
+		if row.Period != "" {
+			budgets[i].Lines = append(budgets[i].Lines, BudgetLine{
+				Period: RecordRef{ID: row.Period, RefName: row.PeriodName},
+				Amount: row.Amount,
+			})
+		}
+	}
+	return budgets
+}
+
+// QueryBudgets returns the budgets matching filter with their lines, using
+// SuiteQL. It's the fallback for accounts where the budget record isn't
+// available through REST; SuiteQL is read only, so budgets are still written
+// with the budget requests (NewBudgetPostRequest etc.).
+func (c *Client) QueryBudgets(ctx context.Context, filter BudgetFilter) (Budgets, error) {
+	req := c.NewBudgetsQueryRequest(filter)
+	req.QueryParams().Limit = 1000
+
+	budgets := Budgets{}
+	for {
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return budgets, err
+		}
+
+		rows := []budgetRow{}
+		err = json.Unmarshal(resp.Items, &rows)
+		if err != nil {
+			return budgets, err
+		}
+		budgets = budgetsFromRows(budgets, rows)
+
+		if !resp.HasMore || resp.Count == 0 {
+			return budgets, nil
+		}
+		req.QueryParams().Offset = resp.Offset + resp.Count
+	}
+}
+
+// GetBudget returns the budget with the given internal id through REST when
+// the account exposes the budget record, and through SuiteQL otherwise. Only
+// budgets read through SuiteQL have Lines.
+func (c *Client) GetBudget(ctx context.Context, id string) (Budget, error) {
+	rest, err := c.budgetRecordAvailable(ctx)
+	if err != nil {
+		return Budget{}, err
+	}
+
+	if rest {
+		req := c.NewBudgetGetRequest()
+		req.PathParams().ID, err = strconv.Atoi(id)
+		if err != nil {
+			return Budget{}, fmt.Errorf("budget id %q: %w", id, err)
+		}
+		resp, err := req.Do(ctx)
+		return resp.Budget, err
+	}
+
+	budgets, err := c.QueryBudgets(ctx, BudgetFilter{ID: id})
+	if err != nil {
+		return Budget{}, err
+	}
+	if len(budgets) == 0 {
+		return Budget{}, notFoundError("budget %s does not exist", id)
+	}
+	return budgets[0], nil
+}
+
+// budgetRecordAvailable reports whether the metadata catalog of the account
+// has the budget record. The schema is cached on the client, so the catalog is
+// only asked once.
+func (c *Client) budgetRecordAvailable(ctx context.Context) (bool, error) {
+	_, err := c.Schema(ctx, "budget")
+	if IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
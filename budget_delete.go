@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBudgetDeleteRequest() BudgetDeleteRequest {
+	r := BudgetDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BudgetDeleteRequest struct {
+	client      *Client
+	queryParams *BudgetDeleteRequestQueryParams
+	pathParams  *BudgetDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BudgetDeleteRequestBody
+}
+
+func (r BudgetDeleteRequest) NewQueryParams() *BudgetDeleteRequestQueryParams {
+	return &BudgetDeleteRequestQueryParams{}
+}
+
+type BudgetDeleteRequestQueryParams struct {
+}
+
+func (p BudgetDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BudgetDeleteRequest) QueryParams() *BudgetDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BudgetDeleteRequest) NewPathParams() *BudgetDeleteRequestPathParams {
+	return &BudgetDeleteRequestPathParams{}
+}
+
+type BudgetDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BudgetDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BudgetDeleteRequest) PathParams() *BudgetDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BudgetDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BudgetDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BudgetDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *BudgetDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BudgetDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BudgetDeleteRequest) NewRequestBody() BudgetDeleteRequestBody {
+	return BudgetDeleteRequestBody{}
+}
+
+type BudgetDeleteRequestBody struct {
+}
+
+func (r *BudgetDeleteRequest) RequestBody() *BudgetDeleteRequestBody {
+	return nil
+}
+
+func (r *BudgetDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BudgetDeleteRequest) SetRequestBody(body BudgetDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BudgetDeleteRequest) NewResponseBody() *BudgetDeleteResponseBody {
+	return &BudgetDeleteResponseBody{}
+}
+
+type BudgetDeleteResponseBody struct {
+}
+
+func (r *BudgetDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/budget/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BudgetDeleteRequest) Do(ctx context.Context) (BudgetDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestBudgetDelete(t *testing.T) {
+	req := client.NewBudgetDeleteRequest()
+	req.PathParams().ID = 3
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,165 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBudgetGetRequest() BudgetGetRequest {
+	r := BudgetGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BudgetGetRequest struct {
+	client      *Client
+	queryParams *BudgetGetRequestQueryParams
+	pathParams  *BudgetGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BudgetGetRequestBody
+}
+
+func (r BudgetGetRequest) NewQueryParams() *BudgetGetRequestQueryParams {
+	return &BudgetGetRequestQueryParams{}
+}
+
+type BudgetGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BudgetGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BudgetGetRequest) QueryParams() *BudgetGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BudgetGetRequest) NewPathParams() *BudgetGetRequestPathParams {
+	return &BudgetGetRequestPathParams{}
+}
+
+type BudgetGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BudgetGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BudgetGetRequest) PathParams() *BudgetGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BudgetGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BudgetGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BudgetGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BudgetGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BudgetGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BudgetGetRequest) NewRequestBody() BudgetGetRequestBody {
+	return BudgetGetRequestBody{}
+}
+
+type BudgetGetRequestBody struct {
+}
+
+func (r *BudgetGetRequest) RequestBody() *BudgetGetRequestBody {
+	return nil
+}
+
+func (r *BudgetGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BudgetGetRequest) SetRequestBody(body BudgetGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BudgetGetRequest) NewResponseBody() *BudgetGetResponseBody {
+	return &BudgetGetResponseBody{}
+}
+
+type BudgetGetResponseBody struct {
+	Links Links `json:"links"`
+	Budget
+}
+
+// UnmarshalJSON decodes the links next to the embedded Budget, which has its
+// own UnmarshalJSON to capture the period amounts.
+func (r *BudgetGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.Budget)
+}
+
+func (r *BudgetGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/budget/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BudgetGetRequest) Do(ctx context.Context) (BudgetGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
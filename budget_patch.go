@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBudgetPatchRequest() BudgetPatchRequest {
+	r := BudgetPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BudgetPatchRequest struct {
+	client      *Client
+	queryParams *BudgetPatchRequestQueryParams
+	pathParams  *BudgetPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BudgetPatchRequestBody
+}
+
+func (r BudgetPatchRequest) NewQueryParams() *BudgetPatchRequestQueryParams {
+	return &BudgetPatchRequestQueryParams{}
+}
+
+type BudgetPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p BudgetPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BudgetPatchRequest) QueryParams() *BudgetPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BudgetPatchRequest) NewPathParams() *BudgetPatchRequestPathParams {
+	return &BudgetPatchRequestPathParams{}
+}
+
+type BudgetPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BudgetPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BudgetPatchRequest) PathParams() *BudgetPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BudgetPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BudgetPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BudgetPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *BudgetPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BudgetPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BudgetPatchRequest) NewRequestBody() BudgetPatchRequestBody {
+	return BudgetPatchRequestBody{}
+}
+
+type BudgetPatchRequestBody struct {
+	Budget
+}
+
+func (r *BudgetPatchRequest) RequestBody() *BudgetPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *BudgetPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BudgetPatchRequest) SetRequestBody(body BudgetPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BudgetPatchRequest) NewResponseBody() *BudgetPatchResponseBody {
+	return &BudgetPatchResponseBody{}
+}
+
+type BudgetPatchResponseBody struct {
+}
+
+func (r *BudgetPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/budget/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *BudgetPatchRequest) Do(ctx context.Context) (BudgetPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
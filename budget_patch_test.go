@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestBudgetPatch(t *testing.T) {
+	req := client.NewBudgetPatchRequest()
+	req.PathParams().ID = 3
+	req.RequestBody().SetPeriodAmount(1, netsuite.MustDecimal("1000"))
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBudgetPostRequest() BudgetPostRequest {
+	r := BudgetPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BudgetPostRequest struct {
+	client      *Client
+	queryParams *BudgetPostRequestQueryParams
+	pathParams  *BudgetPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BudgetPostRequestBody
+}
+
+func (r BudgetPostRequest) NewQueryParams() *BudgetPostRequestQueryParams {
+	return &BudgetPostRequestQueryParams{}
+}
+
+type BudgetPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p BudgetPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BudgetPostRequest) QueryParams() *BudgetPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BudgetPostRequest) NewPathParams() *BudgetPostRequestPathParams {
+	return &BudgetPostRequestPathParams{}
+}
+
+type BudgetPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *BudgetPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *BudgetPostRequest) PathParams() *BudgetPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BudgetPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BudgetPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BudgetPostRequest) Method() string {
+	return r.method
+}
+
+func (r *BudgetPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BudgetPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BudgetPostRequest) NewRequestBody() BudgetPostRequestBody {
+	return BudgetPostRequestBody{}
+}
+
+type BudgetPostRequestBody struct {
+	Budget
+}
+
+func (r *BudgetPostRequest) RequestBody() *BudgetPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *BudgetPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *BudgetPostRequest) SetRequestBody(body BudgetPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BudgetPostRequest) NewResponseBody() *BudgetPostResponseBody {
+	return &BudgetPostResponseBody{}
+}
+
+type BudgetPostResponseBody struct {
+}
+
+func (r *BudgetPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/budget", r.PathParams())
+	return &u, err
+}
+
+func (r *BudgetPostRequest) Do(ctx context.Context) (BudgetPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
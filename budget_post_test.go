@@ -0,0 +1,26 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestBudgetPost(t *testing.T) {
+	req := client.NewBudgetPostRequest()
+	req.RequestBody().Account.ID = "54"
+	req.RequestBody().Year.ID = "12"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().SetPeriodAmount(1, netsuite.MustDecimal("1000"))
+	req.RequestBody().SetPeriodAmount(2, netsuite.MustDecimal("1250"))
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,82 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestBudgetPeriodAmounts(t *testing.T) {
+	budget := netsuite.Budget{}
+	err := json.Unmarshal([]byte(`{"id": "3", "account": {"id": "54"}, "year": {"id": "12"}, "periodAmount1": 100.5, "periodAmount2": 0, "total": 100.5}`), &budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(budget.PeriodAmounts) != 2 || budget.PeriodAmounts[1].String() != "100.5" {
+		t.Fatalf("expected 2 period amounts, got %v", budget.PeriodAmounts)
+	}
+
+	amount, _ := netsuite.NewDecimal("250")
+	budget.SetPeriodAmount(3, amount)
+	b, err := json.Marshal(budget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"account":{"id":"54"},"id":"3","periodAmount1":100.5,"periodAmount2":0,"periodAmount3":250,"total":100.5,"year":{"id":"12"}}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestGetBudgetSuiteQLFallback(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("where b.id = '3'",
+		map[string]interface{}{"id": "3", "year": "12", "yearname": "FY 2024", "account": "54", "accountname": "4000 Sales", "subsidiary": "1", "total": "300", "period": "101", "periodname": "Jan 2024", "amount": "100"},
+		map[string]interface{}{"id": "3", "year": "12", "yearname": "FY 2024", "account": "54", "accountname": "4000 Sales", "subsidiary": "1", "total": "300", "period": "102", "periodname": "Feb 2024", "amount": "200"},
+	)
+
+	budget, err := srv.Client().GetBudget(context.Background(), "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if budget.Account.RefName != "4000 Sales" || budget.Year.RefName != "FY 2024" || len(budget.Lines) != 2 {
+		t.Fatalf("unexpected budget %+v", budget)
+	}
+	if l := budget.Lines[1]; l.Period.ID != "102" || l.Amount.String() != "200" {
+		t.Errorf("unexpected line %+v", l)
+	}
+
+	_, err = srv.Client().GetBudget(context.Background(), "4")
+	if !netsuite.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestGetBudgetREST(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.HandleFunc("/services/rest/record/v1/metadata-catalog/budget", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.Write([]byte(`{"type": "object", "properties": {}}`))
+	})
+	srv.AddRecord("budget", "3", map[string]interface{}{"account": map[string]string{"id": "54"}, "periodAmount1": 100})
+
+	budget, err := srv.Client().GetBudget(context.Background(), "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if budget.Account.ID != "54" || budget.PeriodAmounts[1].String() != "100" {
+		t.Errorf("unexpected budget %+v", budget)
+	}
+	for _, r := range srv.Requests() {
+		if strings.Contains(r.Path, "suiteql") {
+			t.Error("expected the budget to be read through REST")
+		}
+	}
+}
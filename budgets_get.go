@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewBudgetsGetRequest() BudgetsGetRequest {
+	r := BudgetsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type BudgetsGetRequest struct {
+	client      *Client
+	queryParams *BudgetsGetRequestQueryParams
+	pathParams  *BudgetsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody BudgetsGetRequestBody
+}
+
+func (r BudgetsGetRequest) NewQueryParams() *BudgetsGetRequestQueryParams {
+	return &BudgetsGetRequestQueryParams{}
+}
+
+type BudgetsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p BudgetsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *BudgetsGetRequest) QueryParams() *BudgetsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r BudgetsGetRequest) NewPathParams() *BudgetsGetRequestPathParams {
+	return &BudgetsGetRequestPathParams{}
+}
+
+type BudgetsGetRequestPathParams struct {
+}
+
+func (p *BudgetsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *BudgetsGetRequest) PathParams() *BudgetsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *BudgetsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *BudgetsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *BudgetsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *BudgetsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *BudgetsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r BudgetsGetRequest) NewRequestBody() BudgetsGetRequestBody {
+	return BudgetsGetRequestBody{}
+}
+
+type BudgetsGetRequestBody struct {
+}
+
+func (r *BudgetsGetRequest) RequestBody() *BudgetsGetRequestBody {
+	return nil
+}
+
+func (r *BudgetsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *BudgetsGetRequest) SetRequestBody(body BudgetsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *BudgetsGetRequest) NewResponseBody() *BudgetsGetResponseBody {
+	return &BudgetsGetResponseBody{}
+}
+
+type BudgetsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *BudgetsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/budget", r.PathParams())
+	return &u, err
+}
+
+func (r *BudgetsGetRequest) Do(ctx context.Context) (BudgetsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
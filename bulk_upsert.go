@@ -0,0 +1,278 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type BulkUpsertOptions struct {
+	// Concurrency is the number of records upserted in parallel (default 4).
+	// Keep it below the concurrency limit of the account.
+	Concurrency int
+	// MaxRetries is the number of times a transient failure (429, 5xx,
+	// network errors) is retried (default 3)
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled on every next
+	// retry (default 1s)
+	RetryBackoff time.Duration
+	// Skip is called for every record and can return a reason to leave the
+	// record out
+	Skip func(record interface{}) string
+}
+
+// BulkUpsertResult is the outcome of a single record.
+type BulkUpsertResult struct {
+	// Index of the record in the slice passed to BulkUpsert
+	Index      int
+	ExternalID string
+	Attempts   int
+	StatusCode int
+	// ErrorCode is the o:errorCode NetSuite returned for failed records
+	ErrorCode string
+	// Reason a record was skipped
+	Reason string
+	Err    error
+}
+
+type BulkUpsertReport struct {
+	Succeeded []BulkUpsertResult
+	Failed    []BulkUpsertResult
+	Skipped   []BulkUpsertResult
+}
+
+// BulkUpsert creates or updates the records (a slice of models) of the record
+// type by their external id (PUT /record/v1/{recordType}/eid:{externalId}).
+// Records are sent by a bounded number of concurrent workers and transient
+// failures are retried according to opts; the retry policy of the client
+// isn't applied on top of that. Records without external id are skipped.
+//
+// The returned error is only set when the records couldn't be processed at
+// all; failures of individual records are part of the report.
+func (c *Client) BulkUpsert(ctx context.Context, recordType string, records interface{}, opts BulkUpsertOptions) (BulkUpsertReport, error) {
+	report := BulkUpsertReport{}
+
+	v := reflect.ValueOf(records)
+	if v.Kind() != reflect.Slice {
+		return report, fmt.Errorf("records should be a slice, got %T", records)
+	}
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	} else if opts.MaxRetries == 0 {
+		opts.MaxRetries = 3
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = time.Second
+	}
+
+	type job struct {
+		index      int
+		externalID string
+		record     interface{}
+	}
+
+	// the upserts are retried by upsertWithRetries only, so the attempts in
+	// the report are the attempts made
+	client := c.With(WithRetry(RetryPolicy{}))
+
+	jobs := make(chan job)
+	results := make(chan BulkUpsertResult)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results <- client.upsertWithRetries(ctx, recordType, j.index, j.externalID, j.record, opts)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := 0; i < v.Len(); i++ {
+			record := v.Index(i).Interface()
+			res := BulkUpsertResult{Index: i}
+
+			if opts.Skip != nil {
+				res.Reason = opts.Skip(record)
+			}
+
+			if res.Reason == "" {
+				externalID, err := externalIDOf(record)
+				if err != nil {
+					res.Reason = err.Error()
+				} else if externalID == "" {
+					res.Reason = "record has no external id"
+				}
+				res.ExternalID = externalID
+			}
+
+			if res.Reason != "" {
+				results <- res
+				continue
+			}
+
+			select {
+			case jobs <- job{index: i, externalID: res.ExternalID, record: record}:
+			case <-ctx.Done():
+				results <- BulkUpsertResult{Index: i, ExternalID: res.ExternalID, Err: ctx.Err()}
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for res := range results {
+		switch {
+		case res.Reason != "":
+			report.Skipped = append(report.Skipped, res)
+		case res.Err != nil:
+			report.Failed = append(report.Failed, res)
+		default:
+			report.Succeeded = append(report.Succeeded, res)
+		}
+	}
+
+	for _, rr := range [][]BulkUpsertResult{report.Succeeded, report.Failed, report.Skipped} {
+		sort.Slice(rr, func(i, j int) bool { return rr[i].Index < rr[j].Index })
+	}
+
+	return report, nil
+}
+
+func (c *Client) upsertWithRetries(ctx context.Context, recordType string, index int, externalID string, record interface{}, opts BulkUpsertOptions) BulkUpsertResult {
+	res := BulkUpsertResult{Index: index, ExternalID: externalID}
+	backoff := opts.RetryBackoff
+
+	for {
+		res.Attempts++
+		r := recordUpsertRequest{
+			client:     c,
+			recordType: recordType,
+			externalID: externalID,
+			body:       record,
+		}
+
+		req, err := c.NewRequest(withRequestAttempt(ctx, res.Attempts), &r)
+		if err == nil {
+			var resp *http.Response
+			resp, err = c.Do(req, nil)
+			if resp != nil {
+				res.StatusCode = resp.StatusCode
+			}
+		}
+
+		res.Err = err
+		res.ErrorCode = ""
+		errResp := &ErrorResponse{}
+		if errors.As(err, &errResp) && len(errResp.ErrorDetails) > 0 {
+			res.ErrorCode = errResp.ErrorDetails[0].ErrorCode
+		}
+
+		if err == nil || res.Attempts > opts.MaxRetries || !isTransientError(err) {
+			return res
+		}
+
+		select {
+		case <-ctx.Done():
+			res.Err = ctx.Err()
+			return res
+		case <-time.After(backoff):
+		}
+		backoff = backoff * 2
+	}
+}
+
+// isTransientError reports whether a request that failed with err is worth
+// retrying.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	errResp := &ErrorResponse{}
+	if errors.As(err, &errResp) {
+		if errResp.Response == nil {
+			return false
+		}
+		code := errResp.Response.StatusCode
+		return code == http.StatusTooManyRequests || code >= 500
+	}
+
+	urlErr := &url.Error{}
+	return errors.As(err, &urlErr)
+}
+
+func externalIDOf(record interface{}) (string, error) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+
+	props := struct {
+		ExternalID string `json:"externalId"`
+	}{}
+	err = json.Unmarshal(b, &props)
+	return props.ExternalID, err
+}
+
+// recordUpsertRequest is a PUT of a record by external id.
+type recordUpsertRequest struct {
+	client     *Client
+	recordType string
+	externalID string
+	body       interface{}
+}
+
+type recordUpsertRequestPathParams struct {
+	RecordType string
+	ExternalID string
+}
+
+func (p recordUpsertRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"external_id": p.ExternalID,
+	}
+}
+
+func (r *recordUpsertRequest) Method() string {
+	return http.MethodPut
+}
+
+func (r *recordUpsertRequest) PathParamsInterface() PathParams {
+	return recordUpsertRequestPathParams{RecordType: r.recordType, ExternalID: r.externalID}
+}
+
+func (r *recordUpsertRequest) RequestBodyInterface() interface{} {
+	return r.body
+}
+
+func (r *recordUpsertRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}/eid:{{.external_id}}", r.PathParamsInterface())
+	if err != nil {
+		return &u, err
+	}
+
+	// external ids can contain slashes, which the path keeps as is
+	prefix := url.URL{Path: strings.TrimSuffix(u.Path, r.externalID)}
+	u.RawPath = prefix.EscapedPath() + url.PathEscape(r.externalID)
+	return &u, nil
+}
@@ -0,0 +1,102 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestBulkUpsert(t *testing.T) {
+	var retried int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/eid:T-2") && atomic.AddInt32(&retried, 1) <= 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/eid:T-3"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":400,"o:errorDetails":[{"detail":"Invalid field value","o:errorCode":"INVALID_FIELD"}]}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+
+	terms := []netsuite.Term{
+		{ExternalID: "T-1", Name: "Net 14"},
+		{ExternalID: "T-2", Name: "Net 30"},
+		{ExternalID: "T-3", Name: "Net 60"},
+		{Name: "No external id"},
+	}
+
+	report, err := c.BulkUpsert(context.Background(), "term", terms, netsuite.BulkUpsertOptions{
+		Concurrency:  1,
+		RetryBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Succeeded) != 2 || report.Succeeded[1].Attempts != 3 {
+		t.Errorf("expected T-1 and T-2 (after 2 retries) to succeed, got %+v", report.Succeeded)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].ErrorCode != "INVALID_FIELD" || report.Failed[0].Attempts != 1 {
+		t.Errorf("expected T-3 to fail without retries, got %+v", report.Failed)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0].Index != 3 {
+		t.Errorf("expected record without external id to be skipped, got %+v", report.Skipped)
+	}
+}
+
+func TestBulkUpsertEscapesExternalID(t *testing.T) {
+	var hits int32
+	paths := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "INV/2024") {
+			paths <- r.URL.EscapedPath()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(netsuite.WithRetry(netsuite.RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}))
+	c.SetBaseURL(server.URL)
+
+	report, err := c.BulkUpsert(context.Background(), "term", []netsuite.Term{{ExternalID: "INV/2024?a#1"}}, netsuite.BulkUpsertOptions{})
+	if err != nil || len(report.Succeeded) != 1 {
+		t.Fatalf("expected the upsert to succeed, got %+v (%v)", report, err)
+	}
+	if path := <-paths; !strings.HasSuffix(path, "/term/eid:INV%2F2024%3Fa%231") {
+		t.Errorf("expected an escaped external id, got %s", path)
+	}
+
+	report, err = c.BulkUpsert(context.Background(), "term", []netsuite.Term{{ExternalID: "T-1"}}, netsuite.BulkUpsertOptions{
+		MaxRetries:   1,
+		RetryBackoff: time.Millisecond,
+	})
+	if err != nil || len(report.Failed) != 1 || report.Failed[0].Attempts != 2 {
+		t.Fatalf("expected the upsert to fail after 2 attempts, got %+v (%v)", report, err)
+	}
+	if n := atomic.LoadInt32(&hits); n != 2 {
+		t.Errorf("expected the client retry policy not to retry upserts, got %d requests", n)
+	}
+}
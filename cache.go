@@ -0,0 +1,385 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ReferenceDataTTL is how long the organisation structure (subsidiaries,
+	// accounts, tax codes...) is cached with DefaultCacheTTLs
+	ReferenceDataTTL = time.Hour
+	// ItemTTL is how long items are cached with DefaultCacheTTLs
+	ItemTTL = 15 * time.Minute
+	// DefaultCacheStaleTTL is how long expired responses are kept to be
+	// revalidated, see CachePolicy.StaleTTL
+	DefaultCacheStaleTTL = 24 * time.Hour
+)
+
+// CacheStore keeps the cached response bodies. NewMemoryCache returns the
+// default, in-memory store; implement it to share a cache between processes.
+type CacheStore interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	// DeletePrefix removes the entries of which the key starts with prefix
+	DeletePrefix(prefix string)
+}
+
+// CachePolicy makes the client cache the GET responses of slow changing
+// record types, keyed by url (including the query). Writes to a cached record
+// type through the client invalidate its entries; use InvalidateCache for
+// changes made elsewhere.
+type CachePolicy struct {
+	// Store defaults to an in-memory store
+	Store CacheStore
+	// TTLs maps the record types to cache to how long their responses are
+	// used without asking NetSuite, see DefaultCacheTTLs. With a TTL of 0
+	// every read is revalidated.
+	TTLs map[string]time.Duration
+	// StaleTTL is how long responses with an ETag or Last-Modified are kept
+	// after their TTL, to be revalidated with a conditional GET
+	// (If-None-Match, If-Modified-Since); a 304 Not Modified makes them fresh
+	// again. Defaults to DefaultCacheStaleTTL, negative disables revalidation.
+	StaleTTL time.Duration
+}
+
+// DefaultCacheTTLs returns TTLs for the reference data most integrations look
+// up over and over.
+func DefaultCacheTTLs() map[string]time.Duration {
+	ttls := map[string]time.Duration{}
+	for _, rt := range []string{
+		"subsidiary", "account", "department", "classification", "location",
+		"currency", "term", "salesTaxItem", "taxGroup", "taxType", "unitsType",
+		"priceLevel", "paymentMethod",
+	} {
+		ttls[rt] = ReferenceDataTTL
+	}
+	for _, rt := range []string{
+		"inventoryItem", "nonInventorySaleItem", "nonInventoryPurchaseItem",
+		"nonInventoryResaleItem", "serviceSaleItem", "servicePurchaseItem",
+		"serviceResaleItem", "otherChargeSaleItem", "discountItem", "kitItem",
+		"assemblyItem", "giftCertificateItem",
+	} {
+		ttls[rt] = ItemTTL
+	}
+	return ttls
+}
+
+// SetCachePolicy enables the cache; a policy without TTLs disables it.
+func (c *Client) SetCachePolicy(policy CachePolicy) {
+	if policy.Store == nil && len(policy.TTLs) > 0 {
+		policy.Store = NewMemoryCache()
+	}
+
+	// record types are matched case insensitively
+	ttls := map[string]time.Duration{}
+	for rt, ttl := range policy.TTLs {
+		ttls[strings.ToLower(rt)] = ttl
+	}
+	policy.TTLs = ttls
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cachePolicy = policy
+}
+
+func (c *Client) CachePolicy() CachePolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cachePolicy
+}
+
+// InvalidateCache removes the cached responses of a record type; with ids
+// only those of the records (and the lists of the record type, which may
+// include them).
+func (c *Client) InvalidateCache(recordType string, ids ...string) error {
+	policy := c.CachePolicy()
+	if policy.Store == nil {
+		return nil
+	}
+
+	u, err := c.GetEndpointURL("/record/v1/{{.record_type}}", recordPathParams{"record_type": recordType})
+	if err != nil {
+		return err
+	}
+	prefix, _, _ := cacheKeyParts(&u)
+
+	if len(ids) == 0 {
+		policy.Store.DeletePrefix(prefix)
+		return nil
+	}
+	policy.Store.DeletePrefix(prefix + "?")
+	for _, id := range ids {
+		policy.Store.DeletePrefix(prefix + "/" + id + "?")
+		policy.Store.DeletePrefix(prefix + "/" + id + "/")
+	}
+	return nil
+}
+
+type noCacheKey struct{}
+
+// WithoutCache makes the requests sent with ctx skip the cache.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// cacheKeyParts splits a record url into the cache key prefix of its record
+// type, e.g. "1234567.suitetalk.api.netsuite.com|customer|", the rest of the
+// key (/107?fields=id) and the lowercase record type.
+func cacheKeyParts(u *url.URL) (prefix, rest, recordType string) {
+	i := strings.Index(u.Path, "/record/v1/")
+	if i < 0 {
+		return "", "", ""
+	}
+	p := u.Path[i+len("/record/v1/"):]
+	recordType = p
+	if j := strings.Index(p, "/"); j >= 0 {
+		recordType, rest = p[:j], p[j:]
+	}
+	recordType = strings.ToLower(recordType)
+	return u.Host + "|" + recordType + "|", rest + "?" + u.RawQuery, recordType
+}
+
+// cacheKey returns the key and ttl of the response to req, or an empty key
+// when it isn't cached.
+func (cfg clientConfig) cacheKey(req *http.Request) (string, time.Duration) {
+	if cfg.cachePolicy.Store == nil || req.Method != http.MethodGet {
+		return "", 0
+	}
+	if noCache, _ := req.Context().Value(noCacheKey{}).(bool); noCache {
+		return "", 0
+	}
+
+	// the caller does its own revalidation
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return "", 0
+	}
+
+	prefix, rest, recordType := cacheKeyParts(req.URL)
+	ttl, ok := cfg.cachePolicy.TTLs[recordType]
+	if prefix == "" || !ok || ttl < 0 {
+		return "", 0
+	}
+	// translatable fields differ per language
+	if language := req.Header.Get("Accept-Language"); language != "" {
+		rest += "|" + language
+	}
+	return prefix + rest, ttl
+}
+
+// invalidateCache removes the entries of the record type req writes to.
+func (cfg clientConfig) invalidateCache(req *http.Request) {
+	if cfg.cachePolicy.Store == nil || req.Method == http.MethodGet {
+		return
+	}
+
+	prefix, _, recordType := cacheKeyParts(req.URL)
+	if _, ok := cfg.cachePolicy.TTLs[recordType]; ok && prefix != "" {
+		cfg.cachePolicy.Store.DeletePrefix(prefix)
+	}
+}
+
+// cacheEntry is what's kept in the store for a response
+type cacheEntry struct {
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	Expires      time.Time `json:"expires"`
+}
+
+func (e cacheEntry) canRevalidate() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// sendCached sends req, or answers it from the cache. Stale entries with an
+// ETag or Last-Modified are revalidated with a conditional GET.
+func (c *Client) sendCached(cfg clientConfig, req *http.Request, body interface{}) (*http.Response, error) {
+	key, ttl := cfg.cacheKey(req)
+	if key == "" {
+		resp, err := c.sendWithRetries(cfg, req, body)
+		if err == nil {
+			cfg.invalidateCache(req)
+		}
+		return resp, err
+	}
+
+	entry, found := cfg.cacheEntry(key)
+	if found && time.Now().Before(entry.Expires) {
+		resp := entry.response(req, cfg.mediaType)
+		setResponseMeta(req, resp, 0, 0)
+		markCached(req)
+		return resp, nil
+	}
+
+	if found {
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := c.sendWithRetries(cfg, req, body)
+	if err != nil {
+		return resp, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		drain(resp)
+		entry.Expires = time.Now().Add(ttl)
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		cfg.storeEntry(key, entry, ttl)
+		markCached(req)
+		return entry.response(req, cfg.mediaType), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return resp, err
+	}
+
+	cfg.storeEntry(key, cacheEntry{
+		Body:         data,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Expires:      time.Now().Add(ttl),
+	}, ttl)
+	return resp, nil
+}
+
+func (cfg clientConfig) cacheEntry(key string) (cacheEntry, bool) {
+	entry := cacheEntry{}
+	data, ok := cfg.cachePolicy.Store.Get(key)
+	if !ok {
+		return entry, false
+	}
+	err := json.Unmarshal(data, &entry)
+	return entry, err == nil
+}
+
+// storeEntry stores the entry; entries that can be revalidated are kept for
+// StaleTTL after they expire.
+func (cfg clientConfig) storeEntry(key string, entry cacheEntry, ttl time.Duration) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	if entry.canRevalidate() {
+		stale := cfg.cachePolicy.StaleTTL
+		if stale == 0 {
+			stale = DefaultCacheStaleTTL
+		}
+		if stale > 0 {
+			ttl += stale
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	cfg.cachePolicy.Store.Set(key, data, ttl)
+}
+
+// response returns the cached body as a response to req.
+func (e cacheEntry) response(req *http.Request, mediaType string) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", mediaType)
+	if e.ETag != "" {
+		header.Set("ETag", e.ETag)
+	}
+	if e.LastModified != "" {
+		header.Set("Last-Modified", e.LastModified)
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+func markCached(req *http.Request) {
+	if meta := responseMetaFromContext(req.Context()); meta != nil {
+		meta.Cached = true
+	}
+}
+
+// MemoryCache is an in-memory CacheStore
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]memoryCacheEntry{}}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	// drop the expired entries once in a while, so the cache doesn't grow
+	// with entries that are never read again
+	if len(m.entries) > 0 && len(m.entries)%1000 == 0 {
+		for k, e := range m.entries {
+			if now.After(e.expires) {
+				delete(m.entries, k)
+			}
+		}
+	}
+	m.entries[key] = memoryCacheEntry{value: value, expires: now.Add(ttl)}
+}
+
+func (m *MemoryCache) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for k := range m.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(m.entries, k)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestCache(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("subsidiary", "1", map[string]interface{}{"name": "Parent Company"})
+	srv.AddRecord("customer", "107", map[string]interface{}{"companyName": "Omniboost"})
+
+	client := srv.Client()
+	client.SetCachePolicy(netsuite.CachePolicy{TTLs: map[string]time.Duration{"subsidiary": time.Hour}})
+	ctx := context.Background()
+	subsidiaries := netsuite.NewService[map[string]interface{}](client, "subsidiary")
+	customers := netsuite.NewService[map[string]interface{}](client, "customer")
+
+	get := func(svc netsuite.Service[map[string]interface{}], id string) *netsuite.ResponseMeta {
+		meta := &netsuite.ResponseMeta{}
+		_, err := svc.Get(netsuite.WithResponseMeta(ctx, meta), id, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return meta
+	}
+
+	if get(subsidiaries, "1").Cached {
+		t.Errorf("expected the first read to miss the cache")
+	}
+	if !get(subsidiaries, "1").Cached {
+		t.Errorf("expected the second read to hit the cache")
+	}
+	get(customers, "107")
+	if get(customers, "107").Cached {
+		t.Errorf("expected customers not to be cached")
+	}
+
+	// a write invalidates the record type
+	err := subsidiaries.Update(ctx, "1", map[string]interface{}{"name": "Omniboost"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := subsidiaries.Get(ctx, "1", nil)
+	if err != nil || record["name"] != "Omniboost" {
+		t.Errorf("expected the updated subsidiary, got %v (%v)", record, err)
+	}
+
+	// explicit invalidation of changes made elsewhere
+	srv.AddRecord("subsidiary", "1", map[string]interface{}{"name": "Omniboost B.V."})
+	err = client.InvalidateCache("subsidiary", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if get(subsidiaries, "1").Cached {
+		t.Errorf("expected the invalidated subsidiary to be read again")
+	}
+
+	requests := 0
+	for _, r := range srv.Requests() {
+		if r.Method == "GET" {
+			requests++
+		}
+	}
+	if requests != 5 {
+		t.Errorf("expected 5 GET requests to the server, got %d", requests)
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := netsuite.NewMemoryCache()
+	cache.Set("a|1", []byte("1"), time.Hour)
+	cache.Set("a|2", []byte("2"), -time.Second)
+	cache.Set("b|1", []byte("3"), time.Hour)
+
+	if v, ok := cache.Get("a|1"); !ok || string(v) != "1" {
+		t.Errorf("expected a|1, got %q", v)
+	}
+	if _, ok := cache.Get("a|2"); ok {
+		t.Errorf("expected a|2 to be expired")
+	}
+
+	cache.DeletePrefix("a|")
+	if _, ok := cache.Get("a|1"); ok {
+		t.Errorf("expected a|1 to be deleted")
+	}
+	if _, ok := cache.Get("b|1"); !ok {
+		t.Errorf("expected b|1 to be kept")
+	}
+}
+
+func TestCacheRevalidation(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "107", map[string]interface{}{"companyName": "Omniboost"})
+
+	// a TTL of 0 revalidates every read
+	client := srv.Client()
+	client.SetCachePolicy(netsuite.CachePolicy{TTLs: map[string]time.Duration{"customer": 0}})
+	customers := netsuite.NewService[map[string]interface{}](client, "customer")
+
+	get := func() (map[string]interface{}, *netsuite.ResponseMeta) {
+		meta := &netsuite.ResponseMeta{}
+		record, err := customers.Get(netsuite.WithResponseMeta(context.Background(), meta), "107", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return record, meta
+	}
+
+	record, meta := get()
+	if meta.Cached || record["companyName"] != "Omniboost" {
+		t.Fatalf("expected the record from the server, got %v (%+v)", record, meta)
+	}
+
+	record, meta = get()
+	if !meta.Cached || meta.StatusCode != http.StatusNotModified || record["companyName"] != "Omniboost" {
+		t.Errorf("expected the revalidated record from the cache, got %v (%+v)", record, meta)
+	}
+
+	srv.AddRecord("customer", "107", map[string]interface{}{"companyName": "Omniboost B.V."})
+	record, meta = get()
+	if meta.Cached || record["companyName"] != "Omniboost B.V." {
+		t.Errorf("expected the changed record from the server, got %v (%+v)", record, meta)
+	}
+}
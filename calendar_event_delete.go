@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCalendarEventDeleteRequest() CalendarEventDeleteRequest {
+	r := CalendarEventDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CalendarEventDeleteRequest struct {
+	client      *Client
+	queryParams *CalendarEventDeleteRequestQueryParams
+	pathParams  *CalendarEventDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CalendarEventDeleteRequestBody
+}
+
+func (r CalendarEventDeleteRequest) NewQueryParams() *CalendarEventDeleteRequestQueryParams {
+	return &CalendarEventDeleteRequestQueryParams{}
+}
+
+type CalendarEventDeleteRequestQueryParams struct {
+}
+
+func (p CalendarEventDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CalendarEventDeleteRequest) QueryParams() *CalendarEventDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CalendarEventDeleteRequest) NewPathParams() *CalendarEventDeleteRequestPathParams {
+	return &CalendarEventDeleteRequestPathParams{}
+}
+
+type CalendarEventDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CalendarEventDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CalendarEventDeleteRequest) PathParams() *CalendarEventDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CalendarEventDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CalendarEventDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CalendarEventDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CalendarEventDeleteRequest) NewRequestBody() CalendarEventDeleteRequestBody {
+	return CalendarEventDeleteRequestBody{}
+}
+
+type CalendarEventDeleteRequestBody struct {
+}
+
+func (r *CalendarEventDeleteRequest) RequestBody() *CalendarEventDeleteRequestBody {
+	return nil
+}
+
+func (r *CalendarEventDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CalendarEventDeleteRequest) SetRequestBody(body CalendarEventDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CalendarEventDeleteRequest) NewResponseBody() *CalendarEventDeleteResponseBody {
+	return &CalendarEventDeleteResponseBody{}
+}
+
+type CalendarEventDeleteResponseBody struct {
+}
+
+func (r *CalendarEventDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/calendarEvent/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CalendarEventDeleteRequest) Do(ctx context.Context) (CalendarEventDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCalendarEventDelete(t *testing.T) {
+	req := client.NewCalendarEventDeleteRequest()
+	req.PathParams().ID = 2202
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
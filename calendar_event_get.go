@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCalendarEventGetRequest() CalendarEventGetRequest {
+	r := CalendarEventGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CalendarEventGetRequest struct {
+	client      *Client
+	queryParams *CalendarEventGetRequestQueryParams
+	pathParams  *CalendarEventGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CalendarEventGetRequestBody
+}
+
+func (r CalendarEventGetRequest) NewQueryParams() *CalendarEventGetRequestQueryParams {
+	return &CalendarEventGetRequestQueryParams{}
+}
+
+type CalendarEventGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CalendarEventGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CalendarEventGetRequest) QueryParams() *CalendarEventGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CalendarEventGetRequest) NewPathParams() *CalendarEventGetRequestPathParams {
+	return &CalendarEventGetRequestPathParams{}
+}
+
+type CalendarEventGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CalendarEventGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CalendarEventGetRequest) PathParams() *CalendarEventGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CalendarEventGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CalendarEventGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CalendarEventGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CalendarEventGetRequest) NewRequestBody() CalendarEventGetRequestBody {
+	return CalendarEventGetRequestBody{}
+}
+
+type CalendarEventGetRequestBody struct {
+}
+
+func (r *CalendarEventGetRequest) RequestBody() *CalendarEventGetRequestBody {
+	return nil
+}
+
+func (r *CalendarEventGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CalendarEventGetRequest) SetRequestBody(body CalendarEventGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CalendarEventGetRequest) NewResponseBody() *CalendarEventGetResponseBody {
+	return &CalendarEventGetResponseBody{}
+}
+
+type CalendarEventGetResponseBody struct {
+	Links Links `json:"links"`
+	CalendarEvent
+}
+
+func (r *CalendarEventGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/calendarEvent/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CalendarEventGetRequest) Do(ctx context.Context) (CalendarEventGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
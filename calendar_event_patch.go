@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCalendarEventPatchRequest() CalendarEventPatchRequest {
+	r := CalendarEventPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CalendarEventPatchRequest struct {
+	client      *Client
+	queryParams *CalendarEventPatchRequestQueryParams
+	pathParams  *CalendarEventPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CalendarEventPatchRequestBody
+}
+
+func (r CalendarEventPatchRequest) NewQueryParams() *CalendarEventPatchRequestQueryParams {
+	return &CalendarEventPatchRequestQueryParams{}
+}
+
+type CalendarEventPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CalendarEventPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CalendarEventPatchRequest) QueryParams() *CalendarEventPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CalendarEventPatchRequest) NewPathParams() *CalendarEventPatchRequestPathParams {
+	return &CalendarEventPatchRequestPathParams{}
+}
+
+type CalendarEventPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CalendarEventPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CalendarEventPatchRequest) PathParams() *CalendarEventPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CalendarEventPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CalendarEventPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CalendarEventPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CalendarEventPatchRequest) NewRequestBody() CalendarEventPatchRequestBody {
+	return CalendarEventPatchRequestBody{}
+}
+
+type CalendarEventPatchRequestBody struct {
+	CalendarEvent
+}
+
+func (r *CalendarEventPatchRequest) RequestBody() *CalendarEventPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CalendarEventPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CalendarEventPatchRequest) SetRequestBody(body CalendarEventPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CalendarEventPatchRequest) NewResponseBody() *CalendarEventPatchResponseBody {
+	return &CalendarEventPatchResponseBody{}
+}
+
+type CalendarEventPatchResponseBody struct {
+}
+
+func (r *CalendarEventPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/calendarEvent/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CalendarEventPatchRequest) Do(ctx context.Context) (CalendarEventPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCalendarEventPatch(t *testing.T) {
+	req := client.NewCalendarEventPatchRequest()
+	req.PathParams().ID = 2202
+	req.RequestBody().Location = "Amsterdam office"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCalendarEventPostRequest() CalendarEventPostRequest {
+	r := CalendarEventPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CalendarEventPostRequest struct {
+	client      *Client
+	queryParams *CalendarEventPostRequestQueryParams
+	pathParams  *CalendarEventPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CalendarEventPostRequestBody
+}
+
+func (r CalendarEventPostRequest) NewQueryParams() *CalendarEventPostRequestQueryParams {
+	return &CalendarEventPostRequestQueryParams{}
+}
+
+type CalendarEventPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CalendarEventPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CalendarEventPostRequest) QueryParams() *CalendarEventPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CalendarEventPostRequest) NewPathParams() *CalendarEventPostRequestPathParams {
+	return &CalendarEventPostRequestPathParams{}
+}
+
+type CalendarEventPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CalendarEventPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CalendarEventPostRequest) PathParams() *CalendarEventPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CalendarEventPostRequest) Method() string {
+	return r.method
+}
+
+func (r *CalendarEventPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CalendarEventPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CalendarEventPostRequest) NewRequestBody() CalendarEventPostRequestBody {
+	return CalendarEventPostRequestBody{}
+}
+
+type CalendarEventPostRequestBody struct {
+	CalendarEvent
+}
+
+func (r *CalendarEventPostRequest) RequestBody() *CalendarEventPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CalendarEventPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CalendarEventPostRequest) SetRequestBody(body CalendarEventPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CalendarEventPostRequest) NewResponseBody() *CalendarEventPostResponseBody {
+	return &CalendarEventPostResponseBody{}
+}
+
+type CalendarEventPostResponseBody struct {
+}
+
+func (r *CalendarEventPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/calendarEvent", r.PathParams())
+	return &u, err
+}
+
+func (r *CalendarEventPostRequest) Do(ctx context.Context) (CalendarEventPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
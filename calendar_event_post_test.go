@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCalendarEventPost(t *testing.T) {
+	req := client.NewCalendarEventPostRequest()
+	req.RequestBody().Title = "Quarterly business review"
+	req.RequestBody().Organizer.ID = "1642"
+	req.RequestBody().Company.ID = "70202"
+	req.RequestBody().AllDayEvent = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCalendarEventsGetRequest() CalendarEventsGetRequest {
+	r := CalendarEventsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CalendarEventsGetRequest struct {
+	client      *Client
+	queryParams *CalendarEventsGetRequestQueryParams
+	pathParams  *CalendarEventsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CalendarEventsGetRequestBody
+}
+
+func (r CalendarEventsGetRequest) NewQueryParams() *CalendarEventsGetRequestQueryParams {
+	return &CalendarEventsGetRequestQueryParams{}
+}
+
+type CalendarEventsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CalendarEventsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CalendarEventsGetRequest) QueryParams() *CalendarEventsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CalendarEventsGetRequest) NewPathParams() *CalendarEventsGetRequestPathParams {
+	return &CalendarEventsGetRequestPathParams{}
+}
+
+type CalendarEventsGetRequestPathParams struct {
+}
+
+func (p *CalendarEventsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CalendarEventsGetRequest) PathParams() *CalendarEventsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CalendarEventsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CalendarEventsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CalendarEventsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CalendarEventsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CalendarEventsGetRequest) NewRequestBody() CalendarEventsGetRequestBody {
+	return CalendarEventsGetRequestBody{}
+}
+
+type CalendarEventsGetRequestBody struct {
+}
+
+func (r *CalendarEventsGetRequest) RequestBody() *CalendarEventsGetRequestBody {
+	return nil
+}
+
+func (r *CalendarEventsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CalendarEventsGetRequest) SetRequestBody(body CalendarEventsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CalendarEventsGetRequest) NewResponseBody() *CalendarEventsGetResponseBody {
+	return &CalendarEventsGetResponseBody{}
+}
+
+type CalendarEventsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CalendarEventsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/calendarEvent", r.PathParams())
+	return &u, err
+}
+
+func (r *CalendarEventsGetRequest) Do(ctx context.Context) (CalendarEventsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignDeleteRequest() CampaignDeleteRequest {
+	r := CampaignDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignDeleteRequest struct {
+	client      *Client
+	queryParams *CampaignDeleteRequestQueryParams
+	pathParams  *CampaignDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignDeleteRequestBody
+}
+
+func (r CampaignDeleteRequest) NewQueryParams() *CampaignDeleteRequestQueryParams {
+	return &CampaignDeleteRequestQueryParams{}
+}
+
+type CampaignDeleteRequestQueryParams struct {
+}
+
+func (p CampaignDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignDeleteRequest) QueryParams() *CampaignDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignDeleteRequest) NewPathParams() *CampaignDeleteRequestPathParams {
+	return &CampaignDeleteRequestPathParams{}
+}
+
+type CampaignDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignDeleteRequest) PathParams() *CampaignDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignDeleteRequest) NewRequestBody() CampaignDeleteRequestBody {
+	return CampaignDeleteRequestBody{}
+}
+
+type CampaignDeleteRequestBody struct {
+}
+
+func (r *CampaignDeleteRequest) RequestBody() *CampaignDeleteRequestBody {
+	return nil
+}
+
+func (r *CampaignDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CampaignDeleteRequest) SetRequestBody(body CampaignDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignDeleteRequest) NewResponseBody() *CampaignDeleteResponseBody {
+	return &CampaignDeleteResponseBody{}
+}
+
+type CampaignDeleteResponseBody struct {
+}
+
+func (r *CampaignDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaign/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignDeleteRequest) Do(ctx context.Context) (CampaignDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignGetRequest() CampaignGetRequest {
+	r := CampaignGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignGetRequest struct {
+	client      *Client
+	queryParams *CampaignGetRequestQueryParams
+	pathParams  *CampaignGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignGetRequestBody
+}
+
+func (r CampaignGetRequest) NewQueryParams() *CampaignGetRequestQueryParams {
+	return &CampaignGetRequestQueryParams{}
+}
+
+type CampaignGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CampaignGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignGetRequest) QueryParams() *CampaignGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignGetRequest) NewPathParams() *CampaignGetRequestPathParams {
+	return &CampaignGetRequestPathParams{}
+}
+
+type CampaignGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignGetRequest) PathParams() *CampaignGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignGetRequest) NewRequestBody() CampaignGetRequestBody {
+	return CampaignGetRequestBody{}
+}
+
+type CampaignGetRequestBody struct {
+}
+
+func (r *CampaignGetRequest) RequestBody() *CampaignGetRequestBody {
+	return nil
+}
+
+func (r *CampaignGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CampaignGetRequest) SetRequestBody(body CampaignGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignGetRequest) NewResponseBody() *CampaignGetResponseBody {
+	return &CampaignGetResponseBody{}
+}
+
+type CampaignGetResponseBody struct {
+	Links Links `json:"links"`
+	Campaign
+}
+
+func (r *CampaignGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaign/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignGetRequest) Do(ctx context.Context) (CampaignGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignPatchRequest() CampaignPatchRequest {
+	r := CampaignPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignPatchRequest struct {
+	client      *Client
+	queryParams *CampaignPatchRequestQueryParams
+	pathParams  *CampaignPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignPatchRequestBody
+}
+
+func (r CampaignPatchRequest) NewQueryParams() *CampaignPatchRequestQueryParams {
+	return &CampaignPatchRequestQueryParams{}
+}
+
+type CampaignPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CampaignPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignPatchRequest) QueryParams() *CampaignPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignPatchRequest) NewPathParams() *CampaignPatchRequestPathParams {
+	return &CampaignPatchRequestPathParams{}
+}
+
+type CampaignPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignPatchRequest) PathParams() *CampaignPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignPatchRequest) NewRequestBody() CampaignPatchRequestBody {
+	return CampaignPatchRequestBody{}
+}
+
+type CampaignPatchRequestBody struct {
+	Campaign
+}
+
+func (r *CampaignPatchRequest) RequestBody() *CampaignPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CampaignPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CampaignPatchRequest) SetRequestBody(body CampaignPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignPatchRequest) NewResponseBody() *CampaignPatchResponseBody {
+	return &CampaignPatchResponseBody{}
+}
+
+type CampaignPatchResponseBody struct {
+}
+
+func (r *CampaignPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaign/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignPatchRequest) Do(ctx context.Context) (CampaignPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
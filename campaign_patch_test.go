@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCampaignPatch(t *testing.T) {
+	req := client.NewCampaignPatchRequest()
+	req.PathParams().ID = 3
+	req.RequestBody().Cost = netsuite.NewDecimalFromInt(1250, 0)
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignPostRequest() CampaignPostRequest {
+	r := CampaignPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignPostRequest struct {
+	client      *Client
+	queryParams *CampaignPostRequestQueryParams
+	pathParams  *CampaignPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignPostRequestBody
+}
+
+func (r CampaignPostRequest) NewQueryParams() *CampaignPostRequestQueryParams {
+	return &CampaignPostRequestQueryParams{}
+}
+
+type CampaignPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CampaignPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignPostRequest) QueryParams() *CampaignPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignPostRequest) NewPathParams() *CampaignPostRequestPathParams {
+	return &CampaignPostRequestPathParams{}
+}
+
+type CampaignPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignPostRequest) PathParams() *CampaignPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignPostRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignPostRequest) NewRequestBody() CampaignPostRequestBody {
+	return CampaignPostRequestBody{}
+}
+
+type CampaignPostRequestBody struct {
+	Campaign
+}
+
+func (r *CampaignPostRequest) RequestBody() *CampaignPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CampaignPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CampaignPostRequest) SetRequestBody(body CampaignPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignPostRequest) NewResponseBody() *CampaignPostResponseBody {
+	return &CampaignPostResponseBody{}
+}
+
+type CampaignPostResponseBody struct {
+}
+
+func (r *CampaignPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaign", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignPostRequest) Do(ctx context.Context) (CampaignPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
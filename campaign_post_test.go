@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCampaignPost(t *testing.T) {
+	req := client.NewCampaignPostRequest()
+	req.RequestBody().CampaignID = "SPRING22"
+	req.RequestBody().Title = "Spring 2022 newsletter"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
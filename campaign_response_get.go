@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignResponseGetRequest() CampaignResponseGetRequest {
+	r := CampaignResponseGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignResponseGetRequest struct {
+	client      *Client
+	queryParams *CampaignResponseGetRequestQueryParams
+	pathParams  *CampaignResponseGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignResponseGetRequestBody
+}
+
+func (r CampaignResponseGetRequest) NewQueryParams() *CampaignResponseGetRequestQueryParams {
+	return &CampaignResponseGetRequestQueryParams{}
+}
+
+type CampaignResponseGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CampaignResponseGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignResponseGetRequest) QueryParams() *CampaignResponseGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignResponseGetRequest) NewPathParams() *CampaignResponseGetRequestPathParams {
+	return &CampaignResponseGetRequestPathParams{}
+}
+
+type CampaignResponseGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignResponseGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignResponseGetRequest) PathParams() *CampaignResponseGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponseGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponseGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignResponseGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignResponseGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignResponseGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignResponseGetRequest) NewRequestBody() CampaignResponseGetRequestBody {
+	return CampaignResponseGetRequestBody{}
+}
+
+type CampaignResponseGetRequestBody struct {
+}
+
+func (r *CampaignResponseGetRequest) RequestBody() *CampaignResponseGetRequestBody {
+	return nil
+}
+
+func (r *CampaignResponseGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CampaignResponseGetRequest) SetRequestBody(body CampaignResponseGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignResponseGetRequest) NewResponseBody() *CampaignResponseGetResponseBody {
+	return &CampaignResponseGetResponseBody{}
+}
+
+type CampaignResponseGetResponseBody struct {
+	Links Links `json:"links"`
+	CampaignResponse
+}
+
+func (r *CampaignResponseGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaignResponse/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignResponseGetRequest) Do(ctx context.Context) (CampaignResponseGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
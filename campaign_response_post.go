@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignResponsePostRequest() CampaignResponsePostRequest {
+	r := CampaignResponsePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignResponsePostRequest struct {
+	client      *Client
+	queryParams *CampaignResponsePostRequestQueryParams
+	pathParams  *CampaignResponsePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignResponsePostRequestBody
+}
+
+func (r CampaignResponsePostRequest) NewQueryParams() *CampaignResponsePostRequestQueryParams {
+	return &CampaignResponsePostRequestQueryParams{}
+}
+
+type CampaignResponsePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CampaignResponsePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignResponsePostRequest) QueryParams() *CampaignResponsePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignResponsePostRequest) NewPathParams() *CampaignResponsePostRequestPathParams {
+	return &CampaignResponsePostRequestPathParams{}
+}
+
+type CampaignResponsePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CampaignResponsePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CampaignResponsePostRequest) PathParams() *CampaignResponsePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponsePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponsePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignResponsePostRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignResponsePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignResponsePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignResponsePostRequest) NewRequestBody() CampaignResponsePostRequestBody {
+	return CampaignResponsePostRequestBody{}
+}
+
+type CampaignResponsePostRequestBody struct {
+	CampaignResponse
+}
+
+func (r *CampaignResponsePostRequest) RequestBody() *CampaignResponsePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CampaignResponsePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CampaignResponsePostRequest) SetRequestBody(body CampaignResponsePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignResponsePostRequest) NewResponseBody() *CampaignResponsePostResponseBody {
+	return &CampaignResponsePostResponseBody{}
+}
+
+type CampaignResponsePostResponseBody struct {
+}
+
+func (r *CampaignResponsePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaignResponse", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignResponsePostRequest) Do(ctx context.Context) (CampaignResponsePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
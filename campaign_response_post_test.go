@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCampaignResponsePost(t *testing.T) {
+	req := client.NewCampaignResponsePostRequest()
+	req.RequestBody().Entity.ID = "70202"
+	req.RequestBody().LeadSource.ID = "3"
+	req.RequestBody().Response.ID = "RESPONDED"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignResponsesGetRequest() CampaignResponsesGetRequest {
+	r := CampaignResponsesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignResponsesGetRequest struct {
+	client      *Client
+	queryParams *CampaignResponsesGetRequestQueryParams
+	pathParams  *CampaignResponsesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignResponsesGetRequestBody
+}
+
+func (r CampaignResponsesGetRequest) NewQueryParams() *CampaignResponsesGetRequestQueryParams {
+	return &CampaignResponsesGetRequestQueryParams{}
+}
+
+type CampaignResponsesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CampaignResponsesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignResponsesGetRequest) QueryParams() *CampaignResponsesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignResponsesGetRequest) NewPathParams() *CampaignResponsesGetRequestPathParams {
+	return &CampaignResponsesGetRequestPathParams{}
+}
+
+type CampaignResponsesGetRequestPathParams struct {
+}
+
+func (p *CampaignResponsesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CampaignResponsesGetRequest) PathParams() *CampaignResponsesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponsesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignResponsesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignResponsesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignResponsesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignResponsesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignResponsesGetRequest) NewRequestBody() CampaignResponsesGetRequestBody {
+	return CampaignResponsesGetRequestBody{}
+}
+
+type CampaignResponsesGetRequestBody struct {
+}
+
+func (r *CampaignResponsesGetRequest) RequestBody() *CampaignResponsesGetRequestBody {
+	return nil
+}
+
+func (r *CampaignResponsesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CampaignResponsesGetRequest) SetRequestBody(body CampaignResponsesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignResponsesGetRequest) NewResponseBody() *CampaignResponsesGetResponseBody {
+	return &CampaignResponsesGetResponseBody{}
+}
+
+type CampaignResponsesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CampaignResponsesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaignResponse", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignResponsesGetRequest) Do(ctx context.Context) (CampaignResponsesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
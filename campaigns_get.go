@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCampaignsGetRequest() CampaignsGetRequest {
+	r := CampaignsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CampaignsGetRequest struct {
+	client      *Client
+	queryParams *CampaignsGetRequestQueryParams
+	pathParams  *CampaignsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CampaignsGetRequestBody
+}
+
+func (r CampaignsGetRequest) NewQueryParams() *CampaignsGetRequestQueryParams {
+	return &CampaignsGetRequestQueryParams{}
+}
+
+type CampaignsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CampaignsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CampaignsGetRequest) QueryParams() *CampaignsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CampaignsGetRequest) NewPathParams() *CampaignsGetRequestPathParams {
+	return &CampaignsGetRequestPathParams{}
+}
+
+type CampaignsGetRequestPathParams struct {
+}
+
+func (p *CampaignsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CampaignsGetRequest) PathParams() *CampaignsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CampaignsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CampaignsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CampaignsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CampaignsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CampaignsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CampaignsGetRequest) NewRequestBody() CampaignsGetRequestBody {
+	return CampaignsGetRequestBody{}
+}
+
+type CampaignsGetRequestBody struct {
+}
+
+func (r *CampaignsGetRequest) RequestBody() *CampaignsGetRequestBody {
+	return nil
+}
+
+func (r *CampaignsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CampaignsGetRequest) SetRequestBody(body CampaignsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CampaignsGetRequest) NewResponseBody() *CampaignsGetResponseBody {
+	return &CampaignsGetResponseBody{}
+}
+
+type CampaignsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CampaignsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/campaign", r.PathParams())
+	return &u, err
+}
+
+func (r *CampaignsGetRequest) Do(ctx context.Context) (CampaignsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
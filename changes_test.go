@@ -0,0 +1,85 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestChanges(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from transaction",
+		map[string]interface{}{"id": "10", "lastmodified": "2024-03-01 10:00:00", "created": "2024-01-01 09:00:00"},
+		map[string]interface{}{"id": "11", "lastmodified": "2024-03-01 10:05:00", "created": "2024-03-01 10:05:00"},
+		map[string]interface{}{"id": "12", "lastmodified": "2024-03-01 10:06:00", "created": "2024-01-01 09:00:00"},
+	)
+	// the second page, after 10 was modified again while paging: at an
+	// offset of 2, 12 would be skipped
+	srv.AddSuiteQL("lastmodifieddate = TO_TIMESTAMP('2024-03-01 10:05:00', 'YYYY-MM-DD HH24:MI:SS') AND id > 11",
+		map[string]interface{}{"id": "12", "lastmodified": "2024-03-01 10:06:00", "created": "2024-01-01 09:00:00"},
+		map[string]interface{}{"id": "10", "lastmodified": "2024-03-01 10:07:30", "created": "2024-01-01 09:00:00"},
+	)
+
+	since := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	changes, err := srv.Client().Changes(context.Background(), "salesOrder", since, &netsuite.ChangesOptions{PageSize: 2, Where: "subsidiary = 2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := changes.IDs(); len(ids) != 3 || ids[0] != "11" || ids[1] != "12" || ids[2] != "10" {
+		t.Errorf("expected 11, 12 and 10, got %v", ids)
+	}
+	if !changes.Changes[0].Created || changes.Changes[1].Created || changes.Changes[2].Created {
+		t.Errorf("expected only 11 to be created, got %+v", changes.Changes)
+	}
+	if expected := time.Date(2024, 3, 1, 10, 7, 30, 0, time.UTC); !changes.HighWaterMark.Equal(expected) {
+		t.Errorf("expected high-water mark %s, got %s", expected, changes.HighWaterMark)
+	}
+
+	body := struct{ Q string }{}
+	json.Unmarshal(srv.Requests()[0].Body, &body)
+	q := body.Q
+	for _, e := range []string{"FROM transaction", "type = 'SalesOrd'", "lastmodifieddate >= TO_TIMESTAMP('2024-03-01 10:00:00'", "(subsidiary = 2)"} {
+		if !strings.Contains(q, e) {
+			t.Errorf("expected %q in query %s", e, q)
+		}
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("expected 2 pages, got %d requests", n)
+	}
+	if offset := srv.Requests()[1].Query.Get("offset"); offset != "" && offset != "0" {
+		t.Errorf("expected the second page to continue after the last row, got offset %s", offset)
+	}
+}
+
+func TestChangesOrder(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from customer",
+		map[string]interface{}{"id": "9", "lastmodified": "2024-03-01 10:00:00", "created": "2024-01-01 09:00:00"},
+		map[string]interface{}{"id": "10", "lastmodified": "2024-03-01 10:00:00", "created": "2024-01-01 09:00:00"},
+	)
+
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	changes, err := srv.Client().Changes(context.Background(), "customer", since, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := changes.IDs(); len(ids) != 2 || ids[0] != "9" || ids[1] != "10" {
+		t.Errorf("expected 9 before 10, got %v", ids)
+	}
+
+	_, err = srv.Client().Changes(context.Background(), "customer WHERE 1=1 --", since, nil)
+	if err == nil {
+		t.Error("expected an invalid record type to fail")
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("expected the invalid record type not to be queried, got %d requests", n)
+	}
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCheckDeleteRequest() CheckDeleteRequest {
+	r := CheckDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CheckDeleteRequest struct {
+	client      *Client
+	queryParams *CheckDeleteRequestQueryParams
+	pathParams  *CheckDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CheckDeleteRequestBody
+}
+
+func (r CheckDeleteRequest) NewQueryParams() *CheckDeleteRequestQueryParams {
+	return &CheckDeleteRequestQueryParams{}
+}
+
+type CheckDeleteRequestQueryParams struct {
+}
+
+func (p CheckDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CheckDeleteRequest) QueryParams() *CheckDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CheckDeleteRequest) NewPathParams() *CheckDeleteRequestPathParams {
+	return &CheckDeleteRequestPathParams{}
+}
+
+type CheckDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CheckDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CheckDeleteRequest) PathParams() *CheckDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CheckDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CheckDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CheckDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CheckDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CheckDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CheckDeleteRequest) NewRequestBody() CheckDeleteRequestBody {
+	return CheckDeleteRequestBody{}
+}
+
+type CheckDeleteRequestBody struct {
+}
+
+func (r *CheckDeleteRequest) RequestBody() *CheckDeleteRequestBody {
+	return nil
+}
+
+func (r *CheckDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CheckDeleteRequest) SetRequestBody(body CheckDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CheckDeleteRequest) NewResponseBody() *CheckDeleteResponseBody {
+	return &CheckDeleteResponseBody{}
+}
+
+type CheckDeleteResponseBody struct {
+}
+
+func (r *CheckDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/check/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CheckDeleteRequest) Do(ctx context.Context) (CheckDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCheckGetRequest() CheckGetRequest {
+	r := CheckGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CheckGetRequest struct {
+	client      *Client
+	queryParams *CheckGetRequestQueryParams
+	pathParams  *CheckGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CheckGetRequestBody
+}
+
+func (r CheckGetRequest) NewQueryParams() *CheckGetRequestQueryParams {
+	return &CheckGetRequestQueryParams{}
+}
+
+type CheckGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CheckGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CheckGetRequest) QueryParams() *CheckGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CheckGetRequest) NewPathParams() *CheckGetRequestPathParams {
+	return &CheckGetRequestPathParams{}
+}
+
+type CheckGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CheckGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CheckGetRequest) PathParams() *CheckGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CheckGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CheckGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CheckGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CheckGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CheckGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CheckGetRequest) NewRequestBody() CheckGetRequestBody {
+	return CheckGetRequestBody{}
+}
+
+type CheckGetRequestBody struct {
+}
+
+func (r *CheckGetRequest) RequestBody() *CheckGetRequestBody {
+	return nil
+}
+
+func (r *CheckGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CheckGetRequest) SetRequestBody(body CheckGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CheckGetRequest) NewResponseBody() *CheckGetResponseBody {
+	return &CheckGetResponseBody{}
+}
+
+type CheckGetResponseBody struct {
+	Links Links `json:"links"`
+	Check
+}
+
+func (r *CheckGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/check/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CheckGetRequest) Do(ctx context.Context) (CheckGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
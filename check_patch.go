@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCheckPatchRequest() CheckPatchRequest {
+	r := CheckPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CheckPatchRequest struct {
+	client      *Client
+	queryParams *CheckPatchRequestQueryParams
+	pathParams  *CheckPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CheckPatchRequestBody
+}
+
+func (r CheckPatchRequest) NewQueryParams() *CheckPatchRequestQueryParams {
+	return &CheckPatchRequestQueryParams{}
+}
+
+type CheckPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CheckPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CheckPatchRequest) QueryParams() *CheckPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CheckPatchRequest) NewPathParams() *CheckPatchRequestPathParams {
+	return &CheckPatchRequestPathParams{}
+}
+
+type CheckPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CheckPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CheckPatchRequest) PathParams() *CheckPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CheckPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CheckPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CheckPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CheckPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CheckPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CheckPatchRequest) NewRequestBody() CheckPatchRequestBody {
+	return CheckPatchRequestBody{}
+}
+
+type CheckPatchRequestBody struct {
+	Check
+}
+
+func (r *CheckPatchRequest) RequestBody() *CheckPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CheckPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CheckPatchRequest) SetRequestBody(body CheckPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CheckPatchRequest) NewResponseBody() *CheckPatchResponseBody {
+	return &CheckPatchResponseBody{}
+}
+
+type CheckPatchResponseBody struct {
+}
+
+func (r *CheckPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/check/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CheckPatchRequest) Do(ctx context.Context) (CheckPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCheckPostRequest() CheckPostRequest {
+	r := CheckPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CheckPostRequest struct {
+	client      *Client
+	queryParams *CheckPostRequestQueryParams
+	pathParams  *CheckPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CheckPostRequestBody
+}
+
+func (r CheckPostRequest) NewQueryParams() *CheckPostRequestQueryParams {
+	return &CheckPostRequestQueryParams{}
+}
+
+type CheckPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CheckPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CheckPostRequest) QueryParams() *CheckPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CheckPostRequest) NewPathParams() *CheckPostRequestPathParams {
+	return &CheckPostRequestPathParams{}
+}
+
+type CheckPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CheckPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CheckPostRequest) PathParams() *CheckPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CheckPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CheckPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CheckPostRequest) Method() string {
+	return r.method
+}
+
+func (r *CheckPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CheckPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CheckPostRequest) NewRequestBody() CheckPostRequestBody {
+	return CheckPostRequestBody{}
+}
+
+type CheckPostRequestBody struct {
+	Check
+}
+
+func (r *CheckPostRequest) RequestBody() *CheckPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CheckPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CheckPostRequest) SetRequestBody(body CheckPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CheckPostRequest) NewResponseBody() *CheckPostResponseBody {
+	return &CheckPostResponseBody{}
+}
+
+type CheckPostResponseBody struct {
+}
+
+func (r *CheckPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/check", r.PathParams())
+	return &u, err
+}
+
+func (r *CheckPostRequest) Do(ctx context.Context) (CheckPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
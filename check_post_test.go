@@ -0,0 +1,33 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCheckPost(t *testing.T) {
+	req := client.NewCheckPostRequest()
+	req.RequestBody().Entity.ID = "1642"
+	req.RequestBody().Account.ID = "1"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Expense = netsuite.CheckExpenses{
+		Items: netsuite.CheckExpenseItems{
+			{
+				Account: netsuite.Account{ID: "213"},
+				Amount:  netsuite.MustDecimal("250"),
+				Memo:    "Office supplies",
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
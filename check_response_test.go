@@ -0,0 +1,75 @@
+package netsuite_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCheckResponseContentTypes(t *testing.T) {
+	responses := map[string]struct {
+		status      int
+		contentType string
+		body        string
+	}{
+		"/oracle":  {http.StatusBadRequest, "application/vnd.oracle.resource+json", `{"status":400,"o:errorDetails":[{"detail":"Invalid field","o:errorCode":"INVALID_FIELD"}]}`},
+		"/json":    {http.StatusUnauthorized, "application/json; charset=utf-8", `{"error":"invalid_grant"}`},
+		"/html":    {http.StatusBadGateway, "text/html", `<html><head><style>h1{}</style></head><body><h1>502 Bad Gateway</h1></body></html>`},
+		"/empty":   {http.StatusServiceUnavailable, "", ``},
+		"/unknown": {http.StatusTeapot, "text/plain", `short and stout`},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := responses[r.URL.Path]
+		if resp.contentType != "" {
+			w.Header().Set("Content-Type", resp.contentType)
+		}
+		w.WriteHeader(resp.status)
+		w.Write([]byte(resp.body))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+	)
+
+	expected := map[string]string{
+		"/oracle":  "INVALID_FIELD: Invalid field",
+		"/json":    `UNAUTHORIZED: {"error":"invalid_grant"}`,
+		"/html":    "BAD_GATEWAY: 502 Bad Gateway",
+		"/empty":   "SERVICE_UNAVAILABLE: 503 Service Unavailable",
+		"/unknown": "IM_A_TEAPOT: short and stout",
+	}
+	for path, msg := range expected {
+		err := c.Get(context.Background(), path, nil, nil)
+		errResp := &netsuite.ErrorResponse{}
+		if !errors.As(err, &errResp) {
+			t.Errorf("%s: expected an error response, got %v", path, err)
+			continue
+		}
+		if errResp.Error() != msg {
+			t.Errorf("%s: expected %q, got %q", path, msg, errResp.Error())
+		}
+		if errResp.Status != responses[path].status {
+			t.Errorf("%s: expected status %d, got %d", path, responses[path].status, errResp.Status)
+		}
+	}
+
+	// json isn't expected from this endpoint
+	ctx := netsuite.WithErrorContentTypes(context.Background(), "application/vnd.oracle.resource+json")
+	err := c.Get(ctx, "/json", nil, nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "UNAUTHORIZED") {
+		t.Errorf("expected the status of the response, got %v", err)
+	}
+	ctx = netsuite.WithErrorContentTypes(context.Background(), "text/plain")
+	err = c.Get(ctx, "/unknown", nil, nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "IM_A_TEAPOT") {
+		t.Errorf("expected the status of the response, got %v", err)
+	}
+}
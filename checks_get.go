@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewChecksGetRequest() ChecksGetRequest {
+	r := ChecksGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ChecksGetRequest struct {
+	client      *Client
+	queryParams *ChecksGetRequestQueryParams
+	pathParams  *ChecksGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ChecksGetRequestBody
+}
+
+func (r ChecksGetRequest) NewQueryParams() *ChecksGetRequestQueryParams {
+	return &ChecksGetRequestQueryParams{}
+}
+
+type ChecksGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ChecksGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ChecksGetRequest) QueryParams() *ChecksGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ChecksGetRequest) NewPathParams() *ChecksGetRequestPathParams {
+	return &ChecksGetRequestPathParams{}
+}
+
+type ChecksGetRequestPathParams struct {
+}
+
+func (p *ChecksGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ChecksGetRequest) PathParams() *ChecksGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ChecksGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ChecksGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ChecksGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ChecksGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ChecksGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ChecksGetRequest) NewRequestBody() ChecksGetRequestBody {
+	return ChecksGetRequestBody{}
+}
+
+type ChecksGetRequestBody struct {
+}
+
+func (r *ChecksGetRequest) RequestBody() *ChecksGetRequestBody {
+	return nil
+}
+
+func (r *ChecksGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ChecksGetRequest) SetRequestBody(body ChecksGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ChecksGetRequest) NewResponseBody() *ChecksGetResponseBody {
+	return &ChecksGetResponseBody{}
+}
+
+type ChecksGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ChecksGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/check", r.PathParams())
+	return &u, err
+}
+
+func (r *ChecksGetRequest) Do(ctx context.Context) (ChecksGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
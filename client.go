@@ -7,14 +7,15 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
+	"text/template/parse"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,26 +32,57 @@ var (
 	BaseURL string = "https://{{.account_id}}.suitetalk.api.netsuite.com/services/rest"
 )
 
-// NewClient returns a new Exact Globe Client client
-func NewClient(httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
+// NewClient returns a new client configured with opts, e.g.
+//
+//	client := netsuite.NewClient(
+//		netsuite.WithAccountID("1234567_SB1"),
+//		netsuite.WithTokenAuth(consumerKey, consumerSecret, tokenID, tokenSecret),
+//		netsuite.WithRetry(netsuite.RetryPolicy{MaxRetries: 3}),
+//	)
+func NewClient(opts ...Option) *Client {
 	client := &Client{}
 
-	client.SetHTTPClient(httpClient)
+	client.SetHTTPClient(http.DefaultClient)
 	client.SetBaseURL(BaseURL)
 	client.SetDebug(false)
 	client.SetUserAgent(userAgent)
 	client.SetMediaType(mediaType)
 	client.SetCharset(charset)
+	client.schemaCache = newSchemaCache()
+	client.governance = &governanceState{}
+	client.flights = newFlightGroup()
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(client)
+		}
+	}
 
 	return client
 }
 
-// Client manages communication with Exact Globe Client
+// Client manages communication with the NetSuite REST API.
+//
+// A Client is safe for concurrent use by multiple goroutines: the setters and
+// getters are guarded, and every request uses a consistent snapshot of the
+// configuration taken when it's sent. Prefer configuring a client once with
+// NewClient's options, and use With for variations instead of changing a
+// shared client.
 type Client struct {
+	mu sync.RWMutex
+	clientConfig
+
+	// record type schemas fetched with Schema()
+	schemaCache *schemaCache
+
+	governance *governanceState
+
+	// requests in flight, see SetCoalesceRequests
+	flights *flightGroup
+}
+
+// clientConfig is the configuration of a client, guarded by Client.mu
+type clientConfig struct {
 	// HTTP client used to communicate with the Client.
 	http *http.Client
 
@@ -75,10 +107,39 @@ type Client struct {
 	mediaType             string
 	charset               string
 	disallowUnknownFields bool
+	useNumber             bool
+	validateRequests      bool
+	coalesceRequests      bool
+
+	propertyNameValidation PropertyNameValidation
 
 	// Optional function called after every successful request made to the DO Clients
 	beforeRequestDo    BeforeRequestDoCallback
 	onRequestCompleted RequestCompletionCallback
+
+	asyncPollInterval time.Duration
+
+	logger   Logger
+	logLevel LogLevel
+	metrics  Metrics
+
+	dumper          Dumper
+	debugSampleRate float64
+
+	onGovernance    func(*http.Request, Governance)
+	onUnknownFields func(*http.Request, []string)
+
+	retryPolicy        RetryPolicy
+	rateLimiter        RateLimiter
+	concurrencyLimiter ConcurrencyLimiter
+	cachePolicy        CachePolicy
+}
+
+// config returns a snapshot of the configuration.
+func (c *Client) config() clientConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.clientConfig
 }
 
 type BeforeRequestDoCallback func(*http.Client, *http.Request, interface{})
@@ -87,62 +148,98 @@ type BeforeRequestDoCallback func(*http.Client, *http.Request, interface{})
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
 func (c *Client) SetHTTPClient(client *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.http = client
 }
 
-func (c Client) Debug() bool {
+func (c *Client) HTTPClient() *http.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.http
+}
+
+func (c *Client) Debug() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.debug
 }
 
 func (c *Client) SetDebug(debug bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.debug = debug
 }
 
-func (c Client) CompanyID() string {
+func (c *Client) CompanyID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.companyID
 }
 
 func (c *Client) SetCompanyID(companyID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.companyID = companyID
 }
 
-func (c Client) UseTokenAuth() bool {
+func (c *Client) UseTokenAuth() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.useTokenAuth
 }
 
 func (c *Client) SetUseTokenAuth(useTokenAuth bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.useTokenAuth = useTokenAuth
 }
 
-func (c Client) ClientID() string {
+func (c *Client) ClientID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.clientID
 }
 
 func (c *Client) SetClientID(clientID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.clientID = clientID
 }
 
-func (c Client) ClientSecret() string {
+func (c *Client) ClientSecret() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.clientSecret
 }
 
 func (c *Client) SetClientSecret(clientSecret string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.clientSecret = clientSecret
 }
 
-func (c Client) TokenID() string {
+func (c *Client) TokenID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.tokenID
 }
 
 func (c *Client) SetTokenID(tokenID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.tokenID = tokenID
 }
 
-func (c Client) TokenSecret() string {
+func (c *Client) TokenSecret() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.tokenSecret
 }
 
 func (c *Client) SetTokenSecret(tokenSecret string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.tokenSecret = tokenSecret
 }
 
@@ -154,21 +251,26 @@ func (c *Client) SetTokenSecret(tokenSecret string) {
 // 	c.accountID = accountID
 // }
 
-func (c Client) ContentLanguage() string {
+func (c *Client) ContentLanguage() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.contentLanguage
 }
 
 func (c *Client) SetContentLanguage(contentLanguage string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.contentLanguage = contentLanguage
 }
 
-func (c Client) BaseURL() (*url.URL, error) {
-	tmpl, err := template.New("host").Parse(c.baseURL)
+func (c *Client) BaseURL() (*url.URL, error) {
+	cfg := c.config()
+	tmpl, err := template.New("host").Parse(cfg.baseURL)
 	if err != nil {
 		return &url.URL{}, err
 	}
 	buf := new(bytes.Buffer)
-	err = tmpl.Execute(buf, map[string]interface{}{"account_id": c.companyID})
+	err = tmpl.Execute(buf, map[string]interface{}{"account_id": cfg.companyID})
 	if err != nil {
 		return &url.URL{}, err
 	}
@@ -176,38 +278,92 @@ func (c Client) BaseURL() (*url.URL, error) {
 }
 
 func (c *Client) SetBaseURL(baseURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.baseURL = baseURL
 }
 
 func (c *Client) SetMediaType(mediaType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.mediaType = mediaType
 }
 
-func (c Client) MediaType() string {
-	return mediaType
+func (c *Client) MediaType() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.mediaType
 }
 
 func (c *Client) SetCharset(charset string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.charset = charset
 }
 
-func (c Client) Charset() string {
-	return charset
+func (c *Client) Charset() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.charset
 }
 
 func (c *Client) SetUserAgent(userAgent string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.userAgent = userAgent
 }
 
-func (c Client) UserAgent() string {
-	return userAgent
+func (c *Client) UserAgent() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.userAgent
 }
 
 func (c *Client) SetDisallowUnknownFields(disallowUnknownFields bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.disallowUnknownFields = disallowUnknownFields
 }
 
+func (c *Client) DisallowUnknownFields() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.disallowUnknownFields
+}
+
+// SetUseNumber decodes untyped numbers in responses (interface{} values) as
+// json.Number instead of float64, so they can be converted to a Decimal
+// without loss of precision.
+func (c *Client) SetUseNumber(useNumber bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.useNumber = useNumber
+}
+
+func (c *Client) UseNumber() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.useNumber
+}
+
+// SetValidateRequests enables validating the bodies of record POST and PATCH
+// requests against the record type's schema before they're sent. Invalid
+// bodies are returned as a *ValidationError.
+func (c *Client) SetValidateRequests(validateRequests bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validateRequests = validateRequests
+}
+
+func (c *Client) ValidateRequests() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.validateRequests
+}
+
 func (c *Client) SetBeforeRequestDo(fun BeforeRequestDoCallback) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.beforeRequestDo = fun
 }
 
@@ -219,7 +375,7 @@ func (c *Client) GetEndpointURL(p string, pathParams PathParams) (url.URL, error
 
 	parsed, err := url.Parse(p)
 	if err != nil {
-		log.Fatal(err)
+		return url.URL{}, errors.Wrapf(err, "invalid endpoint %s", p)
 	}
 	q := clientURL.Query()
 	for k, vv := range parsed.Query() {
@@ -233,38 +389,101 @@ func (c *Client) GetEndpointURL(p string, pathParams PathParams) (url.URL, error
 
 	tmpl, err := template.New("path").Parse(clientURL.Path)
 	if err != nil {
-		log.Fatal(err)
+		return url.URL{}, errors.Wrapf(err, "invalid path template for %s", p)
+	}
+
+	params := map[string]string{}
+	if pathParams != nil {
+		params = pathParams.Params()
+	}
+
+	// a missing param would be rendered as <no value>
+	missing := missingPathParams(tmpl.Tree.Root, params, nil)
+	if len(missing) > 0 {
+		return url.URL{}, errors.Errorf("missing path params for %s: %s", p, strings.Join(missing, ", "))
 	}
 
 	buf := new(bytes.Buffer)
-	params := pathParams.Params()
 	// params["administration_id"] = c.Administration()
 	err = tmpl.Execute(buf, params)
 	if err != nil {
-		return url.URL{}, err
+		return url.URL{}, errors.Wrapf(err, "path params for %s", p)
 	}
 
 	clientURL.Path = buf.String()
 	return *clientURL, nil
 }
 
+// missingPathParams appends the fields of the path template that have no (or
+// an empty) value in params to missing.
+func missingPathParams(node parse.Node, params map[string]string, missing []string) []string {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		for _, child := range n.Nodes {
+			missing = missingPathParams(child, params, missing)
+		}
+	case *parse.ActionNode:
+		for _, cmd := range n.Pipe.Cmds {
+			for _, arg := range cmd.Args {
+				missing = missingPathParams(arg, params, missing)
+			}
+		}
+	case *parse.FieldNode:
+		name := strings.Join(n.Ident, ".")
+		if params[name] != "" {
+			break
+		}
+		for _, m := range missing {
+			if m == name {
+				return missing
+			}
+		}
+		missing = append(missing, name)
+	}
+	return missing
+}
+
 func (c *Client) NewRequest(ctx context.Context, req Request) (*http.Request, error) {
-	// convert body struct to json
-	buf := new(bytes.Buffer)
-	if req.RequestBodyInterface() != nil {
-		err := json.NewEncoder(buf).Encode(req.RequestBodyInterface())
+	// read the configuration once, so a concurrent setter can't change it
+	// halfway through the request
+	cfg := c.config()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.validateRequests {
+		err := c.validateRequest(ctx, req)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// convert body struct to json, unless it's already encoded
+	var body io.Reader
+	contentType := fmt.Sprintf("%s; charset=%s", cfg.mediaType, cfg.charset)
+	if raw, ok := AsRawBody(req.RequestBodyInterface()); ok {
+		body = raw.Reader
+		if raw.ContentType != "" {
+			contentType = raw.ContentType
+		}
+	} else {
+		buf := new(bytes.Buffer)
+		if req.RequestBodyInterface() != nil {
+			err := json.NewEncoder(buf).Encode(req.RequestBodyInterface())
+			if err != nil {
+				return nil, err
+			}
+		}
+		body = buf
+	}
+
 	// create new http request
 	u, err := req.URL()
 	if err != nil {
 		return nil, err
 	}
 
-	r, err := http.NewRequest(req.Method(), u.String(), buf)
+	r, err := http.NewRequest(req.Method(), u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -281,20 +500,37 @@ func (c *Client) NewRequest(ctx context.Context, req Request) (*http.Request, er
 	}
 
 	// set other headers
-	r.Header.Add("Content-Type", fmt.Sprintf("%s; charset=%s", c.MediaType(), c.Charset()))
-	r.Header.Add("Accept", c.MediaType())
-	r.Header.Add("User-Agent", c.UserAgent())
+	r.Header.Add("Content-Type", contentType)
+	r.Header.Add("Accept", cfg.mediaType)
+	r.Header.Add("User-Agent", cfg.userAgent)
 
-	if c.ContentLanguage() != "" {
-		r.Header.Add("Accept-Language", c.ContentLanguage())
-		r.Header.Add("Content-Language", c.ContentLanguage())
+	if language := requestLanguage(ctx, cfg.contentLanguage); language != "" {
+		r.Header.Add("Accept-Language", language)
+		r.Header.Add("Content-Language", language)
+	}
+
+	setConditionalHeaders(r)
+	setPropertyNameValidation(r, cfg.propertyNameValidation)
+
+	// per request headers
+	if h, ok := req.(RequestHeaders); ok {
+		for k, vv := range h.Headers() {
+			r.Header.Del(k)
+			for _, v := range vv {
+				r.Header.Add(k, v)
+			}
+		}
 	}
 
 	return r, nil
 }
 
 func (c *Client) TokenBasedAuthorizationHeader(r *http.Request) (string, error) {
-	g := c.NewSignatureGenerator(r)
+	return c.config().tokenBasedAuthorizationHeader(r)
+}
+
+func (cfg clientConfig) tokenBasedAuthorizationHeader(r *http.Request) (string, error) {
+	g := cfg.newSignatureGenerator(r)
 	signature, err := g.Generate()
 	if err != nil {
 		return "", err
@@ -323,31 +559,28 @@ oauth_signature="%s"`,
 // Do sends an Client request and returns the Client response. The Client response is json decoded and stored in the value
 // pointed to by v, or returned as an error if an Client error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+//
+// The context of req (see NewRequest) is honored while the request is sent
+// and while the response body is read; a request of which the context is
+// already done isn't sent.
+//
+// Every attempt waits on the rate limiter (see SetRateLimiter) and the
+// concurrency limiter (see SetConcurrencyLimiter), and failed attempts are
+// retried according to the retry policy (see SetRetryPolicy).
 func (c *Client) Do(req *http.Request, body interface{}) (*http.Response, error) {
-	if c.UseTokenAuth() {
-		headerValue, err := c.TokenBasedAuthorizationHeader(req)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		req.Header.Add("Authorization", headerValue)
-	}
+	var (
+		httpResp *http.Response
+		err      error
+	)
 
-	if c.beforeRequestDo != nil {
-		c.beforeRequestDo(c.http, req, body)
-	}
-
-	if c.debug == true {
-		dump, _ := httputil.DumpRequestOut(req, true)
-		log.Println(string(dump))
-	}
+	cfg := c.config()
+	httpResp, err = c.sendCoalesced(cfg, req, body)
 
-	httpResp, err := c.http.Do(req)
 	if err != nil {
-		return nil, err
-	}
-
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, httpResp)
+		if httpResp != nil {
+			httpResp.Body.Close()
+		}
+		return httpResp, err
 	}
 
 	// close body io.Reader
@@ -357,17 +590,6 @@ func (c *Client) Do(req *http.Request, body interface{}) (*http.Response, error)
 		}
 	}()
 
-	if c.debug == true {
-		dump, _ := httputil.DumpResponse(httpResp, true)
-		log.Println(string(dump))
-	}
-
-	// check if the response isn't an error
-	err = CheckResponse(httpResp)
-	if err != nil {
-		return httpResp, err
-	}
-
 	// check the provided interface parameter
 	if httpResp == nil {
 		return httpResp, nil
@@ -382,7 +604,7 @@ func (c *Client) Do(req *http.Request, body interface{}) (*http.Response, error)
 	}
 
 	errResp := &ErrorResponse{Response: httpResp}
-	err = c.Unmarshal(httpResp.Body, body, errResp)
+	err = cfg.decodeResponse(req, httpResp.Body, body, errResp)
 	if err != nil {
 		return httpResp, err
 	}
@@ -394,7 +616,104 @@ func (c *Client) Do(req *http.Request, body interface{}) (*http.Response, error)
 	return httpResp, nil
 }
 
+// sendWithRetries sends req, retried according to the retry policy of cfg.
+func (c *Client) sendWithRetries(cfg clientConfig, req *http.Request, body interface{}) (*http.Response, error) {
+	var (
+		httpResp *http.Response
+		err      error
+	)
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		httpResp, err = c.send(cfg, req, body)
+		if attempt > cfg.retryPolicy.MaxRetries || !cfg.retryPolicy.shouldRetry(req, httpResp, err) {
+			setResponseMeta(req, httpResp, time.Since(start), attempt-1)
+			return httpResp, err
+		}
+
+		drain(httpResp)
+		timer := time.NewTimer(cfg.retryPolicy.backoff(attempt, httpResp))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req, err = rewind(req)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		req = req.WithContext(withRequestAttempt(req.Context(), attempt+1))
+	}
+}
+
+// send signs and sends a single attempt of req with the configuration cfg.
+// The body of the returned response isn't closed.
+func (c *Client) send(cfg clientConfig, req *http.Request, body interface{}) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	if cfg.rateLimiter != nil {
+		if err := cfg.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	release, err := acquire(req.Context(), cfg.concurrencyLimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.useTokenAuth {
+		headerValue, err := cfg.tokenBasedAuthorizationHeader(req)
+		if err != nil {
+			release()
+			return nil, errors.WithStack(err)
+		}
+		// a retried request is signed again with a new nonce
+		req.Header.Set("Authorization", headerValue)
+	}
+
+	if cfg.beforeRequestDo != nil {
+		cfg.beforeRequestDo(cfg.http, req, body)
+	}
+
+	dump := c.ShouldDump()
+	if dump {
+		c.DumpRequest(req)
+	}
+
+	start := time.Now()
+	httpResp, err := cfg.http.Do(req)
+	if err != nil {
+		release()
+		c.RecordRequest(req, nil, err, time.Since(start))
+		return nil, err
+	}
+	// the request is in flight until its response has been read
+	httpResp.Body = releaseBody{ReadCloser: httpResp.Body, release: release}
+
+	if cfg.onRequestCompleted != nil {
+		cfg.onRequestCompleted(req, httpResp)
+	}
+
+	if dump {
+		c.DumpResponse(httpResp)
+	}
+
+	// check if the response isn't an error
+	err = CheckResponse(httpResp)
+	c.RecordRequest(req, httpResp, err, time.Since(start))
+	return httpResp, err
+}
+
 func (c *Client) Unmarshal(r io.Reader, vv ...interface{}) error {
+	return c.config().unmarshal(r, vv...)
+}
+
+func (cfg clientConfig) unmarshal(r io.Reader, vv ...interface{}) error {
 	if len(vv) == 0 {
 		return nil
 	}
@@ -408,11 +727,17 @@ func (c *Client) Unmarshal(r io.Reader, vv ...interface{}) error {
 	for _, v := range vv {
 		r := bytes.NewReader(b)
 		dec := json.NewDecoder(r)
-		if c.disallowUnknownFields {
+		if cfg.disallowUnknownFields {
 			dec.DisallowUnknownFields()
 		}
+		if cfg.useNumber {
+			dec.UseNumber()
+		}
 
 		err := dec.Decode(v)
+		if err == nil && cfg.disallowUnknownFields {
+			err = checkUnknownFields(b, v)
+		}
 		if err != nil && err != io.EOF {
 			errs = append(errs, err)
 		}
@@ -433,9 +758,10 @@ func (c *Client) Unmarshal(r io.Reader, vv ...interface{}) error {
 
 // CheckResponse checks the Client response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
-// the 200 range. Client error responses are expected to have either no response
-// body, or a json response body that maps to ErrorResponse. Any other response
-// body will be silently ignored.
+// the 200 range. Error responses with a json body (see WithErrorContentTypes)
+// are decoded into an ErrorResponse; for any other body, or json that isn't a
+// NetSuite error, the ErrorResponse has the status of the response and the
+// body as its detail.
 func CheckResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 
@@ -449,35 +775,42 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
+	// the answer to a conditional GET of which the record didn't change
+	if r.StatusCode == http.StatusNotModified {
+		return nil
+	}
+
 	// read data and copy it back
 	data, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
 	r.Body = ioutil.NopCloser(bytes.NewReader(data))
 	if err != nil {
-		return errorResponse
+		return statusErrorResponse(errorResponse, nil)
 	}
 
-	err = checkContentType(r)
-	if err != nil {
-		return errors.WithStack(err)
+	// a failed If-Match doesn't always come with error details
+	if r.StatusCode == http.StatusPreconditionFailed && len(bytes.TrimSpace(data)) == 0 {
+		errorResponse.Status = r.StatusCode
+		errorResponse.ErrorDetails = ErrorDetails{{
+			ErrorCode: "PRECONDITION_FAILED",
+			Detail:    "the record was changed since it was read",
+		}}
+		return errorResponse
 	}
 
-	if r.ContentLength == 0 {
-		return errors.New("response body is empty")
+	// bodies of other content types (html from a proxy, text from an auth
+	// endpoint) don't hide the status of the response
+	if !expectsContentType(r) || len(bytes.TrimSpace(data)) == 0 {
+		return statusErrorResponse(errorResponse, data)
 	}
 
 	// convert json to struct
-	if len(data) != 0 {
-		err = json.Unmarshal(data, &errorResponse)
-		if err != nil {
-			return errors.WithStack(err)
-		}
-	}
-
-	if errorResponse.Error() != "" {
-		return errorResponse
+	err = json.Unmarshal(data, &errorResponse)
+	if err != nil || errorResponse.Error() == "" {
+		return statusErrorResponse(errorResponse, data)
 	}
 
-	return nil
+	return errorResponse
 }
 
 // {
@@ -529,17 +862,101 @@ func (d *ErrorDetail) Error() string {
 	return ""
 }
 
-func checkContentType(response *http.Response) error {
-	header := response.Header.Get("Content-Type")
-	contentType := strings.Split(header, ";")[0]
-	if contentType != "application/vnd.oracle.resource+json" {
-		return fmt.Errorf("Expected Content-Type \"%s\", got \"%s\"", mediaType, contentType)
+// DefaultErrorContentTypes are the content types of error responses that
+// are decoded as json, see WithErrorContentTypes.
+var DefaultErrorContentTypes = []string{
+	"application/vnd.oracle.resource+json",
+	"application/json",
+	"application/problem+json",
+}
+
+type errorContentTypesKey struct{}
+
+// WithErrorContentTypes returns a context that makes the error responses to
+// the requests sent with it decoded as json when they have one of the
+// content types, instead of DefaultErrorContentTypes. Error responses of
+// other content types become an *ErrorResponse with the status of the
+// response and (the start of) the body as its detail.
+func WithErrorContentTypes(ctx context.Context, contentTypes ...string) context.Context {
+	return context.WithValue(ctx, errorContentTypesKey{}, contentTypes)
+}
+
+// expectsContentType reports whether the body of the error response r is
+// expected to be json.
+func expectsContentType(r *http.Response) bool {
+	contentTypes := DefaultErrorContentTypes
+	if r.Request != nil {
+		if ct, ok := r.Request.Context().Value(errorContentTypesKey{}).([]string); ok {
+			contentTypes = ct
+		}
 	}
 
-	return nil
+	header := r.Header.Get("Content-Type")
+	contentType := strings.TrimSpace(strings.ToLower(strings.Split(header, ";")[0]))
+	for _, ct := range contentTypes {
+		if strings.EqualFold(ct, contentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxErrorDetail is the length of the body that's kept as the detail of an
+// error response that couldn't be decoded
+const maxErrorDetail = 512
+
+var (
+	htmlTags   = regexp.MustCompile(`(?s)<(script|style).*?</(script|style)>|<[^>]*>`)
+	whitespace = regexp.MustCompile(`\s+`)
+)
+
+// statusErrorResponse fills in errResp from the status of its response,
+// with (the text of) body as the detail.
+func statusErrorResponse(errResp *ErrorResponse, body []byte) *ErrorResponse {
+	r := errResp.Response
+	detail := string(body)
+	if strings.Contains(strings.ToLower(r.Header.Get("Content-Type")), "html") {
+		detail = htmlTags.ReplaceAllString(detail, " ")
+	}
+	detail = strings.TrimSpace(whitespace.ReplaceAllString(detail, " "))
+	if len(detail) > maxErrorDetail {
+		detail = detail[:maxErrorDetail] + "..."
+	}
+	if detail == "" {
+		detail = r.Status
+	}
+
+	// e.g. BAD_GATEWAY, like the codes of NetSuite
+	code := strings.Map(func(c rune) rune {
+		switch {
+		case c == ' ' || c == '-':
+			return '_'
+		case c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+			return c
+		case c >= 'a' && c <= 'z':
+			return c - 'a' + 'A'
+		}
+		return -1
+	}, http.StatusText(r.StatusCode))
+	if code == "" {
+		code = "HTTP_" + strconv.Itoa(r.StatusCode)
+	}
+
+	if errResp.Status == 0 {
+		errResp.Status = r.StatusCode
+	}
+	if errResp.Title == nil {
+		errResp.Title = http.StatusText(r.StatusCode)
+	}
+	errResp.ErrorDetails = ErrorDetails{{ErrorCode: code, Detail: detail}}
+	return errResp
 }
 
 func (c *Client) NewSignatureGenerator(r *http.Request) *SignatureGenerator {
+	return c.config().newSignatureGenerator(r)
+}
+
+func (cfg clientConfig) newSignatureGenerator(r *http.Request) *SignatureGenerator {
 	// u := r.URL
 	// u.RawQuery = ""
 
@@ -547,11 +964,11 @@ func (c *Client) NewSignatureGenerator(r *http.Request) *SignatureGenerator {
 		SignatureMethod:   HMACSHA256,
 		BaseURL:           r.URL.String(),
 		HTTPRequestMethod: r.Method,
-		ClientID:          c.ClientID(),
-		ClientSecret:      c.ClientSecret(),
-		TokenID:           c.TokenID(),
-		TokenSecret:       c.TokenSecret(),
-		AccountID:         strings.Replace(c.CompanyID(), "-", "_", -1),
+		ClientID:          cfg.clientID,
+		ClientSecret:      cfg.clientSecret,
+		TokenID:           cfg.tokenID,
+		TokenSecret:       cfg.tokenSecret,
+		AccountID:         strings.Replace(cfg.companyID, "-", "_", -1),
 		Nonce:             GenerateNonce(),
 		Version:           "1.0",
 		Timestamp:         time.Now().Unix(),
@@ -0,0 +1,55 @@
+package netsuite
+
+import (
+	"net/http"
+	"time"
+)
+
+// Clone returns a copy of the client. The copy shares the http client (and
+// its transport), rate limiter, logger and metrics with c; changing the
+// configuration of the copy doesn't change c.
+func (c *Client) Clone() *Client {
+	return &Client{
+		clientConfig: c.config(),
+		schemaCache:  c.schemaCache,
+		governance:   c.governance,
+		flights:      c.flights,
+	}
+}
+
+// With returns a copy of the client (see Clone) with opts applied, e.g. to
+// call another account or use another language:
+//
+//	tenant := client.With(netsuite.WithAccountID("7654321"), netsuite.WithTimeout(10*time.Second))
+//
+// A copy for another account gets its own schema cache and governance state.
+func (c *Client) With(opts ...Option) *Client {
+	clone := c.Clone()
+	for _, opt := range opts {
+		if opt != nil {
+			opt(clone)
+		}
+	}
+
+	if clone.CompanyID() != c.CompanyID() {
+		clone.schemaCache = newSchemaCache()
+		clone.governance = &governanceState{}
+	}
+	return clone
+}
+
+// WithTimeout sets the timeout of every request, including reading the
+// response body. The transport of the http client is kept so connections are
+// shared with the client the option is applied to.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		hc := http.Client{}
+		if c.http != nil {
+			hc = *c.http
+		}
+		hc.Timeout = timeout
+		c.http = &hc
+	}
+}
@@ -0,0 +1,36 @@
+package netsuite_test
+
+import (
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestClientWith(t *testing.T) {
+	limiter := netsuite.NewRateLimiter(10, 1)
+	c := netsuite.NewClient(
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithRateLimiter(limiter),
+	)
+
+	tenant := c.With(
+		netsuite.WithAccountID("7654321"),
+		netsuite.WithContentLanguage("nl-NL"),
+		netsuite.WithTimeout(5*time.Second),
+	)
+
+	if c.CompanyID() != "1234567" || c.ContentLanguage() != "" || c.HTTPClient().Timeout != 0 {
+		t.Errorf("expected the original client to be unchanged")
+	}
+	if tenant.CompanyID() != "7654321" || tenant.ContentLanguage() != "nl-NL" || tenant.HTTPClient().Timeout != 5*time.Second {
+		t.Errorf("expected the overrides to be applied")
+	}
+	if tenant.TokenID() != "tid" || tenant.RateLimiter() != limiter {
+		t.Errorf("expected credentials and rate limiter to be shared")
+	}
+	if tenant.HTTPClient().Transport != c.HTTPClient().Transport {
+		t.Errorf("expected the transport to be shared")
+	}
+}
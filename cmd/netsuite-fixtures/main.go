@@ -0,0 +1,191 @@
+// Command netsuite-fixtures fetches sample records of every record type with
+// a model in the netsuite package from a (sandbox) account, scrubs the
+// identifiers and writes them as golden json fixtures, together with a test
+// that round-trips every fixture through its model. The test fails when
+// NetSuite returns fields the models don't know about, catching model drift.
+//
+//	go run github.com/omniboost/go-netsuite-rest/cmd/netsuite-fixtures -out testdata/fixtures -test fixtures_test.go
+//
+// The records are fetched with the credentials in the environment (the same
+// variables the package tests use: AUTH_TYPE, COMPANY_ID, CLIENT_ID,
+// CLIENT_SECRET, TOKEN_ID, TOKEN_SECRET, REFRESH_TOKEN, TOKEN_URL and
+// BASE_URL).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/utils"
+	"golang.org/x/oauth2"
+)
+
+func main() {
+	records := flag.String("records", "", "comma separated record types, defaults to all supported types")
+	out := flag.String("out", "testdata/fixtures", "directory the fixtures are written to")
+	test := flag.String("test", "", "write the round-trip test to this file")
+	pkg := flag.String("package", "netsuite_test", "package name of the test")
+	count := flag.Int("count", 1, "number of records per type")
+	scrub := flag.String("scrub", strings.Join(defaultScrubKeys, ","), "comma separated fields to scrub, next to the ids")
+	flag.Parse()
+
+	types := []string{}
+	if *records == "" {
+		for rt := range recordTypes {
+			types = append(types, rt)
+		}
+	} else {
+		types = strings.Split(*records, ",")
+	}
+	sort.Strings(types)
+
+	err := os.MkdirAll(*out, 0755)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := newClient()
+	scrubber := NewScrubber(strings.Split(*scrub, ","))
+	fixtures := []Fixture{}
+	for _, rt := range types {
+		model, ok := recordTypes[rt]
+		if !ok {
+			log.Fatalf("%s: no model for record type", rt)
+		}
+
+		ids, err := listIDs(ctx, client, rt, *count)
+		if err != nil {
+			log.Printf("%s: %s", rt, err)
+			continue
+		}
+
+		for i, id := range ids {
+			record, err := getRecord(ctx, client, rt, id)
+			if err != nil {
+				log.Printf("%s %s: %s", rt, id, err)
+				continue
+			}
+
+			b, err := json.MarshalIndent(scrubber.Scrub(record), "", "  ")
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			f := Fixture{
+				File:  filepath.ToSlash(filepath.Join(*out, fmt.Sprintf("%s_%d.json", rt, i+1))),
+				Model: model,
+			}
+			err = ioutil.WriteFile(f.File, append(b, '\n'), 0644)
+			if err != nil {
+				log.Fatal(err)
+			}
+			fixtures = append(fixtures, f)
+			log.Printf("wrote %s", f.File)
+		}
+	}
+
+	if *test == "" {
+		return
+	}
+
+	src, err := GenerateTest(*pkg, fixtures)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(*test, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func listIDs(ctx context.Context, client *netsuite.Client, recordType string, count int) ([]string, error) {
+	req := client.NewCustomRecordsGetRequest()
+	req.PathParams().RecordType = recordType
+	req.QueryParams().Limit = count
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, item := range resp.Items {
+		ids = append(ids, item.ID)
+	}
+	return ids, nil
+}
+
+// getRecord fetches the record as is, so fields missing from the model are
+// kept.
+func getRecord(ctx context.Context, client *netsuite.Client, recordType, id string) (interface{}, error) {
+	req := client.NewCustomRecordGetRequest()
+	req.PathParams().RecordType = recordType
+	fmt.Sscan(id, &req.PathParams().ID)
+
+	httpReq, err := client.NewRequest(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	err = utils.AddQueryParamsToRequest(req.QueryParams(), httpReq, false)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := json.RawMessage{}
+	_, err = client.Do(httpReq, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var record interface{}
+	err = json.Unmarshal(raw, &record)
+	if m, ok := record.(map[string]interface{}); ok {
+		// the links are part of the response, not of the model
+		delete(m, "links")
+	}
+	return record, err
+}
+
+func newClient() *netsuite.Client {
+	companyID := os.Getenv("COMPANY_ID")
+
+	client := netsuite.NewClient()
+	switch os.Getenv("AUTH_TYPE") {
+	case "oauth":
+		oauthConfig := netsuite.NewOauth2Config(companyID)
+		oauthConfig.ClientID = os.Getenv("CLIENT_ID")
+		oauthConfig.ClientSecret = os.Getenv("CLIENT_SECRET")
+		if tokenURL := os.Getenv("TOKEN_URL"); tokenURL != "" {
+			oauthConfig.Endpoint.TokenURL = tokenURL
+		}
+
+		token := &oauth2.Token{
+			RefreshToken: os.Getenv("REFRESH_TOKEN"),
+		}
+		client = netsuite.NewClient(netsuite.WithHTTPClient(oauthConfig.Client(context.Background(), token)))
+	case "token":
+		client.SetUseTokenAuth(true)
+		client.SetClientID(os.Getenv("CLIENT_ID"))
+		client.SetClientSecret(os.Getenv("CLIENT_SECRET"))
+		client.SetTokenID(os.Getenv("TOKEN_ID"))
+		client.SetTokenSecret(os.Getenv("TOKEN_SECRET"))
+	default:
+		log.Fatalf("Unknown auth type: %s", os.Getenv("AUTH_TYPE"))
+	}
+
+	client.SetCompanyID(companyID)
+	if baseURL := os.Getenv("BASE_URL"); baseURL != "" {
+		client.SetBaseURL(baseURL)
+	}
+
+	return client
+}
@@ -0,0 +1,66 @@
+package main
+
+// recordTypes maps the record types with a model in the netsuite package to
+// the name of that model.
+var recordTypes = map[string]string{
+	"accountingPeriod":          "AccountingPeriod",
+	"billingAccount":            "BillingAccount",
+	"bin":                       "Bin",
+	"binTransfer":               "BinTransfer",
+	"binWorksheet":              "BinWorksheet",
+	"budget":                    "Budget",
+	"calendarEvent":             "CalendarEvent",
+	"campaign":                  "Campaign",
+	"campaignResponse":          "CampaignResponse",
+	"check":                     "Check",
+	"consolidatedExchangeRate":  "ConsolidatedExchangeRate",
+	"contact":                   "Contact",
+	"couponCode":                "CouponCode",
+	"creditCardCharge":          "CreditCardCharge",
+	"creditCardRefund":          "CreditCardRefund",
+	"currency":                  "CurrencyRecord",
+	"customer":                  "Customer",
+	"deposit":                   "Deposit",
+	"employee":                  "Employee",
+	"expenseReport":             "ExpenseReport",
+	"fiscalCalendar":            "FiscalCalendar",
+	"giftCertificate":           "GiftCertificate",
+	"giftCertificateItem":       "GiftCertificateItem",
+	"interCompanyJournalEntry":  "InterCompanyJournalEntry",
+	"inventoryAdjustment":       "InventoryAdjustment",
+	"invoice":                   "Invoice",
+	"job":                       "Job",
+	"journalEntry":              "JournalEntry",
+	"message":                   "Message",
+	"note":                      "Note",
+	"partner":                   "Partner",
+	"paymentCard":               "PaymentCard",
+	"paymentCardToken":          "PaymentCardToken",
+	"paymentMethod":             "PaymentMethod",
+	"phoneCall":                 "PhoneCall",
+	"priceLevel":                "PriceLevel",
+	"projectTask":               "ProjectTask",
+	"promotionCode":             "PromotionCode",
+	"returnAuthorization":       "ReturnAuthorization",
+	"revenueArrangement":        "RevenueArrangement",
+	"revenueElement":            "RevenueElement",
+	"revenuePlan":               "RevenuePlan",
+	"salesTaxItem":              "TaxCode",
+	"shipItem":                  "ShipItem",
+	"subscription":              "Subscription",
+	"subscriptionLine":          "SubscriptionLine",
+	"supportCase":               "SupportCase",
+	"task":                      "Task",
+	"taxGroup":                  "TaxGroup",
+	"taxPeriod":                 "TaxPeriod",
+	"taxType":                   "TaxType",
+	"term":                      "Term",
+	"timeBill":                  "TimeBill",
+	"transferOrder":             "TransferOrder",
+	"usage":                     "Usage",
+	"vendorReturnAuthorization": "VendorReturnAuthorization",
+	"workOrder":                 "WorkOrder",
+	"workOrderClose":            "WorkOrderClose",
+	"workOrderCompletion":       "WorkOrderCompletion",
+	"workOrderIssue":            "WorkOrderIssue",
+}
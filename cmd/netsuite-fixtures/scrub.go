@@ -0,0 +1,103 @@
+package main
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// defaultScrubKeys are the fields whose values are replaced, next to the
+	// ids
+	defaultScrubKeys = []string{
+		"email", "phone", "fax", "mobilePhone", "homePhone", "altPhone", "officePhone",
+		"addr1", "addr2", "addr3", "addressee", "addrText", "addrPhone", "attention",
+		"firstName", "middleName", "lastName", "salutation", "entityId", "companyName",
+		"altName", "legalName", "printOnCheckAs", "url", "comments", "memo", "message",
+		"accountNumber", "vatRegNumber", "defaultAddress",
+	}
+
+	idKeys    = map[string]bool{"id": true, "internalId": true, "externalId": true}
+	numericRe = regexp.MustCompile(`^\d+$`)
+)
+
+// Scrubber replaces identifiers and personal data in records. Ids are
+// replaced consistently, so references between fixtures stay intact.
+type Scrubber struct {
+	Keys map[string]bool
+	// Host replaces the account specific host of links
+	Host string
+
+	ids map[string]string
+}
+
+func NewScrubber(keys []string) *Scrubber {
+	s := &Scrubber{
+		Keys: map[string]bool{},
+		Host: "1234567.suitetalk.api.netsuite.com",
+		ids:  map[string]string{},
+	}
+	for _, k := range keys {
+		s.Keys[k] = true
+	}
+	return s
+}
+
+// ID returns the replacement of id.
+func (s *Scrubber) ID(id string) string {
+	if r, ok := s.ids[id]; ok {
+		return r
+	}
+	r := strconv.Itoa(len(s.ids) + 1)
+	s.ids[id] = r
+	return r
+}
+
+// Scrub returns v (as decoded by encoding/json) with the identifiers
+// replaced.
+func (s *Scrubber) Scrub(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, vv := range v {
+			switch {
+			case idKeys[k]:
+				if str, ok := vv.(string); ok {
+					v[k] = s.ID(str)
+				}
+			case k == "href":
+				if str, ok := vv.(string); ok {
+					v[k] = s.href(str)
+				}
+			case s.Keys[k]:
+				if _, ok := vv.(string); ok {
+					v[k] = "scrubbed"
+				}
+			default:
+				v[k] = s.Scrub(vv)
+			}
+		}
+	case []interface{}:
+		for i, vv := range v {
+			v[i] = s.Scrub(vv)
+		}
+	}
+	return v
+}
+
+func (s *Scrubber) href(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+
+	u.Host = s.Host
+	parts := strings.Split(u.Path, "/")
+	for i, p := range parts {
+		if numericRe.MatchString(p) {
+			parts[i] = s.ID(p)
+		}
+	}
+	u.Path = strings.Join(parts, "/")
+	return u.String()
+}
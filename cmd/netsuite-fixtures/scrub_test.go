@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestScrub(t *testing.T) {
+	record := map[string]interface{}{}
+	err := json.Unmarshal([]byte(`{
+		"id": "4711",
+		"email": "jane@example.com",
+		"companyName": "Example",
+		"daysOverdue": 3,
+		"subsidiary": {
+			"id": "12",
+			"links": [{"rel": "self", "href": "https://TSTDRV123.suitetalk.api.netsuite.com/services/rest/record/v1/subsidiary/12"}]
+		},
+		"parent": {"id": "4711"}
+	}`), &record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewScrubber(defaultScrubKeys)
+	b, _ := json.Marshal(s.Scrub(record))
+	expected := `{"companyName":"scrubbed","daysOverdue":3,"email":"scrubbed","id":"1","parent":{"id":"1"},"subsidiary":{"id":"2","links":[{"href":"https://1234567.suitetalk.api.netsuite.com/services/rest/record/v1/subsidiary/2","rel":"self"}]}}`
+
+	// the ids depend on the map iteration order, compare the structure
+	for _, k := range []string{`"companyName":"scrubbed"`, `"email":"scrubbed"`, `"daysOverdue":3`, "1234567.suitetalk"} {
+		if !strings.Contains(string(b), k) {
+			t.Errorf("expected %s in %s", k, b)
+		}
+	}
+	if strings.Contains(string(b), "4711") || strings.Contains(string(b), "TSTDRV123") || len(b) != len(expected) {
+		t.Errorf("unexpected scrubbed record %s", b)
+	}
+
+	out := map[string]map[string]interface{}{}
+	json.Unmarshal(b, &out)
+	if out["parent"]["id"] != s.ID("4711") || out["subsidiary"]["id"] != s.ID("12") {
+		t.Errorf("ids weren't replaced consistently: %s", b)
+	}
+}
+
+func TestGenerateTest(t *testing.T) {
+	src, err := GenerateTest("netsuite_test", []Fixture{
+		{File: "testdata/fixtures/customer_1.json", Model: "Customer"},
+		{File: "testdata/fixtures/salesTaxItem_1.json", Model: "TaxCode"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range []string{
+		"package netsuite_test",
+		`{"testdata/fixtures/customer_1.json", func() interface{} { return &netsuite.Customer{} }},`,
+		"netsuitetest.RoundTrip(t, f.file, f.model())",
+	} {
+		if !strings.Contains(string(src), e) {
+			t.Errorf("expected generated source to contain %q:\n%s", e, src)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"text/template"
+)
+
+// Fixture is a written fixture and the model it's decoded into
+type Fixture struct {
+	File  string
+	Model string
+}
+
+var testTemplate = template.Must(template.New("test").Parse(`// Code generated by netsuite-fixtures. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestFixtures(t *testing.T) {
+	fixtures := []struct {
+		file  string
+		model func() interface{}
+	}{
+{{- range .Fixtures}}
+		{"{{.File}}", func() interface{} { return &netsuite.{{.Model}}{} }},
+{{- end}}
+	}
+
+	for _, f := range fixtures {
+		f := f
+		t.Run(f.file, func(t *testing.T) {
+			netsuitetest.RoundTrip(t, f.file, f.model())
+		})
+	}
+}
+`))
+
+// GenerateTest returns the source of a test that round-trips every fixture.
+// The test can't be part of package netsuite itself, as netsuitetest imports
+// it.
+func GenerateTest(pkg string, fixtures []Fixture) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	err := testTemplate.Execute(buf, map[string]interface{}{
+		"Package":  pkg,
+		"Fixtures": fixtures,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// Generator converts the component schemas of an OpenAPI document into Go
+// models and request types.
+type Generator struct {
+	Doc     netsuite.OpenAPIDocument
+	Package string
+	Verbs   []string
+
+	types   map[string]*goType
+	order   []string
+	records []record
+}
+
+type goType struct {
+	Name     string
+	Sublist  bool
+	ItemType string
+	Fields   []goField
+}
+
+type goField struct {
+	Name string
+	Type string
+	JSON string
+}
+
+type record struct {
+	Type   string
+	Name   string
+	Plural string
+}
+
+type request struct {
+	Q      string
+	Name   string
+	Model  string
+	Verb   string
+	Method string
+	Path   string
+}
+
+func NewGenerator(doc netsuite.OpenAPIDocument, pkg string) *Generator {
+	return &Generator{
+		Doc:     doc,
+		Package: pkg,
+		Verbs:   []string{"get", "list", "post", "patch", "delete"},
+	}
+}
+
+// Generate returns the formatted source of the models and requests of the
+// given record types.
+func (g *Generator) Generate(recordTypes []string) ([]byte, error) {
+	g.types = map[string]*goType{}
+	g.order = []string{}
+	g.records = []record{}
+
+	for _, rt := range recordTypes {
+		s, ok := g.Doc.Components.Schemas[rt]
+		if !ok {
+			return nil, fmt.Errorf("no schema for record type %s", rt)
+		}
+
+		name := exportName(rt)
+		g.addStruct(name, s)
+		g.records = append(g.records, record{Type: rt, Name: name, Plural: plural(name)})
+	}
+
+	if len(g.Requests()) == 0 {
+		return nil, fmt.Errorf("no requests to generate for verbs %v", g.Verbs)
+	}
+
+	buf := new(bytes.Buffer)
+	err := fileTemplate.Execute(buf, g)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return src, nil
+}
+
+// Q returns the qualifier of the netsuite package in the generated file.
+func (g *Generator) Q() string {
+	if g.Package == "netsuite" {
+		return ""
+	}
+	return "netsuite."
+}
+
+func (g *Generator) Types() []*goType {
+	tt := []*goType{}
+	for _, n := range g.order {
+		tt = append(tt, g.types[n])
+	}
+	return tt
+}
+
+// Requests returns the requests to generate for every record type.
+func (g *Generator) Requests() []request {
+	rr := []request{}
+	for _, rec := range g.records {
+		for _, v := range g.Verbs {
+			r := request{Q: g.Q(), Model: rec.Name, Verb: v}
+			switch v {
+			case "get":
+				r.Name = rec.Name + "Get"
+				r.Method = "http.MethodGet"
+				r.Path = "/record/v1/" + rec.Type + "/{{.id}}"
+			case "list":
+				r.Name = rec.Plural + "Get"
+				r.Method = "http.MethodGet"
+				r.Path = "/record/v1/" + rec.Type
+			case "post":
+				r.Name = rec.Name + "Post"
+				r.Method = "http.MethodPost"
+				r.Path = "/record/v1/" + rec.Type
+			case "patch":
+				r.Name = rec.Name + "Patch"
+				r.Method = "http.MethodPatch"
+				r.Path = "/record/v1/" + rec.Type + "/{{.id}}"
+			case "delete":
+				r.Name = rec.Name + "Delete"
+				r.Method = "http.MethodDelete"
+				r.Path = "/record/v1/" + rec.Type + "/{{.id}}"
+			default:
+				continue
+			}
+			rr = append(rr, r)
+		}
+	}
+	return rr
+}
+
+func (g *Generator) addStruct(name string, s netsuite.JSONSchema) {
+	if _, ok := g.types[name]; ok {
+		return
+	}
+
+	t := &goType{Name: name}
+	g.types[name] = t
+	g.order = append(g.order, name)
+
+	used := map[string]bool{}
+	for _, p := range s.PropertyNames() {
+		fieldName := exportName(p)
+		if fieldName == name {
+			fieldName = fieldName + "Value"
+		}
+		for i := 2; used[fieldName]; i++ {
+			fieldName = fmt.Sprintf("%s%d", exportName(p), i)
+		}
+		used[fieldName] = true
+
+		t.Fields = append(t.Fields, goField{
+			Name: fieldName,
+			Type: g.goTypeOf(name+exportName(p), s.Properties[p]),
+			JSON: p,
+		})
+	}
+}
+
+func (g *Generator) addSublist(name string, s netsuite.JSONSchema) {
+	if _, ok := g.types[name]; ok {
+		return
+	}
+
+	t := &goType{Name: name, Sublist: true}
+	g.types[name] = t
+	g.order = append(g.order, name)
+
+	items := s.Properties["items"]
+	t.ItemType = "interface{}"
+	if items.Items != nil {
+		t.ItemType = g.goTypeOf(name+"Item", *items.Items)
+	}
+}
+
+// goTypeOf returns the Go type of schema s. name is used for types that have
+// to be generated for inline objects.
+func (g *Generator) goTypeOf(name string, s netsuite.JSONSchema) string {
+	q := g.Q()
+
+	if s.Ref != "" {
+		ref := s.RefName()
+		switch ref {
+		case "nsResource":
+			return q + "RecordRef"
+		case "nsLink":
+			return q + "Link"
+		}
+
+		target, ok := g.Doc.Components.Schemas[ref]
+		if !ok {
+			return "interface{}"
+		}
+		if isRecordRef(target) {
+			return q + "RecordRef"
+		}
+		if isCollection(target) {
+			g.addSublist(exportName(ref), target)
+			return exportName(ref)
+		}
+		g.addStruct(exportName(ref), target)
+		return exportName(ref)
+	}
+
+	for _, alt := range append(s.OneOf, s.AnyOf...) {
+		if alt.Type != "null" {
+			return g.goTypeOf(name, alt)
+		}
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date":
+			return q + "Date"
+		case "date-time":
+			return q + "DateTime"
+		}
+		return "string"
+	case "boolean":
+		return q + "Bool"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		if s.Items.RefName() == "nsLink" {
+			return q + "Links"
+		}
+		return "[]" + g.goTypeOf(name+"Item", *s.Items)
+	case "object":
+		if isRecordRef(s) {
+			return q + "RecordRef"
+		}
+		if isCollection(s) {
+			g.addSublist(name, s)
+			return name
+		}
+		if len(s.Properties) == 0 {
+			return "map[string]interface{}"
+		}
+		g.addStruct(name, s)
+		return name
+	}
+
+	return "interface{}"
+}
+
+// isRecordRef reports whether the schema is a reference ({id, refName}) to
+// another record or a select value.
+func isRecordRef(s netsuite.JSONSchema) bool {
+	if _, ok := s.Properties["id"]; !ok {
+		return false
+	}
+
+	for p := range s.Properties {
+		switch p {
+		case "id", "refName", "links", "externalId":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isCollection reports whether the schema is a sublist ({items: [...]}).
+func isCollection(s netsuite.JSONSchema) bool {
+	items, ok := s.Properties["items"]
+	return ok && items.Type == "array"
+}
+
+var initialisms = map[string]string{
+	"Id":   "ID",
+	"Url":  "URL",
+	"Html": "HTML",
+	"Api":  "API",
+}
+
+// exportName converts a NetSuite property or record type name (e.g.
+// "custbody_cash_register" or "customer-addressBookCollection") into an
+// exported Go identifier.
+func exportName(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	name := ""
+	for _, p := range parts {
+		rr := []rune(p)
+		rr[0] = unicode.ToUpper(rr[0])
+		name += string(rr)
+	}
+
+	for k, v := range initialisms {
+		if strings.HasSuffix(name, k) {
+			name = strings.TrimSuffix(name, k) + v
+		}
+	}
+
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ay") && !strings.HasSuffix(s, "ey"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"):
+		return s + "es"
+	}
+	return s + "s"
+}
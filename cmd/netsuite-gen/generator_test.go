@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	doc, err := readDocument("testdata/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pkg      string
+		expected []string
+	}{
+		{
+			pkg: "example",
+			expected: []string{
+				"package example",
+				"type Customer struct",
+				"Subsidiary     netsuite.RecordRef",
+				"CustentityVip  netsuite.Bool",
+				"AddressBook    CustomerAddressBookCollection",
+				"Items        []CustomerAddressBookElement",
+				"AddressBookAddress CustomerAddressBookElementAddressBookAddress",
+				"type CustomrecordRate struct",
+				"CustrecordRateValidFrom netsuite.Date",
+				"func NewCustomerGetRequest(c *netsuite.Client) CustomerGetRequest",
+				"func NewCustomersGetRequest(c *netsuite.Client) CustomersGetRequest",
+				`"/record/v1/customrecord_rate/{{.id}}"`,
+			},
+		},
+		{
+			pkg: "netsuite",
+			expected: []string{
+				"package netsuite",
+				"Subsidiary     RecordRef",
+				"func (c *Client) NewCustomerPatchRequest() CustomerPatchRequest",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		g := NewGenerator(doc, tt.pkg)
+		src, err := g.Generate([]string{"customer", "customrecord_rate"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, e := range tt.expected {
+			if !strings.Contains(string(src), e) {
+				t.Errorf("%s: expected generated source to contain %q", tt.pkg, e)
+			}
+		}
+	}
+}
+
+func TestExportName(t *testing.T) {
+	tests := map[string]string{
+		"customer":                       "Customer",
+		"custbody_cash_register":         "CustbodyCashRegister",
+		"customer-addressBookCollection": "CustomerAddressBookCollection",
+		"externalId":                     "ExternalID",
+		"url":                            "URL",
+		"1099misc":                       "X1099misc",
+	}
+
+	for in, expected := range tests {
+		if out := exportName(in); out != expected {
+			t.Errorf("expected %s for %s, got %s", expected, in, out)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Command netsuite-gen generates typed models and request types for NetSuite
+// record types from the account's OpenAPI metadata (metadata catalog).
+//
+// Add it to a package with go:generate:
+//
+//	//go:generate go run github.com/omniboost/go-netsuite-rest/cmd/netsuite-gen -records customer,customrecord_rate -out netsuite_gen.go
+//
+// The metadata is downloaded with the credentials in the environment (the same
+// variables the package tests use: AUTH_TYPE, COMPANY_ID, CLIENT_ID,
+// CLIENT_SECRET, TOKEN_ID, TOKEN_SECRET, REFRESH_TOKEN, TOKEN_URL and
+// BASE_URL), or read from a previously saved document with -schema.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"golang.org/x/oauth2"
+)
+
+func main() {
+	records := flag.String("records", "", "comma separated record types to generate (required)")
+	out := flag.String("out", "", "output file, defaults to stdout")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	schema := flag.String("schema", "", "read the OpenAPI document from this file instead of downloading it")
+	save := flag.String("save", "", "save the downloaded OpenAPI document to this file")
+	verbs := flag.String("verbs", "get,list,post,patch,delete", "comma separated requests to generate")
+	flag.Parse()
+
+	if *records == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		*pkg = "netsuite"
+	}
+
+	recordTypes := strings.Split(*records, ",")
+
+	var doc netsuite.OpenAPIDocument
+	var err error
+	if *schema != "" {
+		doc, err = readDocument(*schema)
+	} else {
+		doc, err = downloadDocument(context.Background(), newClient(), recordTypes)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *save != "" {
+		b, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = ioutil.WriteFile(*save, b, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	g := NewGenerator(doc, *pkg)
+	g.Verbs = strings.Split(*verbs, ",")
+	src, err := g.Generate(recordTypes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		fmt.Print(string(src))
+		return
+	}
+
+	err = ioutil.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readDocument(filename string) (netsuite.OpenAPIDocument, error) {
+	doc := netsuite.OpenAPIDocument{}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return doc, err
+	}
+
+	err = json.Unmarshal(b, &doc)
+	return doc, err
+}
+
+// downloadDocument fetches the OpenAPI metadata of every record type and
+// merges the component schemas into one document.
+func downloadDocument(ctx context.Context, client *netsuite.Client, recordTypes []string) (netsuite.OpenAPIDocument, error) {
+	doc := netsuite.OpenAPIDocument{
+		Components: netsuite.OpenAPIComponents{
+			Schemas: map[string]netsuite.JSONSchema{},
+		},
+	}
+
+	for _, rt := range recordTypes {
+		req := client.NewMetadataCatalogOpenAPIGetRequest()
+		req.PathParams().RecordType = rt
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return doc, fmt.Errorf("%s: %w", rt, err)
+		}
+
+		doc.OpenAPI = resp.OpenAPI
+		doc.Info = resp.Info
+		for k, v := range resp.Components.Schemas {
+			doc.Components.Schemas[k] = v
+		}
+	}
+
+	return doc, nil
+}
+
+func newClient() *netsuite.Client {
+	companyID := os.Getenv("COMPANY_ID")
+
+	client := netsuite.NewClient()
+	switch os.Getenv("AUTH_TYPE") {
+	case "oauth":
+		oauthConfig := netsuite.NewOauth2Config(companyID)
+		oauthConfig.ClientID = os.Getenv("CLIENT_ID")
+		oauthConfig.ClientSecret = os.Getenv("CLIENT_SECRET")
+		if tokenURL := os.Getenv("TOKEN_URL"); tokenURL != "" {
+			oauthConfig.Endpoint.TokenURL = tokenURL
+		}
+
+		token := &oauth2.Token{
+			RefreshToken: os.Getenv("REFRESH_TOKEN"),
+		}
+		client = netsuite.NewClient(netsuite.WithHTTPClient(oauthConfig.Client(context.Background(), token)))
+	case "token":
+		client.SetUseTokenAuth(true)
+		client.SetClientID(os.Getenv("CLIENT_ID"))
+		client.SetClientSecret(os.Getenv("CLIENT_SECRET"))
+		client.SetTokenID(os.Getenv("TOKEN_ID"))
+		client.SetTokenSecret(os.Getenv("TOKEN_SECRET"))
+	default:
+		log.Fatalf("Unknown auth type: %s", os.Getenv("AUTH_TYPE"))
+	}
+
+	client.SetCompanyID(companyID)
+	if baseURL := os.Getenv("BASE_URL"); baseURL != "" {
+		client.SetBaseURL(baseURL)
+	}
+
+	return client
+}
@@ -0,0 +1,230 @@
+package main
+
+import "text/template"
+
+// The templates use [[ ]] as delimiters so the {{.id}} placeholders of the
+// endpoint paths can be written as is.
+var fileTemplate = template.Must(template.New("file").Delims("[[", "]]").Parse(`// Code generated by netsuite-gen. DO NOT EDIT.
+
+package [[.Package]]
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cydev/zero"
+[[- if .Q]]
+	netsuite "github.com/omniboost/go-netsuite-rest"
+[[- end]]
+	"github.com/omniboost/go-netsuite-rest/omitempty"
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+[[$q := .Q]]
+[[- range .Types]]
+[[- if .Sublist]]
+type [[.Name]] struct {
+	Links        [[$q]]Links ` + "`json:\"links,omitempty\"`" + `
+	Items        [][[.ItemType]] ` + "`json:\"items\"`" + `
+	TotalResults int ` + "`json:\"totalResults,omitempty\"`" + `
+}
+
+func (s [[.Name]]) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+[[else]]
+type [[.Name]] struct {
+[[- range .Fields]]
+	[[.Name]] [[.Type]] ` + "`json:\"[[.JSON]],omitempty\"`" + `
+[[- end]]
+}
+
+func (v [[.Name]]) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(v)
+}
+
+func (v [[.Name]]) IsEmpty() bool {
+	return zero.IsZero(v)
+}
+[[end]]
+[[- end]]
+[[- range .Requests]]
+[[template "request" .]]
+[[- end]]
+`))
+
+func init() {
+	template.Must(fileTemplate.New("request").Parse(`
+[[if .Q]]func New[[.Name]]Request(c *netsuite.Client) [[.Name]]Request {[[else]]func (c *Client) New[[.Name]]Request() [[.Name]]Request {[[end]]
+	r := [[.Name]]Request{
+		client:  c,
+		method:  [[.Method]],
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type [[.Name]]Request struct {
+	client      *[[.Q]]Client
+	queryParams *[[.Name]]RequestQueryParams
+	pathParams  *[[.Name]]RequestPathParams
+	method      string
+	headers     http.Header
+	requestBody [[.Name]]RequestBody
+}
+
+func (r [[.Name]]Request) NewQueryParams() *[[.Name]]RequestQueryParams {
+	return &[[.Name]]RequestQueryParams{}
+}
+
+type [[.Name]]RequestQueryParams struct {
+[[- if eq .Verb "get" "post"]]
+	Fields             [[.Q]]Fields ` + "`schema:\"fields,omitempty\"`" + `
+	ExpandSubResources bool   ` + "`schema:\"expandSubResources,omitempty\"`" + `
+[[- else if eq .Verb "list"]]
+	Q      string ` + "`schema:\"q,omitempty\"`" + `
+	Limit  int    ` + "`schema:\"limit,omitempty\"`" + `
+	Offset int    ` + "`schema:\"offset,omitempty\"`" + `
+[[- else if eq .Verb "patch"]]
+	Replace               [[.Q]]Fields ` + "`schema:\"replace,omitempty\"`" + `
+	ReplaceSelectedFields bool   ` + "`schema:\"replaceSelectedFields,omitempty\"`" + `
+[[- end]]
+}
+
+func (p [[.Name]]RequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder([[.Q]]Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder([[.Q]]DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder([[.Q]]Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *[[.Name]]Request) QueryParams() *[[.Name]]RequestQueryParams {
+	return r.queryParams
+}
+
+func (r [[.Name]]Request) NewPathParams() *[[.Name]]RequestPathParams {
+	return &[[.Name]]RequestPathParams{}
+}
+
+type [[.Name]]RequestPathParams struct {
+	ID int ` + "`schema:\"id\"`" + `
+}
+
+func (p *[[.Name]]RequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *[[.Name]]Request) PathParams() *[[.Name]]RequestPathParams {
+	return r.pathParams
+}
+
+func (r *[[.Name]]Request) PathParamsInterface() [[.Q]]PathParams {
+	return r.pathParams
+}
+
+func (r *[[.Name]]Request) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *[[.Name]]Request) Method() string {
+	return r.method
+}
+
+func (r *[[.Name]]Request) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *[[.Name]]Request) Headers() http.Header {
+	return r.headers
+}
+
+func (r [[.Name]]Request) NewRequestBody() [[.Name]]RequestBody {
+	return [[.Name]]RequestBody{}
+}
+
+type [[.Name]]RequestBody struct {
+[[- if eq .Verb "post" "patch"]]
+	[[.Model]]
+[[- end]]
+}
+
+func (r *[[.Name]]Request) RequestBody() *[[.Name]]RequestBody {
+[[- if eq .Verb "post" "patch"]]
+	return &r.requestBody
+[[- else]]
+	return nil
+[[- end]]
+}
+
+func (r *[[.Name]]Request) RequestBodyInterface() interface{} {
+[[- if eq .Verb "post" "patch"]]
+	return &r.requestBody
+[[- else]]
+	return nil
+[[- end]]
+}
+
+func (r *[[.Name]]Request) SetRequestBody(body [[.Name]]RequestBody) {
+	r.requestBody = body
+}
+
+func (r *[[.Name]]Request) NewResponseBody() *[[.Name]]ResponseBody {
+	return &[[.Name]]ResponseBody{}
+}
+
+type [[.Name]]ResponseBody struct {
+[[- if eq .Verb "get"]]
+	Links [[.Q]]Links ` + "`json:\"links\"`" + `
+	[[.Model]]
+[[- else if eq .Verb "list"]]
+	Links   [[.Q]]Links ` + "`json:\"links\"`" + `
+	Count   int   ` + "`json:\"count\"`" + `
+	HasMore bool  ` + "`json:\"hasMore\"`" + `
+	Items   []struct {
+		Links [[.Q]]Links  ` + "`json:\"links\"`" + `
+		ID    string ` + "`json:\"id\"`" + `
+	} ` + "`json:\"items\"`" + `
+	Offset       int ` + "`json:\"offset\"`" + `
+	TotalResults int ` + "`json:\"totalResults\"`" + `
+[[- end]]
+}
+
+func (r *[[.Name]]Request) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("[[.Path]]", r.PathParams())
+	return &u, err
+}
+
+func (r *[[.Name]]Request) Do(ctx context.Context) ([[.Name]]ResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
+`))
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// env is what the commands run with
+type env struct {
+	ctx    context.Context
+	client *netsuite.Client
+	in     io.Reader
+	out    Output
+	// stderr gets the notes that aren't part of the result, like how to get
+	// the next page
+	stderr io.Writer
+}
+
+type command struct {
+	name  string
+	args  string
+	help  string
+	flags func(fs *flag.FlagSet) func(e env, args []string) error
+}
+
+var commands = []command{
+	{name: "get", args: "<recordType> [<id>]", help: "get a record, or list the ids of the records without id", flags: getCommand},
+	{name: "create", args: "<recordType>", help: "create a record from json (-data, -file or stdin) and print its id", flags: createCommand},
+	{name: "patch", args: "<recordType> <id>", help: "update the fields of a record from json (-data, -file or stdin)", flags: patchCommand},
+	{name: "delete", args: "<recordType> <id>", help: "delete a record", flags: deleteCommand},
+	{name: "suiteql", args: "<query>", help: "run a SuiteQL query, or start a SuiteQL shell with -interactive", flags: suiteqlCommand},
+	{name: "metadata", args: "[<recordType>...]", help: "list the record types, or show their schema", flags: metadataCommand},
+}
+
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+var errUsage = errors.New("invalid arguments")
+
+// pagination adds the -limit, -offset and -all flags.
+type pagination struct {
+	limit  *int
+	offset *int
+	all    *bool
+}
+
+func paginationFlags(fs *flag.FlagSet) pagination {
+	return pagination{
+		limit:  fs.Int("limit", 100, "number of results per page"),
+		offset: fs.Int("offset", 0, "index of the first result"),
+		all:    fs.Bool("all", false, "follow the pages and return all results"),
+	}
+}
+
+func getCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	fields := fs.String("fields", "", "comma separated fields to return")
+	expand := fs.Bool("expand", false, "expand the sublists and subrecords")
+	q := fs.String("q", "", "filter the list, e.g. 'email START_WITH \"jane\"'")
+	page := paginationFlags(fs)
+
+	return func(e env, args []string) error {
+		if len(args) < 1 || len(args) > 2 {
+			return errUsage
+		}
+		svc := netsuite.NewService[json.RawMessage](e.client, args[0])
+
+		if len(args) == 2 {
+			opts := &netsuite.GetOptions{ExpandSubResources: *expand}
+			if *fields != "" {
+				opts.Fields = splitFields(*fields)
+			}
+			raw, err := svc.Get(e.ctx, args[1], opts)
+			if err != nil {
+				return err
+			}
+			record := map[string]interface{}{}
+			err = decode(raw, &record)
+			if err != nil {
+				return err
+			}
+			return e.out.Record(record)
+		}
+
+		opts := &netsuite.ListOptions{Q: *q, Limit: *page.limit, Offset: *page.offset}
+		var items []netsuite.ListItem
+		if *page.all {
+			var err error
+			items, err = svc.ListAll(e.ctx, opts)
+			if err != nil {
+				return err
+			}
+		} else {
+			p, err := svc.List(e.ctx, opts)
+			if err != nil {
+				return err
+			}
+			items = p.Items
+			if p.HasMore {
+				fmt.Fprintf(e.stderr, "%d of %d results, use -offset %d for the next page or -all\n", p.Count, p.TotalResults, p.Offset+p.Count)
+			}
+		}
+
+		records := make([]map[string]interface{}, len(items))
+		for i, item := range items {
+			records[i] = map[string]interface{}{"id": item.ID}
+		}
+		return e.out.Records(records)
+	}
+}
+
+// bodyFlags adds the -data and -file flags of the record json.
+func bodyFlags(fs *flag.FlagSet) func(e env) (json.RawMessage, error) {
+	data := fs.String("data", "", "record json")
+	file := fs.String("file", "", "file with the record json, - for stdin (the default)")
+
+	return func(e env) (json.RawMessage, error) {
+		var b []byte
+		var err error
+		switch {
+		case *data != "":
+			b = []byte(*data)
+		case *file != "" && *file != "-":
+			b, err = ioutil.ReadFile(*file)
+		default:
+			b, err = ioutil.ReadAll(e.in)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !json.Valid(b) {
+			return nil, errors.New("the record is not valid json")
+		}
+		return json.RawMessage(bytes.TrimSpace(b)), nil
+	}
+}
+
+func createCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	body := bodyFlags(fs)
+
+	return func(e env, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		record, err := body(e)
+		if err != nil {
+			return err
+		}
+
+		id, err := netsuite.NewService[json.RawMessage](e.client, args[0]).Create(e.ctx, record)
+		if err != nil {
+			return err
+		}
+		return e.out.Record(map[string]interface{}{"id": id})
+	}
+}
+
+func patchCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	body := bodyFlags(fs)
+	replace := fs.String("replace", "", "comma separated sublists to replace instead of merge")
+
+	return func(e env, args []string) error {
+		if len(args) != 2 {
+			return errUsage
+		}
+		record, err := body(e)
+		if err != nil {
+			return err
+		}
+
+		opts := &netsuite.UpdateOptions{}
+		if *replace != "" {
+			opts.Replace = splitFields(*replace)
+		}
+		return netsuite.NewService[json.RawMessage](e.client, args[0]).Update(e.ctx, args[1], record, opts)
+	}
+}
+
+func deleteCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	return func(e env, args []string) error {
+		if len(args) != 2 {
+			return errUsage
+		}
+		return netsuite.NewService[json.RawMessage](e.client, args[0]).Delete(e.ctx, args[1])
+	}
+}
+
+func suiteqlCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	page := paginationFlags(fs)
+	interactive := fs.Bool("interactive", false, "read queries from stdin, see REPL")
+	history := fs.String("history", defaultHistoryPath(), "history file of the interactive mode")
+
+	return func(e env, args []string) error {
+		if *interactive {
+			repl := NewREPL(e, *history)
+			repl.limit = *page.limit
+			return repl.Run()
+		}
+		if len(args) == 0 {
+			return errUsage
+		}
+
+		records, next, err := query(e, strings.Join(args, " "), *page.limit, *page.offset, *page.all)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			fmt.Fprintf(e.stderr, "%d of %d results, use -offset %d for the next page or -all\n", len(records), next.total, next.offset)
+		}
+		return e.out.Records(records)
+	}
+}
+
+// nextPage is where the results of a query continue
+type nextPage struct {
+	offset int
+	total  int
+}
+
+// query runs a SuiteQL query and returns a page of the results, or all of
+// them. next is set when there are more results.
+func query(e env, q string, limit, offset int, all bool) (records []map[string]interface{}, next *nextPage, err error) {
+	req := e.client.NewSuiteqlPostRequest()
+	req.RequestBody().Q = q
+	req.QueryParams().Limit = limit
+	req.QueryParams().Offset = offset
+
+	records = []map[string]interface{}{}
+	for {
+		resp, err := req.Do(e.ctx)
+		if err != nil {
+			return records, nil, err
+		}
+
+		items := []map[string]interface{}{}
+		err = decode(resp.Items, &items)
+		if err != nil {
+			return records, nil, err
+		}
+		records = append(records, items...)
+
+		if !resp.HasMore {
+			return records, nil, nil
+		}
+		if !all {
+			return records, &nextPage{offset: resp.Offset + resp.Count, total: resp.TotalResults}, nil
+		}
+		req.QueryParams().Offset = resp.Offset + resp.Count
+	}
+}
+
+func metadataCommand(fs *flag.FlagSet) func(e env, args []string) error {
+	openAPI := fs.Bool("openapi", false, "return the OpenAPI document instead of the json schema")
+
+	return func(e env, args []string) error {
+		if len(args) == 0 {
+			req := e.client.NewMetadataCatalogGetRequest()
+			resp, err := req.Do(e.ctx)
+			if err != nil {
+				return err
+			}
+			records := make([]map[string]interface{}, len(resp.Items))
+			for i, name := range resp.Items.Names() {
+				records[i] = map[string]interface{}{"name": name}
+			}
+			return e.out.Records(records)
+		}
+
+		if *openAPI {
+			doc := netsuite.OpenAPIDocument{
+				Components: netsuite.OpenAPIComponents{
+					Schemas: map[string]netsuite.JSONSchema{},
+				},
+			}
+			for _, rt := range args {
+				req := e.client.NewMetadataCatalogOpenAPIGetRequest()
+				req.PathParams().RecordType = rt
+				resp, err := req.Do(e.ctx)
+				if err != nil {
+					return fmt.Errorf("%s: %w", rt, err)
+				}
+				doc.OpenAPI = resp.OpenAPI
+				doc.Info = resp.Info
+				for k, v := range resp.Components.Schemas {
+					doc.Components.Schemas[k] = v
+				}
+			}
+			return e.out.Value(doc)
+		}
+
+		for _, rt := range args {
+			req := e.client.NewMetadataCatalogSchemaGetRequest()
+			req.PathParams().RecordType = rt
+			resp, err := req.Do(e.ctx)
+			if err != nil {
+				return fmt.Errorf("%s: %w", rt, err)
+			}
+
+			if e.out.format == "json" {
+				err = e.out.Value(resp.JSONSchema)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			// a row per field
+			records := []map[string]interface{}{}
+			for _, name := range resp.PropertyNames() {
+				p, _ := resp.Property(name)
+				records = append(records, map[string]interface{}{
+					"field":    name,
+					"type":     schemaType(p),
+					"required": resp.IsRequired(name),
+					"readOnly": p.ReadOnly,
+					"title":    p.Title,
+				})
+			}
+			out := e.out
+			out.columns = []string{"field", "type", "required", "readOnly", "title"}
+			err = out.Records(records)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func schemaType(s netsuite.JSONSchema) string {
+	switch {
+	case s.Ref != "":
+		return s.RefName()
+	case s.Format != "":
+		return s.Type + " (" + s.Format + ")"
+	}
+	return s.Type
+}
+
+// decode unmarshals json keeping the numbers as they were sent.
+func decode(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func splitFields(s string) netsuite.Fields {
+	fields := netsuite.Fields{}
+	for _, f := range strings.Split(s, ",") {
+		fields = append(fields, netsuite.Field(strings.TrimSpace(f)))
+	}
+	return fields
+}
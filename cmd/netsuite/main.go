@@ -0,0 +1,122 @@
+// Command netsuite runs ad-hoc operations against a NetSuite account, for
+// debugging integrations without writing Go:
+//
+//	netsuite get customer 107
+//	netsuite get -q 'email START_WITH "jane"' -all customer
+//	netsuite create -data '{"companyName": "Omniboost"}' customer
+//	netsuite patch -file customer.json customer 107
+//	netsuite delete customer 107
+//	netsuite -o table suiteql -all "SELECT id, companyname FROM customer"
+//	netsuite suiteql -interactive
+//	netsuite metadata customer
+//
+// The credentials are read from a profile in the profiles file (-config,
+// $NETSUITE_CONFIG or netsuite/profiles.json in the user's config directory),
+// see Profile. Without a profiles file the credentials are read from the
+// environment, the same variables the package tests use: AUTH_TYPE,
+// COMPANY_ID, CLIENT_ID, CLIENT_SECRET, TOKEN_ID, TOKEN_SECRET, REFRESH_TOKEN,
+// TOKEN_URL and BASE_URL.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, nil))
+}
+
+// run runs the command line in args and returns the exit code. client is
+// used instead of the client of the profile when set.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer, client *netsuite.Client) int {
+	fs := flag.NewFlagSet("netsuite", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	config := fs.String("config", defaultProfilesPath(), "profiles file")
+	profile := fs.String("profile", os.Getenv("NETSUITE_PROFILE"), "profile to use, defaults to \"default\"")
+	format := fs.String("o", "json", "output format: json, table or csv")
+	columns := fs.String("columns", "", "comma separated columns of the table output")
+	debug := fs.Bool("debug", false, "dump the requests and responses")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: netsuite [flags] <command> [command flags] [args]\n\ncommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(stderr, "  %s %s\n    \t%s\n", c.name, c.args, c.help)
+		}
+		fmt.Fprintf(stderr, "\nflags:\n")
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+	if !validFormat(*format) {
+		fmt.Fprintf(stderr, "unknown output format: %s\n", *format)
+		return 2
+	}
+
+	cmd, ok := findCommand(fs.Arg(0))
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: %s\n", fs.Arg(0))
+		fs.Usage()
+		return 2
+	}
+
+	cmdFlags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmdFlags.SetOutput(stderr)
+	cmdFlags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: netsuite %s [flags] %s\n\n%s\n\nflags:\n", cmd.name, cmd.args, cmd.help)
+		cmdFlags.PrintDefaults()
+	}
+	runCmd := cmd.flags(cmdFlags)
+	err = cmdFlags.Parse(fs.Args()[1:])
+	if err != nil {
+		return 2
+	}
+
+	if client == nil {
+		p, err := LoadProfile(*config, *profile)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		client, err = p.Client(netsuite.WithDebug(*debug))
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	e := env{
+		ctx:    context.Background(),
+		client: client,
+		in:     stdin,
+		out:    Output{w: stdout, format: *format},
+		stderr: stderr,
+	}
+	if *columns != "" {
+		e.out.columns = strings.Split(*columns, ",")
+	}
+
+	err = runCmd(e, cmdFlags.Args())
+	if errors.Is(err, errUsage) {
+		cmdFlags.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestRun(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "107", map[string]interface{}{
+		"companyName": "Omniboost",
+		"subsidiary":  map[string]interface{}{"id": "1", "refName": "Parent Company"},
+	})
+	srv.AddSuiteQL("from customer",
+		map[string]interface{}{"id": "107", "companyname": "Omniboost"},
+		map[string]interface{}{"id": "108", "companyname": "Example"},
+	)
+
+	tests := []struct {
+		args     []string
+		stdin    string
+		expected []string
+	}{
+		{
+			args:     []string{"get", "customer", "107"},
+			expected: []string{`"companyName": "Omniboost"`},
+		},
+		{
+			args:     []string{"-o", "table", "get", "customer", "107"},
+			expected: []string{"companyName  Omniboost", "subsidiary   Parent Company"},
+		},
+		{
+			args:     []string{"-o", "table", "suiteql", "-limit", "1", "-all", "SELECT id, companyname FROM customer"},
+			expected: []string{"ID   COMPANYNAME", "107  Omniboost", "108  Example"},
+		},
+		{
+			args:     []string{"create", "customer"},
+			stdin:    `{"companyName": "Example"}`,
+			expected: []string{`"id": "`},
+		},
+		{
+			args:     []string{"-o", "table", "get", "customer"},
+			expected: []string{"ID", "107"},
+		},
+	}
+
+	for _, test := range tests {
+		stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+		code := run(test.args, strings.NewReader(test.stdin), stdout, stderr, srv.Client())
+		if code != 0 {
+			t.Errorf("%v: exit code %d: %s", test.args, code, stderr)
+			continue
+		}
+		for _, e := range test.expected {
+			if !strings.Contains(stdout.String(), e) {
+				t.Errorf("%v: expected %q in output:\n%s", test.args, e, stdout)
+			}
+		}
+	}
+
+	code := run([]string{"patch", "-data", `{"companyName": "Omniboost B.V."}`, "customer", "107"}, nil, new(bytes.Buffer), new(bytes.Buffer), srv.Client())
+	if code != 0 {
+		t.Fatalf("patch: exit code %d", code)
+	}
+	record, _ := srv.Record("customer", "107")
+	fields := map[string]interface{}{}
+	json.Unmarshal(record, &fields)
+	if fields["companyName"] != "Omniboost B.V." {
+		t.Errorf("expected the patched name, got %v", fields["companyName"])
+	}
+
+	code = run([]string{"delete", "customer"}, nil, new(bytes.Buffer), new(bytes.Buffer), srv.Client())
+	if code != 2 {
+		t.Errorf("expected a usage error, got exit code %d", code)
+	}
+}
+
+func TestREPL(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from customer",
+		map[string]interface{}{"id": "107", "companyname": "Omniboost"},
+		map[string]interface{}{"id": "108", "companyname": "Example; Inc."},
+	)
+
+	dir := t.TempDir()
+	history := filepath.Join(dir, "history")
+	csvFile := filepath.Join(dir, "customers.csv")
+	stdin := strings.Join([]string{
+		"SELECT id, companyname",
+		"FROM customer;",
+		".format csv",
+		"!1",
+		"SELECT id FROM customer WHERE companyname = 'a;b'; > " + csvFile,
+		".history",
+		".quit",
+	}, "\n")
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	code := run([]string{"suiteql", "-interactive", "-limit", "1", "-history", history}, strings.NewReader(stdin), stdout, stderr, srv.Client())
+	if code != 0 {
+		t.Fatalf("exit code %d: %s", code, stderr)
+	}
+
+	for _, e := range []string{"ID   COMPANYNAME", "108  Example; Inc.", "id,companyname\n107,Omniboost\n"} {
+		if !strings.Contains(stdout.String(), e) {
+			t.Errorf("expected %q in output:\n%s", e, stdout)
+		}
+	}
+
+	b, err := ioutil.ReadFile(csvFile)
+	if err != nil || !strings.HasPrefix(string(b), "id,companyname\n") {
+		t.Errorf("expected the results in %s, got %q (%v)", csvFile, b, err)
+	}
+
+	b, _ = ioutil.ReadFile(history)
+	if lines := strings.Split(strings.TrimSpace(string(b)), "\n"); len(lines) != 2 {
+		t.Errorf("expected 2 queries in the history, got %q", lines)
+	}
+}
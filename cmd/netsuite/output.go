@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output writes results as indented json, as a table or as csv.
+type Output struct {
+	w      io.Writer
+	format string
+	// columns of the table, defaults to all fields with a simple value
+	columns []string
+}
+
+// Record writes a single record; as a table it's a row per field.
+func (o Output) Record(record map[string]interface{}) error {
+	if o.format == "json" {
+		return o.json(record)
+	}
+
+	columns := o.columns
+	if len(columns) == 0 {
+		columns = tableColumns([]map[string]interface{}{record}, true)
+	}
+
+	if o.format == "csv" {
+		w := csv.NewWriter(o.w)
+		for _, c := range columns {
+			w.Write([]string{c, cell(record[c])})
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	for _, c := range columns {
+		fmt.Fprintf(tw, "%s\t%s\n", c, cell(record[c]))
+	}
+	return tw.Flush()
+}
+
+// Records writes a list of records; as a table it's a row per record.
+func (o Output) Records(records []map[string]interface{}) error {
+	if o.format == "json" {
+		return o.json(records)
+	}
+
+	columns := o.columns
+	if len(columns) == 0 {
+		columns = tableColumns(records, false)
+	}
+
+	if o.format == "csv" {
+		w := csv.NewWriter(o.w)
+		w.Write(columns)
+		for _, r := range records {
+			w.Write(cells(r, columns))
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, r := range records {
+		fmt.Fprintln(tw, strings.Join(cells(r, columns), "\t"))
+	}
+	return tw.Flush()
+}
+
+// Value writes anything else, like a metadata document, as json.
+func (o Output) Value(v interface{}) error {
+	return o.json(v)
+}
+
+func (o Output) json(v interface{}) error {
+	enc := json.NewEncoder(o.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// tableColumns returns the sorted fields of the records, without the links.
+// Nested objects are only included when all is set, or when they are
+// references (with an id or refName).
+func tableColumns(records []map[string]interface{}, all bool) []string {
+	seen := map[string]bool{}
+	columns := []string{}
+	for _, r := range records {
+		for k, v := range r {
+			if seen[k] || k == "links" {
+				continue
+			}
+			if m, ok := v.(map[string]interface{}); ok && !all && !isRef(m) {
+				continue
+			}
+			if _, ok := v.([]interface{}); ok && !all {
+				continue
+			}
+			seen[k] = true
+			columns = append(columns, k)
+		}
+	}
+
+	sort.Slice(columns, func(i, j int) bool {
+		// id first
+		if columns[i] == "id" || columns[j] == "id" {
+			return columns[i] == "id"
+		}
+		return columns[i] < columns[j]
+	})
+	return columns
+}
+
+func isRef(m map[string]interface{}) bool {
+	_, id := m["id"]
+	_, refName := m["refName"]
+	return id || refName
+}
+
+func cells(record map[string]interface{}, columns []string) []string {
+	cc := make([]string, len(columns))
+	for i, c := range columns {
+		cc[i] = cell(record[c])
+	}
+	return cc
+}
+
+// cell formats a value for a table: references by their name, other objects
+// as compact json.
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}:
+		if name, ok := v["refName"].(string); ok {
+			return name
+		}
+		if id, ok := v["id"].(string); ok {
+			return id
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return strings.TrimSpace(buf.String())
+}
+
+func validFormat(format string) bool {
+	return format == "json" || format == "table" || format == "csv"
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"golang.org/x/oauth2"
+)
+
+// Profile holds the credentials of an account. Profiles are kept in a json
+// file keyed by profile name:
+//
+//	{
+//	  "sandbox": {
+//	    "auth_type": "token",
+//	    "account_id": "1234567_SB1",
+//	    "consumer_key": "...",
+//	    "consumer_secret": "...",
+//	    "token_id": "...",
+//	    "token_secret": "..."
+//	  }
+//	}
+type Profile struct {
+	// AuthType is "token" (token based auth) or "oauth" (OAuth 2.0)
+	AuthType       string `json:"auth_type"`
+	AccountID      string `json:"account_id"`
+	ConsumerKey    string `json:"consumer_key,omitempty"`
+	ConsumerSecret string `json:"consumer_secret,omitempty"`
+	TokenID        string `json:"token_id,omitempty"`
+	TokenSecret    string `json:"token_secret,omitempty"`
+	ClientID       string `json:"client_id,omitempty"`
+	ClientSecret   string `json:"client_secret,omitempty"`
+	RefreshToken   string `json:"refresh_token,omitempty"`
+	TokenURL       string `json:"token_url,omitempty"`
+	BaseURL        string `json:"base_url,omitempty"`
+}
+
+// defaultProfilesPath returns $NETSUITE_CONFIG or profiles.json in the
+// netsuite directory of the user's config directory.
+func defaultProfilesPath() string {
+	if path := os.Getenv("NETSUITE_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "netsuite", "profiles.json")
+}
+
+// LoadProfile reads the named profile from the profiles file. Without a
+// profiles file the credentials are read from the environment, the same
+// variables the package tests use.
+func LoadProfile(path, name string) (Profile, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && name == "" {
+		return envProfile(), nil
+	}
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profiles := map[string]Profile{}
+	err = json.Unmarshal(b, &profiles)
+	if err != nil {
+		return Profile{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if name == "" {
+		name = "default"
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%s: no profile %q", path, name)
+	}
+	return profile, nil
+}
+
+func envProfile() Profile {
+	return Profile{
+		AuthType:       os.Getenv("AUTH_TYPE"),
+		AccountID:      os.Getenv("COMPANY_ID"),
+		ConsumerKey:    os.Getenv("CLIENT_ID"),
+		ConsumerSecret: os.Getenv("CLIENT_SECRET"),
+		TokenID:        os.Getenv("TOKEN_ID"),
+		TokenSecret:    os.Getenv("TOKEN_SECRET"),
+		ClientID:       os.Getenv("CLIENT_ID"),
+		ClientSecret:   os.Getenv("CLIENT_SECRET"),
+		RefreshToken:   os.Getenv("REFRESH_TOKEN"),
+		TokenURL:       os.Getenv("TOKEN_URL"),
+		BaseURL:        os.Getenv("BASE_URL"),
+	}
+}
+
+// Client returns a client for the account of the profile.
+func (p Profile) Client(opts ...netsuite.Option) (*netsuite.Client, error) {
+	options := []netsuite.Option{netsuite.WithAccountID(p.AccountID)}
+	if p.BaseURL != "" {
+		options = append(options, netsuite.WithBaseURL(p.BaseURL))
+	}
+
+	switch p.AuthType {
+	case "token":
+		options = append(options, netsuite.WithTokenAuth(p.ConsumerKey, p.ConsumerSecret, p.TokenID, p.TokenSecret))
+	case "oauth":
+		oauthConfig := netsuite.NewOauth2Config(p.AccountID)
+		oauthConfig.ClientID = p.ClientID
+		oauthConfig.ClientSecret = p.ClientSecret
+		if p.TokenURL != "" {
+			oauthConfig.Endpoint.TokenURL = p.TokenURL
+		}
+		token := &oauth2.Token{RefreshToken: p.RefreshToken}
+		options = append(options, netsuite.WithHTTPClient(oauthConfig.Client(context.Background(), token)))
+	default:
+		return nil, fmt.Errorf("unknown auth type: %q", p.AuthType)
+	}
+
+	client := netsuite.NewClient(append(options, opts...)...)
+	return client, client.Validate()
+}
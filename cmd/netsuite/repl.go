@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const replHelp = `Enter a query and end it with ; or an empty line. All pages of the results
+are fetched. The output can be written to a file or piped to a command:
+
+  SELECT id, companyname FROM customer; > customers.csv
+  SELECT id, companyname FROM customer; | jq '.[].id'
+
+Commands:
+  .format json|table|csv  set the output format (files get the format of their extension)
+  .limit <n>              set the page size
+  .history                list the previous queries
+  !<n>, !!                run query n of the history, or the last one, again
+  .help                   show this help
+  .quit                   exit (or ctrl-d)
+`
+
+// REPL reads SuiteQL queries from stdin and writes their results, for
+// netsuite suiteql -interactive.
+type REPL struct {
+	env         env
+	historyPath string
+	history     []string
+	limit       int
+}
+
+// defaultHistoryPath returns suiteql_history in the netsuite directory of the
+// user's config directory.
+func defaultHistoryPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "netsuite", "suiteql_history")
+}
+
+// NewREPL returns a REPL that keeps its history in historyPath; without path
+// the history isn't saved.
+func NewREPL(e env, historyPath string) *REPL {
+	r := &REPL{
+		env:         e,
+		historyPath: historyPath,
+		limit:       1000,
+	}
+	if e.out.format == "json" {
+		// json is hard to read in a terminal
+		r.env.out.format = "table"
+	}
+
+	if historyPath != "" {
+		b, err := ioutil.ReadFile(historyPath)
+		if err == nil {
+			for _, l := range strings.Split(string(b), "\n") {
+				if l != "" {
+					r.history = append(r.history, l)
+				}
+			}
+		}
+	}
+	return r
+}
+
+// Run reads and runs queries until .quit or the end of the input.
+func (r *REPL) Run() error {
+	scanner := bufio.NewScanner(r.env.in)
+	buf := []string{}
+	r.prompt(buf)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if len(buf) == 0 {
+			switch {
+			case line == "":
+				r.prompt(buf)
+				continue
+			case line == ".quit" || line == ".exit":
+				return nil
+			case strings.HasPrefix(line, "."):
+				r.command(line)
+				r.prompt(buf)
+				continue
+			case strings.HasPrefix(line, "!"):
+				q, err := r.recall(line)
+				if err != nil {
+					fmt.Fprintln(r.env.stderr, err)
+				} else {
+					fmt.Fprintln(r.env.stderr, q)
+					r.execute(q)
+				}
+				r.prompt(buf)
+				continue
+			}
+		}
+
+		if line != "" {
+			buf = append(buf, line)
+		}
+		stmt := strings.Join(buf, " ")
+		if _, _, complete := splitStatement(stmt); complete || line == "" {
+			r.execute(stmt)
+			buf = buf[:0]
+		}
+		r.prompt(buf)
+	}
+
+	if len(buf) > 0 {
+		r.execute(strings.Join(buf, " "))
+	}
+	return scanner.Err()
+}
+
+func (r *REPL) prompt(buf []string) {
+	if len(buf) == 0 {
+		fmt.Fprint(r.env.stderr, "suiteql> ")
+	} else {
+		fmt.Fprint(r.env.stderr, "     ...> ")
+	}
+}
+
+func (r *REPL) command(line string) {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case ".help":
+		fmt.Fprint(r.env.stderr, replHelp)
+	case ".format":
+		if len(fields) != 2 || !validFormat(fields[1]) {
+			fmt.Fprintln(r.env.stderr, "usage: .format json|table|csv")
+			return
+		}
+		r.env.out.format = fields[1]
+	case ".limit":
+		n := 0
+		if len(fields) == 2 {
+			n, _ = strconv.Atoi(fields[1])
+		}
+		if n <= 0 {
+			fmt.Fprintln(r.env.stderr, "usage: .limit <n>")
+			return
+		}
+		r.limit = n
+	case ".history":
+		for i, q := range r.history {
+			fmt.Fprintf(r.env.stderr, "%4d  %s\n", i+1, q)
+		}
+	default:
+		fmt.Fprintf(r.env.stderr, "unknown command %s, see .help\n", fields[0])
+	}
+}
+
+// recall returns the query of !n or !!.
+func (r *REPL) recall(line string) (string, error) {
+	if len(r.history) == 0 {
+		return "", fmt.Errorf("the history is empty")
+	}
+	if line == "!!" {
+		return r.history[len(r.history)-1], nil
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 1 || n > len(r.history) {
+		return "", fmt.Errorf("no query %s in the history", line[1:])
+	}
+	return r.history[n-1], nil
+}
+
+// execute runs a statement: a query, optionally followed by ; and "> file"
+// or "| command".
+func (r *REPL) execute(stmt string) {
+	q, target, _ := splitStatement(stmt)
+	if q == "" {
+		return
+	}
+	r.addHistory(strings.TrimSpace(stmt))
+
+	records, _, err := query(r.env, q, r.limit, 0, true)
+	if err != nil {
+		fmt.Fprintln(r.env.stderr, err)
+		return
+	}
+
+	err = r.write(records, target)
+	if err != nil {
+		fmt.Fprintln(r.env.stderr, err)
+		return
+	}
+	fmt.Fprintf(r.env.stderr, "(%d rows)\n", len(records))
+}
+
+// splitStatement splits a statement at the ; that ends the query, outside
+// of quotes, into the query and the "> file" or "| command" after it.
+func splitStatement(stmt string) (q string, target string, complete bool) {
+	quoted := false
+	for i, c := range stmt {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == ';' && !quoted:
+			return strings.TrimSpace(stmt[:i]), strings.TrimSpace(stmt[i+1:]), true
+		}
+	}
+	return strings.TrimSpace(stmt), "", false
+}
+
+func (r *REPL) write(records []map[string]interface{}, target string) error {
+	out := r.env.out
+
+	switch {
+	case target == "":
+		return out.Records(records)
+	case strings.HasPrefix(target, ">"):
+		path := strings.TrimSpace(target[1:])
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			out.format = "csv"
+		case ".json":
+			out.format = "json"
+		}
+
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		out.w = f
+		err = out.Records(records)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	case strings.HasPrefix(target, "|"):
+		buf := new(bytes.Buffer)
+		out.w = buf
+		err := out.Records(records)
+		if err != nil {
+			return err
+		}
+
+		cmd := exec.Command("sh", "-c", strings.TrimSpace(target[1:]))
+		cmd.Stdin = buf
+		cmd.Stdout = r.env.out.w
+		cmd.Stderr = r.env.stderr
+		return cmd.Run()
+	default:
+		return fmt.Errorf("unknown output %q, use > file or | command", target)
+	}
+}
+
+func (r *REPL) addHistory(stmt string) {
+	if len(r.history) > 0 && r.history[len(r.history)-1] == stmt {
+		return
+	}
+	r.history = append(r.history, stmt)
+
+	if r.historyPath == "" {
+		return
+	}
+	err := os.MkdirAll(filepath.Dir(r.historyPath), 0700)
+	if err == nil {
+		var f *os.File
+		f, err = os.OpenFile(r.historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err == nil {
+			_, err = io.WriteString(f, stmt+"\n")
+			f.Close()
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(r.env.stderr, "history: %s\n", err)
+	}
+}
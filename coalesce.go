@@ -0,0 +1,171 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SetCoalesceRequests makes concurrent identical GET and SuiteQL requests
+// share one request to NetSuite, e.g. when many workers resolve the same item
+// or customer at once. Requests are identical when they have the same url,
+// headers, body and credentials. Every caller gets its own copy of the
+// response, which is read into memory.
+func (c *Client) SetCoalesceRequests(coalesce bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.coalesceRequests = coalesce
+}
+
+func (c *Client) CoalesceRequests() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.coalesceRequests
+}
+
+// flightGroup keeps the requests in flight by key
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: map[string]*flight{}}
+}
+
+// sendCoalesced sends req, or waits for an identical request in flight and
+// answers req with a copy of its response.
+func (c *Client) sendCoalesced(cfg clientConfig, req *http.Request, body interface{}) (*http.Response, error) {
+	key := cfg.flightKey(req)
+	if key == "" || c.flights == nil {
+		return c.sendCached(cfg, req, body)
+	}
+
+	start := time.Now()
+	c.flights.mu.Lock()
+	if f, ok := c.flights.flights[key]; ok {
+		c.flights.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		// the request was canceled by the caller that sent it, not by us
+		if isContextError(f.err) {
+			return c.sendCached(cfg, req, body)
+		}
+		resp := f.response(req)
+		setResponseMeta(req, resp, time.Since(start), 0)
+		markCoalesced(req)
+		return resp, f.err
+	}
+
+	f := &flight{done: make(chan struct{})}
+	c.flights.flights[key] = f
+	c.flights.mu.Unlock()
+
+	defer func() {
+		c.flights.mu.Lock()
+		delete(c.flights.flights, key)
+		c.flights.mu.Unlock()
+		close(f.done)
+	}()
+
+	f.resp, f.err = c.sendCached(cfg, req, body)
+	if f.resp == nil {
+		return nil, f.err
+	}
+
+	var err error
+	f.body, err = ioutil.ReadAll(f.resp.Body)
+	f.resp.Body.Close()
+	if err != nil && f.err == nil {
+		f.err = err
+	}
+	return f.response(req), f.err
+}
+
+// response returns a copy of the response of the flight for req.
+func (f *flight) response(req *http.Request) *http.Response {
+	if f.resp == nil {
+		return nil
+	}
+	resp := *f.resp
+	resp.Header = f.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(f.body))
+	resp.Request = req
+	return &resp
+}
+
+// flightKey returns the key identical requests share, or an empty key when
+// req isn't coalesced.
+func (cfg clientConfig) flightKey(req *http.Request) string {
+	if !cfg.coalesceRequests {
+		return ""
+	}
+
+	var body []byte
+	switch {
+	case req.Method == http.MethodGet:
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/query/v1/suiteql"):
+		if req.GetBody == nil {
+			return ""
+		}
+		r, err := req.GetBody()
+		if err != nil {
+			return ""
+		}
+		body, err = ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return ""
+		}
+	default:
+		return ""
+	}
+
+	h := sha1.New()
+	// requests with other credentials may see other records
+	fmt.Fprintf(h, "%s\n%s\n%s\n%p\n", cfg.companyID, cfg.clientID, cfg.tokenID, cfg.http)
+	fmt.Fprintf(h, "%s %s\n", req.Method, req.URL)
+
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		if k != "Authorization" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s: %s\n", k, strings.Join(req.Header[k], ", "))
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func markCoalesced(req *http.Request) {
+	if meta := responseMetaFromContext(req.Context()); meta != nil {
+		meta.Coalesced = true
+	}
+}
+
+func isContextError(err error) bool {
+	return err == context.Canceled || err == context.DeadlineExceeded
+}
@@ -0,0 +1,110 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCoalesceRequests(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"count":1,"hasMore":false,"items":[{"id":"1"}],"offset":0,"totalResults":1}`))
+			return
+		}
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	newClient := func(coalesce bool) *netsuite.Client {
+		return netsuite.NewClient(
+			netsuite.WithBaseURL(server.URL),
+			netsuite.WithAccountID("1234567"),
+			netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+			netsuite.WithRequestCoalescing(coalesce),
+		)
+	}
+
+	// run calls do from 5 goroutines at once and returns the number of
+	// requests the server got and the number of coalesced responses
+	run := func(do func(ctx context.Context) error) (int32, int32) {
+		atomic.StoreInt32(&hits, 0)
+		var coalesced int32
+		wg := sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				meta := &netsuite.ResponseMeta{}
+				if err := do(netsuite.WithResponseMeta(context.Background(), meta)); err != nil {
+					t.Error(err)
+				}
+				if meta.Coalesced {
+					atomic.AddInt32(&coalesced, 1)
+				}
+			}()
+		}
+		wg.Wait()
+		return atomic.LoadInt32(&hits), coalesced
+	}
+
+	c := newClient(true)
+	hits, coalesced := run(func(ctx context.Context) error {
+		req := c.NewTermGetRequest()
+		req.PathParams().ID = 1
+		resp, err := req.Do(ctx)
+		if err == nil && resp.Name != "Net 30" {
+			t.Errorf("expected every caller to get the term, got %q", resp.Name)
+		}
+		return err
+	})
+	if hits != 1 || coalesced != 4 {
+		t.Errorf("expected identical gets to share one request, got %d requests and %d coalesced", hits, coalesced)
+	}
+
+	hits, _ = run(func(ctx context.Context) error {
+		req := c.NewSuiteqlPostRequest()
+		req.RequestBody().Q = "SELECT id FROM term"
+		resp, err := req.Do(ctx)
+		if err == nil && resp.Count != 1 {
+			t.Errorf("expected every caller to get the results, got %d", resp.Count)
+		}
+		return err
+	})
+	if hits != 1 {
+		t.Errorf("expected identical queries to share one request, got %d", hits)
+	}
+
+	var n int32
+	hits, _ = run(func(ctx context.Context) error {
+		req := c.NewSuiteqlPostRequest()
+		req.RequestBody().Q = "SELECT id FROM term WHERE id > " + strconv.Itoa(int(atomic.AddInt32(&n, 1)))
+		_, err := req.Do(ctx)
+		return err
+	})
+	if hits != 5 {
+		t.Errorf("expected different queries to be sent on their own, got %d requests", hits)
+	}
+
+	c = newClient(false)
+	hits, _ = run(func(ctx context.Context) error {
+		req := c.NewTermGetRequest()
+		req.PathParams().ID = 1
+		_, err := req.Do(ctx)
+		return err
+	})
+	if hits != 5 {
+		t.Errorf("expected requests not to be coalesced by default, got %d requests", hits)
+	}
+}
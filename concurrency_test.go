@@ -0,0 +1,52 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// TestConcurrentUse is meant to be run with -race: a client is used from
+// several goroutines while it's reconfigured.
+func TestConcurrentUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+	)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				req := c.NewTermGetRequest()
+				req.PathParams().ID = 1
+				_, err := req.Do(context.Background())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 10; i++ {
+		c.SetContentLanguage("nl-NL")
+		c.SetTokenID("tid")
+		c.SetLogLevel(netsuite.LogLevelDebug)
+		c.SetRetryPolicy(netsuite.RetryPolicy{MaxRetries: 1})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,101 @@
+package netsuite
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// ConfigError is returned when a request is made with an incomplete or
+// inconsistent client configuration. It lists all problems at once.
+type ConfigError struct {
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("invalid client configuration: %s", strings.Join(e.Problems, ", "))
+}
+
+var (
+	accountIDRe = regexp.MustCompile(`^[0-9A-Za-z_-]+$`)
+)
+
+// Validate checks that the account id, credentials and base url are
+// consistent and returns a *ConfigError when they aren't. It's called before
+// every request is built; call it after NewClient to fail early.
+func (c *Client) Validate() error {
+	return c.config().validate()
+}
+
+func (cfg clientConfig) validate() error {
+	problems := []string{}
+
+	if cfg.http == nil {
+		problems = append(problems, "http client is not set")
+	}
+
+	if cfg.companyID != "" && !accountIDRe.MatchString(cfg.companyID) {
+		problems = append(problems, fmt.Sprintf("account id %q is invalid", cfg.companyID))
+	}
+
+	if cfg.companyID == "" && (cfg.useTokenAuth || strings.Contains(cfg.baseURL, ".account_id")) {
+		problems = append(problems, "account id is not set")
+	}
+
+	if cfg.useTokenAuth {
+		for _, f := range []struct {
+			name  string
+			value string
+		}{
+			{"consumer key (client id)", cfg.clientID},
+			{"consumer secret (client secret)", cfg.clientSecret},
+			{"token id", cfg.tokenID},
+			{"token secret", cfg.tokenSecret},
+		} {
+			if f.value == "" {
+				problems = append(problems, f.name+" is not set")
+			}
+		}
+	}
+
+	problems = append(problems, cfg.validateBaseURL()...)
+
+	if len(problems) > 0 {
+		return &ConfigError{Problems: problems}
+	}
+	return nil
+}
+
+func (cfg clientConfig) validateBaseURL() []string {
+	if cfg.baseURL == "" {
+		return []string{"base url is not set"}
+	}
+
+	tmpl, err := template.New("host").Option("missingkey=error").Parse(cfg.baseURL)
+	if err != nil {
+		return []string{fmt.Sprintf("base url %q is invalid: %s", cfg.baseURL, err)}
+	}
+
+	if strings.Contains(cfg.baseURL, ".account_id") && cfg.companyID == "" {
+		// reported by validate
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, map[string]interface{}{"account_id": cfg.companyID})
+	if err != nil {
+		return []string{fmt.Sprintf("base url %q is invalid: %s", cfg.baseURL, err)}
+	}
+
+	u, err := url.Parse(buf.String())
+	if err != nil {
+		return []string{fmt.Sprintf("base url %q is invalid: %s", cfg.baseURL, err)}
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return []string{fmt.Sprintf("base url %q is not an absolute http(s) url", cfg.baseURL)}
+	}
+	return nil
+}
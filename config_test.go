@@ -0,0 +1,47 @@
+package netsuite_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestConfigValidation(t *testing.T) {
+	c := netsuite.NewClient(
+		netsuite.WithTokenAuth("ck", "", "tid", ""),
+	)
+
+	err := c.Validate()
+	configErr := &netsuite.ConfigError{}
+	if !errors.As(err, &configErr) {
+		t.Fatalf("expected a config error, got %v", err)
+	}
+	for _, problem := range []string{"account id", "consumer secret", "token secret"} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("expected %q to be reported: %s", problem, err)
+		}
+	}
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	_, err = req.Do(context.Background())
+	if !errors.As(err, &configErr) {
+		t.Errorf("expected the request to fail with a config error, got %v", err)
+	}
+
+	c = netsuite.NewClient(
+		netsuite.WithAccountID("1234567_SB1"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+	)
+	if err := c.Validate(); err != nil {
+		t.Errorf("expected a valid configuration, got %v", err)
+	}
+
+	c.SetBaseURL("localhost:8080")
+	if err := c.Validate(); err == nil {
+		t.Errorf("expected a relative base url to be invalid")
+	}
+}
@@ -0,0 +1,175 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ConsolidatedRateType is the rate of a consolidated exchange rate used to
+// translate an account: income statement accounts use the average rate,
+// balance sheet accounts the current rate and equity accounts the historical
+// rate.
+type ConsolidatedRateType string
+
+const (
+	ConsolidatedRateCurrent    ConsolidatedRateType = "current"
+	ConsolidatedRateAverage    ConsolidatedRateType = "average"
+	ConsolidatedRateHistorical ConsolidatedRateType = "historical"
+)
+
+// Rate returns the rate of the given type.
+func (c ConsolidatedExchangeRate) Rate(t ConsolidatedRateType) (Decimal, error) {
+	switch t {
+	case ConsolidatedRateCurrent:
+		return c.CurrentRate, nil
+	case ConsolidatedRateAverage:
+		return c.AverageRate, nil
+	case ConsolidatedRateHistorical:
+		return c.HistoricalRate, nil
+	}
+	return Decimal{}, fmt.Errorf("unknown consolidated rate type %q", t)
+}
+
+// Find returns the rate from one subsidiary to another, by their internal
+// ids.
+func (rr ConsolidatedExchangeRates) Find(fromSubsidiary, toSubsidiary string) (ConsolidatedExchangeRate, bool) {
+	for _, r := range rr {
+		if r.FromSubsidiary.ID == fromSubsidiary && r.ToSubsidiary.ID == toSubsidiary {
+			return r, true
+		}
+	}
+	return ConsolidatedExchangeRate{}, false
+}
+
+// ConsolidatedExchangeRateFilter selects consolidated exchange rates by the
+// internal ids of their period, subsidiaries and accounting book. Empty
+// fields match every rate.
+type ConsolidatedExchangeRateFilter struct {
+	PostingPeriod  string
+	FromSubsidiary string
+	ToSubsidiary   string
+	AccountingBook string
+}
+
+// NewConsolidatedExchangeRatesQueryRequest returns a SuiteQL request that
+// selects the consolidated exchange rates matching filter, with their rates,
+// periods, subsidiaries and currencies. The record list of the REST API only
+// returns ids, so this is the way to extract the rates of a period in one go.
+// Decode the response with ToConsolidatedExchangeRates.
+func (c *Client) NewConsolidatedExchangeRatesQueryRequest(filter ConsolidatedExchangeRateFilter) SuiteqlPostRequest {
+	r := c.NewSuiteqlPostRequest()
+	r.RequestBody().Q = consolidatedExchangeRatesQuery(filter)
+	return r
+}
+
+// QueryConsolidatedExchangeRates returns every consolidated exchange rate
+// matching filter, see NewConsolidatedExchangeRatesQueryRequest.
+func (c *Client) QueryConsolidatedExchangeRates(ctx context.Context, filter ConsolidatedExchangeRateFilter) (ConsolidatedExchangeRates, error) {
+	req := c.NewConsolidatedExchangeRatesQueryRequest(filter)
+	req.QueryParams().Limit = 1000
+
+	rates := ConsolidatedExchangeRates{}
+	for {
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return rates, err
+		}
+
+		page, err := resp.ToConsolidatedExchangeRates(c)
+		if err != nil {
+			return rates, err
+		}
+		rates = append(rates, page...)
+
+		if !resp.HasMore || resp.Count == 0 {
+			return rates, nil
+		}
+		req.QueryParams().Offset = resp.Offset + resp.Count
+	}
+}
+
+func consolidatedExchangeRatesQuery(filter ConsolidatedExchangeRateFilter) string {
+	conditions := []string{}
+	for _, c := range []struct{ column, value string }{
+		{"postingperiod", filter.PostingPeriod},
+		{"fromsubsidiary", filter.FromSubsidiary},
+		{"tosubsidiary", filter.ToSubsidiary},
+		{"accountingbook", filter.AccountingBook},
+	} {
+		if c.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s = '%s'", c.column, strings.Replace(c.value, "'", "''", -1)))
+		}
+	}
+
+	q := "SELECT id, externalid, postingperiod, BUILTIN.DF(postingperiod) AS postingperiodname, " +
+		"fromsubsidiary, BUILTIN.DF(fromsubsidiary) AS fromsubsidiaryname, tosubsidiary, BUILTIN.DF(tosubsidiary) AS tosubsidiaryname, " +
+		"fromcurrency, BUILTIN.DF(fromcurrency) AS fromcurrencyname, tocurrency, BUILTIN.DF(tocurrency) AS tocurrencyname, " +
+		"accountingbook, BUILTIN.DF(accountingbook) AS accountingbookname, " +
+		"currentrate, averagerate, historicalrate, isderived, isperiodclosed FROM consolidatedexchangerate"
+	if len(conditions) > 0 {
+		q += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return q + " ORDER BY postingperiod, fromsubsidiary, tosubsidiary, id"
+}
+
+// consolidatedExchangeRateRow is a consolidated exchange rate as SuiteQL
+// returns it: references as ids and names, booleans as T/F.
+type consolidatedExchangeRateRow struct {
+	Links              Links   `json:"links"`
+	ID                 string  `json:"id"`
+	ExternalID         string  `json:"externalid"`
+	PostingPeriod      string  `json:"postingperiod"`
+	PostingPeriodName  string  `json:"postingperiodname"`
+	FromSubsidiary     string  `json:"fromsubsidiary"`
+	FromSubsidiaryName string  `json:"fromsubsidiaryname"`
+	ToSubsidiary       string  `json:"tosubsidiary"`
+	ToSubsidiaryName   string  `json:"tosubsidiaryname"`
+	FromCurrency       string  `json:"fromcurrency"`
+	FromCurrencyName   string  `json:"fromcurrencyname"`
+	ToCurrency         string  `json:"tocurrency"`
+	ToCurrencyName     string  `json:"tocurrencyname"`
+	AccountingBook     string  `json:"accountingbook"`
+	AccountingBookName string  `json:"accountingbookname"`
+	CurrentRate        Decimal `json:"currentrate"`
+	AverageRate        Decimal `json:"averagerate"`
+	HistoricalRate     Decimal `json:"historicalrate"`
+	IsDerived          string  `json:"isderived"`
+	IsPeriodClosed     string  `json:"isperiodclosed"`
+}
+
+func (r *SuiteqlPostResponseBody) ToConsolidatedExchangeRates(client *Client) (ConsolidatedExchangeRates, error) {
+	rows := []consolidatedExchangeRateRow{}
+
+	reader := bytes.NewReader(r.Items)
+	dec := json.NewDecoder(reader)
+	if client.DisallowUnknownFields() {
+		dec.DisallowUnknownFields()
+	}
+	err := dec.Decode(&rows)
+	if err != nil {
+		return ConsolidatedExchangeRates{}, err
+	}
+
+	items := make(ConsolidatedExchangeRates, len(rows))
+	for i, row := range rows {
+		items[i] = ConsolidatedExchangeRate{
+			AccountingBook: RecordRef{ID: row.AccountingBook, RefName: row.AccountingBookName},
+			AverageRate:    row.AverageRate,
+			CurrentRate:    row.CurrentRate,
+			ExternalID:     row.ExternalID,
+			FromCurrency:   RecordRef{ID: row.FromCurrency, RefName: row.FromCurrencyName},
+			FromSubsidiary: RecordRef{ID: row.FromSubsidiary, RefName: row.FromSubsidiaryName},
+			HistoricalRate: row.HistoricalRate,
+			ID:             row.ID,
+			IsDerived:      Bool(row.IsDerived == "T"),
+			IsPeriodClosed: Bool(row.IsPeriodClosed == "T"),
+			PostingPeriod:  RecordRef{ID: row.PostingPeriod, RefName: row.PostingPeriodName},
+			ToCurrency:     RecordRef{ID: row.ToCurrency, RefName: row.ToCurrencyName},
+			ToSubsidiary:   RecordRef{ID: row.ToSubsidiary, RefName: row.ToSubsidiaryName},
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewConsolidatedExchangeRateGetRequest() ConsolidatedExchangeRateGetRequest {
+	r := ConsolidatedExchangeRateGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ConsolidatedExchangeRateGetRequest struct {
+	client      *Client
+	queryParams *ConsolidatedExchangeRateGetRequestQueryParams
+	pathParams  *ConsolidatedExchangeRateGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ConsolidatedExchangeRateGetRequestBody
+}
+
+func (r ConsolidatedExchangeRateGetRequest) NewQueryParams() *ConsolidatedExchangeRateGetRequestQueryParams {
+	return &ConsolidatedExchangeRateGetRequestQueryParams{}
+}
+
+type ConsolidatedExchangeRateGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ConsolidatedExchangeRateGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) QueryParams() *ConsolidatedExchangeRateGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ConsolidatedExchangeRateGetRequest) NewPathParams() *ConsolidatedExchangeRateGetRequestPathParams {
+	return &ConsolidatedExchangeRateGetRequestPathParams{}
+}
+
+type ConsolidatedExchangeRateGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ConsolidatedExchangeRateGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) PathParams() *ConsolidatedExchangeRateGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ConsolidatedExchangeRateGetRequest) NewRequestBody() ConsolidatedExchangeRateGetRequestBody {
+	return ConsolidatedExchangeRateGetRequestBody{}
+}
+
+type ConsolidatedExchangeRateGetRequestBody struct {
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) RequestBody() *ConsolidatedExchangeRateGetRequestBody {
+	return nil
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) SetRequestBody(body ConsolidatedExchangeRateGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) NewResponseBody() *ConsolidatedExchangeRateGetResponseBody {
+	return &ConsolidatedExchangeRateGetResponseBody{}
+}
+
+type ConsolidatedExchangeRateGetResponseBody struct {
+	Links Links `json:"links"`
+	ConsolidatedExchangeRate
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/consolidatedExchangeRate/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ConsolidatedExchangeRateGetRequest) Do(ctx context.Context) (ConsolidatedExchangeRateGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,60 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestQueryConsolidatedExchangeRates(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from consolidatedexchangerate",
+		map[string]interface{}{
+			"id": "7", "postingperiod": "120", "postingperiodname": "Mar 2024",
+			"fromsubsidiary": "2", "fromsubsidiaryname": "Acme UK", "tosubsidiary": "1", "tosubsidiaryname": "Acme Inc",
+			"fromcurrency": "2", "fromcurrencyname": "GBP", "tocurrency": "1", "tocurrencyname": "USD",
+			"accountingbook": "1", "accountingbookname": "Primary Accounting Book",
+			"currentrate": "1.2650", "averagerate": "1.2710", "historicalrate": "1.2500",
+			"isderived": "F", "isperiodclosed": "T",
+		},
+		map[string]interface{}{
+			"id": "8", "postingperiod": "120", "fromsubsidiary": "3", "tosubsidiary": "1",
+			"currentrate": "1.0850", "averagerate": "1.0870", "historicalrate": "1.1000",
+			"isderived": "T", "isperiodclosed": "T",
+		},
+	)
+
+	rates, err := srv.Client().QueryConsolidatedExchangeRates(context.Background(), netsuite.ConsolidatedExchangeRateFilter{PostingPeriod: "120", ToSubsidiary: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("expected 2 rates, got %d", len(rates))
+	}
+
+	rate, ok := rates.Find("2", "1")
+	if !ok {
+		t.Fatal("expected a rate from subsidiary 2 to 1")
+	}
+	if rate.PostingPeriod.RefName != "Mar 2024" || rate.FromCurrency.RefName != "GBP" || !bool(rate.IsPeriodClosed) || bool(rate.IsDerived) {
+		t.Errorf("unexpected rate %+v", rate)
+	}
+	average, err := rate.Rate(netsuite.ConsolidatedRateAverage)
+	if err != nil || average.String() != "1.271" {
+		t.Errorf("expected average rate 1.271, got %s (%v)", average, err)
+	}
+	if _, err := rate.Rate("spot"); err == nil {
+		t.Error("expected an error for an unknown rate type")
+	}
+
+	body := struct{ Q string }{}
+	json.Unmarshal(srv.Requests()[0].Body, &body)
+	if !strings.Contains(body.Q, "WHERE postingperiod = '120' AND tosubsidiary = '1'") {
+		t.Errorf("expected the query to filter on period and subsidiary, got %s", body.Q)
+	}
+}
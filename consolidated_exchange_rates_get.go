@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewConsolidatedExchangeRatesGetRequest() ConsolidatedExchangeRatesGetRequest {
+	r := ConsolidatedExchangeRatesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ConsolidatedExchangeRatesGetRequest struct {
+	client      *Client
+	queryParams *ConsolidatedExchangeRatesGetRequestQueryParams
+	pathParams  *ConsolidatedExchangeRatesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ConsolidatedExchangeRatesGetRequestBody
+}
+
+func (r ConsolidatedExchangeRatesGetRequest) NewQueryParams() *ConsolidatedExchangeRatesGetRequestQueryParams {
+	return &ConsolidatedExchangeRatesGetRequestQueryParams{}
+}
+
+type ConsolidatedExchangeRatesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ConsolidatedExchangeRatesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) QueryParams() *ConsolidatedExchangeRatesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ConsolidatedExchangeRatesGetRequest) NewPathParams() *ConsolidatedExchangeRatesGetRequestPathParams {
+	return &ConsolidatedExchangeRatesGetRequestPathParams{}
+}
+
+type ConsolidatedExchangeRatesGetRequestPathParams struct {
+}
+
+func (p *ConsolidatedExchangeRatesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) PathParams() *ConsolidatedExchangeRatesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ConsolidatedExchangeRatesGetRequest) NewRequestBody() ConsolidatedExchangeRatesGetRequestBody {
+	return ConsolidatedExchangeRatesGetRequestBody{}
+}
+
+type ConsolidatedExchangeRatesGetRequestBody struct {
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) RequestBody() *ConsolidatedExchangeRatesGetRequestBody {
+	return nil
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) SetRequestBody(body ConsolidatedExchangeRatesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) NewResponseBody() *ConsolidatedExchangeRatesGetResponseBody {
+	return &ConsolidatedExchangeRatesGetResponseBody{}
+}
+
+type ConsolidatedExchangeRatesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/consolidatedExchangeRate", r.PathParams())
+	return &u, err
+}
+
+func (r *ConsolidatedExchangeRatesGetRequest) Do(ctx context.Context) (ConsolidatedExchangeRatesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewContactDeleteRequest() ContactDeleteRequest {
+	r := ContactDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ContactDeleteRequest struct {
+	client      *Client
+	queryParams *ContactDeleteRequestQueryParams
+	pathParams  *ContactDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ContactDeleteRequestBody
+}
+
+func (r ContactDeleteRequest) NewQueryParams() *ContactDeleteRequestQueryParams {
+	return &ContactDeleteRequestQueryParams{}
+}
+
+type ContactDeleteRequestQueryParams struct {
+}
+
+func (p ContactDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ContactDeleteRequest) QueryParams() *ContactDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ContactDeleteRequest) NewPathParams() *ContactDeleteRequestPathParams {
+	return &ContactDeleteRequestPathParams{}
+}
+
+type ContactDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ContactDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ContactDeleteRequest) PathParams() *ContactDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ContactDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ContactDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ContactDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *ContactDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ContactDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ContactDeleteRequest) NewRequestBody() ContactDeleteRequestBody {
+	return ContactDeleteRequestBody{}
+}
+
+type ContactDeleteRequestBody struct {
+}
+
+func (r *ContactDeleteRequest) RequestBody() *ContactDeleteRequestBody {
+	return nil
+}
+
+func (r *ContactDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ContactDeleteRequest) SetRequestBody(body ContactDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ContactDeleteRequest) NewResponseBody() *ContactDeleteResponseBody {
+	return &ContactDeleteResponseBody{}
+}
+
+type ContactDeleteResponseBody struct {
+}
+
+func (r *ContactDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/contact/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ContactDeleteRequest) Do(ctx context.Context) (ContactDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
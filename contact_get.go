@@ -0,0 +1,165 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewContactGetRequest() ContactGetRequest {
+	r := ContactGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ContactGetRequest struct {
+	client      *Client
+	queryParams *ContactGetRequestQueryParams
+	pathParams  *ContactGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ContactGetRequestBody
+}
+
+func (r ContactGetRequest) NewQueryParams() *ContactGetRequestQueryParams {
+	return &ContactGetRequestQueryParams{}
+}
+
+type ContactGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ContactGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ContactGetRequest) QueryParams() *ContactGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ContactGetRequest) NewPathParams() *ContactGetRequestPathParams {
+	return &ContactGetRequestPathParams{}
+}
+
+type ContactGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ContactGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ContactGetRequest) PathParams() *ContactGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ContactGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ContactGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ContactGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ContactGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ContactGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ContactGetRequest) NewRequestBody() ContactGetRequestBody {
+	return ContactGetRequestBody{}
+}
+
+type ContactGetRequestBody struct {
+}
+
+func (r *ContactGetRequest) RequestBody() *ContactGetRequestBody {
+	return nil
+}
+
+func (r *ContactGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ContactGetRequest) SetRequestBody(body ContactGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ContactGetRequest) NewResponseBody() *ContactGetResponseBody {
+	return &ContactGetResponseBody{}
+}
+
+type ContactGetResponseBody struct {
+	Links Links `json:"links"`
+	Contact
+}
+
+// UnmarshalJSON decodes the links next to the embedded Contact, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *ContactGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.Contact)
+}
+
+func (r *ContactGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/contact/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ContactGetRequest) Do(ctx context.Context) (ContactGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
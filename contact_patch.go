@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewContactPatchRequest() ContactPatchRequest {
+	r := ContactPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ContactPatchRequest struct {
+	client      *Client
+	queryParams *ContactPatchRequestQueryParams
+	pathParams  *ContactPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ContactPatchRequestBody
+}
+
+func (r ContactPatchRequest) NewQueryParams() *ContactPatchRequestQueryParams {
+	return &ContactPatchRequestQueryParams{}
+}
+
+type ContactPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p ContactPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ContactPatchRequest) QueryParams() *ContactPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ContactPatchRequest) NewPathParams() *ContactPatchRequestPathParams {
+	return &ContactPatchRequestPathParams{}
+}
+
+type ContactPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ContactPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ContactPatchRequest) PathParams() *ContactPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ContactPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ContactPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ContactPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *ContactPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ContactPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ContactPatchRequest) NewRequestBody() ContactPatchRequestBody {
+	return ContactPatchRequestBody{}
+}
+
+type ContactPatchRequestBody struct {
+	Contact
+}
+
+func (r *ContactPatchRequest) RequestBody() *ContactPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *ContactPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ContactPatchRequest) SetRequestBody(body ContactPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ContactPatchRequest) NewResponseBody() *ContactPatchResponseBody {
+	return &ContactPatchResponseBody{}
+}
+
+type ContactPatchResponseBody struct {
+}
+
+func (r *ContactPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/contact/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ContactPatchRequest) Do(ctx context.Context) (ContactPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
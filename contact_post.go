@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewContactPostRequest() ContactPostRequest {
+	r := ContactPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ContactPostRequest struct {
+	client      *Client
+	queryParams *ContactPostRequestQueryParams
+	pathParams  *ContactPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ContactPostRequestBody
+}
+
+func (r ContactPostRequest) NewQueryParams() *ContactPostRequestQueryParams {
+	return &ContactPostRequestQueryParams{}
+}
+
+type ContactPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ContactPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ContactPostRequest) QueryParams() *ContactPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ContactPostRequest) NewPathParams() *ContactPostRequestPathParams {
+	return &ContactPostRequestPathParams{}
+}
+
+type ContactPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ContactPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ContactPostRequest) PathParams() *ContactPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ContactPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ContactPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ContactPostRequest) Method() string {
+	return r.method
+}
+
+func (r *ContactPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ContactPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ContactPostRequest) NewRequestBody() ContactPostRequestBody {
+	return ContactPostRequestBody{}
+}
+
+type ContactPostRequestBody struct {
+	Contact
+}
+
+func (r *ContactPostRequest) RequestBody() *ContactPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *ContactPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ContactPostRequest) SetRequestBody(body ContactPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ContactPostRequest) NewResponseBody() *ContactPostResponseBody {
+	return &ContactPostResponseBody{}
+}
+
+type ContactPostResponseBody struct {
+}
+
+func (r *ContactPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/contact", r.PathParams())
+	return &u, err
+}
+
+func (r *ContactPostRequest) Do(ctx context.Context) (ContactPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
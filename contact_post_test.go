@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestContactPost(t *testing.T) {
+	req := client.NewContactPostRequest()
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Company.ID = "70202"
+	req.RequestBody().FirstName = "Kees"
+	req.RequestBody().LastName = "Zorge"
+	req.RequestBody().Email = "kees@omniboost.io"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewContactsGetRequest() ContactsGetRequest {
+	r := ContactsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ContactsGetRequest struct {
+	client      *Client
+	queryParams *ContactsGetRequestQueryParams
+	pathParams  *ContactsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ContactsGetRequestBody
+}
+
+func (r ContactsGetRequest) NewQueryParams() *ContactsGetRequestQueryParams {
+	return &ContactsGetRequestQueryParams{}
+}
+
+type ContactsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ContactsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ContactsGetRequest) QueryParams() *ContactsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ContactsGetRequest) NewPathParams() *ContactsGetRequestPathParams {
+	return &ContactsGetRequestPathParams{}
+}
+
+type ContactsGetRequestPathParams struct {
+}
+
+func (p *ContactsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ContactsGetRequest) PathParams() *ContactsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ContactsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ContactsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ContactsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ContactsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ContactsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ContactsGetRequest) NewRequestBody() ContactsGetRequestBody {
+	return ContactsGetRequestBody{}
+}
+
+type ContactsGetRequestBody struct {
+}
+
+func (r *ContactsGetRequest) RequestBody() *ContactsGetRequestBody {
+	return nil
+}
+
+func (r *ContactsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ContactsGetRequest) SetRequestBody(body ContactsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ContactsGetRequest) NewResponseBody() *ContactsGetResponseBody {
+	return &ContactsGetResponseBody{}
+}
+
+type ContactsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ContactsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/contact", r.PathParams())
+	return &u, err
+}
+
+func (r *ContactsGetRequest) Do(ctx context.Context) (ContactsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCouponCodeDeleteRequest() CouponCodeDeleteRequest {
+	r := CouponCodeDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CouponCodeDeleteRequest struct {
+	client      *Client
+	queryParams *CouponCodeDeleteRequestQueryParams
+	pathParams  *CouponCodeDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CouponCodeDeleteRequestBody
+}
+
+func (r CouponCodeDeleteRequest) NewQueryParams() *CouponCodeDeleteRequestQueryParams {
+	return &CouponCodeDeleteRequestQueryParams{}
+}
+
+type CouponCodeDeleteRequestQueryParams struct {
+}
+
+func (p CouponCodeDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CouponCodeDeleteRequest) QueryParams() *CouponCodeDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CouponCodeDeleteRequest) NewPathParams() *CouponCodeDeleteRequestPathParams {
+	return &CouponCodeDeleteRequestPathParams{}
+}
+
+type CouponCodeDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CouponCodeDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CouponCodeDeleteRequest) PathParams() *CouponCodeDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodeDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodeDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CouponCodeDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CouponCodeDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CouponCodeDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CouponCodeDeleteRequest) NewRequestBody() CouponCodeDeleteRequestBody {
+	return CouponCodeDeleteRequestBody{}
+}
+
+type CouponCodeDeleteRequestBody struct {
+}
+
+func (r *CouponCodeDeleteRequest) RequestBody() *CouponCodeDeleteRequestBody {
+	return nil
+}
+
+func (r *CouponCodeDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CouponCodeDeleteRequest) SetRequestBody(body CouponCodeDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CouponCodeDeleteRequest) NewResponseBody() *CouponCodeDeleteResponseBody {
+	return &CouponCodeDeleteResponseBody{}
+}
+
+type CouponCodeDeleteResponseBody struct {
+}
+
+func (r *CouponCodeDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/couponCode/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CouponCodeDeleteRequest) Do(ctx context.Context) (CouponCodeDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
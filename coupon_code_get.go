@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCouponCodeGetRequest() CouponCodeGetRequest {
+	r := CouponCodeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CouponCodeGetRequest struct {
+	client      *Client
+	queryParams *CouponCodeGetRequestQueryParams
+	pathParams  *CouponCodeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CouponCodeGetRequestBody
+}
+
+func (r CouponCodeGetRequest) NewQueryParams() *CouponCodeGetRequestQueryParams {
+	return &CouponCodeGetRequestQueryParams{}
+}
+
+type CouponCodeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CouponCodeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CouponCodeGetRequest) QueryParams() *CouponCodeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CouponCodeGetRequest) NewPathParams() *CouponCodeGetRequestPathParams {
+	return &CouponCodeGetRequestPathParams{}
+}
+
+type CouponCodeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CouponCodeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CouponCodeGetRequest) PathParams() *CouponCodeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CouponCodeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CouponCodeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CouponCodeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CouponCodeGetRequest) NewRequestBody() CouponCodeGetRequestBody {
+	return CouponCodeGetRequestBody{}
+}
+
+type CouponCodeGetRequestBody struct {
+}
+
+func (r *CouponCodeGetRequest) RequestBody() *CouponCodeGetRequestBody {
+	return nil
+}
+
+func (r *CouponCodeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CouponCodeGetRequest) SetRequestBody(body CouponCodeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CouponCodeGetRequest) NewResponseBody() *CouponCodeGetResponseBody {
+	return &CouponCodeGetResponseBody{}
+}
+
+type CouponCodeGetResponseBody struct {
+	Links Links `json:"links"`
+	CouponCode
+}
+
+func (r *CouponCodeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/couponCode/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CouponCodeGetRequest) Do(ctx context.Context) (CouponCodeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCouponCodePostRequest() CouponCodePostRequest {
+	r := CouponCodePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CouponCodePostRequest struct {
+	client      *Client
+	queryParams *CouponCodePostRequestQueryParams
+	pathParams  *CouponCodePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CouponCodePostRequestBody
+}
+
+func (r CouponCodePostRequest) NewQueryParams() *CouponCodePostRequestQueryParams {
+	return &CouponCodePostRequestQueryParams{}
+}
+
+type CouponCodePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CouponCodePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CouponCodePostRequest) QueryParams() *CouponCodePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CouponCodePostRequest) NewPathParams() *CouponCodePostRequestPathParams {
+	return &CouponCodePostRequestPathParams{}
+}
+
+type CouponCodePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CouponCodePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CouponCodePostRequest) PathParams() *CouponCodePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CouponCodePostRequest) Method() string {
+	return r.method
+}
+
+func (r *CouponCodePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CouponCodePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CouponCodePostRequest) NewRequestBody() CouponCodePostRequestBody {
+	return CouponCodePostRequestBody{}
+}
+
+type CouponCodePostRequestBody struct {
+	CouponCode
+}
+
+func (r *CouponCodePostRequest) RequestBody() *CouponCodePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CouponCodePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CouponCodePostRequest) SetRequestBody(body CouponCodePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CouponCodePostRequest) NewResponseBody() *CouponCodePostResponseBody {
+	return &CouponCodePostResponseBody{}
+}
+
+type CouponCodePostResponseBody struct {
+}
+
+func (r *CouponCodePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/couponCode", r.PathParams())
+	return &u, err
+}
+
+func (r *CouponCodePostRequest) Do(ctx context.Context) (CouponCodePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCouponCodePost(t *testing.T) {
+	req := client.NewCouponCodePostRequest()
+	req.RequestBody().Promotion.ID = "31"
+	req.RequestBody().Code = "SPRING22-0001"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCouponCodesGetRequest() CouponCodesGetRequest {
+	r := CouponCodesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CouponCodesGetRequest struct {
+	client      *Client
+	queryParams *CouponCodesGetRequestQueryParams
+	pathParams  *CouponCodesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CouponCodesGetRequestBody
+}
+
+func (r CouponCodesGetRequest) NewQueryParams() *CouponCodesGetRequestQueryParams {
+	return &CouponCodesGetRequestQueryParams{}
+}
+
+type CouponCodesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CouponCodesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CouponCodesGetRequest) QueryParams() *CouponCodesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CouponCodesGetRequest) NewPathParams() *CouponCodesGetRequestPathParams {
+	return &CouponCodesGetRequestPathParams{}
+}
+
+type CouponCodesGetRequestPathParams struct {
+}
+
+func (p *CouponCodesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CouponCodesGetRequest) PathParams() *CouponCodesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CouponCodesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CouponCodesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CouponCodesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CouponCodesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CouponCodesGetRequest) NewRequestBody() CouponCodesGetRequestBody {
+	return CouponCodesGetRequestBody{}
+}
+
+type CouponCodesGetRequestBody struct {
+}
+
+func (r *CouponCodesGetRequest) RequestBody() *CouponCodesGetRequestBody {
+	return nil
+}
+
+func (r *CouponCodesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CouponCodesGetRequest) SetRequestBody(body CouponCodesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CouponCodesGetRequest) NewResponseBody() *CouponCodesGetResponseBody {
+	return &CouponCodesGetResponseBody{}
+}
+
+type CouponCodesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CouponCodesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/couponCode", r.PathParams())
+	return &u, err
+}
+
+func (r *CouponCodesGetRequest) Do(ctx context.Context) (CouponCodesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardChargeDeleteRequest() CreditCardChargeDeleteRequest {
+	r := CreditCardChargeDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardChargeDeleteRequest struct {
+	client      *Client
+	queryParams *CreditCardChargeDeleteRequestQueryParams
+	pathParams  *CreditCardChargeDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardChargeDeleteRequestBody
+}
+
+func (r CreditCardChargeDeleteRequest) NewQueryParams() *CreditCardChargeDeleteRequestQueryParams {
+	return &CreditCardChargeDeleteRequestQueryParams{}
+}
+
+type CreditCardChargeDeleteRequestQueryParams struct {
+}
+
+func (p CreditCardChargeDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardChargeDeleteRequest) QueryParams() *CreditCardChargeDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardChargeDeleteRequest) NewPathParams() *CreditCardChargeDeleteRequestPathParams {
+	return &CreditCardChargeDeleteRequestPathParams{}
+}
+
+type CreditCardChargeDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardChargeDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardChargeDeleteRequest) PathParams() *CreditCardChargeDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargeDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargeDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardChargeDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardChargeDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardChargeDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardChargeDeleteRequest) NewRequestBody() CreditCardChargeDeleteRequestBody {
+	return CreditCardChargeDeleteRequestBody{}
+}
+
+type CreditCardChargeDeleteRequestBody struct {
+}
+
+func (r *CreditCardChargeDeleteRequest) RequestBody() *CreditCardChargeDeleteRequestBody {
+	return nil
+}
+
+func (r *CreditCardChargeDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardChargeDeleteRequest) SetRequestBody(body CreditCardChargeDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardChargeDeleteRequest) NewResponseBody() *CreditCardChargeDeleteResponseBody {
+	return &CreditCardChargeDeleteResponseBody{}
+}
+
+type CreditCardChargeDeleteResponseBody struct {
+}
+
+func (r *CreditCardChargeDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardCharge/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardChargeDeleteRequest) Do(ctx context.Context) (CreditCardChargeDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
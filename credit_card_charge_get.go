@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardChargeGetRequest() CreditCardChargeGetRequest {
+	r := CreditCardChargeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardChargeGetRequest struct {
+	client      *Client
+	queryParams *CreditCardChargeGetRequestQueryParams
+	pathParams  *CreditCardChargeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardChargeGetRequestBody
+}
+
+func (r CreditCardChargeGetRequest) NewQueryParams() *CreditCardChargeGetRequestQueryParams {
+	return &CreditCardChargeGetRequestQueryParams{}
+}
+
+type CreditCardChargeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CreditCardChargeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardChargeGetRequest) QueryParams() *CreditCardChargeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardChargeGetRequest) NewPathParams() *CreditCardChargeGetRequestPathParams {
+	return &CreditCardChargeGetRequestPathParams{}
+}
+
+type CreditCardChargeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardChargeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardChargeGetRequest) PathParams() *CreditCardChargeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardChargeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardChargeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardChargeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardChargeGetRequest) NewRequestBody() CreditCardChargeGetRequestBody {
+	return CreditCardChargeGetRequestBody{}
+}
+
+type CreditCardChargeGetRequestBody struct {
+}
+
+func (r *CreditCardChargeGetRequest) RequestBody() *CreditCardChargeGetRequestBody {
+	return nil
+}
+
+func (r *CreditCardChargeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardChargeGetRequest) SetRequestBody(body CreditCardChargeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardChargeGetRequest) NewResponseBody() *CreditCardChargeGetResponseBody {
+	return &CreditCardChargeGetResponseBody{}
+}
+
+type CreditCardChargeGetResponseBody struct {
+	Links Links `json:"links"`
+	CreditCardCharge
+}
+
+func (r *CreditCardChargeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardCharge/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardChargeGetRequest) Do(ctx context.Context) (CreditCardChargeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardChargePatchRequest() CreditCardChargePatchRequest {
+	r := CreditCardChargePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardChargePatchRequest struct {
+	client      *Client
+	queryParams *CreditCardChargePatchRequestQueryParams
+	pathParams  *CreditCardChargePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardChargePatchRequestBody
+}
+
+func (r CreditCardChargePatchRequest) NewQueryParams() *CreditCardChargePatchRequestQueryParams {
+	return &CreditCardChargePatchRequestQueryParams{}
+}
+
+type CreditCardChargePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CreditCardChargePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardChargePatchRequest) QueryParams() *CreditCardChargePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardChargePatchRequest) NewPathParams() *CreditCardChargePatchRequestPathParams {
+	return &CreditCardChargePatchRequestPathParams{}
+}
+
+type CreditCardChargePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardChargePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardChargePatchRequest) PathParams() *CreditCardChargePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardChargePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardChargePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardChargePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardChargePatchRequest) NewRequestBody() CreditCardChargePatchRequestBody {
+	return CreditCardChargePatchRequestBody{}
+}
+
+type CreditCardChargePatchRequestBody struct {
+	CreditCardCharge
+}
+
+func (r *CreditCardChargePatchRequest) RequestBody() *CreditCardChargePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CreditCardChargePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CreditCardChargePatchRequest) SetRequestBody(body CreditCardChargePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardChargePatchRequest) NewResponseBody() *CreditCardChargePatchResponseBody {
+	return &CreditCardChargePatchResponseBody{}
+}
+
+type CreditCardChargePatchResponseBody struct {
+}
+
+func (r *CreditCardChargePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardCharge/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardChargePatchRequest) Do(ctx context.Context) (CreditCardChargePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
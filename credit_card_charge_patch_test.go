@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCreditCardChargePatch(t *testing.T) {
+	req := client.NewCreditCardChargePatchRequest()
+	req.PathParams().ID = 7120
+	req.RequestBody().Memo = "Card feed import"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardChargePostRequest() CreditCardChargePostRequest {
+	r := CreditCardChargePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardChargePostRequest struct {
+	client      *Client
+	queryParams *CreditCardChargePostRequestQueryParams
+	pathParams  *CreditCardChargePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardChargePostRequestBody
+}
+
+func (r CreditCardChargePostRequest) NewQueryParams() *CreditCardChargePostRequestQueryParams {
+	return &CreditCardChargePostRequestQueryParams{}
+}
+
+type CreditCardChargePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CreditCardChargePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardChargePostRequest) QueryParams() *CreditCardChargePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardChargePostRequest) NewPathParams() *CreditCardChargePostRequestPathParams {
+	return &CreditCardChargePostRequestPathParams{}
+}
+
+type CreditCardChargePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardChargePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardChargePostRequest) PathParams() *CreditCardChargePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardChargePostRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardChargePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardChargePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardChargePostRequest) NewRequestBody() CreditCardChargePostRequestBody {
+	return CreditCardChargePostRequestBody{}
+}
+
+type CreditCardChargePostRequestBody struct {
+	CreditCardCharge
+}
+
+func (r *CreditCardChargePostRequest) RequestBody() *CreditCardChargePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CreditCardChargePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CreditCardChargePostRequest) SetRequestBody(body CreditCardChargePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardChargePostRequest) NewResponseBody() *CreditCardChargePostResponseBody {
+	return &CreditCardChargePostResponseBody{}
+}
+
+type CreditCardChargePostResponseBody struct {
+}
+
+func (r *CreditCardChargePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardCharge", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardChargePostRequest) Do(ctx context.Context) (CreditCardChargePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
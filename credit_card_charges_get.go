@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardChargesGetRequest() CreditCardChargesGetRequest {
+	r := CreditCardChargesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardChargesGetRequest struct {
+	client      *Client
+	queryParams *CreditCardChargesGetRequestQueryParams
+	pathParams  *CreditCardChargesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardChargesGetRequestBody
+}
+
+func (r CreditCardChargesGetRequest) NewQueryParams() *CreditCardChargesGetRequestQueryParams {
+	return &CreditCardChargesGetRequestQueryParams{}
+}
+
+type CreditCardChargesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CreditCardChargesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardChargesGetRequest) QueryParams() *CreditCardChargesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardChargesGetRequest) NewPathParams() *CreditCardChargesGetRequestPathParams {
+	return &CreditCardChargesGetRequestPathParams{}
+}
+
+type CreditCardChargesGetRequestPathParams struct {
+}
+
+func (p *CreditCardChargesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CreditCardChargesGetRequest) PathParams() *CreditCardChargesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardChargesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardChargesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardChargesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardChargesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardChargesGetRequest) NewRequestBody() CreditCardChargesGetRequestBody {
+	return CreditCardChargesGetRequestBody{}
+}
+
+type CreditCardChargesGetRequestBody struct {
+}
+
+func (r *CreditCardChargesGetRequest) RequestBody() *CreditCardChargesGetRequestBody {
+	return nil
+}
+
+func (r *CreditCardChargesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardChargesGetRequest) SetRequestBody(body CreditCardChargesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardChargesGetRequest) NewResponseBody() *CreditCardChargesGetResponseBody {
+	return &CreditCardChargesGetResponseBody{}
+}
+
+type CreditCardChargesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CreditCardChargesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardCharge", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardChargesGetRequest) Do(ctx context.Context) (CreditCardChargesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
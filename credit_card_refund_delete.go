@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardRefundDeleteRequest() CreditCardRefundDeleteRequest {
+	r := CreditCardRefundDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardRefundDeleteRequest struct {
+	client      *Client
+	queryParams *CreditCardRefundDeleteRequestQueryParams
+	pathParams  *CreditCardRefundDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardRefundDeleteRequestBody
+}
+
+func (r CreditCardRefundDeleteRequest) NewQueryParams() *CreditCardRefundDeleteRequestQueryParams {
+	return &CreditCardRefundDeleteRequestQueryParams{}
+}
+
+type CreditCardRefundDeleteRequestQueryParams struct {
+}
+
+func (p CreditCardRefundDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardRefundDeleteRequest) QueryParams() *CreditCardRefundDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardRefundDeleteRequest) NewPathParams() *CreditCardRefundDeleteRequestPathParams {
+	return &CreditCardRefundDeleteRequestPathParams{}
+}
+
+type CreditCardRefundDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardRefundDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardRefundDeleteRequest) PathParams() *CreditCardRefundDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardRefundDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardRefundDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardRefundDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardRefundDeleteRequest) NewRequestBody() CreditCardRefundDeleteRequestBody {
+	return CreditCardRefundDeleteRequestBody{}
+}
+
+type CreditCardRefundDeleteRequestBody struct {
+}
+
+func (r *CreditCardRefundDeleteRequest) RequestBody() *CreditCardRefundDeleteRequestBody {
+	return nil
+}
+
+func (r *CreditCardRefundDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardRefundDeleteRequest) SetRequestBody(body CreditCardRefundDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardRefundDeleteRequest) NewResponseBody() *CreditCardRefundDeleteResponseBody {
+	return &CreditCardRefundDeleteResponseBody{}
+}
+
+type CreditCardRefundDeleteResponseBody struct {
+}
+
+func (r *CreditCardRefundDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardRefund/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardRefundDeleteRequest) Do(ctx context.Context) (CreditCardRefundDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardRefundGetRequest() CreditCardRefundGetRequest {
+	r := CreditCardRefundGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardRefundGetRequest struct {
+	client      *Client
+	queryParams *CreditCardRefundGetRequestQueryParams
+	pathParams  *CreditCardRefundGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardRefundGetRequestBody
+}
+
+func (r CreditCardRefundGetRequest) NewQueryParams() *CreditCardRefundGetRequestQueryParams {
+	return &CreditCardRefundGetRequestQueryParams{}
+}
+
+type CreditCardRefundGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CreditCardRefundGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardRefundGetRequest) QueryParams() *CreditCardRefundGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardRefundGetRequest) NewPathParams() *CreditCardRefundGetRequestPathParams {
+	return &CreditCardRefundGetRequestPathParams{}
+}
+
+type CreditCardRefundGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardRefundGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardRefundGetRequest) PathParams() *CreditCardRefundGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardRefundGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardRefundGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardRefundGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardRefundGetRequest) NewRequestBody() CreditCardRefundGetRequestBody {
+	return CreditCardRefundGetRequestBody{}
+}
+
+type CreditCardRefundGetRequestBody struct {
+}
+
+func (r *CreditCardRefundGetRequest) RequestBody() *CreditCardRefundGetRequestBody {
+	return nil
+}
+
+func (r *CreditCardRefundGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardRefundGetRequest) SetRequestBody(body CreditCardRefundGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardRefundGetRequest) NewResponseBody() *CreditCardRefundGetResponseBody {
+	return &CreditCardRefundGetResponseBody{}
+}
+
+type CreditCardRefundGetResponseBody struct {
+	Links Links `json:"links"`
+	CreditCardRefund
+}
+
+func (r *CreditCardRefundGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardRefund/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardRefundGetRequest) Do(ctx context.Context) (CreditCardRefundGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
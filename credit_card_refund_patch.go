@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardRefundPatchRequest() CreditCardRefundPatchRequest {
+	r := CreditCardRefundPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardRefundPatchRequest struct {
+	client      *Client
+	queryParams *CreditCardRefundPatchRequestQueryParams
+	pathParams  *CreditCardRefundPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardRefundPatchRequestBody
+}
+
+func (r CreditCardRefundPatchRequest) NewQueryParams() *CreditCardRefundPatchRequestQueryParams {
+	return &CreditCardRefundPatchRequestQueryParams{}
+}
+
+type CreditCardRefundPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CreditCardRefundPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardRefundPatchRequest) QueryParams() *CreditCardRefundPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardRefundPatchRequest) NewPathParams() *CreditCardRefundPatchRequestPathParams {
+	return &CreditCardRefundPatchRequestPathParams{}
+}
+
+type CreditCardRefundPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardRefundPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardRefundPatchRequest) PathParams() *CreditCardRefundPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardRefundPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardRefundPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardRefundPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardRefundPatchRequest) NewRequestBody() CreditCardRefundPatchRequestBody {
+	return CreditCardRefundPatchRequestBody{}
+}
+
+type CreditCardRefundPatchRequestBody struct {
+	CreditCardRefund
+}
+
+func (r *CreditCardRefundPatchRequest) RequestBody() *CreditCardRefundPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CreditCardRefundPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CreditCardRefundPatchRequest) SetRequestBody(body CreditCardRefundPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardRefundPatchRequest) NewResponseBody() *CreditCardRefundPatchResponseBody {
+	return &CreditCardRefundPatchResponseBody{}
+}
+
+type CreditCardRefundPatchResponseBody struct {
+}
+
+func (r *CreditCardRefundPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardRefund/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardRefundPatchRequest) Do(ctx context.Context) (CreditCardRefundPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
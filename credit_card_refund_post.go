@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardRefundPostRequest() CreditCardRefundPostRequest {
+	r := CreditCardRefundPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardRefundPostRequest struct {
+	client      *Client
+	queryParams *CreditCardRefundPostRequestQueryParams
+	pathParams  *CreditCardRefundPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardRefundPostRequestBody
+}
+
+func (r CreditCardRefundPostRequest) NewQueryParams() *CreditCardRefundPostRequestQueryParams {
+	return &CreditCardRefundPostRequestQueryParams{}
+}
+
+type CreditCardRefundPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CreditCardRefundPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardRefundPostRequest) QueryParams() *CreditCardRefundPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardRefundPostRequest) NewPathParams() *CreditCardRefundPostRequestPathParams {
+	return &CreditCardRefundPostRequestPathParams{}
+}
+
+type CreditCardRefundPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CreditCardRefundPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CreditCardRefundPostRequest) PathParams() *CreditCardRefundPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardRefundPostRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardRefundPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardRefundPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardRefundPostRequest) NewRequestBody() CreditCardRefundPostRequestBody {
+	return CreditCardRefundPostRequestBody{}
+}
+
+type CreditCardRefundPostRequestBody struct {
+	CreditCardRefund
+}
+
+func (r *CreditCardRefundPostRequest) RequestBody() *CreditCardRefundPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CreditCardRefundPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CreditCardRefundPostRequest) SetRequestBody(body CreditCardRefundPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardRefundPostRequest) NewResponseBody() *CreditCardRefundPostResponseBody {
+	return &CreditCardRefundPostResponseBody{}
+}
+
+type CreditCardRefundPostResponseBody struct {
+}
+
+func (r *CreditCardRefundPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardRefund", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardRefundPostRequest) Do(ctx context.Context) (CreditCardRefundPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
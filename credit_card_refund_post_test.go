@@ -0,0 +1,33 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCreditCardRefundPost(t *testing.T) {
+	req := client.NewCreditCardRefundPostRequest()
+	req.RequestBody().Entity.ID = "1642"
+	req.RequestBody().Account.ID = "340"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Expense = netsuite.CreditCardExpenses{
+		Items: netsuite.CreditCardExpenseItems{
+			{
+				Account: netsuite.Account{ID: "213"},
+				Amount:  netsuite.MustDecimal("49.99"),
+				Memo:    "Software subscription",
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
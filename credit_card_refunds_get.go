@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCreditCardRefundsGetRequest() CreditCardRefundsGetRequest {
+	r := CreditCardRefundsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CreditCardRefundsGetRequest struct {
+	client      *Client
+	queryParams *CreditCardRefundsGetRequestQueryParams
+	pathParams  *CreditCardRefundsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CreditCardRefundsGetRequestBody
+}
+
+func (r CreditCardRefundsGetRequest) NewQueryParams() *CreditCardRefundsGetRequestQueryParams {
+	return &CreditCardRefundsGetRequestQueryParams{}
+}
+
+type CreditCardRefundsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CreditCardRefundsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CreditCardRefundsGetRequest) QueryParams() *CreditCardRefundsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CreditCardRefundsGetRequest) NewPathParams() *CreditCardRefundsGetRequestPathParams {
+	return &CreditCardRefundsGetRequestPathParams{}
+}
+
+type CreditCardRefundsGetRequestPathParams struct {
+}
+
+func (p *CreditCardRefundsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CreditCardRefundsGetRequest) PathParams() *CreditCardRefundsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CreditCardRefundsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CreditCardRefundsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CreditCardRefundsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CreditCardRefundsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CreditCardRefundsGetRequest) NewRequestBody() CreditCardRefundsGetRequestBody {
+	return CreditCardRefundsGetRequestBody{}
+}
+
+type CreditCardRefundsGetRequestBody struct {
+}
+
+func (r *CreditCardRefundsGetRequest) RequestBody() *CreditCardRefundsGetRequestBody {
+	return nil
+}
+
+func (r *CreditCardRefundsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CreditCardRefundsGetRequest) SetRequestBody(body CreditCardRefundsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CreditCardRefundsGetRequest) NewResponseBody() *CreditCardRefundsGetResponseBody {
+	return &CreditCardRefundsGetResponseBody{}
+}
+
+type CreditCardRefundsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CreditCardRefundsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/creditCardRefund", r.PathParams())
+	return &u, err
+}
+
+func (r *CreditCardRefundsGetRequest) Do(ctx context.Context) (CreditCardRefundsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
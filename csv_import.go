@@ -0,0 +1,293 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// RestletBaseURL is the endpoint RESTlets are deployed on.
+var RestletBaseURL = "https://{{.account_id}}.restlets.api.netsuite.com/app/site/hosting/restlet.nl"
+
+const (
+	CSVImportStatusPending    = "PENDING"
+	CSVImportStatusProcessing = "PROCESSING"
+	CSVImportStatusComplete   = "COMPLETE"
+	CSVImportStatusFailed     = "FAILED"
+)
+
+// CSVImport runs saved CSV imports. The REST API can't upload files or start
+// CSV imports, so it talks to a companion RESTlet (see
+// suitescript/csv_import_restlet.js) which understands the actions "upload",
+// "submit", "status" and "file".
+type CSVImport struct {
+	client *Client
+
+	ScriptID string
+	DeployID string
+	// FolderID is the file cabinet folder the CSV files are uploaded to
+	FolderID string
+	// PollInterval is the interval Wait checks the import status with
+	PollInterval time.Duration
+	// BaseURL overrides RestletBaseURL
+	BaseURL string
+}
+
+func (c *Client) NewCSVImport(scriptID, deployID string) *CSVImport {
+	return &CSVImport{
+		client:       c,
+		ScriptID:     scriptID,
+		DeployID:     deployID,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+type CSVImportStatus struct {
+	TaskID           string  `json:"taskId"`
+	Status           string  `json:"status"`
+	PercentCompleted float64 `json:"percentCompleted"`
+	// ResponseFileID is the file cabinet id of the CSV response file, once the
+	// import has completed
+	ResponseFileID string `json:"responseFileId"`
+	// Errors are the rows of the response file that failed to import
+	Errors CSVImportRowErrors `json:"-"`
+}
+
+func (s CSVImportStatus) Done() bool {
+	return s.Status == CSVImportStatusComplete || s.Status == CSVImportStatusFailed
+}
+
+type CSVImportRowErrors []CSVImportRowError
+
+type CSVImportRowError struct {
+	// Row is the (1 based) data row in the uploaded file
+	Row     int
+	Message string
+	// Values of the row by column header
+	Values map[string]string
+}
+
+// Upload stores the CSV in the file cabinet and returns the file id.
+func (i *CSVImport) Upload(ctx context.Context, name string, content io.Reader) (string, error) {
+	b, err := ioutil.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+
+	resp := struct {
+		FileID string `json:"fileId"`
+	}{}
+	err = i.call(ctx, map[string]interface{}{
+		"action":   "upload",
+		"name":     name,
+		"folderId": i.FolderID,
+		"contents": string(b),
+	}, &resp)
+	return resp.FileID, err
+}
+
+// Submit starts the saved CSV import (mappingID is the script id or internal
+// id of the saved import) for the uploaded file and returns the task id.
+func (i *CSVImport) Submit(ctx context.Context, mappingID string, fileID string) (string, error) {
+	resp := struct {
+		TaskID string `json:"taskId"`
+	}{}
+	err := i.call(ctx, map[string]interface{}{
+		"action":    "submit",
+		"mappingId": mappingID,
+		"fileId":    fileID,
+	}, &resp)
+	return resp.TaskID, err
+}
+
+// Status returns the status of the import task.
+func (i *CSVImport) Status(ctx context.Context, taskID string) (CSVImportStatus, error) {
+	status := CSVImportStatus{}
+	err := i.call(ctx, map[string]interface{}{
+		"action": "status",
+		"taskId": taskID,
+	}, &status)
+	return status, err
+}
+
+// Wait polls the import task until it's done and collects the row level
+// errors from the response file.
+func (i *CSVImport) Wait(ctx context.Context, taskID string) (CSVImportStatus, error) {
+	for {
+		status, err := i.Status(ctx, taskID)
+		if err != nil {
+			return status, err
+		}
+
+		if status.Done() {
+			if status.ResponseFileID != "" {
+				status.Errors, err = i.RowErrors(ctx, status.ResponseFileID)
+			}
+			return status, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(i.PollInterval):
+		}
+	}
+}
+
+// Run uploads the CSV, starts the saved import and waits for it to finish.
+func (i *CSVImport) Run(ctx context.Context, mappingID string, name string, content io.Reader) (CSVImportStatus, error) {
+	fileID, err := i.Upload(ctx, name, content)
+	if err != nil {
+		return CSVImportStatus{}, err
+	}
+
+	taskID, err := i.Submit(ctx, mappingID, fileID)
+	if err != nil {
+		return CSVImportStatus{}, err
+	}
+
+	return i.Wait(ctx, taskID)
+}
+
+// RowErrors fetches the CSV response file and returns the rows that failed.
+func (i *CSVImport) RowErrors(ctx context.Context, responseFileID string) (CSVImportRowErrors, error) {
+	resp := struct {
+		Contents string `json:"contents"`
+	}{}
+	err := i.call(ctx, map[string]interface{}{
+		"action": "file",
+		"fileId": responseFileID,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCSVImportResponse(strings.NewReader(resp.Contents))
+}
+
+// ParseCSVImportResponse parses a CSV import response file: the uploaded rows
+// with the import result in the first column and the error message in the
+// second.
+func ParseCSVImportResponse(r io.Reader) (CSVImportRowErrors, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return CSVImportRowErrors{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 {
+		return nil, errors.New("CSV response file should start with a result and a message column")
+	}
+
+	rowErrors := CSVImportRowErrors{}
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return rowErrors, err
+		}
+		if len(record) < 2 || strings.EqualFold(record[0], "success") {
+			continue
+		}
+
+		values := map[string]string{}
+		for j := 2; j < len(record) && j < len(header); j++ {
+			values[header[j]] = record[j]
+		}
+
+		rowErrors = append(rowErrors, CSVImportRowError{
+			Row:     row,
+			Message: record[1],
+			Values:  values,
+		})
+	}
+
+	return rowErrors, nil
+}
+
+func (i *CSVImport) url() (*url.URL, error) {
+	baseURL := i.BaseURL
+	if baseURL == "" {
+		baseURL = RestletBaseURL
+	}
+
+	tmpl, err := template.New("restlet").Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, map[string]interface{}{"account_id": strings.ToLower(strings.Replace(i.client.CompanyID(), "_", "-", -1))})
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(buf.String())
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("script", i.ScriptID)
+	q.Set("deploy", i.DeployID)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+func (i *CSVImport) call(ctx context.Context, body interface{}, v interface{}) error {
+	u, err := i.url()
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", i.client.UserAgent())
+
+	// RESTlets answer with plain application/json, also on errors
+	resp := struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	raw := json.RawMessage{}
+	_, err = i.client.Do(req, &raw)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(raw, &resp)
+	if err == nil && resp.Error != nil {
+		return fmt.Errorf("%s: %s", resp.Error.Code, resp.Error.Message)
+	}
+
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,87 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestCSVImportRun(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("script") != "customscript_csv_import" || r.URL.Query().Get("deploy") != "1" {
+			t.Errorf("unexpected script/deploy: %s", r.URL.RawQuery)
+		}
+
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body["action"] {
+		case "upload":
+			if body["folderId"] != "12" || body["name"] != "terms.csv" {
+				t.Errorf("unexpected upload: %v", body)
+			}
+			w.Write([]byte(`{"fileId":"100"}`))
+		case "submit":
+			if body["mappingId"] != "custimport_terms" || body["fileId"] != "100" {
+				t.Errorf("unexpected submit: %v", body)
+			}
+			w.Write([]byte(`{"taskId":"CSVIMPORT_1"}`))
+		case "status":
+			polls++
+			if polls < 2 {
+				w.Write([]byte(`{"taskId":"CSVIMPORT_1","status":"PROCESSING","percentCompleted":50}`))
+				return
+			}
+			w.Write([]byte(`{"taskId":"CSVIMPORT_1","status":"COMPLETE","percentCompleted":100,"responseFileId":"101"}`))
+		case "file":
+			b, _ := json.Marshal(map[string]string{
+				"contents": "Result,Message,Name,Days\nSuccess,,Net 14,14\nFailed,Invalid days reference key x,Net X,x\n",
+			})
+			w.Write(b)
+		default:
+			w.Write([]byte(`{"error":{"code":"INVALID_ACTION","message":"unknown action"}}`))
+		}
+	}))
+	defer server.Close()
+
+	csvImport := netsuite.NewClient().NewCSVImport("customscript_csv_import", "1")
+	csvImport.BaseURL = server.URL
+	csvImport.FolderID = "12"
+	csvImport.PollInterval = time.Millisecond
+
+	status, err := csvImport.Run(context.Background(), "custimport_terms", "terms.csv", strings.NewReader("Name,Days\nNet 14,14\nNet X,x\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status.Status != netsuite.CSVImportStatusComplete || polls != 2 {
+		t.Errorf("expected complete import after 2 polls, got %+v (%d polls)", status, polls)
+	}
+	if len(status.Errors) != 1 || status.Errors[0].Row != 2 || status.Errors[0].Values["Name"] != "Net X" {
+		t.Errorf("expected error on row 2, got %+v", status.Errors)
+	}
+}
+
+func TestCSVImportRestletError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":{"code":"SSS_MISSING_REQD_ARGUMENT","message":"mappingId is required"}}`))
+	}))
+	defer server.Close()
+
+	csvImport := netsuite.NewClient().NewCSVImport("customscript_csv_import", "1")
+	csvImport.BaseURL = server.URL
+
+	_, err := csvImport.Submit(context.Background(), "", "100")
+	if err == nil || !strings.Contains(err.Error(), "SSS_MISSING_REQD_ARGUMENT") {
+		t.Errorf("expected RESTlet error, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCurrenciesGetRequest() CurrenciesGetRequest {
+	r := CurrenciesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CurrenciesGetRequest struct {
+	client      *Client
+	queryParams *CurrenciesGetRequestQueryParams
+	pathParams  *CurrenciesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CurrenciesGetRequestBody
+}
+
+func (r CurrenciesGetRequest) NewQueryParams() *CurrenciesGetRequestQueryParams {
+	return &CurrenciesGetRequestQueryParams{}
+}
+
+type CurrenciesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CurrenciesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CurrenciesGetRequest) QueryParams() *CurrenciesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CurrenciesGetRequest) NewPathParams() *CurrenciesGetRequestPathParams {
+	return &CurrenciesGetRequestPathParams{}
+}
+
+type CurrenciesGetRequestPathParams struct {
+}
+
+func (p *CurrenciesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *CurrenciesGetRequest) PathParams() *CurrenciesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CurrenciesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CurrenciesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CurrenciesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CurrenciesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CurrenciesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CurrenciesGetRequest) NewRequestBody() CurrenciesGetRequestBody {
+	return CurrenciesGetRequestBody{}
+}
+
+type CurrenciesGetRequestBody struct {
+}
+
+func (r *CurrenciesGetRequest) RequestBody() *CurrenciesGetRequestBody {
+	return nil
+}
+
+func (r *CurrenciesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CurrenciesGetRequest) SetRequestBody(body CurrenciesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CurrenciesGetRequest) NewResponseBody() *CurrenciesGetResponseBody {
+	return &CurrenciesGetResponseBody{}
+}
+
+type CurrenciesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CurrenciesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/currency", r.PathParams())
+	return &u, err
+}
+
+func (r *CurrenciesGetRequest) Do(ctx context.Context) (CurrenciesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
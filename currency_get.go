@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCurrencyGetRequest() CurrencyGetRequest {
+	r := CurrencyGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CurrencyGetRequest struct {
+	client      *Client
+	queryParams *CurrencyGetRequestQueryParams
+	pathParams  *CurrencyGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CurrencyGetRequestBody
+}
+
+func (r CurrencyGetRequest) NewQueryParams() *CurrencyGetRequestQueryParams {
+	return &CurrencyGetRequestQueryParams{}
+}
+
+type CurrencyGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CurrencyGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CurrencyGetRequest) QueryParams() *CurrencyGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CurrencyGetRequest) NewPathParams() *CurrencyGetRequestPathParams {
+	return &CurrencyGetRequestPathParams{}
+}
+
+type CurrencyGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CurrencyGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CurrencyGetRequest) PathParams() *CurrencyGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CurrencyGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CurrencyGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CurrencyGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CurrencyGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CurrencyGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CurrencyGetRequest) NewRequestBody() CurrencyGetRequestBody {
+	return CurrencyGetRequestBody{}
+}
+
+type CurrencyGetRequestBody struct {
+}
+
+func (r *CurrencyGetRequest) RequestBody() *CurrencyGetRequestBody {
+	return nil
+}
+
+func (r *CurrencyGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CurrencyGetRequest) SetRequestBody(body CurrencyGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CurrencyGetRequest) NewResponseBody() *CurrencyGetResponseBody {
+	return &CurrencyGetResponseBody{}
+}
+
+type CurrencyGetResponseBody struct {
+	Links Links `json:"links"`
+	CurrencyRecord
+}
+
+func (r *CurrencyGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/currency/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CurrencyGetRequest) Do(ctx context.Context) (CurrencyGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
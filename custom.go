@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -83,6 +84,14 @@ func (r *CustomRequest) Method() string {
 	return r.method
 }
 
+func (r *CustomRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r CustomRequest) NewRequestBody() CustomRequestBody {
 	return struct{}{}
 }
@@ -113,9 +122,9 @@ func (r *CustomRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *CustomRequest) Do() (CustomResponseBody, error) {
+func (r *CustomRequest) Do(ctx context.Context) (CustomResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -0,0 +1,240 @@
+package netsuite
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// customFieldPrefixes are the script id prefixes NetSuite uses for custom
+// body, column, entity, event, item and record fields.
+var customFieldPrefixes = []string{
+	"custbody",
+	"custcol",
+	"custentity",
+	"custevent",
+	"custitem",
+	"custrecord",
+}
+
+func isCustomField(name string) bool {
+	for _, p := range customFieldPrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomFields holds the custom fields of a record that aren't mapped on the
+// model itself. They're captured on unmarshal and written back on marshal so
+// they survive a get/patch round trip. Unknown properties that aren't custom
+// fields fail the decoding when the client disallows unknown fields, like
+// they do for any other model.
+type CustomFields map[string]json.RawMessage
+
+func (cf CustomFields) IsEmpty() bool {
+	return len(cf) == 0
+}
+
+// Has reports whether the custom field with the given script id is present.
+func (cf CustomFields) Has(id string) bool {
+	_, ok := cf[id]
+	return ok
+}
+
+func (cf CustomFields) get(id string, v interface{}) bool {
+	raw, ok := cf[id]
+	if !ok || string(raw) == "null" {
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
+
+func (cf CustomFields) String(id string) (string, bool) {
+	var s string
+	ok := cf.get(id, &s)
+	return s, ok
+}
+
+func (cf CustomFields) Bool(id string) (bool, bool) {
+	var b Bool
+	ok := cf.get(id, &b)
+	return bool(b), ok
+}
+
+func (cf CustomFields) Float(id string) (float64, bool) {
+	var f float64
+	ok := cf.get(id, &f)
+	return f, ok
+}
+
+// RecordRef returns the value of a list/record custom field.
+func (cf CustomFields) RecordRef(id string) (RecordRef, bool) {
+	var ref RecordRef
+	ok := cf.get(id, &ref)
+	return ref, ok
+}
+
+// MultiSelect returns the values of a multiple select custom field. Both the
+// expanded ({"items": [...]}) and the plain array form are accepted.
+func (cf CustomFields) MultiSelect(id string) ([]RecordRef, bool) {
+	raw, ok := cf[id]
+	if !ok {
+		return nil, false
+	}
+
+	refs := []RecordRef{}
+	if err := json.Unmarshal(raw, &refs); err == nil {
+		return refs, true
+	}
+
+	wrapper := struct {
+		Items []RecordRef `json:"items"`
+	}{}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return nil, false
+	}
+	return wrapper.Items, true
+}
+
+func (cf *CustomFields) set(id string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	if *cf == nil {
+		*cf = CustomFields{}
+	}
+	(*cf)[id] = b
+	return nil
+}
+
+func (cf *CustomFields) SetString(id string, s string) error {
+	return cf.set(id, s)
+}
+
+func (cf *CustomFields) SetBool(id string, b bool) error {
+	return cf.set(id, b)
+}
+
+func (cf *CustomFields) SetFloat(id string, f float64) error {
+	return cf.set(id, f)
+}
+
+// SetRecordRef sets a list/record custom field to the record with the given
+// internal id.
+func (cf *CustomFields) SetRecordRef(id string, refID string) error {
+	return cf.set(id, struct {
+		ID string `json:"id"`
+	}{ID: refID})
+}
+
+// SetMultiSelect sets a multiple select custom field to the records with the
+// given internal ids.
+func (cf *CustomFields) SetMultiSelect(id string, refIDs ...string) error {
+	type ref struct {
+		ID string `json:"id"`
+	}
+
+	items := []ref{}
+	for _, i := range refIDs {
+		items = append(items, ref{ID: i})
+	}
+
+	return cf.set(id, struct {
+		Items []ref `json:"items"`
+	}{Items: items})
+}
+
+// SetNull clears the custom field on NetSuite's side.
+func (cf *CustomFields) SetNull(id string) {
+	if *cf == nil {
+		*cf = CustomFields{}
+	}
+	(*cf)[id] = json.RawMessage("null")
+}
+
+func (cf CustomFields) Delete(id string) {
+	delete(cf, id)
+}
+
+// unmarshalWithCustomFields decodes data into v (a pointer to a struct) and
+// returns the custom fields in data that aren't mapped on the struct. The
+// custom fields are stripped before the other fields are decoded. A
+// json.Unmarshaler can't see the settings of the decoder calling it, so the
+// client checks for unknown fields after decoding when it disallows them (see
+// checkUnknownFields).
+func unmarshalWithCustomFields(data []byte, v interface{}) (CustomFields, error) {
+	props := map[string]json.RawMessage{}
+	err := json.Unmarshal(data, &props)
+	if err != nil {
+		return nil, err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	cf := CustomFields{}
+	for k, raw := range props {
+		if !isCustomField(k) || known[k] {
+			continue
+		}
+		cf[k] = raw
+		delete(props, k)
+	}
+
+	if len(cf) > 0 {
+		data, err = json.Marshal(props)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	err = dec.Decode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cf) == 0 {
+		return nil, nil
+	}
+	return cf, nil
+}
+
+// marshalWithCustomFields adds the custom fields to the marshaled JSON object
+// in b.
+func marshalWithCustomFields(b []byte, cf CustomFields) ([]byte, error) {
+	if len(cf) == 0 {
+		return b, nil
+	}
+
+	props := map[string]json.RawMessage{}
+	err := json.Unmarshal(b, &props)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, raw := range cf {
+		props[k] = raw
+	}
+
+	return json.Marshal(props)
+}
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names[name] = true
+	}
+	return names
+}
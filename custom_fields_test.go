@@ -0,0 +1,72 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestCustomFields(t *testing.T) {
+	customer := netsuite.Customer{}
+	err := json.Unmarshal([]byte(`{"id":"70202","custentity_nch_customer_number":"C1","custentity_tier":{"id":"3","refName":"Gold"},"custentity_tags":{"items":[{"id":"1"},{"id":"2"}]}}`), &customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if customer.CustomFields.Has("custentity_nch_customer_number") {
+		t.Error("expected mapped custom field not to be captured")
+	}
+
+	tier, ok := customer.CustomFields.RecordRef("custentity_tier")
+	if !ok || tier.ID != "3" {
+		t.Errorf("expected tier 3, got %v", tier)
+	}
+
+	tags, ok := customer.CustomFields.MultiSelect("custentity_tags")
+	if !ok || len(tags) != 2 {
+		t.Errorf("expected 2 tags, got %v", tags)
+	}
+
+	je := netsuite.JournalEntry{Memo: "test"}
+	je.CustomFields.SetBool("custbody_cash_register", true)
+	b, err := json.Marshal(je)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	props := map[string]interface{}{}
+	json.Unmarshal(b, &props)
+	if props["custbody_cash_register"] != true {
+		t.Errorf("expected custom field to be marshaled, got %s", string(b))
+	}
+}
+
+func TestCustomFieldsDisallowUnknownFields(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Acme", "custentity_region": "EU"})
+	srv.AddRecord("customer", "2", map[string]interface{}{"companyName": "Acme", "custentity_region": "EU", "bogusField": true})
+
+	c := srv.Client()
+	c.SetDisallowUnknownFields(true)
+
+	req := c.NewCustomerGetRequest()
+	req.PathParams().ID = 1
+	customer, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if region, _ := customer.CustomFields.String("custentity_region"); region != "EU" {
+		t.Errorf("expected region EU, got %q", region)
+	}
+
+	req.PathParams().ID = 2
+	_, err = req.Do(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "bogusField") {
+		t.Errorf("expected the unknown field to fail the decoding, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package netsuite
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// NewCustomListValuesRequest returns a SuiteQL request that selects the values
+// of a custom list (customlist_*). Decode the response with
+// ToCustomListValues.
+func (c *Client) NewCustomListValuesRequest(listID string) SuiteqlPostRequest {
+	r := c.NewSuiteqlPostRequest()
+	r.RequestBody().Q = fmt.Sprintf("SELECT id, name, scriptid, isinactive FROM %s ORDER BY id", listID)
+	return r
+}
+
+func (r *SuiteqlPostResponseBody) ToCustomListValues(client *Client) (CustomListValues, error) {
+	items := CustomListValues{}
+
+	reader := bytes.NewReader(r.Items)
+	dec := json.NewDecoder(reader)
+	if client.DisallowUnknownFields() {
+		dec.DisallowUnknownFields()
+	}
+	err := dec.Decode(&items)
+	return items, err
+}
+
+type CustomListValues []CustomListValue
+
+// Label returns the text behind the internal id of a custom list value, as
+// it is stored in custom select fields.
+func (vv CustomListValues) Label(id string) (string, bool) {
+	for _, v := range vv {
+		if v.ID == id {
+			return v.Name, true
+		}
+	}
+	return "", false
+}
+
+// ID returns the internal id of the custom list value with the given text.
+func (vv CustomListValues) ID(name string) (string, bool) {
+	for _, v := range vv {
+		if v.Name == name {
+			return v.ID, true
+		}
+	}
+	return "", false
+}
+
+type CustomListValue struct {
+	Links      Links  `json:"links"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ScriptID   string `json:"scriptid"`
+	IsInactive Bool   `json:"isinactive"`
+}
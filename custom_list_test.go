@@ -0,0 +1,47 @@
+package netsuite_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestCustomListValues(t *testing.T) {
+	req := client.NewCustomListValuesRequest("customlist_nch_invoice_type")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	values, err := resp.ToCustomListValues(client)
+	if err != nil {
+		t.Error(err)
+	}
+
+	log.Println(values.Label("1"))
+}
+
+func TestCustomListValuesInactive(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("FROM customlist_nch_invoice_type",
+		map[string]interface{}{"id": "1", "name": "Regular", "scriptid": "VAL_1", "isinactive": "F"},
+		map[string]interface{}{"id": "2", "name": "Legacy", "scriptid": "VAL_2", "isinactive": "T"},
+	)
+
+	c := srv.Client()
+	req := c.NewCustomListValuesRequest("customlist_nch_invoice_type")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := resp.ToCustomListValues(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0].IsInactive || !values[1].IsInactive {
+		t.Errorf("unexpected values %+v", values)
+	}
+}
@@ -0,0 +1,114 @@
+package netsuite
+
+import "context"
+
+// CustomRecordService creates requests for a single custom record type so the
+// record type doesn't have to be set on every request.
+//
+//	fees := client.CustomRecord("customrecord_nch_invoice_fee")
+//	req := fees.NewGetRequest()
+//	req.PathParams().ID = 12
+type CustomRecordService struct {
+	client *Client
+	typeID string
+}
+
+func (c *Client) CustomRecord(typeID string) CustomRecordService {
+	return CustomRecordService{
+		client: c,
+		typeID: typeID,
+	}
+}
+
+func (s CustomRecordService) TypeID() string {
+	return s.typeID
+}
+
+func (s CustomRecordService) NewGetRequest() CustomRecordGetRequest {
+	r := s.client.NewCustomRecordGetRequest()
+	r.PathParams().RecordType = s.typeID
+	return r
+}
+
+func (s CustomRecordService) NewListRequest() CustomRecordsGetRequest {
+	r := s.client.NewCustomRecordsGetRequest()
+	r.PathParams().RecordType = s.typeID
+	return r
+}
+
+// NewSearchRequest returns a list request filtered with a REST query, e.g.
+// `name START_WITH "Invoice"`.
+func (s CustomRecordService) NewSearchRequest(q string) CustomRecordsGetRequest {
+	r := s.NewListRequest()
+	r.QueryParams().Q = q
+	return r
+}
+
+func (s CustomRecordService) NewPostRequest() CustomRecordPostRequest {
+	r := s.client.NewCustomRecordPostRequest()
+	r.PathParams().RecordType = s.typeID
+	return r
+}
+
+func (s CustomRecordService) NewPatchRequest() CustomRecordPatchRequest {
+	r := s.client.NewCustomRecordPatchRequest()
+	r.PathParams().RecordType = s.typeID
+	return r
+}
+
+func (s CustomRecordService) NewDeleteRequest() CustomRecordDeleteRequest {
+	r := s.client.NewCustomRecordDeleteRequest()
+	r.PathParams().RecordType = s.typeID
+	return r
+}
+
+// CustomRecordTypeService is implemented by CustomRecordService, see API for
+// the other record types.
+type CustomRecordTypeService interface {
+	Get(ctx context.Context, id int, params *CustomRecordGetRequestQueryParams) (CustomRecordGetResponseBody, error)
+	List(ctx context.Context, params *CustomRecordsGetRequestQueryParams) (CustomRecordsGetResponseBody, error)
+	Create(ctx context.Context, body CustomRecordPostRequestBody) (CustomRecordPostResponseBody, error)
+	Update(ctx context.Context, id int, body CustomRecordPatchRequestBody, params *CustomRecordPatchRequestQueryParams) (CustomRecordPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CustomRecordDeleteResponseBody, error)
+}
+
+var _ CustomRecordTypeService = CustomRecordService{}
+
+func (s CustomRecordService) Get(ctx context.Context, id int, params *CustomRecordGetRequestQueryParams) (CustomRecordGetResponseBody, error) {
+	req := s.NewGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s CustomRecordService) List(ctx context.Context, params *CustomRecordsGetRequestQueryParams) (CustomRecordsGetResponseBody, error) {
+	req := s.NewListRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s CustomRecordService) Create(ctx context.Context, body CustomRecordPostRequestBody) (CustomRecordPostResponseBody, error) {
+	req := s.NewPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s CustomRecordService) Update(ctx context.Context, id int, body CustomRecordPatchRequestBody, params *CustomRecordPatchRequestQueryParams) (CustomRecordPatchResponseBody, error) {
+	req := s.NewPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s CustomRecordService) Delete(ctx context.Context, id int) (CustomRecordDeleteResponseBody, error) {
+	req := s.NewDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
@@ -0,0 +1,146 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomRecordDeleteRequest() CustomRecordDeleteRequest {
+	r := CustomRecordDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomRecordDeleteRequest struct {
+	client      *Client
+	queryParams *CustomRecordDeleteRequestQueryParams
+	pathParams  *CustomRecordDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomRecordDeleteRequestBody
+}
+
+func (r CustomRecordDeleteRequest) NewQueryParams() *CustomRecordDeleteRequestQueryParams {
+	return &CustomRecordDeleteRequestQueryParams{}
+}
+
+type CustomRecordDeleteRequestQueryParams struct {
+}
+
+func (p CustomRecordDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomRecordDeleteRequest) QueryParams() *CustomRecordDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomRecordDeleteRequest) NewPathParams() *CustomRecordDeleteRequestPathParams {
+	return &CustomRecordDeleteRequestPathParams{}
+}
+
+type CustomRecordDeleteRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+	ID         int    `schema:"id"`
+}
+
+func (p *CustomRecordDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"id":          strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CustomRecordDeleteRequest) PathParams() *CustomRecordDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomRecordDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomRecordDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRecordDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomRecordDeleteRequest) NewRequestBody() CustomRecordDeleteRequestBody {
+	return CustomRecordDeleteRequestBody{}
+}
+
+type CustomRecordDeleteRequestBody struct {
+}
+
+func (r *CustomRecordDeleteRequest) RequestBody() *CustomRecordDeleteRequestBody {
+	return nil
+}
+
+func (r *CustomRecordDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CustomRecordDeleteRequest) SetRequestBody(body CustomRecordDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomRecordDeleteRequest) NewResponseBody() *CustomRecordDeleteResponseBody {
+	return &CustomRecordDeleteResponseBody{}
+}
+
+type CustomRecordDeleteResponseBody struct {
+}
+
+func (r *CustomRecordDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomRecordDeleteRequest) Do(ctx context.Context) (CustomRecordDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomRecordDelete(t *testing.T) {
+	req := client.NewCustomRecordDeleteRequest()
+	req.PathParams().RecordType = "customrecord_nch_invoice_fee"
+	req.PathParams().ID = 1
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,150 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomRecordGetRequest() CustomRecordGetRequest {
+	r := CustomRecordGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomRecordGetRequest struct {
+	client      *Client
+	queryParams *CustomRecordGetRequestQueryParams
+	pathParams  *CustomRecordGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomRecordGetRequestBody
+}
+
+func (r CustomRecordGetRequest) NewQueryParams() *CustomRecordGetRequestQueryParams {
+	return &CustomRecordGetRequestQueryParams{}
+}
+
+type CustomRecordGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CustomRecordGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomRecordGetRequest) QueryParams() *CustomRecordGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomRecordGetRequest) NewPathParams() *CustomRecordGetRequestPathParams {
+	return &CustomRecordGetRequestPathParams{}
+}
+
+type CustomRecordGetRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+	ID         int    `schema:"id"`
+}
+
+func (p *CustomRecordGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"id":          strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CustomRecordGetRequest) PathParams() *CustomRecordGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomRecordGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomRecordGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRecordGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomRecordGetRequest) NewRequestBody() CustomRecordGetRequestBody {
+	return CustomRecordGetRequestBody{}
+}
+
+type CustomRecordGetRequestBody struct {
+}
+
+func (r *CustomRecordGetRequest) RequestBody() *CustomRecordGetRequestBody {
+	return nil
+}
+
+func (r *CustomRecordGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CustomRecordGetRequest) SetRequestBody(body CustomRecordGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomRecordGetRequest) NewResponseBody() *CustomRecordGetResponseBody {
+	return &CustomRecordGetResponseBody{}
+}
+
+type CustomRecordGetResponseBody struct {
+	CustomRecord
+}
+
+func (r *CustomRecordGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomRecordGetRequest) Do(ctx context.Context) (CustomRecordGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
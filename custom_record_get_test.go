@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomRecordGet(t *testing.T) {
+	req := client.NewCustomRecordGetRequest()
+	req.PathParams().RecordType = "customrecord_nch_invoice_fee"
+	req.PathParams().ID = 1
+	// req.QueryParams().Fields = netsuite.Fields{"line"}
+	req.QueryParams().ExpandSubResources = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
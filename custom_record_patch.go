@@ -0,0 +1,150 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomRecordPatchRequest() CustomRecordPatchRequest {
+	r := CustomRecordPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomRecordPatchRequest struct {
+	client      *Client
+	queryParams *CustomRecordPatchRequestQueryParams
+	pathParams  *CustomRecordPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomRecordPatchRequestBody
+}
+
+func (r CustomRecordPatchRequest) NewQueryParams() *CustomRecordPatchRequestQueryParams {
+	return &CustomRecordPatchRequestQueryParams{}
+}
+
+type CustomRecordPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CustomRecordPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomRecordPatchRequest) QueryParams() *CustomRecordPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomRecordPatchRequest) NewPathParams() *CustomRecordPatchRequestPathParams {
+	return &CustomRecordPatchRequestPathParams{}
+}
+
+type CustomRecordPatchRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+	ID         int    `schema:"id"`
+}
+
+func (p *CustomRecordPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"id":          strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CustomRecordPatchRequest) PathParams() *CustomRecordPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomRecordPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomRecordPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRecordPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomRecordPatchRequest) NewRequestBody() CustomRecordPatchRequestBody {
+	return CustomRecordPatchRequestBody{}
+}
+
+type CustomRecordPatchRequestBody struct {
+	CustomRecord
+}
+
+func (r *CustomRecordPatchRequest) RequestBody() *CustomRecordPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CustomRecordPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CustomRecordPatchRequest) SetRequestBody(body CustomRecordPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomRecordPatchRequest) NewResponseBody() *CustomRecordPatchResponseBody {
+	return &CustomRecordPatchResponseBody{}
+}
+
+type CustomRecordPatchResponseBody struct {
+}
+
+func (r *CustomRecordPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomRecordPatchRequest) Do(ctx context.Context) (CustomRecordPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
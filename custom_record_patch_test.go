@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomRecordPatch(t *testing.T) {
+	req := client.NewCustomRecordPatchRequest()
+	req.PathParams().RecordType = "customrecord_nch_invoice_fee"
+	req.PathParams().ID = 1
+	req.RequestBody().Set("name", "Omniboost")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
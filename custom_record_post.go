@@ -0,0 +1,150 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomRecordPostRequest() CustomRecordPostRequest {
+	r := CustomRecordPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomRecordPostRequest struct {
+	client      *Client
+	queryParams *CustomRecordPostRequestQueryParams
+	pathParams  *CustomRecordPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomRecordPostRequestBody
+}
+
+func (r CustomRecordPostRequest) NewQueryParams() *CustomRecordPostRequestQueryParams {
+	return &CustomRecordPostRequestQueryParams{}
+}
+
+type CustomRecordPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p CustomRecordPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomRecordPostRequest) QueryParams() *CustomRecordPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomRecordPostRequest) NewPathParams() *CustomRecordPostRequestPathParams {
+	return &CustomRecordPostRequestPathParams{}
+}
+
+type CustomRecordPostRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+	ID         int    `schema:"id"`
+}
+
+func (p *CustomRecordPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"id":          strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CustomRecordPostRequest) PathParams() *CustomRecordPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomRecordPostRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomRecordPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRecordPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomRecordPostRequest) NewRequestBody() CustomRecordPostRequestBody {
+	return CustomRecordPostRequestBody{}
+}
+
+type CustomRecordPostRequestBody struct {
+	CustomRecord
+}
+
+func (r *CustomRecordPostRequest) RequestBody() *CustomRecordPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *CustomRecordPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CustomRecordPostRequest) SetRequestBody(body CustomRecordPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomRecordPostRequest) NewResponseBody() *CustomRecordPostResponseBody {
+	return &CustomRecordPostResponseBody{}
+}
+
+type CustomRecordPostResponseBody struct {
+}
+
+func (r *CustomRecordPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomRecordPostRequest) Do(ctx context.Context) (CustomRecordPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomRecordPost(t *testing.T) {
+	req := client.NewCustomRecordPostRequest()
+	req.PathParams().RecordType = "customrecord_nch_invoice_fee"
+	req.RequestBody().Set("name", "Omniboost")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
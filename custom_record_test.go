@@ -0,0 +1,19 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestCustomRecordSearch(t *testing.T) {
+	req := client.CustomRecord("customrecord_nch_invoice_fee").NewSearchRequest(`name START_WITH "Invoice"`)
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,158 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomRecordsGetRequest() CustomRecordsGetRequest {
+	r := CustomRecordsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomRecordsGetRequest struct {
+	client      *Client
+	queryParams *CustomRecordsGetRequestQueryParams
+	pathParams  *CustomRecordsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomRecordsGetRequestBody
+}
+
+func (r CustomRecordsGetRequest) NewQueryParams() *CustomRecordsGetRequestQueryParams {
+	return &CustomRecordsGetRequestQueryParams{}
+}
+
+type CustomRecordsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p CustomRecordsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomRecordsGetRequest) QueryParams() *CustomRecordsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomRecordsGetRequest) NewPathParams() *CustomRecordsGetRequestPathParams {
+	return &CustomRecordsGetRequestPathParams{}
+}
+
+type CustomRecordsGetRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+}
+
+func (p *CustomRecordsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+	}
+}
+
+func (r *CustomRecordsGetRequest) PathParams() *CustomRecordsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomRecordsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomRecordsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomRecordsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomRecordsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomRecordsGetRequest) NewRequestBody() CustomRecordsGetRequestBody {
+	return CustomRecordsGetRequestBody{}
+}
+
+type CustomRecordsGetRequestBody struct {
+}
+
+func (r *CustomRecordsGetRequest) RequestBody() *CustomRecordsGetRequestBody {
+	return nil
+}
+
+func (r *CustomRecordsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *CustomRecordsGetRequest) SetRequestBody(body CustomRecordsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomRecordsGetRequest) NewResponseBody() *CustomRecordsGetResponseBody {
+	return &CustomRecordsGetResponseBody{}
+}
+
+type CustomRecordsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *CustomRecordsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomRecordsGetRequest) Do(ctx context.Context) (CustomRecordsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
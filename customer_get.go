@@ -1,6 +1,8 @@
 package netsuite
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +90,14 @@ func (r *CustomerGetRequest) Method() string {
 	return r.method
 }
 
+func (r *CustomerGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomerGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r CustomerGetRequest) NewRequestBody() CustomerGetRequestBody {
 	return CustomerGetRequestBody{}
 }
@@ -116,14 +126,29 @@ type CustomerGetResponseBody struct {
 	Customer
 }
 
+// UnmarshalJSON decodes the links next to the embedded Customer, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *CustomerGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.Customer)
+}
+
 func (r *CustomerGetRequest) URL() (*url.URL, error) {
 	u, err := r.client.GetEndpointURL("/record/v1/customer/{{.id}}", r.PathParams())
 	return &u, err
 }
 
-func (r *CustomerGetRequest) Do() (CustomerGetResponseBody, error) {
+func (r *CustomerGetRequest) Do(ctx context.Context) (CustomerGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
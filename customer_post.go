@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +89,14 @@ func (r *CustomerPostRequest) Method() string {
 	return r.method
 }
 
+func (r *CustomerPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomerPostRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r CustomerPostRequest) NewRequestBody() CustomerPostRequestBody {
 	return CustomerPostRequestBody{}
 }
@@ -120,9 +129,9 @@ func (r *CustomerPostRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *CustomerPostRequest) Do() (CustomerPostResponseBody, error) {
+func (r *CustomerPostRequest) Do(ctx context.Context) (CustomerPostResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
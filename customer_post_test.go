@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -14,7 +15,7 @@ func TestCustomerPost(t *testing.T) {
 	req.RequestBody().LastName = "Zorge"
 	req.RequestBody().Email = "kees@Omniboost.io"
 	req.RequestBody().Phone = "1335132342"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
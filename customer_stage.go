@@ -0,0 +1,33 @@
+package netsuite
+
+// CustomerStage returns the stage of the customer record or an empty stage
+// when it wasn't returned by NetSuite.
+func (c Customer) CustomerStage() CustomerStage {
+	if c.Stage == nil {
+		return ""
+	}
+	return CustomerStage(c.Stage.ID)
+}
+
+func (c Customer) IsLead() bool {
+	return c.CustomerStage() == CustomerStageLead
+}
+
+func (c Customer) IsProspect() bool {
+	return c.CustomerStage() == CustomerStageProspect
+}
+
+func (c Customer) IsCustomer() bool {
+	return c.CustomerStage() == CustomerStageCustomer
+}
+
+// NewCustomerConvertRequest returns a PATCH request that moves the lead or
+// prospect with the given internal id to the stage belonging to entityStatusID.
+// Use a status of the CUSTOMER stage (e.g. "13", Closed Won) to convert a lead
+// to a customer.
+func (c *Client) NewCustomerConvertRequest(id int, entityStatusID string) CustomerStatusPatchRequest {
+	req := c.NewCustomerStatusPatchRequest()
+	req.PathParams().ID = id
+	req.RequestBody().EntityStatus = NewRecordRef(entityStatusID)
+	return req
+}
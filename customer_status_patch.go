@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewCustomerStatusPatchRequest() CustomerStatusPatchRequest {
+	r := CustomerStatusPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type CustomerStatusPatchRequest struct {
+	client      *Client
+	queryParams *CustomerStatusPatchRequestQueryParams
+	pathParams  *CustomerStatusPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody CustomerStatusPatchRequestBody
+}
+
+func (r CustomerStatusPatchRequest) NewQueryParams() *CustomerStatusPatchRequestQueryParams {
+	return &CustomerStatusPatchRequestQueryParams{}
+}
+
+type CustomerStatusPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p CustomerStatusPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *CustomerStatusPatchRequest) QueryParams() *CustomerStatusPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r CustomerStatusPatchRequest) NewPathParams() *CustomerStatusPatchRequestPathParams {
+	return &CustomerStatusPatchRequestPathParams{}
+}
+
+type CustomerStatusPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *CustomerStatusPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *CustomerStatusPatchRequest) PathParams() *CustomerStatusPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *CustomerStatusPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *CustomerStatusPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *CustomerStatusPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *CustomerStatusPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomerStatusPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r CustomerStatusPatchRequest) NewRequestBody() CustomerStatusPatchRequestBody {
+	return CustomerStatusPatchRequestBody{}
+}
+
+type CustomerStatusPatchRequestBody struct {
+	CustomerStatus
+}
+
+func (r *CustomerStatusPatchRequest) RequestBody() *CustomerStatusPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *CustomerStatusPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *CustomerStatusPatchRequest) SetRequestBody(body CustomerStatusPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *CustomerStatusPatchRequest) NewResponseBody() *CustomerStatusPatchResponseBody {
+	return &CustomerStatusPatchResponseBody{}
+}
+
+type CustomerStatusPatchResponseBody struct {
+}
+
+func (r *CustomerStatusPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/customer/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *CustomerStatusPatchRequest) Do(ctx context.Context) (CustomerStatusPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
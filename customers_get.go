@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *CustomersGetRequest) Method() string {
 	return r.method
 }
 
+func (r *CustomersGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *CustomersGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r CustomersGetRequest) NewRequestBody() CustomersGetRequestBody {
 	return CustomersGetRequestBody{}
 }
@@ -127,9 +136,9 @@ func (r *CustomersGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *CustomersGetRequest) Do() (CustomersGetResponseBody, error) {
+func (r *CustomersGetRequest) Do(ctx context.Context) (CustomersGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
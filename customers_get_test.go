@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -11,7 +12,7 @@ func TestCustomersGet(t *testing.T) {
 	// req.QueryParams().Q = "id BETWEEN_NOT [1, 42]"
 	// req.QueryParams().Q = "email START_WITH kees@omniboost"
 	req.QueryParams().Q = "subsidiary = 46"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
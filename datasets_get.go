@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *DataSetsGetRequest) Method() string {
 	return r.method
 }
 
+func (r *DataSetsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DataSetsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r DataSetsGetRequest) NewRequestBody() DataSetsGetRequestBody {
 	return DataSetsGetRequestBody{}
 }
@@ -115,9 +124,9 @@ func (r *DataSetsGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *DataSetsGetRequest) Do() (DataSetsGetResponseBody, error) {
+func (r *DataSetsGetRequest) Do(ctx context.Context) (DataSetsGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -0,0 +1,117 @@
+package netsuite
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Decimal is an amount, rate or other value that has to round-trip without
+// floating point drift. It's encoded as a json number and decoded from
+// numbers and from strings (as returned by SuiteQL); empty strings and null
+// are decoded as zero.
+type Decimal struct {
+	decimal.Decimal
+}
+
+// NewDecimal parses value, e.g. "12.50".
+func NewDecimal(value string) (Decimal, error) {
+	if value == "" {
+		return Decimal{}, nil
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return Decimal{}, errors.WithStack(err)
+	}
+	return Decimal{Decimal: d}, nil
+}
+
+// MustDecimal is like NewDecimal but panics when value is invalid.
+func MustDecimal(value string) Decimal {
+	d, err := NewDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromFloat converts f using the shortest representation that
+// rounds back to f, so 0.1 becomes 0.1.
+func NewDecimalFromFloat(f float64) Decimal {
+	return Decimal{Decimal: decimal.NewFromFloat(f)}
+}
+
+// NewDecimalFromInt returns value * 10^exp, e.g. NewDecimalFromInt(1250, -2)
+// for 12.50.
+func NewDecimalFromInt(value int64, exp int32) Decimal {
+	return Decimal{Decimal: decimal.New(value, exp)}
+}
+
+// DecimalFromValue converts an untyped value, e.g. a field of a custom record
+// or a SuiteQL result decoded with WithUseNumber, to a Decimal.
+func DecimalFromValue(v interface{}) (Decimal, error) {
+	switch v := v.(type) {
+	case nil:
+		return Decimal{}, nil
+	case Decimal:
+		return v, nil
+	case json.Number:
+		return NewDecimal(v.String())
+	case string:
+		return NewDecimal(v)
+	case float64:
+		return NewDecimalFromFloat(v), nil
+	case int:
+		return NewDecimalFromInt(int64(v), 0), nil
+	case int64:
+		return NewDecimalFromInt(v, 0), nil
+	}
+	return Decimal{}, errors.Errorf("can't convert %T to a decimal", v)
+}
+
+func (d Decimal) IsEmpty() bool {
+	return d.Decimal.IsZero()
+}
+
+func (d Decimal) MarshalSchema() string {
+	return d.Decimal.String()
+}
+
+// RoundCurrency rounds d to the places of a currency (usually 2) using
+// banker's rounding, like NetSuite does for line amounts.
+func (d Decimal) RoundCurrency(places int32) Decimal {
+	return Decimal{Decimal: d.Decimal.RoundBank(places)}
+}
+
+func (d Decimal) Add(d2 Decimal) Decimal {
+	return Decimal{Decimal: d.Decimal.Add(d2.Decimal)}
+}
+
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	return Decimal{Decimal: d.Decimal.Sub(d2.Decimal)}
+}
+
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{Decimal: d.Decimal.Mul(d2.Decimal)}
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.Decimal.String()), nil
+}
+
+func (d *Decimal) UnmarshalJSON(text []byte) error {
+	text = bytes.TrimSpace(text)
+	if bytes.Equal(text, []byte("null")) || bytes.Equal(text, []byte(`""`)) {
+		*d = Decimal{}
+		return nil
+	}
+
+	err := d.Decimal.UnmarshalJSON(text)
+	if err != nil {
+		return fmt.Errorf("invalid decimal %s: %w", text, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestDecimal(t *testing.T) {
+	line := netsuite.JournalEntryLineElement{}
+	err := json.Unmarshal([]byte(`{"debit":0.1,"credit":"1234567.89"}`), &line)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	total := line.Debit.Add(netsuite.MustDecimal("0.2"))
+	if total.String() != "0.3" {
+		t.Errorf("expected 0.3, got %s", total)
+	}
+	if line.Credit.String() != "1234567.89" {
+		t.Errorf("expected 1234567.89, got %s", line.Credit)
+	}
+
+	b, err := json.Marshal(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"debit":0.1`) || !strings.Contains(string(b), `"credit":1234567.89`) {
+		t.Errorf("unexpected json: %s", b)
+	}
+
+	for _, data := range []string{`{"debit":null}`, `{"debit":""}`} {
+		line := netsuite.JournalEntryLineElement{}
+		if err := json.Unmarshal([]byte(data), &line); err != nil || !line.Debit.IsZero() {
+			t.Errorf("%s: expected zero, got %s (%v)", data, line.Debit, err)
+		}
+	}
+
+	rec := netsuite.CustomRecord{}
+	rec.Set("custrecord_amount", json.Number("19.99"))
+	amount, err := rec.Decimal("custrecord_amount")
+	if err != nil || amount.String() != "19.99" {
+		t.Errorf("expected 19.99, got %s (%v)", amount, err)
+	}
+}
@@ -0,0 +1,234 @@
+package netsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type strictDecodingKey struct{}
+
+// WithStrictDecoding returns a context that makes the decoding of the
+// response to the request sent with it fail on fields the response type
+// doesn't have (strict), or ignore them, regardless of
+// Client.SetDisallowUnknownFields.
+func WithStrictDecoding(ctx context.Context, strict bool) context.Context {
+	return context.WithValue(ctx, strictDecodingKey{}, strict)
+}
+
+// SetOnUnknownFields registers a function that's called with the fields of a
+// response its type doesn't have, e.g. to detect schema drift in staging
+// while production decodes leniently. The fields of the response to a single
+// request are also in its ResponseMeta.
+func (c *Client) SetOnUnknownFields(fun func(req *http.Request, fields []string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onUnknownFields = fun
+}
+
+// decodeResponse decodes the response to req into body, or errResp when it's
+// an error response.
+func (cfg clientConfig) decodeResponse(req *http.Request, r io.Reader, body interface{}, errResp *ErrorResponse) error {
+	if strict, ok := req.Context().Value(strictDecodingKey{}).(bool); ok {
+		cfg.disallowUnknownFields = strict
+	}
+
+	meta := responseMetaFromContext(req.Context())
+	if cfg.onUnknownFields == nil && meta == nil {
+		return cfg.unmarshal(r, body, errResp)
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	err = cfg.unmarshal(bytes.NewReader(data), body, errResp)
+	if err != nil || errResp.Error() != "" {
+		return err
+	}
+
+	fields := UnknownFields(data, body)
+	if meta != nil {
+		meta.UnknownFields = fields
+	}
+	if len(fields) > 0 && cfg.onUnknownFields != nil {
+		cfg.onUnknownFields(req, fields)
+	}
+	return nil
+}
+
+var (
+	unmarshalerType  = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	extraFielderType = reflect.TypeOf((*extraFielder)(nil)).Elem()
+	customFieldsType = reflect.TypeOf(CustomFields{})
+)
+
+// extraFielder is implemented by models that decode like their struct
+// themselves but also take properties the struct has no field for, like the
+// period amounts of a budget. UnknownFields skips those properties.
+type extraFielder interface {
+	isExtraField(name string) bool
+}
+
+// UnknownFields returns the (dotted) names of the fields in the json data
+// that decoding it into v ignores, sorted. Models capturing custom fields
+// (CustomFields) are inspected without their custom fields; other values that
+// decode themselves (json.Unmarshaler) and untyped values aren't inspected.
+func UnknownFields(data []byte, v interface{}) []string {
+	var doc interface{}
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil
+	}
+
+	found := map[string]bool{}
+	unknownFields(doc, reflect.TypeOf(v), "", found)
+
+	fields := make([]string, 0, len(found))
+	for f := range found {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func unknownFields(doc interface{}, t reflect.Type, path string, found map[string]bool) {
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var extra func(string) bool
+	if t.Implements(unmarshalerType) || reflect.PtrTo(t).Implements(unmarshalerType) {
+		extra = extraFields(t)
+		if extra == nil {
+			return
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for k, v := range obj {
+			name := joinField(path, k)
+			// encoding/json matches the names case insensitively
+			ft, ok := fields[strings.ToLower(k)]
+			if !ok {
+				if extra == nil || !extra(k) {
+					found[name] = true
+				}
+				continue
+			}
+			unknownFields(v, ft, name, found)
+		}
+	case reflect.Map:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for k, v := range obj {
+			unknownFields(v, t.Elem(), joinField(path, k), found)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := doc.([]interface{})
+		if !ok {
+			return
+		}
+		for _, v := range arr {
+			unknownFields(v, t.Elem(), path, found)
+		}
+	}
+}
+
+// checkUnknownFields fails like a json.Decoder that disallows unknown fields
+// on the fields in data that decoding it into v ignores. The decoder can't
+// check the models that decode themselves, like the ones with custom fields.
+func checkUnknownFields(data []byte, v interface{}) error {
+	fields := UnknownFields(data, v)
+	if len(fields) == 0 {
+		return nil
+	}
+	return fmt.Errorf("json: unknown field %q", fields[0])
+}
+
+// extraFields returns which properties the struct type t, which decodes
+// itself, takes on top of its fields: the custom fields of a model with
+// CustomFields, or those of an extraFielder. It returns nil for any other
+// type, which isn't inspected.
+func extraFields(t reflect.Type) func(string) bool {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if t.Implements(extraFielderType) {
+		return reflect.Zero(t).Interface().(extraFielder).isExtraField
+	}
+	if f, ok := t.FieldByName("CustomFields"); ok && f.Type == customFieldsType {
+		return isCustomField
+	}
+	return nil
+}
+
+// jsonFields returns the types of the fields of struct type t by their
+// lowercased json names, including the fields of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	embedded := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					embedded[k] = v
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+
+	// the fields of the struct itself win
+	for k, v := range embedded {
+		if _, ok := fields[k]; !ok {
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+func joinField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
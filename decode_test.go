@@ -0,0 +1,127 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestStrictDecoding(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("term", "1", map[string]interface{}{"name": "Net 30", "newField": true})
+
+	reported := []string{}
+	c := srv.Client().With(netsuite.WithOnUnknownFields(func(req *http.Request, fields []string) {
+		reported = append(reported, fields...)
+	}))
+	terms := netsuite.NewService[netsuite.Term](c, "term")
+	ctx := context.Background()
+
+	meta := &netsuite.ResponseMeta{}
+	term, err := terms.Get(netsuite.WithResponseMeta(ctx, meta), "1", nil)
+	if err != nil || term.Name != "Net 30" {
+		t.Fatalf("expected a lenient decode, got %+v (%v)", term, err)
+	}
+	if !reflect.DeepEqual(meta.UnknownFields, []string{"newField"}) || !reflect.DeepEqual(reported, []string{"newField"}) {
+		t.Errorf("expected the unknown field to be reported, got %v and %v", meta.UnknownFields, reported)
+	}
+
+	_, err = terms.Get(netsuite.WithStrictDecoding(ctx, true), "1", nil)
+	if err == nil || !strings.Contains(err.Error(), "newField") {
+		t.Errorf("expected a strict decode to fail, got %v", err)
+	}
+
+	c.SetDisallowUnknownFields(true)
+	_, err = terms.Get(netsuite.WithStrictDecoding(ctx, false), "1", nil)
+	if err != nil {
+		t.Errorf("expected the request to override the client, got %v", err)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	type line struct {
+		Item string `json:"item"`
+	}
+	type base struct {
+		ID string `json:"id"`
+	}
+	type record struct {
+		base
+		Name   string                 `json:"name"`
+		Lines  []line                 `json:"lines"`
+		Custom map[string]line        `json:"custom"`
+		Raw    json.RawMessage        `json:"raw"`
+		Any    map[string]interface{} `json:"any"`
+	}
+
+	data := []byte(`{
+		"id": "1", "NAME": "x", "extra": 1,
+		"lines": [{"item": "a", "qty": 1}, {"item": "b", "rate": 2}],
+		"custom": {"k": {"item": "c", "memo": "m"}},
+		"raw": {"id": "2", "whatever": true},
+		"any": {"free": {"form": 1}}
+	}`)
+	fields := netsuite.UnknownFields(data, &record{})
+	expected := []string{"custom.k.memo", "extra", "lines.qty", "lines.rate"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+}
+
+func TestStrictDecodingCustomFields(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Acme", "custentity_region": "EU", "bogusField": true})
+
+	reported := []string{}
+	c := srv.Client().With(netsuite.WithOnUnknownFields(func(req *http.Request, fields []string) {
+		reported = append(reported, fields...)
+	}))
+	ctx := context.Background()
+
+	req := c.NewCustomerGetRequest()
+	req.PathParams().ID = 1
+	meta := &netsuite.ResponseMeta{}
+	customer, err := req.Do(netsuite.WithResponseMeta(ctx, meta))
+	if err != nil || customer.CompanyName != "Acme" || !customer.CustomFields.Has("custentity_region") {
+		t.Fatalf("expected a lenient decode, got %+v (%v)", customer, err)
+	}
+	if !reflect.DeepEqual(meta.UnknownFields, []string{"bogusField"}) || !reflect.DeepEqual(reported, []string{"bogusField"}) {
+		t.Errorf("expected only the non custom field to be reported, got %v and %v", meta.UnknownFields, reported)
+	}
+
+	_, err = req.Do(netsuite.WithStrictDecoding(ctx, true))
+	if err == nil || !strings.Contains(err.Error(), "bogusField") {
+		t.Errorf("expected a strict decode to fail, got %v", err)
+	}
+
+	customers := netsuite.NewService[netsuite.Customer](c, "customer")
+	_, err = customers.Get(netsuite.WithStrictDecoding(ctx, true), "1", nil)
+	if err == nil || !strings.Contains(err.Error(), "bogusField") {
+		t.Errorf("expected a strict decode to fail, got %v", err)
+	}
+}
+
+func TestUnknownFieldsCustomFields(t *testing.T) {
+	data := []byte(`{
+		"custbody_channel": "web", "newBodyField": 1,
+		"item": {"items": [{"custcol_note": "n", "newLineField": 2}]}
+	}`)
+	fields := netsuite.UnknownFields(data, &netsuite.Invoice{})
+	expected := []string{"item.items.newLineField", "newBodyField"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+
+	fields = netsuite.UnknownFields([]byte(`{"periodAmount1": 10, "periodAmountX": 1}`), &netsuite.Budget{})
+	if !reflect.DeepEqual(fields, []string{"periodAmountX"}) {
+		t.Errorf("expected periodAmountX, got %v", fields)
+	}
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewDepositDeleteRequest() DepositDeleteRequest {
+	r := DepositDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type DepositDeleteRequest struct {
+	client      *Client
+	queryParams *DepositDeleteRequestQueryParams
+	pathParams  *DepositDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody DepositDeleteRequestBody
+}
+
+func (r DepositDeleteRequest) NewQueryParams() *DepositDeleteRequestQueryParams {
+	return &DepositDeleteRequestQueryParams{}
+}
+
+type DepositDeleteRequestQueryParams struct {
+}
+
+func (p DepositDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DepositDeleteRequest) QueryParams() *DepositDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r DepositDeleteRequest) NewPathParams() *DepositDeleteRequestPathParams {
+	return &DepositDeleteRequestPathParams{}
+}
+
+type DepositDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *DepositDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *DepositDeleteRequest) PathParams() *DepositDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *DepositDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *DepositDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DepositDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *DepositDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DepositDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r DepositDeleteRequest) NewRequestBody() DepositDeleteRequestBody {
+	return DepositDeleteRequestBody{}
+}
+
+type DepositDeleteRequestBody struct {
+}
+
+func (r *DepositDeleteRequest) RequestBody() *DepositDeleteRequestBody {
+	return nil
+}
+
+func (r *DepositDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *DepositDeleteRequest) SetRequestBody(body DepositDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DepositDeleteRequest) NewResponseBody() *DepositDeleteResponseBody {
+	return &DepositDeleteResponseBody{}
+}
+
+type DepositDeleteResponseBody struct {
+}
+
+func (r *DepositDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/deposit/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *DepositDeleteRequest) Do(ctx context.Context) (DepositDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
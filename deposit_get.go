@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewDepositGetRequest() DepositGetRequest {
+	r := DepositGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type DepositGetRequest struct {
+	client      *Client
+	queryParams *DepositGetRequestQueryParams
+	pathParams  *DepositGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody DepositGetRequestBody
+}
+
+func (r DepositGetRequest) NewQueryParams() *DepositGetRequestQueryParams {
+	return &DepositGetRequestQueryParams{}
+}
+
+type DepositGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p DepositGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DepositGetRequest) QueryParams() *DepositGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r DepositGetRequest) NewPathParams() *DepositGetRequestPathParams {
+	return &DepositGetRequestPathParams{}
+}
+
+type DepositGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *DepositGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *DepositGetRequest) PathParams() *DepositGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *DepositGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *DepositGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DepositGetRequest) Method() string {
+	return r.method
+}
+
+func (r *DepositGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DepositGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r DepositGetRequest) NewRequestBody() DepositGetRequestBody {
+	return DepositGetRequestBody{}
+}
+
+type DepositGetRequestBody struct {
+}
+
+func (r *DepositGetRequest) RequestBody() *DepositGetRequestBody {
+	return nil
+}
+
+func (r *DepositGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *DepositGetRequest) SetRequestBody(body DepositGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DepositGetRequest) NewResponseBody() *DepositGetResponseBody {
+	return &DepositGetResponseBody{}
+}
+
+type DepositGetResponseBody struct {
+	Links Links `json:"links"`
+	Deposit
+}
+
+func (r *DepositGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/deposit/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *DepositGetRequest) Do(ctx context.Context) (DepositGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
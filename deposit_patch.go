@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewDepositPatchRequest() DepositPatchRequest {
+	r := DepositPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type DepositPatchRequest struct {
+	client      *Client
+	queryParams *DepositPatchRequestQueryParams
+	pathParams  *DepositPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody DepositPatchRequestBody
+}
+
+func (r DepositPatchRequest) NewQueryParams() *DepositPatchRequestQueryParams {
+	return &DepositPatchRequestQueryParams{}
+}
+
+type DepositPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p DepositPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DepositPatchRequest) QueryParams() *DepositPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r DepositPatchRequest) NewPathParams() *DepositPatchRequestPathParams {
+	return &DepositPatchRequestPathParams{}
+}
+
+type DepositPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *DepositPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *DepositPatchRequest) PathParams() *DepositPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *DepositPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *DepositPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DepositPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *DepositPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DepositPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r DepositPatchRequest) NewRequestBody() DepositPatchRequestBody {
+	return DepositPatchRequestBody{}
+}
+
+type DepositPatchRequestBody struct {
+	Deposit
+}
+
+func (r *DepositPatchRequest) RequestBody() *DepositPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *DepositPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *DepositPatchRequest) SetRequestBody(body DepositPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DepositPatchRequest) NewResponseBody() *DepositPatchResponseBody {
+	return &DepositPatchResponseBody{}
+}
+
+type DepositPatchResponseBody struct {
+}
+
+func (r *DepositPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/deposit/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *DepositPatchRequest) Do(ctx context.Context) (DepositPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
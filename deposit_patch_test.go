@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestDepositPatch(t *testing.T) {
+	req := client.NewDepositPatchRequest()
+	req.PathParams().ID = 6121
+	req.RequestBody().Memo = "Reconciled"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewDepositPostRequest() DepositPostRequest {
+	r := DepositPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type DepositPostRequest struct {
+	client      *Client
+	queryParams *DepositPostRequestQueryParams
+	pathParams  *DepositPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody DepositPostRequestBody
+}
+
+func (r DepositPostRequest) NewQueryParams() *DepositPostRequestQueryParams {
+	return &DepositPostRequestQueryParams{}
+}
+
+type DepositPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p DepositPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DepositPostRequest) QueryParams() *DepositPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r DepositPostRequest) NewPathParams() *DepositPostRequestPathParams {
+	return &DepositPostRequestPathParams{}
+}
+
+type DepositPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *DepositPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *DepositPostRequest) PathParams() *DepositPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *DepositPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *DepositPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DepositPostRequest) Method() string {
+	return r.method
+}
+
+func (r *DepositPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DepositPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r DepositPostRequest) NewRequestBody() DepositPostRequestBody {
+	return DepositPostRequestBody{}
+}
+
+type DepositPostRequestBody struct {
+	Deposit
+}
+
+func (r *DepositPostRequest) RequestBody() *DepositPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *DepositPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *DepositPostRequest) SetRequestBody(body DepositPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DepositPostRequest) NewResponseBody() *DepositPostResponseBody {
+	return &DepositPostResponseBody{}
+}
+
+type DepositPostResponseBody struct {
+}
+
+func (r *DepositPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/deposit", r.PathParams())
+	return &u, err
+}
+
+func (r *DepositPostRequest) Do(ctx context.Context) (DepositPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
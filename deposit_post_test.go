@@ -0,0 +1,32 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestDepositPost(t *testing.T) {
+	req := client.NewDepositPostRequest()
+	req.RequestBody().Account.ID = "1"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Other = netsuite.DepositOther{
+		Items: netsuite.DepositOtherItems{
+			{
+				Account: netsuite.Account{ID: "213"},
+				Amount:  netsuite.MustDecimal("100"),
+				Memo:    "Interest",
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewDepositsGetRequest() DepositsGetRequest {
+	r := DepositsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type DepositsGetRequest struct {
+	client      *Client
+	queryParams *DepositsGetRequestQueryParams
+	pathParams  *DepositsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody DepositsGetRequestBody
+}
+
+func (r DepositsGetRequest) NewQueryParams() *DepositsGetRequestQueryParams {
+	return &DepositsGetRequestQueryParams{}
+}
+
+type DepositsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p DepositsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *DepositsGetRequest) QueryParams() *DepositsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r DepositsGetRequest) NewPathParams() *DepositsGetRequestPathParams {
+	return &DepositsGetRequestPathParams{}
+}
+
+type DepositsGetRequestPathParams struct {
+}
+
+func (p *DepositsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *DepositsGetRequest) PathParams() *DepositsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *DepositsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *DepositsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *DepositsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *DepositsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *DepositsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r DepositsGetRequest) NewRequestBody() DepositsGetRequestBody {
+	return DepositsGetRequestBody{}
+}
+
+type DepositsGetRequestBody struct {
+}
+
+func (r *DepositsGetRequest) RequestBody() *DepositsGetRequestBody {
+	return nil
+}
+
+func (r *DepositsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *DepositsGetRequest) SetRequestBody(body DepositsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *DepositsGetRequest) NewResponseBody() *DepositsGetResponseBody {
+	return &DepositsGetResponseBody{}
+}
+
+type DepositsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *DepositsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/deposit", r.PathParams())
+	return &u, err
+}
+
+func (r *DepositsGetRequest) Do(ctx context.Context) (DepositsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
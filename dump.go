@@ -0,0 +1,153 @@
+package netsuite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+	"time"
+)
+
+// Dumper receives the request and response dumps when debugging is enabled.
+// Kind is "request" or "response".
+type Dumper interface {
+	Dump(ctx context.Context, kind string, dump []byte)
+}
+
+// SetDumper routes the debug dumps to d instead of the logger (or the
+// standard logger) and enables debugging.
+func (c *Client) SetDumper(d Dumper) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dumper = d
+	c.debug = d != nil
+}
+
+// SetDebugWriter writes the debug dumps to w and enables debugging.
+func (c *Client) SetDebugWriter(w io.Writer) {
+	if w == nil {
+		c.SetDumper(nil)
+		return
+	}
+	c.SetDumper(NewWriterDumper(w))
+}
+
+// SetDebugSampleRate dumps only a fraction (0 < rate <= 1) of the requests
+// when debugging is enabled. A rate of 0 (the default) dumps all requests.
+func (c *Client) SetDebugSampleRate(rate float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.debugSampleRate = rate
+}
+
+// ShouldDump reports whether the next request should be dumped: debugging is
+// enabled and the request is part of the sample.
+func (c *Client) ShouldDump() bool {
+	c.mu.RLock()
+	debug, rate := c.debug, c.debugSampleRate
+	c.mu.RUnlock()
+
+	if !debug {
+		return false
+	}
+	if rate <= 0 || rate >= 1 {
+		return true
+	}
+	return rand.Float64() < rate
+}
+
+// DumpRequest dumps the request to the dumper, the logger at debug level or
+// else the standard logger. Call ShouldDump first.
+func (c *Client) DumpRequest(req *http.Request) {
+	dump, _ := httputil.DumpRequestOut(req, true)
+	c.dump(req.Context(), "request", dump)
+}
+
+// DumpResponse dumps the response, see DumpRequest.
+func (c *Client) DumpResponse(resp *http.Response) {
+	dump, _ := httputil.DumpResponse(resp, true)
+	ctx := context.Background()
+	if resp.Request != nil {
+		ctx = resp.Request.Context()
+	}
+	c.dump(ctx, "response", dump)
+}
+
+func (c *Client) dump(ctx context.Context, kind string, dump []byte) {
+	c.mu.RLock()
+	dumper, logger := c.dumper, c.logger
+	c.mu.RUnlock()
+
+	switch {
+	case dumper != nil:
+		dumper.Dump(ctx, kind, dump)
+	case logger != nil:
+		logger.Log(ctx, LogLevelDebug, kind, LogAttr{Key: "dump", Value: string(dump)})
+	default:
+		log.Println(string(dump))
+	}
+}
+
+type writerDumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterDumper writes every dump to w, preceded by a line with the time and
+// kind. Writes are serialized, so w can be shared by concurrent requests.
+func NewWriterDumper(w io.Writer) Dumper {
+	return &writerDumper{w: w}
+}
+
+func (d *writerDumper) Dump(ctx context.Context, kind string, dump []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fmt.Fprintf(d.w, "--- %s %s\n%s\n", time.Now().Format(time.RFC3339Nano), kind, dump)
+}
+
+type Dump struct {
+	Time time.Time
+	Kind string
+	Dump []byte
+}
+
+// RingDumper keeps the last dumps in memory, e.g. to attach them to an error
+// report.
+type RingDumper struct {
+	mu    sync.Mutex
+	dumps []Dump
+	next  int
+	full  bool
+}
+
+// NewRingDumper keeps the last size dumps.
+func NewRingDumper(size int) *RingDumper {
+	if size < 1 {
+		size = 1
+	}
+	return &RingDumper{dumps: make([]Dump, size)}
+}
+
+func (d *RingDumper) Dump(ctx context.Context, kind string, dump []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dumps[d.next] = Dump{Time: time.Now(), Kind: kind, Dump: dump}
+	d.next = (d.next + 1) % len(d.dumps)
+	if d.next == 0 {
+		d.full = true
+	}
+}
+
+// Dumps returns the kept dumps, oldest first.
+func (d *RingDumper) Dumps() []Dump {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.full {
+		return append([]Dump{}, d.dumps[:d.next]...)
+	}
+	return append(append([]Dump{}, d.dumps[d.next:]...), d.dumps[:d.next]...)
+}
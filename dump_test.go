@@ -0,0 +1,55 @@
+package netsuite_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestDumpers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+
+	buf := new(bytes.Buffer)
+	c.SetDebugWriter(buf)
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), " request\nGET /record/v1/term/1") || !strings.Contains(buf.String(), `{"id":"1","name":"Net 30"}`) {
+		t.Errorf("unexpected dump: %s", buf.String())
+	}
+
+	ring := netsuite.NewRingDumper(3)
+	c.SetDumper(ring)
+	for i := 0; i < 2; i++ {
+		req.Do(context.Background())
+	}
+	dumps := ring.Dumps()
+	if len(dumps) != 3 || dumps[0].Kind != "response" || dumps[2].Kind != "response" {
+		t.Errorf("expected last 3 dumps, got %d", len(dumps))
+	}
+
+	ring = netsuite.NewRingDumper(3)
+	c.SetDumper(ring)
+	c.SetDebugSampleRate(0.000001)
+	for i := 0; i < 10; i++ {
+		req.Do(context.Background())
+	}
+	if len(ring.Dumps()) != 0 {
+		t.Errorf("expected requests to be sampled out, got %d dumps", len(ring.Dumps()))
+	}
+}
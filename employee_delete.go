@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewEmployeeDeleteRequest() EmployeeDeleteRequest {
+	r := EmployeeDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type EmployeeDeleteRequest struct {
+	client      *Client
+	queryParams *EmployeeDeleteRequestQueryParams
+	pathParams  *EmployeeDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody EmployeeDeleteRequestBody
+}
+
+func (r EmployeeDeleteRequest) NewQueryParams() *EmployeeDeleteRequestQueryParams {
+	return &EmployeeDeleteRequestQueryParams{}
+}
+
+type EmployeeDeleteRequestQueryParams struct {
+}
+
+func (p EmployeeDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *EmployeeDeleteRequest) QueryParams() *EmployeeDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r EmployeeDeleteRequest) NewPathParams() *EmployeeDeleteRequestPathParams {
+	return &EmployeeDeleteRequestPathParams{}
+}
+
+type EmployeeDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *EmployeeDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *EmployeeDeleteRequest) PathParams() *EmployeeDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *EmployeeDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *EmployeeDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *EmployeeDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *EmployeeDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *EmployeeDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r EmployeeDeleteRequest) NewRequestBody() EmployeeDeleteRequestBody {
+	return EmployeeDeleteRequestBody{}
+}
+
+type EmployeeDeleteRequestBody struct {
+}
+
+func (r *EmployeeDeleteRequest) RequestBody() *EmployeeDeleteRequestBody {
+	return nil
+}
+
+func (r *EmployeeDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *EmployeeDeleteRequest) SetRequestBody(body EmployeeDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *EmployeeDeleteRequest) NewResponseBody() *EmployeeDeleteResponseBody {
+	return &EmployeeDeleteResponseBody{}
+}
+
+type EmployeeDeleteResponseBody struct {
+}
+
+func (r *EmployeeDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/employee/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *EmployeeDeleteRequest) Do(ctx context.Context) (EmployeeDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,165 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewEmployeeGetRequest() EmployeeGetRequest {
+	r := EmployeeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type EmployeeGetRequest struct {
+	client      *Client
+	queryParams *EmployeeGetRequestQueryParams
+	pathParams  *EmployeeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody EmployeeGetRequestBody
+}
+
+func (r EmployeeGetRequest) NewQueryParams() *EmployeeGetRequestQueryParams {
+	return &EmployeeGetRequestQueryParams{}
+}
+
+type EmployeeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p EmployeeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *EmployeeGetRequest) QueryParams() *EmployeeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r EmployeeGetRequest) NewPathParams() *EmployeeGetRequestPathParams {
+	return &EmployeeGetRequestPathParams{}
+}
+
+type EmployeeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *EmployeeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *EmployeeGetRequest) PathParams() *EmployeeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *EmployeeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *EmployeeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *EmployeeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *EmployeeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *EmployeeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r EmployeeGetRequest) NewRequestBody() EmployeeGetRequestBody {
+	return EmployeeGetRequestBody{}
+}
+
+type EmployeeGetRequestBody struct {
+}
+
+func (r *EmployeeGetRequest) RequestBody() *EmployeeGetRequestBody {
+	return nil
+}
+
+func (r *EmployeeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *EmployeeGetRequest) SetRequestBody(body EmployeeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *EmployeeGetRequest) NewResponseBody() *EmployeeGetResponseBody {
+	return &EmployeeGetResponseBody{}
+}
+
+type EmployeeGetResponseBody struct {
+	Links Links `json:"links"`
+	Employee
+}
+
+// UnmarshalJSON decodes the links next to the embedded Employee, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *EmployeeGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.Employee)
+}
+
+func (r *EmployeeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/employee/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *EmployeeGetRequest) Do(ctx context.Context) (EmployeeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewEmployeePatchRequest() EmployeePatchRequest {
+	r := EmployeePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type EmployeePatchRequest struct {
+	client      *Client
+	queryParams *EmployeePatchRequestQueryParams
+	pathParams  *EmployeePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody EmployeePatchRequestBody
+}
+
+func (r EmployeePatchRequest) NewQueryParams() *EmployeePatchRequestQueryParams {
+	return &EmployeePatchRequestQueryParams{}
+}
+
+type EmployeePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p EmployeePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *EmployeePatchRequest) QueryParams() *EmployeePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r EmployeePatchRequest) NewPathParams() *EmployeePatchRequestPathParams {
+	return &EmployeePatchRequestPathParams{}
+}
+
+type EmployeePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *EmployeePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *EmployeePatchRequest) PathParams() *EmployeePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *EmployeePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *EmployeePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *EmployeePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *EmployeePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *EmployeePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r EmployeePatchRequest) NewRequestBody() EmployeePatchRequestBody {
+	return EmployeePatchRequestBody{}
+}
+
+type EmployeePatchRequestBody struct {
+	Employee
+}
+
+func (r *EmployeePatchRequest) RequestBody() *EmployeePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *EmployeePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *EmployeePatchRequest) SetRequestBody(body EmployeePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *EmployeePatchRequest) NewResponseBody() *EmployeePatchResponseBody {
+	return &EmployeePatchResponseBody{}
+}
+
+type EmployeePatchResponseBody struct {
+}
+
+func (r *EmployeePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/employee/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *EmployeePatchRequest) Do(ctx context.Context) (EmployeePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestEmployeePatch(t *testing.T) {
+	req := client.NewEmployeePatchRequest()
+	req.PathParams().ID = 1642
+	req.RequestBody().ExpenseLimit = netsuite.MustDecimal("2500")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewEmployeePostRequest() EmployeePostRequest {
+	r := EmployeePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type EmployeePostRequest struct {
+	client      *Client
+	queryParams *EmployeePostRequestQueryParams
+	pathParams  *EmployeePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody EmployeePostRequestBody
+}
+
+func (r EmployeePostRequest) NewQueryParams() *EmployeePostRequestQueryParams {
+	return &EmployeePostRequestQueryParams{}
+}
+
+type EmployeePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p EmployeePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *EmployeePostRequest) QueryParams() *EmployeePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r EmployeePostRequest) NewPathParams() *EmployeePostRequestPathParams {
+	return &EmployeePostRequestPathParams{}
+}
+
+type EmployeePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *EmployeePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *EmployeePostRequest) PathParams() *EmployeePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *EmployeePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *EmployeePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *EmployeePostRequest) Method() string {
+	return r.method
+}
+
+func (r *EmployeePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *EmployeePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r EmployeePostRequest) NewRequestBody() EmployeePostRequestBody {
+	return EmployeePostRequestBody{}
+}
+
+type EmployeePostRequestBody struct {
+	Employee
+}
+
+func (r *EmployeePostRequest) RequestBody() *EmployeePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *EmployeePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *EmployeePostRequest) SetRequestBody(body EmployeePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *EmployeePostRequest) NewResponseBody() *EmployeePostResponseBody {
+	return &EmployeePostResponseBody{}
+}
+
+type EmployeePostResponseBody struct {
+}
+
+func (r *EmployeePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/employee", r.PathParams())
+	return &u, err
+}
+
+func (r *EmployeePostRequest) Do(ctx context.Context) (EmployeePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
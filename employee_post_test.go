@@ -0,0 +1,27 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestEmployeePost(t *testing.T) {
+	req := client.NewEmployeePostRequest()
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().FirstName = "Kees"
+	req.RequestBody().LastName = "Zorge"
+	req.RequestBody().Email = "kees@omniboost.io"
+	req.RequestBody().Supervisor.ID = "1642"
+	req.RequestBody().ExpenseLimit = netsuite.MustDecimal("2500")
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewEmployeesGetRequest() EmployeesGetRequest {
+	r := EmployeesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type EmployeesGetRequest struct {
+	client      *Client
+	queryParams *EmployeesGetRequestQueryParams
+	pathParams  *EmployeesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody EmployeesGetRequestBody
+}
+
+func (r EmployeesGetRequest) NewQueryParams() *EmployeesGetRequestQueryParams {
+	return &EmployeesGetRequestQueryParams{}
+}
+
+type EmployeesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p EmployeesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *EmployeesGetRequest) QueryParams() *EmployeesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r EmployeesGetRequest) NewPathParams() *EmployeesGetRequestPathParams {
+	return &EmployeesGetRequestPathParams{}
+}
+
+type EmployeesGetRequestPathParams struct {
+}
+
+func (p *EmployeesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *EmployeesGetRequest) PathParams() *EmployeesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *EmployeesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *EmployeesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *EmployeesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *EmployeesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *EmployeesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r EmployeesGetRequest) NewRequestBody() EmployeesGetRequestBody {
+	return EmployeesGetRequestBody{}
+}
+
+type EmployeesGetRequestBody struct {
+}
+
+func (r *EmployeesGetRequest) RequestBody() *EmployeesGetRequestBody {
+	return nil
+}
+
+func (r *EmployeesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *EmployeesGetRequest) SetRequestBody(body EmployeesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *EmployeesGetRequest) NewResponseBody() *EmployeesGetResponseBody {
+	return &EmployeesGetResponseBody{}
+}
+
+type EmployeesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *EmployeesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/employee", r.PathParams())
+	return &u, err
+}
+
+func (r *EmployeesGetRequest) Do(ctx context.Context) (EmployeesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
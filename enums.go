@@ -0,0 +1,56 @@
+package netsuite
+
+import "strings"
+
+//go:generate go run gen_enums.go
+
+// TransactionStatusOf returns the status of a transaction of tranType (e.g.
+// SalesOrd) with the status code of its orderStatus or status field (e.g. B).
+func TransactionStatusOf(tranType, code string) TransactionStatus {
+	if code == "" {
+		return ""
+	}
+	return TransactionStatus(tranType + ":" + code)
+}
+
+// Type returns the transaction type, e.g. SalesOrd.
+func (v TransactionStatus) Type() string {
+	tranType, _, _ := strings.Cut(string(v), ":")
+	return tranType
+}
+
+// Code returns the status code, e.g. B.
+func (v TransactionStatus) Code() string {
+	_, code, _ := strings.Cut(string(v), ":")
+	return code
+}
+
+// TransactionStatus returns the status of the transfer order.
+func (t TransferOrder) TransactionStatus() TransactionStatus {
+	return TransactionStatusOf("TrnfrOrd", t.OrderStatus.ID)
+}
+
+// TransactionStatus returns the status of the return authorization.
+func (r ReturnAuthorization) TransactionStatus() TransactionStatus {
+	return TransactionStatusOf("RtnAuth", r.OrderStatus.ID)
+}
+
+// TransactionStatus returns the status of the vendor return authorization.
+func (v VendorReturnAuthorization) TransactionStatus() TransactionStatus {
+	return TransactionStatusOf("VendAuth", v.OrderStatus.ID)
+}
+
+// TransactionStatus returns the status of the work order.
+func (w WorkOrder) TransactionStatus() TransactionStatus {
+	return TransactionStatusOf("WorkOrd", w.OrderStatus.ID)
+}
+
+// Approval returns the approval status of the expense report.
+func (e ExpenseReport) Approval() ApprovalStatus {
+	return ApprovalStatus(e.ApprovalStatus.ID)
+}
+
+// Approval returns the approval status of the time bill.
+func (t TimeBill) Approval() ApprovalStatus {
+	return ApprovalStatus(t.ApprovalStatus.ID)
+}
@@ -0,0 +1,481 @@
+// Code generated by gen_enums.go. DO NOT EDIT.
+
+package netsuite
+
+import (
+	"encoding/json"
+)
+
+// TransactionStatus is the status of a transaction as used by saved searches
+// and SuiteQL: the transaction type and the status code, e.g. SalesOrd:B.
+// See TransactionStatusOf for the status code of a record.
+type TransactionStatus string
+
+const (
+	TransactionStatusSalesOrderPendingApproval                               TransactionStatus = "SalesOrd:A"
+	TransactionStatusSalesOrderPendingFulfillment                            TransactionStatus = "SalesOrd:B"
+	TransactionStatusSalesOrderCancelled                                     TransactionStatus = "SalesOrd:C"
+	TransactionStatusSalesOrderPartiallyFulfilled                            TransactionStatus = "SalesOrd:D"
+	TransactionStatusSalesOrderPendingBillingPartiallyFulfilled              TransactionStatus = "SalesOrd:E"
+	TransactionStatusSalesOrderPendingBilling                                TransactionStatus = "SalesOrd:F"
+	TransactionStatusSalesOrderBilled                                        TransactionStatus = "SalesOrd:G"
+	TransactionStatusSalesOrderClosed                                        TransactionStatus = "SalesOrd:H"
+	TransactionStatusPurchaseOrderPendingSupervisorApproval                  TransactionStatus = "PurchOrd:A"
+	TransactionStatusPurchaseOrderPendingReceipt                             TransactionStatus = "PurchOrd:B"
+	TransactionStatusPurchaseOrderRejectedBySupervisor                       TransactionStatus = "PurchOrd:C"
+	TransactionStatusPurchaseOrderPartiallyReceived                          TransactionStatus = "PurchOrd:D"
+	TransactionStatusPurchaseOrderPendingBillingPartiallyReceived            TransactionStatus = "PurchOrd:E"
+	TransactionStatusPurchaseOrderPendingBill                                TransactionStatus = "PurchOrd:F"
+	TransactionStatusPurchaseOrderFullyBilled                                TransactionStatus = "PurchOrd:G"
+	TransactionStatusPurchaseOrderClosed                                     TransactionStatus = "PurchOrd:H"
+	TransactionStatusInvoiceOpen                                             TransactionStatus = "CustInvc:A"
+	TransactionStatusInvoicePaidInFull                                       TransactionStatus = "CustInvc:B"
+	TransactionStatusVendorBillOpen                                          TransactionStatus = "VendBill:A"
+	TransactionStatusVendorBillPaidInFull                                    TransactionStatus = "VendBill:B"
+	TransactionStatusTransferOrderPendingApproval                            TransactionStatus = "TrnfrOrd:A"
+	TransactionStatusTransferOrderPendingFulfillment                         TransactionStatus = "TrnfrOrd:B"
+	TransactionStatusTransferOrderRejected                                   TransactionStatus = "TrnfrOrd:C"
+	TransactionStatusTransferOrderPartiallyFulfilled                         TransactionStatus = "TrnfrOrd:D"
+	TransactionStatusTransferOrderPendingReceiptPartiallyFulfilled           TransactionStatus = "TrnfrOrd:E"
+	TransactionStatusTransferOrderPendingReceipt                             TransactionStatus = "TrnfrOrd:F"
+	TransactionStatusTransferOrderReceived                                   TransactionStatus = "TrnfrOrd:G"
+	TransactionStatusTransferOrderClosed                                     TransactionStatus = "TrnfrOrd:H"
+	TransactionStatusReturnAuthorizationPendingApproval                      TransactionStatus = "RtnAuth:A"
+	TransactionStatusReturnAuthorizationPendingReceipt                       TransactionStatus = "RtnAuth:B"
+	TransactionStatusReturnAuthorizationCancelled                            TransactionStatus = "RtnAuth:C"
+	TransactionStatusReturnAuthorizationPartiallyReceived                    TransactionStatus = "RtnAuth:D"
+	TransactionStatusReturnAuthorizationPendingRefundPartiallyReceived       TransactionStatus = "RtnAuth:E"
+	TransactionStatusReturnAuthorizationPendingRefund                        TransactionStatus = "RtnAuth:F"
+	TransactionStatusReturnAuthorizationRefunded                             TransactionStatus = "RtnAuth:G"
+	TransactionStatusReturnAuthorizationClosed                               TransactionStatus = "RtnAuth:H"
+	TransactionStatusVendorReturnAuthorizationPendingApproval                TransactionStatus = "VendAuth:A"
+	TransactionStatusVendorReturnAuthorizationPendingReturn                  TransactionStatus = "VendAuth:B"
+	TransactionStatusVendorReturnAuthorizationCancelled                      TransactionStatus = "VendAuth:C"
+	TransactionStatusVendorReturnAuthorizationPartiallyReturned              TransactionStatus = "VendAuth:D"
+	TransactionStatusVendorReturnAuthorizationPendingCreditPartiallyReturned TransactionStatus = "VendAuth:E"
+	TransactionStatusVendorReturnAuthorizationPendingCredit                  TransactionStatus = "VendAuth:F"
+	TransactionStatusVendorReturnAuthorizationCredited                       TransactionStatus = "VendAuth:G"
+	TransactionStatusVendorReturnAuthorizationClosed                         TransactionStatus = "VendAuth:H"
+	TransactionStatusWorkOrderPlanned                                        TransactionStatus = "WorkOrd:A"
+	TransactionStatusWorkOrderReleased                                       TransactionStatus = "WorkOrd:B"
+	TransactionStatusWorkOrderInProcess                                      TransactionStatus = "WorkOrd:D"
+	TransactionStatusWorkOrderBuilt                                          TransactionStatus = "WorkOrd:G"
+	TransactionStatusWorkOrderClosed                                         TransactionStatus = "WorkOrd:H"
+	TransactionStatusExpenseReportInProgress                                 TransactionStatus = "ExpRept:A"
+	TransactionStatusExpenseReportPendingSupervisorApproval                  TransactionStatus = "ExpRept:B"
+	TransactionStatusExpenseReportPendingAccountingApproval                  TransactionStatus = "ExpRept:C"
+	TransactionStatusExpenseReportRejectedBySupervisor                       TransactionStatus = "ExpRept:D"
+	TransactionStatusExpenseReportRejectedByAccounting                       TransactionStatus = "ExpRept:E"
+	TransactionStatusExpenseReportApprovedByAccounting                       TransactionStatus = "ExpRept:F"
+	TransactionStatusExpenseReportPaidInFull                                 TransactionStatus = "ExpRept:I"
+)
+
+// TransactionStatusValues returns all known values.
+func TransactionStatusValues() []TransactionStatus {
+	return []TransactionStatus{
+		TransactionStatusSalesOrderPendingApproval,
+		TransactionStatusSalesOrderPendingFulfillment,
+		TransactionStatusSalesOrderCancelled,
+		TransactionStatusSalesOrderPartiallyFulfilled,
+		TransactionStatusSalesOrderPendingBillingPartiallyFulfilled,
+		TransactionStatusSalesOrderPendingBilling,
+		TransactionStatusSalesOrderBilled,
+		TransactionStatusSalesOrderClosed,
+		TransactionStatusPurchaseOrderPendingSupervisorApproval,
+		TransactionStatusPurchaseOrderPendingReceipt,
+		TransactionStatusPurchaseOrderRejectedBySupervisor,
+		TransactionStatusPurchaseOrderPartiallyReceived,
+		TransactionStatusPurchaseOrderPendingBillingPartiallyReceived,
+		TransactionStatusPurchaseOrderPendingBill,
+		TransactionStatusPurchaseOrderFullyBilled,
+		TransactionStatusPurchaseOrderClosed,
+		TransactionStatusInvoiceOpen,
+		TransactionStatusInvoicePaidInFull,
+		TransactionStatusVendorBillOpen,
+		TransactionStatusVendorBillPaidInFull,
+		TransactionStatusTransferOrderPendingApproval,
+		TransactionStatusTransferOrderPendingFulfillment,
+		TransactionStatusTransferOrderRejected,
+		TransactionStatusTransferOrderPartiallyFulfilled,
+		TransactionStatusTransferOrderPendingReceiptPartiallyFulfilled,
+		TransactionStatusTransferOrderPendingReceipt,
+		TransactionStatusTransferOrderReceived,
+		TransactionStatusTransferOrderClosed,
+		TransactionStatusReturnAuthorizationPendingApproval,
+		TransactionStatusReturnAuthorizationPendingReceipt,
+		TransactionStatusReturnAuthorizationCancelled,
+		TransactionStatusReturnAuthorizationPartiallyReceived,
+		TransactionStatusReturnAuthorizationPendingRefundPartiallyReceived,
+		TransactionStatusReturnAuthorizationPendingRefund,
+		TransactionStatusReturnAuthorizationRefunded,
+		TransactionStatusReturnAuthorizationClosed,
+		TransactionStatusVendorReturnAuthorizationPendingApproval,
+		TransactionStatusVendorReturnAuthorizationPendingReturn,
+		TransactionStatusVendorReturnAuthorizationCancelled,
+		TransactionStatusVendorReturnAuthorizationPartiallyReturned,
+		TransactionStatusVendorReturnAuthorizationPendingCreditPartiallyReturned,
+		TransactionStatusVendorReturnAuthorizationPendingCredit,
+		TransactionStatusVendorReturnAuthorizationCredited,
+		TransactionStatusVendorReturnAuthorizationClosed,
+		TransactionStatusWorkOrderPlanned,
+		TransactionStatusWorkOrderReleased,
+		TransactionStatusWorkOrderInProcess,
+		TransactionStatusWorkOrderBuilt,
+		TransactionStatusWorkOrderClosed,
+		TransactionStatusExpenseReportInProgress,
+		TransactionStatusExpenseReportPendingSupervisorApproval,
+		TransactionStatusExpenseReportPendingAccountingApproval,
+		TransactionStatusExpenseReportRejectedBySupervisor,
+		TransactionStatusExpenseReportRejectedByAccounting,
+		TransactionStatusExpenseReportApprovedByAccounting,
+		TransactionStatusExpenseReportPaidInFull,
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v TransactionStatus) IsValid() bool {
+	_, ok := transactionStatusLabels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v TransactionStatus) String() string {
+	if label, ok := transactionStatusLabels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v TransactionStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var transactionStatusLabels = map[TransactionStatus]string{
+	TransactionStatusSalesOrderPendingApproval:                               "Pending Approval",
+	TransactionStatusSalesOrderPendingFulfillment:                            "Pending Fulfillment",
+	TransactionStatusSalesOrderCancelled:                                     "Cancelled",
+	TransactionStatusSalesOrderPartiallyFulfilled:                            "Partially Fulfilled",
+	TransactionStatusSalesOrderPendingBillingPartiallyFulfilled:              "Pending Billing/Partially Fulfilled",
+	TransactionStatusSalesOrderPendingBilling:                                "Pending Billing",
+	TransactionStatusSalesOrderBilled:                                        "Billed",
+	TransactionStatusSalesOrderClosed:                                        "Closed",
+	TransactionStatusPurchaseOrderPendingSupervisorApproval:                  "Pending Supervisor Approval",
+	TransactionStatusPurchaseOrderPendingReceipt:                             "Pending Receipt",
+	TransactionStatusPurchaseOrderRejectedBySupervisor:                       "Rejected by Supervisor",
+	TransactionStatusPurchaseOrderPartiallyReceived:                          "Partially Received",
+	TransactionStatusPurchaseOrderPendingBillingPartiallyReceived:            "Pending Billing/Partially Received",
+	TransactionStatusPurchaseOrderPendingBill:                                "Pending Bill",
+	TransactionStatusPurchaseOrderFullyBilled:                                "Fully Billed",
+	TransactionStatusPurchaseOrderClosed:                                     "Closed",
+	TransactionStatusInvoiceOpen:                                             "Open",
+	TransactionStatusInvoicePaidInFull:                                       "Paid In Full",
+	TransactionStatusVendorBillOpen:                                          "Open",
+	TransactionStatusVendorBillPaidInFull:                                    "Paid In Full",
+	TransactionStatusTransferOrderPendingApproval:                            "Pending Approval",
+	TransactionStatusTransferOrderPendingFulfillment:                         "Pending Fulfillment",
+	TransactionStatusTransferOrderRejected:                                   "Rejected",
+	TransactionStatusTransferOrderPartiallyFulfilled:                         "Partially Fulfilled",
+	TransactionStatusTransferOrderPendingReceiptPartiallyFulfilled:           "Pending Receipt/Partially Fulfilled",
+	TransactionStatusTransferOrderPendingReceipt:                             "Pending Receipt",
+	TransactionStatusTransferOrderReceived:                                   "Received",
+	TransactionStatusTransferOrderClosed:                                     "Closed",
+	TransactionStatusReturnAuthorizationPendingApproval:                      "Pending Approval",
+	TransactionStatusReturnAuthorizationPendingReceipt:                       "Pending Receipt",
+	TransactionStatusReturnAuthorizationCancelled:                            "Cancelled",
+	TransactionStatusReturnAuthorizationPartiallyReceived:                    "Partially Received",
+	TransactionStatusReturnAuthorizationPendingRefundPartiallyReceived:       "Pending Refund/Partially Received",
+	TransactionStatusReturnAuthorizationPendingRefund:                        "Pending Refund",
+	TransactionStatusReturnAuthorizationRefunded:                             "Refunded",
+	TransactionStatusReturnAuthorizationClosed:                               "Closed",
+	TransactionStatusVendorReturnAuthorizationPendingApproval:                "Pending Approval",
+	TransactionStatusVendorReturnAuthorizationPendingReturn:                  "Pending Return",
+	TransactionStatusVendorReturnAuthorizationCancelled:                      "Cancelled",
+	TransactionStatusVendorReturnAuthorizationPartiallyReturned:              "Partially Returned",
+	TransactionStatusVendorReturnAuthorizationPendingCreditPartiallyReturned: "Pending Credit/Partially Returned",
+	TransactionStatusVendorReturnAuthorizationPendingCredit:                  "Pending Credit",
+	TransactionStatusVendorReturnAuthorizationCredited:                       "Credited",
+	TransactionStatusVendorReturnAuthorizationClosed:                         "Closed",
+	TransactionStatusWorkOrderPlanned:                                        "Planned",
+	TransactionStatusWorkOrderReleased:                                       "Released",
+	TransactionStatusWorkOrderInProcess:                                      "In Process",
+	TransactionStatusWorkOrderBuilt:                                          "Built",
+	TransactionStatusWorkOrderClosed:                                         "Closed",
+	TransactionStatusExpenseReportInProgress:                                 "In Progress",
+	TransactionStatusExpenseReportPendingSupervisorApproval:                  "Pending Supervisor Approval",
+	TransactionStatusExpenseReportPendingAccountingApproval:                  "Pending Accounting Approval",
+	TransactionStatusExpenseReportRejectedBySupervisor:                       "Rejected by Supervisor",
+	TransactionStatusExpenseReportRejectedByAccounting:                       "Rejected by Accounting",
+	TransactionStatusExpenseReportApprovedByAccounting:                       "Approved by Accounting",
+	TransactionStatusExpenseReportPaidInFull:                                 "Paid In Full",
+}
+
+// ApprovalStatus is the id of the approval status of a transaction or time bill
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPendingApproval ApprovalStatus = "1"
+	ApprovalStatusApproved        ApprovalStatus = "2"
+	ApprovalStatusRejected        ApprovalStatus = "3"
+)
+
+// ApprovalStatusValues returns all known values.
+func ApprovalStatusValues() []ApprovalStatus {
+	return []ApprovalStatus{
+		ApprovalStatusPendingApproval,
+		ApprovalStatusApproved,
+		ApprovalStatusRejected,
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v ApprovalStatus) IsValid() bool {
+	_, ok := approvalStatusLabels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v ApprovalStatus) String() string {
+	if label, ok := approvalStatusLabels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v ApprovalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var approvalStatusLabels = map[ApprovalStatus]string{
+	ApprovalStatusPendingApproval: "Pending Approval",
+	ApprovalStatusApproved:        "Approved",
+	ApprovalStatusRejected:        "Rejected",
+}
+
+// AccountType is the type (acctType) of a general ledger account
+type AccountType string
+
+const (
+	AccountTypeAccountsPayable       AccountType = "AcctPay"
+	AccountTypeAccountsReceivable    AccountType = "AcctRec"
+	AccountTypeBank                  AccountType = "Bank"
+	AccountTypeCostOfGoodsSold       AccountType = "COGS"
+	AccountTypeCreditCard            AccountType = "CredCard"
+	AccountTypeDeferredExpense       AccountType = "DeferExpense"
+	AccountTypeDeferredRevenue       AccountType = "DeferRevenue"
+	AccountTypeEquity                AccountType = "Equity"
+	AccountTypeExpense               AccountType = "Expense"
+	AccountTypeFixedAsset            AccountType = "FixedAsset"
+	AccountTypeIncome                AccountType = "Income"
+	AccountTypeLongTermLiability     AccountType = "LongTermLiab"
+	AccountTypeNonPosting            AccountType = "NonPosting"
+	AccountTypeOtherAsset            AccountType = "OthAsset"
+	AccountTypeOtherCurrentAsset     AccountType = "OthCurrAsset"
+	AccountTypeOtherCurrentLiability AccountType = "OthCurrLiab"
+	AccountTypeOtherExpense          AccountType = "OthExpense"
+	AccountTypeOtherIncome           AccountType = "OthIncome"
+	AccountTypeStatistical           AccountType = "Stat"
+	AccountTypeUnbilledReceivable    AccountType = "UnbilledRec"
+)
+
+// AccountTypeValues returns all known values.
+func AccountTypeValues() []AccountType {
+	return []AccountType{
+		AccountTypeAccountsPayable,
+		AccountTypeAccountsReceivable,
+		AccountTypeBank,
+		AccountTypeCostOfGoodsSold,
+		AccountTypeCreditCard,
+		AccountTypeDeferredExpense,
+		AccountTypeDeferredRevenue,
+		AccountTypeEquity,
+		AccountTypeExpense,
+		AccountTypeFixedAsset,
+		AccountTypeIncome,
+		AccountTypeLongTermLiability,
+		AccountTypeNonPosting,
+		AccountTypeOtherAsset,
+		AccountTypeOtherCurrentAsset,
+		AccountTypeOtherCurrentLiability,
+		AccountTypeOtherExpense,
+		AccountTypeOtherIncome,
+		AccountTypeStatistical,
+		AccountTypeUnbilledReceivable,
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v AccountType) IsValid() bool {
+	_, ok := accountTypeLabels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v AccountType) String() string {
+	if label, ok := accountTypeLabels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v AccountType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var accountTypeLabels = map[AccountType]string{
+	AccountTypeAccountsPayable:       "Accounts Payable",
+	AccountTypeAccountsReceivable:    "Accounts Receivable",
+	AccountTypeBank:                  "Bank",
+	AccountTypeCostOfGoodsSold:       "Cost of Goods Sold",
+	AccountTypeCreditCard:            "Credit Card",
+	AccountTypeDeferredExpense:       "Deferred Expense",
+	AccountTypeDeferredRevenue:       "Deferred Revenue",
+	AccountTypeEquity:                "Equity",
+	AccountTypeExpense:               "Expense",
+	AccountTypeFixedAsset:            "Fixed Asset",
+	AccountTypeIncome:                "Income",
+	AccountTypeLongTermLiability:     "Long Term Liability",
+	AccountTypeNonPosting:            "Non Posting",
+	AccountTypeOtherAsset:            "Other Asset",
+	AccountTypeOtherCurrentAsset:     "Other Current Asset",
+	AccountTypeOtherCurrentLiability: "Other Current Liability",
+	AccountTypeOtherExpense:          "Other Expense",
+	AccountTypeOtherIncome:           "Other Income",
+	AccountTypeStatistical:           "Statistical",
+	AccountTypeUnbilledReceivable:    "Unbilled Receivable",
+}
+
+// ItemType is the type of an item, e.g. the itemType of a transaction line
+type ItemType string
+
+const (
+	ItemTypeAssembly         ItemType = "Assembly"
+	ItemTypeDescription      ItemType = "Description"
+	ItemTypeDiscount         ItemType = "Discount"
+	ItemTypeDownloadItem     ItemType = "DwnLdItem"
+	ItemTypeEndGroup         ItemType = "EndGroup"
+	ItemTypeGiftCertificate  ItemType = "GiftCert"
+	ItemTypeGroup            ItemType = "Group"
+	ItemTypeInventoryPart    ItemType = "InvtPart"
+	ItemTypeKit              ItemType = "Kit"
+	ItemTypeMarkup           ItemType = "Markup"
+	ItemTypeNonInventoryPart ItemType = "NonInvtPart"
+	ItemTypeOtherCharge      ItemType = "OthCharge"
+	ItemTypePayment          ItemType = "Payment"
+	ItemTypeService          ItemType = "Service"
+	ItemTypeShipItem         ItemType = "ShipItem"
+	ItemTypeSubtotal         ItemType = "Subtotal"
+	ItemTypeTaxGroup         ItemType = "TaxGroup"
+	ItemTypeTaxItem          ItemType = "TaxItem"
+)
+
+// ItemTypeValues returns all known values.
+func ItemTypeValues() []ItemType {
+	return []ItemType{
+		ItemTypeAssembly,
+		ItemTypeDescription,
+		ItemTypeDiscount,
+		ItemTypeDownloadItem,
+		ItemTypeEndGroup,
+		ItemTypeGiftCertificate,
+		ItemTypeGroup,
+		ItemTypeInventoryPart,
+		ItemTypeKit,
+		ItemTypeMarkup,
+		ItemTypeNonInventoryPart,
+		ItemTypeOtherCharge,
+		ItemTypePayment,
+		ItemTypeService,
+		ItemTypeShipItem,
+		ItemTypeSubtotal,
+		ItemTypeTaxGroup,
+		ItemTypeTaxItem,
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v ItemType) IsValid() bool {
+	_, ok := itemTypeLabels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v ItemType) String() string {
+	if label, ok := itemTypeLabels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v ItemType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var itemTypeLabels = map[ItemType]string{
+	ItemTypeAssembly:         "Assembly/Bill of Materials",
+	ItemTypeDescription:      "Description",
+	ItemTypeDiscount:         "Discount",
+	ItemTypeDownloadItem:     "Download Item",
+	ItemTypeEndGroup:         "End of Item Group",
+	ItemTypeGiftCertificate:  "Gift Certificate",
+	ItemTypeGroup:            "Item Group",
+	ItemTypeInventoryPart:    "Inventory Item",
+	ItemTypeKit:              "Kit/Package",
+	ItemTypeMarkup:           "Markup",
+	ItemTypeNonInventoryPart: "Non-inventory Item",
+	ItemTypeOtherCharge:      "Other Charge",
+	ItemTypePayment:          "Payment",
+	ItemTypeService:          "Service",
+	ItemTypeShipItem:         "Shipping Cost Item",
+	ItemTypeSubtotal:         "Subtotal",
+	ItemTypeTaxGroup:         "Tax Group",
+	ItemTypeTaxItem:          "Tax Item",
+}
+
+// CustomerStage is the lifecycle stage of a customer record. NetSuite stores
+// leads, prospects and customers as the same record and derives the stage from
+// the entity status.
+type CustomerStage string
+
+const (
+	CustomerStageLead     CustomerStage = "LEAD"
+	CustomerStageProspect CustomerStage = "PROSPECT"
+	CustomerStageCustomer CustomerStage = "CUSTOMER"
+)
+
+// CustomerStageValues returns all known values.
+func CustomerStageValues() []CustomerStage {
+	return []CustomerStage{
+		CustomerStageLead,
+		CustomerStageProspect,
+		CustomerStageCustomer,
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v CustomerStage) IsValid() bool {
+	_, ok := customerStageLabels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v CustomerStage) String() string {
+	if label, ok := customerStageLabels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v CustomerStage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var customerStageLabels = map[CustomerStage]string{
+	CustomerStageLead:     "Lead",
+	CustomerStageProspect: "Prospect",
+	CustomerStageCustomer: "Customer",
+}
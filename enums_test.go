@@ -0,0 +1,43 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestEnums(t *testing.T) {
+	order := netsuite.TransferOrder{OrderStatus: netsuite.NewRecordRef("B")}
+	status := order.TransactionStatus()
+	if status != netsuite.TransactionStatusTransferOrderPendingFulfillment {
+		t.Errorf("unexpected status: %s", status)
+	}
+	if status.String() != "Pending Fulfillment" || status.Type() != "TrnfrOrd" || status.Code() != "B" {
+		t.Errorf("unexpected status %s (%s, %s)", status, status.Type(), status.Code())
+	}
+
+	if netsuite.ItemType("Unknown").IsValid() || netsuite.ItemType("Unknown").String() != "Unknown" {
+		t.Errorf("expected unknown item type to be invalid")
+	}
+
+	line := netsuite.InvoiceItemItem{}
+	err := json.Unmarshal([]byte(`{"itemType":"InvtPart"}`), &line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch line.ItemType {
+	case netsuite.ItemTypeInventoryPart:
+	default:
+		t.Errorf("unexpected item type: %s", line.ItemType)
+	}
+
+	b, _ := json.Marshal(netsuite.AccountTypeBank)
+	if string(b) != `"Bank"` {
+		t.Errorf("unexpected json: %s", b)
+	}
+
+	if len(netsuite.ApprovalStatusValues()) != 3 {
+		t.Errorf("expected 3 approval statuses")
+	}
+}
@@ -0,0 +1,60 @@
+package netsuite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+type ifMatchKey struct{}
+
+type ifNoneMatchKey struct{}
+
+// WithIfMatch makes the requests sent with ctx conditional on the record
+// still having the given ETag (as returned by a GET, see ETag). NetSuite
+// rejects a PATCH or PUT of a record that was changed in the meantime with
+// 412 Precondition Failed, see IsPreconditionFailed.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// WithIfNoneMatch makes the GET requests sent with ctx conditional on the
+// record having changed since it had the given ETag. A 304 Not Modified
+// response isn't an error and has no body; check its status (or the status of
+// the ResponseMeta). A CachePolicy revalidates its entries by itself.
+func WithIfNoneMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifNoneMatchKey{}, etag)
+}
+
+// setConditionalHeaders sets the If-Match and If-None-Match headers of the
+// context of req.
+func setConditionalHeaders(req *http.Request) {
+	ctx := req.Context()
+	if etag, _ := ctx.Value(ifMatchKey{}).(string); etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+	if etag, _ := ctx.Value(ifNoneMatchKey{}).(string); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+}
+
+// ETag returns the ETag of a response.
+func ETag(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	return resp.Header.Get("ETag")
+}
+
+// IsPreconditionFailed reports whether err is the response to a request with
+// an If-Match that didn't match: the record was changed by someone else.
+func IsPreconditionFailed(err error) bool {
+	errResp := &ErrorResponse{}
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	if errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusPreconditionFailed
+	}
+	return errResp.Status == http.StatusPreconditionFailed
+}
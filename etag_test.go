@@ -0,0 +1,39 @@
+package netsuite_test
+
+import (
+	"context"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestIfMatch(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("term", "1", map[string]interface{}{"name": "Net 30"})
+
+	ctx := context.Background()
+	terms := netsuite.NewService[netsuite.Term](srv.Client(), "term")
+
+	_, etag, err := terms.GetWithETag(ctx, "1", nil)
+	if err != nil || etag == "" {
+		t.Fatalf("expected an etag, got %q (%v)", etag, err)
+	}
+
+	err = terms.Update(netsuite.WithIfMatch(ctx, etag), "1", netsuite.Term{Name: "Net 60"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the etag is stale now
+	err = terms.Update(netsuite.WithIfMatch(ctx, etag), "1", netsuite.Term{Name: "Net 90"}, nil)
+	if !netsuite.IsPreconditionFailed(err) {
+		t.Fatalf("expected precondition failed, got %v", err)
+	}
+
+	term, err := terms.Get(ctx, "1", nil)
+	if err != nil || term.Name != "Net 60" {
+		t.Errorf("expected the first update to be kept, got %+v (%v)", term, err)
+	}
+}
@@ -0,0 +1,58 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// IsNotFound reports whether err is the response to a request for a record
+// that doesn't exist.
+func IsNotFound(err error) bool {
+	errResp := &ErrorResponse{}
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	for _, d := range errResp.ErrorDetails {
+		if d.ErrorCode == "NONEXISTENT_ID" || d.ErrorCode == "RCRD_DSNT_EXIST" {
+			return true
+		}
+	}
+	if errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusNotFound
+	}
+	return errResp.Status == http.StatusNotFound
+}
+
+// notFoundError returns the error NetSuite returns for a record that doesn't
+// exist, for lookups that don't go through the record API.
+func notFoundError(format string, args ...interface{}) error {
+	return &ErrorResponse{
+		Status: http.StatusNotFound,
+		Title:  "Record not found",
+		ErrorDetails: ErrorDetails{
+			{ErrorCode: "NONEXISTENT_ID", Detail: fmt.Sprintf(format, args...)},
+		},
+	}
+}
+
+// Exists reports whether the record exists, see Service.Exists.
+func (c *Client) Exists(ctx context.Context, recordType, id string) (bool, error) {
+	return NewService[json.RawMessage](c, recordType).Exists(ctx, id)
+}
+
+// Exists reports whether the record with the given id exists. Only the id of
+// the record is fetched.
+func (s Service[T]) Exists(ctx context.Context, id string) (bool, error) {
+	if id == "" {
+		return false, errors.New("exists: id is empty")
+	}
+
+	_, err := s.do(ctx, http.MethodGet, id, GetOptions{Fields: Fields{"id"}}, nil, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,39 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestExists(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "12", map[string]interface{}{"companyName": "Acme"})
+	srv.AddError(http.MethodGet, "/record/v1/customer/13", http.StatusForbidden, "INSUFFICIENT_PERMISSION", "Permission violation")
+
+	ctx := context.Background()
+	c := srv.Client()
+
+	ok, err := c.Exists(ctx, "customer", "12")
+	if err != nil || !ok {
+		t.Errorf("expected the customer to exist, got %v (%v)", ok, err)
+	}
+	requests := srv.Requests()
+	if q := requests[len(requests)-1].Query.Get("fields"); q != "id" {
+		t.Errorf("expected only the id to be fetched, got fields %q", q)
+	}
+
+	ok, err = c.Exists(ctx, "customer", "404")
+	if err != nil || ok {
+		t.Errorf("expected the customer not to exist, got %v (%v)", ok, err)
+	}
+
+	ok, err = c.Exists(ctx, "customer", "13")
+	if err == nil || ok || netsuite.IsNotFound(err) {
+		t.Errorf("expected other errors to be returned, got %v (%v)", ok, err)
+	}
+}
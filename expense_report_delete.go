@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewExpenseReportDeleteRequest() ExpenseReportDeleteRequest {
+	r := ExpenseReportDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ExpenseReportDeleteRequest struct {
+	client      *Client
+	queryParams *ExpenseReportDeleteRequestQueryParams
+	pathParams  *ExpenseReportDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ExpenseReportDeleteRequestBody
+}
+
+func (r ExpenseReportDeleteRequest) NewQueryParams() *ExpenseReportDeleteRequestQueryParams {
+	return &ExpenseReportDeleteRequestQueryParams{}
+}
+
+type ExpenseReportDeleteRequestQueryParams struct {
+}
+
+func (p ExpenseReportDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ExpenseReportDeleteRequest) QueryParams() *ExpenseReportDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ExpenseReportDeleteRequest) NewPathParams() *ExpenseReportDeleteRequestPathParams {
+	return &ExpenseReportDeleteRequestPathParams{}
+}
+
+type ExpenseReportDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ExpenseReportDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ExpenseReportDeleteRequest) PathParams() *ExpenseReportDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ExpenseReportDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *ExpenseReportDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ExpenseReportDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ExpenseReportDeleteRequest) NewRequestBody() ExpenseReportDeleteRequestBody {
+	return ExpenseReportDeleteRequestBody{}
+}
+
+type ExpenseReportDeleteRequestBody struct {
+}
+
+func (r *ExpenseReportDeleteRequest) RequestBody() *ExpenseReportDeleteRequestBody {
+	return nil
+}
+
+func (r *ExpenseReportDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ExpenseReportDeleteRequest) SetRequestBody(body ExpenseReportDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ExpenseReportDeleteRequest) NewResponseBody() *ExpenseReportDeleteResponseBody {
+	return &ExpenseReportDeleteResponseBody{}
+}
+
+type ExpenseReportDeleteResponseBody struct {
+}
+
+func (r *ExpenseReportDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/expenseReport/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ExpenseReportDeleteRequest) Do(ctx context.Context) (ExpenseReportDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewExpenseReportGetRequest() ExpenseReportGetRequest {
+	r := ExpenseReportGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ExpenseReportGetRequest struct {
+	client      *Client
+	queryParams *ExpenseReportGetRequestQueryParams
+	pathParams  *ExpenseReportGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ExpenseReportGetRequestBody
+}
+
+func (r ExpenseReportGetRequest) NewQueryParams() *ExpenseReportGetRequestQueryParams {
+	return &ExpenseReportGetRequestQueryParams{}
+}
+
+type ExpenseReportGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ExpenseReportGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ExpenseReportGetRequest) QueryParams() *ExpenseReportGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ExpenseReportGetRequest) NewPathParams() *ExpenseReportGetRequestPathParams {
+	return &ExpenseReportGetRequestPathParams{}
+}
+
+type ExpenseReportGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ExpenseReportGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ExpenseReportGetRequest) PathParams() *ExpenseReportGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ExpenseReportGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ExpenseReportGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ExpenseReportGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ExpenseReportGetRequest) NewRequestBody() ExpenseReportGetRequestBody {
+	return ExpenseReportGetRequestBody{}
+}
+
+type ExpenseReportGetRequestBody struct {
+}
+
+func (r *ExpenseReportGetRequest) RequestBody() *ExpenseReportGetRequestBody {
+	return nil
+}
+
+func (r *ExpenseReportGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ExpenseReportGetRequest) SetRequestBody(body ExpenseReportGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ExpenseReportGetRequest) NewResponseBody() *ExpenseReportGetResponseBody {
+	return &ExpenseReportGetResponseBody{}
+}
+
+type ExpenseReportGetResponseBody struct {
+	Links Links `json:"links"`
+	ExpenseReport
+}
+
+func (r *ExpenseReportGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/expenseReport/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ExpenseReportGetRequest) Do(ctx context.Context) (ExpenseReportGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewExpenseReportPatchRequest() ExpenseReportPatchRequest {
+	r := ExpenseReportPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ExpenseReportPatchRequest struct {
+	client      *Client
+	queryParams *ExpenseReportPatchRequestQueryParams
+	pathParams  *ExpenseReportPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ExpenseReportPatchRequestBody
+}
+
+func (r ExpenseReportPatchRequest) NewQueryParams() *ExpenseReportPatchRequestQueryParams {
+	return &ExpenseReportPatchRequestQueryParams{}
+}
+
+type ExpenseReportPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p ExpenseReportPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ExpenseReportPatchRequest) QueryParams() *ExpenseReportPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ExpenseReportPatchRequest) NewPathParams() *ExpenseReportPatchRequestPathParams {
+	return &ExpenseReportPatchRequestPathParams{}
+}
+
+type ExpenseReportPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ExpenseReportPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ExpenseReportPatchRequest) PathParams() *ExpenseReportPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ExpenseReportPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *ExpenseReportPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ExpenseReportPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ExpenseReportPatchRequest) NewRequestBody() ExpenseReportPatchRequestBody {
+	return ExpenseReportPatchRequestBody{}
+}
+
+type ExpenseReportPatchRequestBody struct {
+	ExpenseReport
+}
+
+func (r *ExpenseReportPatchRequest) RequestBody() *ExpenseReportPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *ExpenseReportPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ExpenseReportPatchRequest) SetRequestBody(body ExpenseReportPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ExpenseReportPatchRequest) NewResponseBody() *ExpenseReportPatchResponseBody {
+	return &ExpenseReportPatchResponseBody{}
+}
+
+type ExpenseReportPatchResponseBody struct {
+}
+
+func (r *ExpenseReportPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/expenseReport/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ExpenseReportPatchRequest) Do(ctx context.Context) (ExpenseReportPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
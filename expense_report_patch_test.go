@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestExpenseReportPatch(t *testing.T) {
+	req := client.NewExpenseReportPatchRequest()
+	req.PathParams().ID = 2311
+	req.RequestBody().SupervisorApproval = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewExpenseReportPostRequest() ExpenseReportPostRequest {
+	r := ExpenseReportPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ExpenseReportPostRequest struct {
+	client      *Client
+	queryParams *ExpenseReportPostRequestQueryParams
+	pathParams  *ExpenseReportPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ExpenseReportPostRequestBody
+}
+
+func (r ExpenseReportPostRequest) NewQueryParams() *ExpenseReportPostRequestQueryParams {
+	return &ExpenseReportPostRequestQueryParams{}
+}
+
+type ExpenseReportPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ExpenseReportPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ExpenseReportPostRequest) QueryParams() *ExpenseReportPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ExpenseReportPostRequest) NewPathParams() *ExpenseReportPostRequestPathParams {
+	return &ExpenseReportPostRequestPathParams{}
+}
+
+type ExpenseReportPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ExpenseReportPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ExpenseReportPostRequest) PathParams() *ExpenseReportPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ExpenseReportPostRequest) Method() string {
+	return r.method
+}
+
+func (r *ExpenseReportPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ExpenseReportPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ExpenseReportPostRequest) NewRequestBody() ExpenseReportPostRequestBody {
+	return ExpenseReportPostRequestBody{}
+}
+
+type ExpenseReportPostRequestBody struct {
+	ExpenseReport
+}
+
+func (r *ExpenseReportPostRequest) RequestBody() *ExpenseReportPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *ExpenseReportPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ExpenseReportPostRequest) SetRequestBody(body ExpenseReportPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ExpenseReportPostRequest) NewResponseBody() *ExpenseReportPostResponseBody {
+	return &ExpenseReportPostResponseBody{}
+}
+
+type ExpenseReportPostResponseBody struct {
+}
+
+func (r *ExpenseReportPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/expenseReport", r.PathParams())
+	return &u, err
+}
+
+func (r *ExpenseReportPostRequest) Do(ctx context.Context) (ExpenseReportPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,33 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestExpenseReportPost(t *testing.T) {
+	req := client.NewExpenseReportPostRequest()
+	req.RequestBody().Entity.ID = "1642"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Memo = "Conference travel"
+	req.RequestBody().Expense = netsuite.ExpenseReportExpenses{
+		Items: netsuite.ExpenseReportExpenseItems{
+			{
+				Category: netsuite.RecordRef{ID: "3"},
+				Amount:   netsuite.MustDecimal("125.50"),
+				Memo:     "Hotel",
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewExpenseReportsGetRequest() ExpenseReportsGetRequest {
+	r := ExpenseReportsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ExpenseReportsGetRequest struct {
+	client      *Client
+	queryParams *ExpenseReportsGetRequestQueryParams
+	pathParams  *ExpenseReportsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ExpenseReportsGetRequestBody
+}
+
+func (r ExpenseReportsGetRequest) NewQueryParams() *ExpenseReportsGetRequestQueryParams {
+	return &ExpenseReportsGetRequestQueryParams{}
+}
+
+type ExpenseReportsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ExpenseReportsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ExpenseReportsGetRequest) QueryParams() *ExpenseReportsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ExpenseReportsGetRequest) NewPathParams() *ExpenseReportsGetRequestPathParams {
+	return &ExpenseReportsGetRequestPathParams{}
+}
+
+type ExpenseReportsGetRequestPathParams struct {
+}
+
+func (p *ExpenseReportsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ExpenseReportsGetRequest) PathParams() *ExpenseReportsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ExpenseReportsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ExpenseReportsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ExpenseReportsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ExpenseReportsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ExpenseReportsGetRequest) NewRequestBody() ExpenseReportsGetRequestBody {
+	return ExpenseReportsGetRequestBody{}
+}
+
+type ExpenseReportsGetRequestBody struct {
+}
+
+func (r *ExpenseReportsGetRequest) RequestBody() *ExpenseReportsGetRequestBody {
+	return nil
+}
+
+func (r *ExpenseReportsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ExpenseReportsGetRequest) SetRequestBody(body ExpenseReportsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ExpenseReportsGetRequest) NewResponseBody() *ExpenseReportsGetResponseBody {
+	return &ExpenseReportsGetResponseBody{}
+}
+
+type ExpenseReportsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ExpenseReportsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/expenseReport", r.PathParams())
+	return &u, err
+}
+
+func (r *ExpenseReportsGetRequest) Do(ctx context.Context) (ExpenseReportsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,134 @@
+// Package export streams the results of a SuiteQL query or a record list to
+// CSV or newline delimited json, e.g. to load them into a warehouse:
+//
+//	f, _ := os.Create("customers.ndjson")
+//	w := export.NewNDJSONWriter(f, nil)
+//	n, err := export.SuiteQL(ctx, client, "SELECT id, companyname FROM customer", w, nil)
+//
+// Results are written page by page, so an export never holds more than a page
+// in memory.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// DefaultPageSize is the number of results fetched per request, the maximum
+// NetSuite allows.
+const DefaultPageSize = 1000
+
+// Options configures an export
+type Options struct {
+	// PageSize defaults to DefaultPageSize
+	PageSize int
+	// Fields limits the fields of the records of a list export, e.g.
+	// "companyName,subsidiary"
+	Fields netsuite.Fields
+}
+
+func (o *Options) pageSize() int {
+	if o == nil || o.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return o.PageSize
+}
+
+// RecordWriter writes exported records.
+type RecordWriter interface {
+	Write(record map[string]interface{}) error
+	// Flush writes any buffered data, it's called when the export is done
+	Flush() error
+}
+
+var (
+	_ RecordWriter = (*CSVWriter)(nil)
+	_ RecordWriter = (*NDJSONWriter)(nil)
+)
+
+// SuiteQL runs the query and writes every result to w. It returns the number
+// of results written.
+func SuiteQL(ctx context.Context, client *netsuite.Client, query string, w RecordWriter, opts *Options) (int, error) {
+	req := client.NewSuiteqlPostRequest()
+	req.RequestBody().Q = query
+	req.QueryParams().Limit = opts.pageSize()
+
+	n := 0
+	for {
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return n, err
+		}
+
+		items := []map[string]interface{}{}
+		err = decode(resp.Items, &items)
+		if err != nil {
+			return n, err
+		}
+		for _, item := range items {
+			err = w.Write(item)
+			if err != nil {
+				return n, err
+			}
+			n++
+		}
+
+		if !resp.HasMore || resp.Count == 0 {
+			return n, w.Flush()
+		}
+		req.QueryParams().Offset = resp.Offset + resp.Count
+	}
+}
+
+// List writes the records of recordType matching q (empty for all records)
+// to w. Lists only return ids, so every record is fetched on its own; prefer
+// SuiteQL for large exports.
+func List(ctx context.Context, client *netsuite.Client, recordType, q string, w RecordWriter, opts *Options) (int, error) {
+	svc := netsuite.NewService[json.RawMessage](client, recordType)
+	listOpts := &netsuite.ListOptions{Q: q, Limit: opts.pageSize()}
+	getOpts := &netsuite.GetOptions{}
+	if opts != nil {
+		getOpts.Fields = opts.Fields
+	}
+
+	n := 0
+	for {
+		page, err := svc.List(ctx, listOpts)
+		if err != nil {
+			return n, err
+		}
+
+		for _, item := range page.Items {
+			raw, err := svc.Get(ctx, item.ID, getOpts)
+			if err != nil {
+				return n, err
+			}
+
+			record := map[string]interface{}{}
+			err = decode(raw, &record)
+			if err != nil {
+				return n, err
+			}
+			err = w.Write(record)
+			if err != nil {
+				return n, err
+			}
+			n++
+		}
+
+		if !page.HasMore || page.Count == 0 {
+			return n, w.Flush()
+		}
+		listOpts.Offset = page.Offset + page.Count
+	}
+}
+
+// decode unmarshals json keeping the numbers as they were sent.
+func decode(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
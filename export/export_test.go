@@ -0,0 +1,75 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/export"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestSuiteQL(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from transaction",
+		map[string]interface{}{"id": "1", "tranid": "INV1", "total": 10.5},
+		map[string]interface{}{"id": "2", "tranid": "INV2, \"rush\"", "total": 20},
+		map[string]interface{}{"id": "3", "tranid": "INV3", "total": json.Number("12345678901234567890")},
+	)
+
+	buf := new(bytes.Buffer)
+	n, err := export.SuiteQL(context.Background(), srv.Client(), "SELECT id, tranid, total FROM transaction", export.NewCSVWriter(buf, nil), &export.Options{PageSize: 2})
+	if err != nil || n != 3 {
+		t.Fatalf("expected 3 results, got %d (%v)", n, err)
+	}
+
+	expected := "id,total,tranid\n1,10.5,INV1\n2,20,\"INV2, \"\"rush\"\"\"\n3,12345678901234567890,INV3\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf)
+	}
+}
+
+func TestList(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	for _, id := range []string{"107", "108"} {
+		srv.AddRecord("customer", id, map[string]interface{}{
+			"companyName": "Customer " + id,
+			"subsidiary": map[string]interface{}{
+				"id":      "1",
+				"refName": "Parent Company",
+				"links":   []interface{}{map[string]interface{}{"rel": "self", "href": "https://example.com"}},
+			},
+		})
+	}
+
+	buf := new(bytes.Buffer)
+	w := export.NewNDJSONWriter(buf, []string{"id", "companyName", "subsidiary.refName"})
+	n, err := export.List(context.Background(), srv.Client(), "customer", "", w, &export.Options{PageSize: 1})
+	if err != nil || n != 2 {
+		t.Fatalf("expected 2 records, got %d (%v)", n, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := `{"companyName":"Customer 107","id":"107","subsidiary.refName":"Parent Company"}`
+	if len(lines) != 2 || lines[0] != expected {
+		t.Errorf("expected %s, got:\n%s", expected, buf)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	flat := export.Flatten(map[string]interface{}{
+		"id":    "1",
+		"links": []interface{}{},
+		"entity": map[string]interface{}{
+			"id":    "107",
+			"links": []interface{}{},
+		},
+	})
+	if len(flat) != 2 || flat["entity.id"] != "107" {
+		t.Errorf("unexpected flattened record: %v", flat)
+	}
+}
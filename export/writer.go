@@ -0,0 +1,170 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Flatten returns the record with the nested objects replaced by their fields
+// with dotted names: {"subsidiary": {"id": "1", "refName": "Parent"}} becomes
+// {"subsidiary.id": "1", "subsidiary.refName": "Parent"}. The links are
+// dropped and arrays (like the lines of a sublist) are kept as they are.
+func Flatten(record map[string]interface{}) map[string]interface{} {
+	flat := map[string]interface{}{}
+	flatten("", record, flat)
+	return flat
+}
+
+func flatten(prefix string, record map[string]interface{}, flat map[string]interface{}) {
+	for k, v := range record {
+		if k == "links" {
+			continue
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			flatten(prefix+k+".", m, flat)
+			continue
+		}
+		flat[prefix+k] = v
+	}
+}
+
+// Columns returns the sorted field names of a flattened record, with id
+// first.
+func Columns(record map[string]interface{}) []string {
+	columns := make([]string, 0, len(record))
+	for k := range record {
+		columns = append(columns, k)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		if columns[i] == "id" || columns[j] == "id" {
+			return columns[i] == "id"
+		}
+		return columns[i] < columns[j]
+	})
+	return columns
+}
+
+// CSVWriter writes flattened records as csv rows, with a header row.
+type CSVWriter struct {
+	w       *csv.Writer
+	columns []string
+	header  bool
+}
+
+// NewCSVWriter returns a writer of the given columns (dotted for nested
+// fields, see Flatten). Without columns the columns of the first record are
+// used; fields only later records have are left out.
+func NewCSVWriter(w io.Writer, columns []string) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), columns: columns}
+}
+
+func (w *CSVWriter) Write(record map[string]interface{}) error {
+	flat := Flatten(record)
+	if !w.header {
+		if len(w.columns) == 0 {
+			w.columns = Columns(flat)
+		}
+		err := w.w.Write(w.columns)
+		if err != nil {
+			return err
+		}
+		w.header = true
+	}
+
+	row := make([]string, len(w.columns))
+	for i, c := range w.columns {
+		row[i] = csvValue(flat[c])
+	}
+	return w.w.Write(row)
+}
+
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func csvValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool, float64, int:
+		return fmt.Sprint(v)
+	}
+
+	b, _ := marshal(v)
+	return string(b)
+}
+
+// NDJSONWriter writes records as json, one per line.
+type NDJSONWriter struct {
+	w       *bufio.Writer
+	columns []string
+	// Flat makes the writer flatten the records, see Flatten
+	Flat bool
+}
+
+// NewNDJSONWriter returns a writer of the given fields (dotted for nested
+// fields, which are then flattened); without columns the records are written
+// as they are, apart from their links.
+func NewNDJSONWriter(w io.Writer, columns []string) *NDJSONWriter {
+	return &NDJSONWriter{w: bufio.NewWriter(w), columns: columns}
+}
+
+func (w *NDJSONWriter) Write(record map[string]interface{}) error {
+	var v map[string]interface{}
+	switch {
+	case len(w.columns) > 0:
+		flat := Flatten(record)
+		v = make(map[string]interface{}, len(w.columns))
+		for _, c := range w.columns {
+			v[c] = flat[c]
+		}
+	case w.Flat:
+		v = Flatten(record)
+	default:
+		v = withoutLinks(record)
+	}
+
+	b, err := marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(append(b, '\n'))
+	return err
+}
+
+func (w *NDJSONWriter) Flush() error {
+	return w.w.Flush()
+}
+
+func withoutLinks(record map[string]interface{}) map[string]interface{} {
+	v := make(map[string]interface{}, len(record))
+	for k, f := range record {
+		if k == "links" {
+			continue
+		}
+		if m, ok := f.(map[string]interface{}); ok {
+			f = withoutLinks(m)
+		}
+		v[k] = f
+	}
+	return v
+}
+
+// marshal encodes v as compact json without escaping html characters.
+func marshal(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), err
+}
@@ -0,0 +1,86 @@
+// Package fam gives typed access to the custom records of the Fixed Assets
+// Management (FAM) SuiteApp: assets, asset types and the depreciation history
+// of assets.
+//
+//	assets := fam.Assets(client)
+//	asset, err := assets.Get(ctx, "12", nil)
+//	history, err := fam.AssetHistory(ctx, client, asset.ID)
+//
+// The services are netsuite.Service values, so assets are created, updated
+// and listed like any other record. Only the commonly used fields of the
+// SuiteApp are modeled; the others are available by decoding the records
+// into a map with netsuite.NewService[map[string]interface{}].
+package fam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// The record types of the FAM custom records
+const (
+	AssetRecordType               = "customrecord_ncfar_asset"
+	AssetTypeRecordType           = "customrecord_ncfar_assettype"
+	DepreciationHistoryRecordType = "customrecord_ncfar_deprhistory"
+)
+
+// Assets returns the service of the fixed assets.
+func Assets(client *netsuite.Client) netsuite.Service[Asset] {
+	return netsuite.NewService[Asset](client, AssetRecordType)
+}
+
+// AssetTypes returns the service of the asset types.
+func AssetTypes(client *netsuite.Client) netsuite.Service[AssetType] {
+	return netsuite.NewService[AssetType](client, AssetTypeRecordType)
+}
+
+// DepreciationHistories returns the service of the depreciation history
+// records.
+func DepreciationHistories(client *netsuite.Client) netsuite.Service[DepreciationHistory] {
+	return netsuite.NewService[DepreciationHistory](client, DepreciationHistoryRecordType)
+}
+
+// AssetHistory returns the depreciation history of the asset with the given
+// internal id, oldest first. History records deleted while they're fetched
+// are left out.
+func AssetHistory(ctx context.Context, client *netsuite.Client, assetID string) ([]DepreciationHistory, error) {
+	svc := DepreciationHistories(client)
+	items, err := svc.ListAll(ctx, &netsuite.ListOptions{Q: fmt.Sprintf("custrecord_deprhistasset ANY_OF [%s]", assetID)})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	result, err := svc.GetMany(ctx, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := result.Errors[id]; err != nil && !netsuite.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	history := make([]DepreciationHistory, 0, len(result.Records))
+	for _, h := range result.Records {
+		history = append(history, h)
+	}
+	sort.Slice(history, func(i, j int) bool {
+		a, b := history[i], history[j]
+		if !a.Date.Equal(b.Date.Time) {
+			return a.Date.Before(b.Date.Time)
+		}
+		// ids are numeric
+		if len(a.ID) != len(b.ID) {
+			return len(a.ID) < len(b.ID)
+		}
+		return a.ID < b.ID
+	})
+	return history, nil
+}
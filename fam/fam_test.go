@@ -0,0 +1,76 @@
+package fam_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/fam"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestAssets(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord(fam.AssetRecordType, "12", map[string]interface{}{
+		"name":                          "FAM00012",
+		"custrecord_assettype":          map[string]string{"id": "3", "refName": "Vehicles"},
+		"custrecord_assetcost":          25000,
+		"custrecord_assetbookvalue":     20833.33,
+		"custrecord_assetlifetime":      60,
+		"custrecord_assetdeprstartdate": "2024-01-01",
+	})
+
+	ctx := context.Background()
+	asset, err := fam.Assets(srv.Client()).Get(ctx, "12", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Name != "FAM00012" || asset.AssetType.RefName != "Vehicles" || asset.BookValue.String() != "20833.33" || asset.Lifetime != 60 {
+		t.Errorf("unexpected asset %+v", asset)
+	}
+	if asset.DepreciationStartDate.String() != "2024-01-01" {
+		t.Errorf("expected depreciation to start on 2024-01-01, got %s", asset.DepreciationStartDate)
+	}
+
+	asset = fam.Asset{AltName: "Delivery van", Subsidiary: asset.Subsidiary}
+	asset.AssetType.ID = "3"
+	id, err := fam.Assets(srv.Client()).Create(ctx, asset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	posted, _ := srv.Record(fam.AssetRecordType, id)
+	fields := map[string]json.RawMessage{}
+	json.Unmarshal(posted, &fields)
+	if string(fields["custrecord_assettype"]) != `{"id":"3"}` || string(fields["altName"]) != `"Delivery van"` {
+		t.Errorf("unexpected posted asset %s", posted)
+	}
+}
+
+func TestAssetHistory(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord(fam.DepreciationHistoryRecordType, "10", map[string]interface{}{
+		"custrecord_deprhistasset":  map[string]string{"id": "12"},
+		"custrecord_deprhistdate":   "2024-02-29",
+		"custrecord_deprhistamount": 416.67,
+	})
+	srv.AddRecord(fam.DepreciationHistoryRecordType, "9", map[string]interface{}{
+		"custrecord_deprhistasset":  map[string]string{"id": "12"},
+		"custrecord_deprhistdate":   "2024-01-31",
+		"custrecord_deprhistamount": 416.67,
+	})
+
+	history, err := fam.AssetHistory(context.Background(), srv.Client(), "12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].ID != "9" || history[1].ID != "10" {
+		t.Fatalf("expected the history oldest first, got %+v", history)
+	}
+
+	if q := srv.Requests()[0].Query.Get("q"); !strings.Contains(q, "custrecord_deprhistasset ANY_OF [12]") {
+		t.Errorf("expected the history to be listed by asset, got %q", q)
+	}
+}
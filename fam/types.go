@@ -0,0 +1,113 @@
+package fam
+
+import (
+	"github.com/cydev/zero"
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/omitempty"
+)
+
+// Asset is a fixed asset (customrecord_ncfar_asset).
+type Asset struct {
+	ID         string `json:"id,omitempty"`
+	ExternalID string `json:"externalId,omitempty"`
+	// Name is the asset number FAM generates, e.g. FAM00012
+	Name        string             `json:"name,omitempty"`
+	AltName     string             `json:"altName,omitempty"`
+	Description string             `json:"custrecord_assetdescr,omitempty"`
+	AssetType   netsuite.RecordRef `json:"custrecord_assettype,omitempty"`
+	Status      netsuite.RecordRef `json:"custrecord_assetstatus,omitempty"`
+	Parent      netsuite.RecordRef `json:"custrecord_assetparent,omitempty"`
+	SerialNo    string             `json:"custrecord_assetserialno,omitempty"`
+
+	Subsidiary netsuite.RecordRef `json:"custrecord_assetsubsidiary,omitempty"`
+	Department netsuite.RecordRef `json:"custrecord_assetdepartment,omitempty"`
+	Class      netsuite.RecordRef `json:"custrecord_assetclass,omitempty"`
+	Location   netsuite.RecordRef `json:"custrecord_assetlocation,omitempty"`
+	Currency   netsuite.RecordRef `json:"custrecord_assetcurrency,omitempty"`
+
+	Supplier      netsuite.RecordRef `json:"custrecord_assetsupplier,omitempty"`
+	PurchaseOrder netsuite.RecordRef `json:"custrecord_assetpurchaseorder,omitempty"`
+	PurchaseDate  netsuite.Date      `json:"custrecord_assetpurchasedate,omitempty"`
+
+	OriginalCost  netsuite.Decimal `json:"custrecord_assetcost,omitempty"`
+	CurrentCost   netsuite.Decimal `json:"custrecord_assetcurrentcost,omitempty"`
+	ResidualValue netsuite.Decimal `json:"custrecord_assetresidualvalue,omitempty"`
+	BookValue     netsuite.Decimal `json:"custrecord_assetbookvalue,omitempty"`
+
+	DepreciationMethod    netsuite.RecordRef `json:"custrecord_assetaccmethod,omitempty"`
+	DepreciationActive    netsuite.RecordRef `json:"custrecord_assetdepractive,omitempty"`
+	DepreciationStartDate netsuite.Date      `json:"custrecord_assetdeprstartdate,omitempty"`
+	DepreciationEndDate   netsuite.Date      `json:"custrecord_assetdeprenddate,omitempty"`
+	LastDepreciation      netsuite.Decimal   `json:"custrecord_assetlastdepramt,omitempty"`
+	// Lifetime is the lifetime in periods, CurrentAge the periods depreciated
+	Lifetime   int `json:"custrecord_assetlifetime,omitempty"`
+	CurrentAge int `json:"custrecord_assetcurrentage,omitempty"`
+
+	DisposalDate netsuite.Date `json:"custrecord_assetdisposaldate,omitempty"`
+	IsInactive   netsuite.Bool `json:"isInactive,omitempty"`
+}
+
+func (a Asset) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(a)
+}
+
+func (a Asset) IsEmpty() bool {
+	return zero.IsZero(a)
+}
+
+// AssetType is an asset type (customrecord_ncfar_assettype), with the defaults
+// of the assets of the type.
+type AssetType struct {
+	ID          string `json:"id,omitempty"`
+	ExternalID  string `json:"externalId,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"custrecord_assettypedescription,omitempty"`
+
+	DepreciationMethod netsuite.RecordRef `json:"custrecord_assettypeaccmethod,omitempty"`
+	Lifetime           int                `json:"custrecord_assettypelifetime,omitempty"`
+	// ResidualPercentage is the residual value as a percentage of the cost
+	ResidualPercentage netsuite.Decimal `json:"custrecord_assettyperesidperc,omitempty"`
+
+	AssetAccount              netsuite.RecordRef `json:"custrecord_assettypeassetacc,omitempty"`
+	DepreciationAccount       netsuite.RecordRef `json:"custrecord_assettypedepracc,omitempty"`
+	DepreciationChargeAccount netsuite.RecordRef `json:"custrecord_assettypedeprchargeacc,omitempty"`
+	DisposalAccount           netsuite.RecordRef `json:"custrecord_assettypedisposalacc,omitempty"`
+
+	IsInactive netsuite.Bool `json:"isInactive,omitempty"`
+}
+
+func (a AssetType) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(a)
+}
+
+func (a AssetType) IsEmpty() bool {
+	return zero.IsZero(a)
+}
+
+// DepreciationHistory is an entry of the history of an asset
+// (customrecord_ncfar_deprhistory): its acquisition, a depreciation, a
+// revaluation, a transfer or its disposal.
+type DepreciationHistory struct {
+	ID         string             `json:"id,omitempty"`
+	Name       string             `json:"name,omitempty"`
+	Asset      netsuite.RecordRef `json:"custrecord_deprhistasset,omitempty"`
+	AssetType  netsuite.RecordRef `json:"custrecord_deprhistassettype,omitempty"`
+	Subsidiary netsuite.RecordRef `json:"custrecord_deprhistsubsidiary,omitempty"`
+	// TransactionType is the kind of entry, e.g. Depreciation or Disposal
+	TransactionType    netsuite.RecordRef `json:"custrecord_deprhisttype,omitempty"`
+	DepreciationMethod netsuite.RecordRef `json:"custrecord_deprhistaccmethod,omitempty"`
+	AccountingBook     netsuite.RecordRef `json:"custrecord_deprhistaccountingbook,omitempty"`
+	Date               netsuite.Date      `json:"custrecord_deprhistdate,omitempty"`
+	Period             int                `json:"custrecord_deprhistperiod,omitempty"`
+	Amount             netsuite.Decimal   `json:"custrecord_deprhistamount,omitempty"`
+	BookValue          netsuite.Decimal   `json:"custrecord_deprhistbookvalue,omitempty"`
+	Journal            netsuite.RecordRef `json:"custrecord_deprhistjournal,omitempty"`
+}
+
+func (d DepreciationHistory) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(d)
+}
+
+func (d DepreciationHistory) IsEmpty() bool {
+	return zero.IsZero(d)
+}
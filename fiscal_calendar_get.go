@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewFiscalCalendarGetRequest() FiscalCalendarGetRequest {
+	r := FiscalCalendarGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type FiscalCalendarGetRequest struct {
+	client      *Client
+	queryParams *FiscalCalendarGetRequestQueryParams
+	pathParams  *FiscalCalendarGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody FiscalCalendarGetRequestBody
+}
+
+func (r FiscalCalendarGetRequest) NewQueryParams() *FiscalCalendarGetRequestQueryParams {
+	return &FiscalCalendarGetRequestQueryParams{}
+}
+
+type FiscalCalendarGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p FiscalCalendarGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *FiscalCalendarGetRequest) QueryParams() *FiscalCalendarGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r FiscalCalendarGetRequest) NewPathParams() *FiscalCalendarGetRequestPathParams {
+	return &FiscalCalendarGetRequestPathParams{}
+}
+
+type FiscalCalendarGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *FiscalCalendarGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *FiscalCalendarGetRequest) PathParams() *FiscalCalendarGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *FiscalCalendarGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *FiscalCalendarGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *FiscalCalendarGetRequest) Method() string {
+	return r.method
+}
+
+func (r *FiscalCalendarGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *FiscalCalendarGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r FiscalCalendarGetRequest) NewRequestBody() FiscalCalendarGetRequestBody {
+	return FiscalCalendarGetRequestBody{}
+}
+
+type FiscalCalendarGetRequestBody struct {
+}
+
+func (r *FiscalCalendarGetRequest) RequestBody() *FiscalCalendarGetRequestBody {
+	return nil
+}
+
+func (r *FiscalCalendarGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *FiscalCalendarGetRequest) SetRequestBody(body FiscalCalendarGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *FiscalCalendarGetRequest) NewResponseBody() *FiscalCalendarGetResponseBody {
+	return &FiscalCalendarGetResponseBody{}
+}
+
+type FiscalCalendarGetResponseBody struct {
+	Links Links `json:"links"`
+	FiscalCalendar
+}
+
+func (r *FiscalCalendarGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/fiscalCalendar/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *FiscalCalendarGetRequest) Do(ctx context.Context) (FiscalCalendarGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewFiscalCalendarsGetRequest() FiscalCalendarsGetRequest {
+	r := FiscalCalendarsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type FiscalCalendarsGetRequest struct {
+	client      *Client
+	queryParams *FiscalCalendarsGetRequestQueryParams
+	pathParams  *FiscalCalendarsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody FiscalCalendarsGetRequestBody
+}
+
+func (r FiscalCalendarsGetRequest) NewQueryParams() *FiscalCalendarsGetRequestQueryParams {
+	return &FiscalCalendarsGetRequestQueryParams{}
+}
+
+type FiscalCalendarsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p FiscalCalendarsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *FiscalCalendarsGetRequest) QueryParams() *FiscalCalendarsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r FiscalCalendarsGetRequest) NewPathParams() *FiscalCalendarsGetRequestPathParams {
+	return &FiscalCalendarsGetRequestPathParams{}
+}
+
+type FiscalCalendarsGetRequestPathParams struct {
+}
+
+func (p *FiscalCalendarsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *FiscalCalendarsGetRequest) PathParams() *FiscalCalendarsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *FiscalCalendarsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *FiscalCalendarsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *FiscalCalendarsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *FiscalCalendarsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *FiscalCalendarsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r FiscalCalendarsGetRequest) NewRequestBody() FiscalCalendarsGetRequestBody {
+	return FiscalCalendarsGetRequestBody{}
+}
+
+type FiscalCalendarsGetRequestBody struct {
+}
+
+func (r *FiscalCalendarsGetRequest) RequestBody() *FiscalCalendarsGetRequestBody {
+	return nil
+}
+
+func (r *FiscalCalendarsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *FiscalCalendarsGetRequest) SetRequestBody(body FiscalCalendarsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *FiscalCalendarsGetRequest) NewResponseBody() *FiscalCalendarsGetResponseBody {
+	return &FiscalCalendarsGetResponseBody{}
+}
+
+type FiscalCalendarsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *FiscalCalendarsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/fiscalCalendar", r.PathParams())
+	return &u, err
+}
+
+func (r *FiscalCalendarsGetRequest) Do(ctx context.Context) (FiscalCalendarsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,237 @@
+//go:build ignore
+
+// gen_enums generates enums_gen.go: run go generate after changing the enums
+// below.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+type enum struct {
+	Name   string
+	Doc    string
+	Values []value
+}
+
+type value struct {
+	Name  string
+	Code  string
+	Label string
+}
+
+var enums = []enum{
+	{
+		Name: "TransactionStatus",
+		Doc: `// TransactionStatus is the status of a transaction as used by saved searches
+// and SuiteQL: the transaction type and the status code, e.g. SalesOrd:B.
+// See TransactionStatusOf for the status code of a record.`,
+		Values: []value{
+			{"SalesOrderPendingApproval", "SalesOrd:A", "Pending Approval"},
+			{"SalesOrderPendingFulfillment", "SalesOrd:B", "Pending Fulfillment"},
+			{"SalesOrderCancelled", "SalesOrd:C", "Cancelled"},
+			{"SalesOrderPartiallyFulfilled", "SalesOrd:D", "Partially Fulfilled"},
+			{"SalesOrderPendingBillingPartiallyFulfilled", "SalesOrd:E", "Pending Billing/Partially Fulfilled"},
+			{"SalesOrderPendingBilling", "SalesOrd:F", "Pending Billing"},
+			{"SalesOrderBilled", "SalesOrd:G", "Billed"},
+			{"SalesOrderClosed", "SalesOrd:H", "Closed"},
+			{"PurchaseOrderPendingSupervisorApproval", "PurchOrd:A", "Pending Supervisor Approval"},
+			{"PurchaseOrderPendingReceipt", "PurchOrd:B", "Pending Receipt"},
+			{"PurchaseOrderRejectedBySupervisor", "PurchOrd:C", "Rejected by Supervisor"},
+			{"PurchaseOrderPartiallyReceived", "PurchOrd:D", "Partially Received"},
+			{"PurchaseOrderPendingBillingPartiallyReceived", "PurchOrd:E", "Pending Billing/Partially Received"},
+			{"PurchaseOrderPendingBill", "PurchOrd:F", "Pending Bill"},
+			{"PurchaseOrderFullyBilled", "PurchOrd:G", "Fully Billed"},
+			{"PurchaseOrderClosed", "PurchOrd:H", "Closed"},
+			{"InvoiceOpen", "CustInvc:A", "Open"},
+			{"InvoicePaidInFull", "CustInvc:B", "Paid In Full"},
+			{"VendorBillOpen", "VendBill:A", "Open"},
+			{"VendorBillPaidInFull", "VendBill:B", "Paid In Full"},
+			{"TransferOrderPendingApproval", "TrnfrOrd:A", "Pending Approval"},
+			{"TransferOrderPendingFulfillment", "TrnfrOrd:B", "Pending Fulfillment"},
+			{"TransferOrderRejected", "TrnfrOrd:C", "Rejected"},
+			{"TransferOrderPartiallyFulfilled", "TrnfrOrd:D", "Partially Fulfilled"},
+			{"TransferOrderPendingReceiptPartiallyFulfilled", "TrnfrOrd:E", "Pending Receipt/Partially Fulfilled"},
+			{"TransferOrderPendingReceipt", "TrnfrOrd:F", "Pending Receipt"},
+			{"TransferOrderReceived", "TrnfrOrd:G", "Received"},
+			{"TransferOrderClosed", "TrnfrOrd:H", "Closed"},
+			{"ReturnAuthorizationPendingApproval", "RtnAuth:A", "Pending Approval"},
+			{"ReturnAuthorizationPendingReceipt", "RtnAuth:B", "Pending Receipt"},
+			{"ReturnAuthorizationCancelled", "RtnAuth:C", "Cancelled"},
+			{"ReturnAuthorizationPartiallyReceived", "RtnAuth:D", "Partially Received"},
+			{"ReturnAuthorizationPendingRefundPartiallyReceived", "RtnAuth:E", "Pending Refund/Partially Received"},
+			{"ReturnAuthorizationPendingRefund", "RtnAuth:F", "Pending Refund"},
+			{"ReturnAuthorizationRefunded", "RtnAuth:G", "Refunded"},
+			{"ReturnAuthorizationClosed", "RtnAuth:H", "Closed"},
+			{"VendorReturnAuthorizationPendingApproval", "VendAuth:A", "Pending Approval"},
+			{"VendorReturnAuthorizationPendingReturn", "VendAuth:B", "Pending Return"},
+			{"VendorReturnAuthorizationCancelled", "VendAuth:C", "Cancelled"},
+			{"VendorReturnAuthorizationPartiallyReturned", "VendAuth:D", "Partially Returned"},
+			{"VendorReturnAuthorizationPendingCreditPartiallyReturned", "VendAuth:E", "Pending Credit/Partially Returned"},
+			{"VendorReturnAuthorizationPendingCredit", "VendAuth:F", "Pending Credit"},
+			{"VendorReturnAuthorizationCredited", "VendAuth:G", "Credited"},
+			{"VendorReturnAuthorizationClosed", "VendAuth:H", "Closed"},
+			{"WorkOrderPlanned", "WorkOrd:A", "Planned"},
+			{"WorkOrderReleased", "WorkOrd:B", "Released"},
+			{"WorkOrderInProcess", "WorkOrd:D", "In Process"},
+			{"WorkOrderBuilt", "WorkOrd:G", "Built"},
+			{"WorkOrderClosed", "WorkOrd:H", "Closed"},
+			{"ExpenseReportInProgress", "ExpRept:A", "In Progress"},
+			{"ExpenseReportPendingSupervisorApproval", "ExpRept:B", "Pending Supervisor Approval"},
+			{"ExpenseReportPendingAccountingApproval", "ExpRept:C", "Pending Accounting Approval"},
+			{"ExpenseReportRejectedBySupervisor", "ExpRept:D", "Rejected by Supervisor"},
+			{"ExpenseReportRejectedByAccounting", "ExpRept:E", "Rejected by Accounting"},
+			{"ExpenseReportApprovedByAccounting", "ExpRept:F", "Approved by Accounting"},
+			{"ExpenseReportPaidInFull", "ExpRept:I", "Paid In Full"},
+		},
+	},
+	{
+		Name: "ApprovalStatus",
+		Doc:  `// ApprovalStatus is the id of the approval status of a transaction or time bill`,
+		Values: []value{
+			{"PendingApproval", "1", "Pending Approval"},
+			{"Approved", "2", "Approved"},
+			{"Rejected", "3", "Rejected"},
+		},
+	},
+	{
+		Name: "AccountType",
+		Doc:  `// AccountType is the type (acctType) of a general ledger account`,
+		Values: []value{
+			{"AccountsPayable", "AcctPay", "Accounts Payable"},
+			{"AccountsReceivable", "AcctRec", "Accounts Receivable"},
+			{"Bank", "Bank", "Bank"},
+			{"CostOfGoodsSold", "COGS", "Cost of Goods Sold"},
+			{"CreditCard", "CredCard", "Credit Card"},
+			{"DeferredExpense", "DeferExpense", "Deferred Expense"},
+			{"DeferredRevenue", "DeferRevenue", "Deferred Revenue"},
+			{"Equity", "Equity", "Equity"},
+			{"Expense", "Expense", "Expense"},
+			{"FixedAsset", "FixedAsset", "Fixed Asset"},
+			{"Income", "Income", "Income"},
+			{"LongTermLiability", "LongTermLiab", "Long Term Liability"},
+			{"NonPosting", "NonPosting", "Non Posting"},
+			{"OtherAsset", "OthAsset", "Other Asset"},
+			{"OtherCurrentAsset", "OthCurrAsset", "Other Current Asset"},
+			{"OtherCurrentLiability", "OthCurrLiab", "Other Current Liability"},
+			{"OtherExpense", "OthExpense", "Other Expense"},
+			{"OtherIncome", "OthIncome", "Other Income"},
+			{"Statistical", "Stat", "Statistical"},
+			{"UnbilledReceivable", "UnbilledRec", "Unbilled Receivable"},
+		},
+	},
+	{
+		Name: "ItemType",
+		Doc:  `// ItemType is the type of an item, e.g. the itemType of a transaction line`,
+		Values: []value{
+			{"Assembly", "Assembly", "Assembly/Bill of Materials"},
+			{"Description", "Description", "Description"},
+			{"Discount", "Discount", "Discount"},
+			{"DownloadItem", "DwnLdItem", "Download Item"},
+			{"EndGroup", "EndGroup", "End of Item Group"},
+			{"GiftCertificate", "GiftCert", "Gift Certificate"},
+			{"Group", "Group", "Item Group"},
+			{"InventoryPart", "InvtPart", "Inventory Item"},
+			{"Kit", "Kit", "Kit/Package"},
+			{"Markup", "Markup", "Markup"},
+			{"NonInventoryPart", "NonInvtPart", "Non-inventory Item"},
+			{"OtherCharge", "OthCharge", "Other Charge"},
+			{"Payment", "Payment", "Payment"},
+			{"Service", "Service", "Service"},
+			{"ShipItem", "ShipItem", "Shipping Cost Item"},
+			{"Subtotal", "Subtotal", "Subtotal"},
+			{"TaxGroup", "TaxGroup", "Tax Group"},
+			{"TaxItem", "TaxItem", "Tax Item"},
+		},
+	},
+	{
+		Name: "CustomerStage",
+		Doc: `// CustomerStage is the lifecycle stage of a customer record. NetSuite stores
+// leads, prospects and customers as the same record and derives the stage from
+// the entity status.`,
+		Values: []value{
+			{"Lead", "LEAD", "Lead"},
+			{"Prospect", "PROSPECT", "Prospect"},
+			{"Customer", "CUSTOMER", "Customer"},
+		},
+	},
+}
+
+var tmpl = template.Must(template.New("enums").Funcs(template.FuncMap{"lower": lowerFirst}).Parse(`// Code generated by gen_enums.go. DO NOT EDIT.
+
+package netsuite
+
+import (
+	"encoding/json"
+)
+{{range .}}{{$name := .Name}}
+{{.Doc}}
+type {{.Name}} string
+
+const (
+{{- range .Values}}
+	{{$name}}{{.Name}} {{$name}} = "{{.Code}}"
+{{- end}}
+)
+
+// {{.Name}}Values returns all known values.
+func {{.Name}}Values() []{{.Name}} {
+	return []{{.Name}}{
+{{- range .Values}}
+		{{$name}}{{.Name}},
+{{- end}}
+	}
+}
+
+// IsValid reports whether v is a known value.
+func (v {{.Name}}) IsValid() bool {
+	_, ok := {{.Name | lower}}Labels[v]
+	return ok
+}
+
+// String returns the label NetSuite shows for v, or the value itself when
+// it's unknown.
+func (v {{.Name}}) String() string {
+	if label, ok := {{.Name | lower}}Labels[v]; ok {
+		return label
+	}
+	return string(v)
+}
+
+func (v {{.Name}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+var {{.Name | lower}}Labels = map[{{.Name}}]string{
+{{- range .Values}}
+	{{$name}}{{.Name}}: "{{.Label}}",
+{{- end}}
+}
+{{end}}`))
+
+func main() {
+	buf := new(bytes.Buffer)
+	err := tmpl.Execute(buf, enums)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = os.WriteFile("enums_gen.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,258 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+	"github.com/pkg/errors"
+)
+
+// Service gives typed access to the records of a single record type, so a
+// new record type only needs a model:
+//
+//	terms := netsuite.NewService[netsuite.Term](client, "term")
+//	term, err := terms.Get(ctx, "2", nil)
+//
+// Ids are strings so external ids (eid:...) can be used as well. The status,
+// headers and retries of a call are available with WithResponseMeta.
+type Service[T any] struct {
+	client     *Client
+	recordType string
+}
+
+// NewService returns the service for the records of recordType, e.g.
+// "customer" or "customrecord_rate".
+func NewService[T any](client *Client, recordType string) Service[T] {
+	return Service[T]{
+		client:     client,
+		recordType: recordType,
+	}
+}
+
+func (s Service[T]) RecordType() string {
+	return s.recordType
+}
+
+// GetOptions are the query params of a record GET
+type GetOptions struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (o GetOptions) ToURLValues() (url.Values, error) {
+	return encodeOptions(o)
+}
+
+// ListOptions are the query params of a record list
+type ListOptions struct {
+	// Q filters the records, e.g. `email START_WITH "barbara"`
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (o ListOptions) ToURLValues() (url.Values, error) {
+	return encodeOptions(o)
+}
+
+// UpdateOptions are the query params of a record PATCH
+type UpdateOptions struct {
+	// Replace lists the sublists of which the lines are replaced instead of
+	// merged
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (o UpdateOptions) ToURLValues() (url.Values, error) {
+	return encodeOptions(o)
+}
+
+func encodeOptions(o interface{}) (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(o, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+// ListPage is a page of a record list
+type ListPage struct {
+	Links        Links      `json:"links"`
+	Count        int        `json:"count"`
+	HasMore      bool       `json:"hasMore"`
+	Items        []ListItem `json:"items"`
+	Offset       int        `json:"offset"`
+	TotalResults int        `json:"totalResults"`
+}
+
+// ListItem is a record in a list page; lists only return the ids.
+type ListItem struct {
+	Links Links  `json:"links"`
+	ID    string `json:"id"`
+}
+
+// Get returns the record with the given id.
+func (s Service[T]) Get(ctx context.Context, id string, opts *GetOptions) (T, error) {
+	var record T
+	params := GetOptions{}
+	if opts != nil {
+		params = *opts
+	}
+	_, err := s.do(ctx, http.MethodGet, id, params, nil, &record)
+	return record, err
+}
+
+// GetWithETag returns the record with the given id and its ETag. Pass the
+// ETag to WithIfMatch to update the record only when it hasn't changed.
+func (s Service[T]) GetWithETag(ctx context.Context, id string, opts *GetOptions) (T, string, error) {
+	var record T
+	params := GetOptions{}
+	if opts != nil {
+		params = *opts
+	}
+	resp, err := s.do(ctx, http.MethodGet, id, params, nil, &record)
+	return record, ETag(resp), err
+}
+
+// List returns a single page of records.
+func (s Service[T]) List(ctx context.Context, opts *ListOptions) (ListPage, error) {
+	page := ListPage{}
+	params := ListOptions{}
+	if opts != nil {
+		params = *opts
+	}
+	_, err := s.do(ctx, http.MethodGet, "", params, nil, &page)
+	return page, err
+}
+
+// ListAll follows the pages of the list and returns the records of all
+// pages, starting at opts.Offset.
+func (s Service[T]) ListAll(ctx context.Context, opts *ListOptions) ([]ListItem, error) {
+	o := ListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	items := []ListItem{}
+	for {
+		page, err := s.List(ctx, &o)
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, page.Items...)
+		if !page.HasMore || len(page.Items) == 0 {
+			return items, nil
+		}
+		o.Offset = page.Offset + len(page.Items)
+	}
+}
+
+// Create creates the record and returns its id.
+func (s Service[T]) Create(ctx context.Context, record T) (string, error) {
+	resp, err := s.do(ctx, http.MethodPost, "", nil, record, nil)
+	if err != nil {
+		return "", err
+	}
+	return IDFromLocation(resp)
+}
+
+// Update updates the fields of the record that are set. Use a context from
+// WithIfMatch to make the update conditional.
+func (s Service[T]) Update(ctx context.Context, id string, record T, opts *UpdateOptions) error {
+	params := UpdateOptions{}
+	if opts != nil {
+		params = *opts
+	}
+	_, err := s.do(ctx, http.MethodPatch, id, params, record, nil)
+	return err
+}
+
+// Delete deletes the record.
+func (s Service[T]) Delete(ctx context.Context, id string) error {
+	_, err := s.do(ctx, http.MethodDelete, id, nil, nil, nil)
+	return err
+}
+
+func (s Service[T]) do(ctx context.Context, method, id string, params interface{}, body interface{}, v interface{}) (*http.Response, error) {
+	r := &recordRequest{
+		client:     s.client,
+		method:     method,
+		recordType: s.recordType,
+		id:         id,
+		body:       body,
+	}
+
+	req, err := s.client.NewRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		err = utils.AddQueryParamsToRequest(params, req, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.client.Do(req, v)
+}
+
+// IDFromLocation returns the id of the record a POST created, from the
+// Location header of the response.
+func IDFromLocation(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", errors.New("response has no Location header")
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return path.Base(u.Path), nil
+}
+
+// recordRequest is a request on /record/v1/{record_type}[/{id}]
+type recordRequest struct {
+	client     *Client
+	method     string
+	recordType string
+	id         string
+	body       interface{}
+}
+
+type recordPathParams map[string]string
+
+func (p recordPathParams) Params() map[string]string {
+	return p
+}
+
+func (r *recordRequest) Method() string {
+	return r.method
+}
+
+func (r *recordRequest) PathParamsInterface() PathParams {
+	return recordPathParams{"record_type": r.recordType, "id": r.id}
+}
+
+func (r *recordRequest) RequestBodyInterface() interface{} {
+	return r.body
+}
+
+func (r *recordRequest) URL() (*url.URL, error) {
+	p := "/record/v1/{{.record_type}}"
+	if r.id != "" {
+		p += "/{{.id}}"
+	}
+	u, err := r.client.GetEndpointURL(p, r.PathParamsInterface())
+	return &u, err
+}
@@ -0,0 +1,48 @@
+package netsuite_test
+
+import (
+	"context"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestGenericService(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("term", "1", map[string]interface{}{"name": "Net 30"})
+
+	ctx := context.Background()
+	terms := netsuite.NewService[netsuite.Term](srv.Client(), "term")
+
+	term, err := terms.Get(ctx, "1", nil)
+	if err != nil || term.Name != "Net 30" {
+		t.Fatalf("unexpected term: %+v (%v)", term, err)
+	}
+
+	id, err := terms.Create(ctx, netsuite.Term{Name: "Net 60"})
+	if err != nil || id == "" {
+		t.Fatalf("expected the id of the new term, got %q (%v)", id, err)
+	}
+
+	err = terms.Update(ctx, id, netsuite.Term{Name: "Net 90"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := terms.ListAll(ctx, &netsuite.ListOptions{Limit: 1})
+	if err != nil || len(items) != 2 {
+		t.Fatalf("expected 2 terms over 2 pages, got %d (%v)", len(items), err)
+	}
+
+	err = terms.Delete(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = terms.Get(ctx, id, nil)
+	if err == nil {
+		t.Errorf("expected the deleted term to be gone")
+	}
+}
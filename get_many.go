@@ -0,0 +1,107 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// GetManyOptions configures GetMany
+type GetManyOptions struct {
+	GetOptions
+	// Concurrency is the number of records fetched in parallel (default 4).
+	// It's lowered to the concurrency limit NetSuite reports, see Governance.
+	Concurrency int
+}
+
+// GetManyResult holds the records GetMany fetched and the errors of the ones
+// it couldn't, by id.
+type GetManyResult[T any] struct {
+	Records map[string]T
+	Errors  map[string]error
+}
+
+// NotFound returns the sorted ids of the records that don't exist.
+func (r GetManyResult[T]) NotFound() []string {
+	ids := []string{}
+	for id, err := range r.Errors {
+		if IsNotFound(err) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// GetMany fetches the records with the given ids, see Service.GetMany.
+func (c *Client) GetMany(ctx context.Context, recordType string, ids []string, opts *GetManyOptions) (GetManyResult[json.RawMessage], error) {
+	return NewService[json.RawMessage](c, recordType).GetMany(ctx, ids, opts)
+}
+
+// GetMany fetches the records with the given ids concurrently, e.g. to
+// hydrate the references of transactions. Records that couldn't be fetched
+// have an error in the result; the returned error is only set when ctx is
+// done before all records were fetched.
+func (s Service[T]) GetMany(ctx context.Context, ids []string, opts *GetManyOptions) (GetManyResult[T], error) {
+	o := GetManyOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+	if limit := s.client.Governance().ConcurrencyLimit; limit != nil && *limit > 0 && *limit < o.Concurrency {
+		o.Concurrency = *limit
+	}
+
+	result := GetManyResult[T]{Records: map[string]T{}, Errors: map[string]error{}}
+	mu := sync.Mutex{}
+
+	jobs := make(chan string)
+	wg := sync.WaitGroup{}
+	for i := 0; i < o.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				record, err := s.Get(ctx, id, &o.GetOptions)
+
+				mu.Lock()
+				if err != nil {
+					result.Errors[id] = err
+				} else {
+					result.Records[id] = record
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if id == "" {
+			mu.Lock()
+			result.Errors[id] = errors.New("id is empty")
+			mu.Unlock()
+			continue
+		}
+
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			mu.Lock()
+			result.Errors[id] = ctx.Err()
+			mu.Unlock()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return result, ctx.Err()
+}
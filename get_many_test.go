@@ -0,0 +1,40 @@
+package netsuite_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestGetMany(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	for _, id := range []string{"1", "2", "3"} {
+		srv.AddRecord("term", id, map[string]interface{}{"name": "Net " + id})
+	}
+
+	ctx := context.Background()
+	terms := netsuite.NewService[netsuite.Term](srv.Client(), "term")
+
+	result, err := terms.GetMany(ctx, []string{"1", "2", "", "3", "2", "404"}, &netsuite.GetManyOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Records) != 3 || result.Records["3"].Name != "Net 3" {
+		t.Errorf("expected 3 terms, got %+v", result.Records)
+	}
+	if !reflect.DeepEqual(result.NotFound(), []string{"404"}) || len(result.Errors) != 2 || result.Errors[""] == nil {
+		t.Errorf("expected the empty id and 404 to fail, got %v", result.Errors)
+	}
+	if n := len(srv.Requests()); n != 4 {
+		t.Errorf("expected duplicate ids to be fetched once, got %d requests", n)
+	}
+
+	raw, err := srv.Client().GetMany(ctx, "term", []string{"1"}, nil)
+	if err != nil || len(raw.Records["1"]) == 0 {
+		t.Errorf("expected the raw term, got %v (%v)", raw.Records, err)
+	}
+}
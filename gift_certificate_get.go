@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificateGetRequest() GiftCertificateGetRequest {
+	r := GiftCertificateGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificateGetRequest struct {
+	client      *Client
+	queryParams *GiftCertificateGetRequestQueryParams
+	pathParams  *GiftCertificateGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificateGetRequestBody
+}
+
+func (r GiftCertificateGetRequest) NewQueryParams() *GiftCertificateGetRequestQueryParams {
+	return &GiftCertificateGetRequestQueryParams{}
+}
+
+type GiftCertificateGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p GiftCertificateGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificateGetRequest) QueryParams() *GiftCertificateGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificateGetRequest) NewPathParams() *GiftCertificateGetRequestPathParams {
+	return &GiftCertificateGetRequestPathParams{}
+}
+
+type GiftCertificateGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *GiftCertificateGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *GiftCertificateGetRequest) PathParams() *GiftCertificateGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificateGetRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificateGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificateGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificateGetRequest) NewRequestBody() GiftCertificateGetRequestBody {
+	return GiftCertificateGetRequestBody{}
+}
+
+type GiftCertificateGetRequestBody struct {
+}
+
+func (r *GiftCertificateGetRequest) RequestBody() *GiftCertificateGetRequestBody {
+	return nil
+}
+
+func (r *GiftCertificateGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *GiftCertificateGetRequest) SetRequestBody(body GiftCertificateGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificateGetRequest) NewResponseBody() *GiftCertificateGetResponseBody {
+	return &GiftCertificateGetResponseBody{}
+}
+
+type GiftCertificateGetResponseBody struct {
+	Links Links `json:"links"`
+	GiftCertificate
+}
+
+func (r *GiftCertificateGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificate/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificateGetRequest) Do(ctx context.Context) (GiftCertificateGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
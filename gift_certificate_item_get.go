@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificateItemGetRequest() GiftCertificateItemGetRequest {
+	r := GiftCertificateItemGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificateItemGetRequest struct {
+	client      *Client
+	queryParams *GiftCertificateItemGetRequestQueryParams
+	pathParams  *GiftCertificateItemGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificateItemGetRequestBody
+}
+
+func (r GiftCertificateItemGetRequest) NewQueryParams() *GiftCertificateItemGetRequestQueryParams {
+	return &GiftCertificateItemGetRequestQueryParams{}
+}
+
+type GiftCertificateItemGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p GiftCertificateItemGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificateItemGetRequest) QueryParams() *GiftCertificateItemGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificateItemGetRequest) NewPathParams() *GiftCertificateItemGetRequestPathParams {
+	return &GiftCertificateItemGetRequestPathParams{}
+}
+
+type GiftCertificateItemGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *GiftCertificateItemGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *GiftCertificateItemGetRequest) PathParams() *GiftCertificateItemGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificateItemGetRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificateItemGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificateItemGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificateItemGetRequest) NewRequestBody() GiftCertificateItemGetRequestBody {
+	return GiftCertificateItemGetRequestBody{}
+}
+
+type GiftCertificateItemGetRequestBody struct {
+}
+
+func (r *GiftCertificateItemGetRequest) RequestBody() *GiftCertificateItemGetRequestBody {
+	return nil
+}
+
+func (r *GiftCertificateItemGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *GiftCertificateItemGetRequest) SetRequestBody(body GiftCertificateItemGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificateItemGetRequest) NewResponseBody() *GiftCertificateItemGetResponseBody {
+	return &GiftCertificateItemGetResponseBody{}
+}
+
+type GiftCertificateItemGetResponseBody struct {
+	Links Links `json:"links"`
+	GiftCertificateItem
+}
+
+func (r *GiftCertificateItemGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificateItem/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificateItemGetRequest) Do(ctx context.Context) (GiftCertificateItemGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificateItemPatchRequest() GiftCertificateItemPatchRequest {
+	r := GiftCertificateItemPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificateItemPatchRequest struct {
+	client      *Client
+	queryParams *GiftCertificateItemPatchRequestQueryParams
+	pathParams  *GiftCertificateItemPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificateItemPatchRequestBody
+}
+
+func (r GiftCertificateItemPatchRequest) NewQueryParams() *GiftCertificateItemPatchRequestQueryParams {
+	return &GiftCertificateItemPatchRequestQueryParams{}
+}
+
+type GiftCertificateItemPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p GiftCertificateItemPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificateItemPatchRequest) QueryParams() *GiftCertificateItemPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificateItemPatchRequest) NewPathParams() *GiftCertificateItemPatchRequestPathParams {
+	return &GiftCertificateItemPatchRequestPathParams{}
+}
+
+type GiftCertificateItemPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *GiftCertificateItemPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *GiftCertificateItemPatchRequest) PathParams() *GiftCertificateItemPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificateItemPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificateItemPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificateItemPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificateItemPatchRequest) NewRequestBody() GiftCertificateItemPatchRequestBody {
+	return GiftCertificateItemPatchRequestBody{}
+}
+
+type GiftCertificateItemPatchRequestBody struct {
+	GiftCertificateItem
+}
+
+func (r *GiftCertificateItemPatchRequest) RequestBody() *GiftCertificateItemPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *GiftCertificateItemPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *GiftCertificateItemPatchRequest) SetRequestBody(body GiftCertificateItemPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificateItemPatchRequest) NewResponseBody() *GiftCertificateItemPatchResponseBody {
+	return &GiftCertificateItemPatchResponseBody{}
+}
+
+type GiftCertificateItemPatchResponseBody struct {
+}
+
+func (r *GiftCertificateItemPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificateItem/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificateItemPatchRequest) Do(ctx context.Context) (GiftCertificateItemPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
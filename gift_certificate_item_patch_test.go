@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGiftCertificateItemPatch(t *testing.T) {
+	req := client.NewGiftCertificateItemPatchRequest()
+	req.PathParams().ID = 520
+	req.RequestBody().DisplayName = "Gift card (EUR)"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
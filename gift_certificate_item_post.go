@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificateItemPostRequest() GiftCertificateItemPostRequest {
+	r := GiftCertificateItemPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificateItemPostRequest struct {
+	client      *Client
+	queryParams *GiftCertificateItemPostRequestQueryParams
+	pathParams  *GiftCertificateItemPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificateItemPostRequestBody
+}
+
+func (r GiftCertificateItemPostRequest) NewQueryParams() *GiftCertificateItemPostRequestQueryParams {
+	return &GiftCertificateItemPostRequestQueryParams{}
+}
+
+type GiftCertificateItemPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p GiftCertificateItemPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificateItemPostRequest) QueryParams() *GiftCertificateItemPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificateItemPostRequest) NewPathParams() *GiftCertificateItemPostRequestPathParams {
+	return &GiftCertificateItemPostRequestPathParams{}
+}
+
+type GiftCertificateItemPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *GiftCertificateItemPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *GiftCertificateItemPostRequest) PathParams() *GiftCertificateItemPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificateItemPostRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificateItemPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificateItemPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificateItemPostRequest) NewRequestBody() GiftCertificateItemPostRequestBody {
+	return GiftCertificateItemPostRequestBody{}
+}
+
+type GiftCertificateItemPostRequestBody struct {
+	GiftCertificateItem
+}
+
+func (r *GiftCertificateItemPostRequest) RequestBody() *GiftCertificateItemPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *GiftCertificateItemPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *GiftCertificateItemPostRequest) SetRequestBody(body GiftCertificateItemPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificateItemPostRequest) NewResponseBody() *GiftCertificateItemPostResponseBody {
+	return &GiftCertificateItemPostResponseBody{}
+}
+
+type GiftCertificateItemPostResponseBody struct {
+}
+
+func (r *GiftCertificateItemPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificateItem", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificateItemPostRequest) Do(ctx context.Context) (GiftCertificateItemPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGiftCertificateItemPost(t *testing.T) {
+	req := client.NewGiftCertificateItemPostRequest()
+	req.RequestBody().ItemID = "Gift card"
+	req.RequestBody().LiabilityAccount.ID = "258"
+	req.RequestBody().Subsidiary.ID = "46"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
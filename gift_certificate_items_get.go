@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificateItemsGetRequest() GiftCertificateItemsGetRequest {
+	r := GiftCertificateItemsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificateItemsGetRequest struct {
+	client      *Client
+	queryParams *GiftCertificateItemsGetRequestQueryParams
+	pathParams  *GiftCertificateItemsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificateItemsGetRequestBody
+}
+
+func (r GiftCertificateItemsGetRequest) NewQueryParams() *GiftCertificateItemsGetRequestQueryParams {
+	return &GiftCertificateItemsGetRequestQueryParams{}
+}
+
+type GiftCertificateItemsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p GiftCertificateItemsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificateItemsGetRequest) QueryParams() *GiftCertificateItemsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificateItemsGetRequest) NewPathParams() *GiftCertificateItemsGetRequestPathParams {
+	return &GiftCertificateItemsGetRequestPathParams{}
+}
+
+type GiftCertificateItemsGetRequestPathParams struct {
+}
+
+func (p *GiftCertificateItemsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GiftCertificateItemsGetRequest) PathParams() *GiftCertificateItemsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificateItemsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificateItemsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificateItemsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificateItemsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificateItemsGetRequest) NewRequestBody() GiftCertificateItemsGetRequestBody {
+	return GiftCertificateItemsGetRequestBody{}
+}
+
+type GiftCertificateItemsGetRequestBody struct {
+}
+
+func (r *GiftCertificateItemsGetRequest) RequestBody() *GiftCertificateItemsGetRequestBody {
+	return nil
+}
+
+func (r *GiftCertificateItemsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *GiftCertificateItemsGetRequest) SetRequestBody(body GiftCertificateItemsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificateItemsGetRequest) NewResponseBody() *GiftCertificateItemsGetResponseBody {
+	return &GiftCertificateItemsGetResponseBody{}
+}
+
+type GiftCertificateItemsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *GiftCertificateItemsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificateItem", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificateItemsGetRequest) Do(ctx context.Context) (GiftCertificateItemsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
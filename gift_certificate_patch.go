@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificatePatchRequest() GiftCertificatePatchRequest {
+	r := GiftCertificatePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificatePatchRequest struct {
+	client      *Client
+	queryParams *GiftCertificatePatchRequestQueryParams
+	pathParams  *GiftCertificatePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificatePatchRequestBody
+}
+
+func (r GiftCertificatePatchRequest) NewQueryParams() *GiftCertificatePatchRequestQueryParams {
+	return &GiftCertificatePatchRequestQueryParams{}
+}
+
+type GiftCertificatePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p GiftCertificatePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificatePatchRequest) QueryParams() *GiftCertificatePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificatePatchRequest) NewPathParams() *GiftCertificatePatchRequestPathParams {
+	return &GiftCertificatePatchRequestPathParams{}
+}
+
+type GiftCertificatePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *GiftCertificatePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *GiftCertificatePatchRequest) PathParams() *GiftCertificatePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificatePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificatePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificatePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificatePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificatePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificatePatchRequest) NewRequestBody() GiftCertificatePatchRequestBody {
+	return GiftCertificatePatchRequestBody{}
+}
+
+type GiftCertificatePatchRequestBody struct {
+	GiftCertificate
+}
+
+func (r *GiftCertificatePatchRequest) RequestBody() *GiftCertificatePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *GiftCertificatePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *GiftCertificatePatchRequest) SetRequestBody(body GiftCertificatePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificatePatchRequest) NewResponseBody() *GiftCertificatePatchResponseBody {
+	return &GiftCertificatePatchResponseBody{}
+}
+
+type GiftCertificatePatchResponseBody struct {
+}
+
+func (r *GiftCertificatePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificate/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificatePatchRequest) Do(ctx context.Context) (GiftCertificatePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGiftCertificatePatch(t *testing.T) {
+	req := client.NewGiftCertificatePatchRequest()
+	req.PathParams().ID = 41
+	req.RequestBody().Email = "kees@omniboost.io"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
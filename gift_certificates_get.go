@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewGiftCertificatesGetRequest() GiftCertificatesGetRequest {
+	r := GiftCertificatesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type GiftCertificatesGetRequest struct {
+	client      *Client
+	queryParams *GiftCertificatesGetRequestQueryParams
+	pathParams  *GiftCertificatesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody GiftCertificatesGetRequestBody
+}
+
+func (r GiftCertificatesGetRequest) NewQueryParams() *GiftCertificatesGetRequestQueryParams {
+	return &GiftCertificatesGetRequestQueryParams{}
+}
+
+type GiftCertificatesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p GiftCertificatesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *GiftCertificatesGetRequest) QueryParams() *GiftCertificatesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r GiftCertificatesGetRequest) NewPathParams() *GiftCertificatesGetRequestPathParams {
+	return &GiftCertificatesGetRequestPathParams{}
+}
+
+type GiftCertificatesGetRequestPathParams struct {
+}
+
+func (p *GiftCertificatesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *GiftCertificatesGetRequest) PathParams() *GiftCertificatesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificatesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *GiftCertificatesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *GiftCertificatesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *GiftCertificatesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *GiftCertificatesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r GiftCertificatesGetRequest) NewRequestBody() GiftCertificatesGetRequestBody {
+	return GiftCertificatesGetRequestBody{}
+}
+
+type GiftCertificatesGetRequestBody struct {
+}
+
+func (r *GiftCertificatesGetRequest) RequestBody() *GiftCertificatesGetRequestBody {
+	return nil
+}
+
+func (r *GiftCertificatesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *GiftCertificatesGetRequest) SetRequestBody(body GiftCertificatesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *GiftCertificatesGetRequest) NewResponseBody() *GiftCertificatesGetResponseBody {
+	return &GiftCertificatesGetResponseBody{}
+}
+
+type GiftCertificatesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *GiftCertificatesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/giftCertificate", r.PathParams())
+	return &u, err
+}
+
+func (r *GiftCertificatesGetRequest) Do(ctx context.Context) (GiftCertificatesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
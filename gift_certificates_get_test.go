@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestGiftCertificatesGet(t *testing.T) {
+	req := client.NewGiftCertificatesGetRequest()
+	req.QueryParams().Q = `giftCertCode IS "GC-2022-0001"`
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
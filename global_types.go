@@ -13,8 +13,9 @@ func (l Links) IsEmpty() bool {
 }
 
 type Link struct {
-	Rel  string `json:"rel"`
-	Href string `json:"href"`
+	Rel       string `json:"rel"`
+	Href      string `json:"href"`
+	MediaType string `json:"mediaType,omitempty"`
 }
 
 type Fields []Field
@@ -28,3 +29,9 @@ func (ff Fields) MarshalSchema() string {
 }
 
 type Field string
+
+type RecordTypes []string
+
+func (rr RecordTypes) MarshalSchema() string {
+	return strings.Join(rr, ",")
+}
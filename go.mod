@@ -1,15 +1,21 @@
 module github.com/omniboost/go-netsuite-rest
 
-go 1.16
+go 1.18
 
 require (
 	github.com/cydev/zero v0.0.0-20160322155811-4a4535dd56e7
-	github.com/fatih/structtag v1.2.0 // indirect
 	github.com/gorilla/schema v0.0.0-20171211162101-9fa3b6af65dc
-	github.com/hashicorp/go-multierror v1.1.1 // indirect
 	github.com/pkg/errors v0.9.1
-	golang.org/x/oauth2 v0.0.0-20210628180205-a41e5a781914 // indirect
+	github.com/shopspring/decimal v1.3.1
+	golang.org/x/oauth2 v0.0.0-20210628180205-a41e5a781914
 	gopkg.in/guregu/null.v3 v3.5.0
 )
 
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.0.0-20210525063256-abc453219eb5 // indirect
+	google.golang.org/appengine v1.6.6 // indirect
+	google.golang.org/protobuf v1.26.0 // indirect
+)
+
 replace github.com/gorilla/schema => github.com/omniboost/schema v1.1.1-0.20191030093734-a170fe1a7240
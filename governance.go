@@ -0,0 +1,141 @@
+package netsuite
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Names of the response headers parsed into Governance. NetSuite doesn't send
+// them on every endpoint (or every account), so they're variables that can be
+// adjusted.
+var (
+	GovernanceHeaderConcurrencyLimit = "X-NetSuite-Concurrency-Limit"
+	GovernanceHeaderConcurrencyInUse = "X-NetSuite-Concurrency-In-Use"
+	GovernanceHeaderRemainingUsage   = "X-NetSuite-Remaining-Usage"
+)
+
+// Governance holds the account limits NetSuite reported on a response. Fields
+// are nil when the header wasn't present.
+type Governance struct {
+	// ConcurrencyLimit is the number of requests the account (or integration)
+	// may have in flight
+	ConcurrencyLimit *int
+	// ConcurrencyInUse is the number of requests in flight
+	ConcurrencyInUse *int
+	// RemainingUsage is the governance usage left, e.g. for RESTlets
+	RemainingUsage *int
+	// RetryAfter is set on throttled responses that tell when to retry
+	RetryAfter time.Duration
+	// Throttled is true when the request was rejected because of the limits
+	Throttled bool
+}
+
+// ParseGovernance reads the governance headers of the response.
+func ParseGovernance(resp *http.Response) Governance {
+	g := Governance{
+		ConcurrencyLimit: headerInt(resp.Header, GovernanceHeaderConcurrencyLimit),
+		ConcurrencyInUse: headerInt(resp.Header, GovernanceHeaderConcurrencyInUse),
+		RemainingUsage:   headerInt(resp.Header, GovernanceHeaderRemainingUsage),
+		Throttled:        resp.StatusCode == http.StatusTooManyRequests,
+	}
+
+	if v := strings.TrimSpace(resp.Header.Get("Retry-After")); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			g.RetryAfter = time.Duration(secs) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			g.RetryAfter = time.Until(t)
+		}
+	}
+
+	return g
+}
+
+// IsEmpty reports whether the response had no governance information at all.
+func (g Governance) IsEmpty() bool {
+	return g.ConcurrencyLimit == nil && g.ConcurrencyInUse == nil && g.RemainingUsage == nil &&
+		g.RetryAfter == 0 && !g.Throttled
+}
+
+// ConcurrencyAvailable returns the number of requests that can still be sent
+// concurrently, if both the limit and the usage are known.
+func (g Governance) ConcurrencyAvailable() (int, bool) {
+	if g.ConcurrencyLimit == nil || g.ConcurrencyInUse == nil {
+		return 0, false
+	}
+	return *g.ConcurrencyLimit - *g.ConcurrencyInUse, true
+}
+
+func headerInt(h http.Header, key string) *int {
+	v := strings.TrimSpace(h.Get(key))
+	if v == "" {
+		return nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return nil
+	}
+	return &i
+}
+
+// GovernanceObserver is implemented by rate limiters that adjust to the
+// governance information NetSuite reports. The rate limiter of NewRateLimiter
+// implements it: it pauses until Retry-After has passed on throttled
+// responses and drops its burst while the concurrency or the remaining usage
+// of the account is used up.
+type GovernanceObserver interface {
+	ObserveGovernance(g Governance)
+}
+
+type governanceState struct {
+	mu   sync.RWMutex
+	last Governance
+}
+
+// Governance returns the governance information of the most recent response
+// that had any.
+func (c *Client) Governance() Governance {
+	if c.governance == nil {
+		return Governance{}
+	}
+	c.governance.mu.RLock()
+	defer c.governance.mu.RUnlock()
+	return c.governance.last
+}
+
+// SetOnGovernance registers a function that's called with the governance
+// information of every response that has any, e.g. to export it as metrics.
+// A rate limiter that implements GovernanceObserver is told about it as well.
+func (c *Client) SetOnGovernance(fun func(*http.Request, Governance)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onGovernance = fun
+}
+
+func (c *Client) observeGovernance(req *http.Request, resp *http.Response) {
+	g := ParseGovernance(resp)
+	if g.IsEmpty() {
+		return
+	}
+
+	if c.governance != nil {
+		c.governance.mu.Lock()
+		c.governance.last = g
+		c.governance.mu.Unlock()
+	}
+
+	c.mu.RLock()
+	onGovernance := c.onGovernance
+	limiter := c.rateLimiter
+	c.mu.RUnlock()
+
+	if observer, ok := limiter.(GovernanceObserver); ok {
+		observer.ObserveGovernance(g)
+	}
+
+	if onGovernance != nil {
+		onGovernance(req, g)
+	}
+}
@@ -0,0 +1,76 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestGovernance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Header().Set(netsuite.GovernanceHeaderConcurrencyLimit, "15")
+		w.Header().Set(netsuite.GovernanceHeaderConcurrencyInUse, "15")
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+
+	observed := []netsuite.Governance{}
+	c.SetOnGovernance(func(req *http.Request, g netsuite.Governance) {
+		observed = append(observed, g)
+	})
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	req.Do(context.Background())
+
+	g := c.Governance()
+	if available, ok := g.ConcurrencyAvailable(); !ok || available != 0 {
+		t.Errorf("expected no concurrency available, got %d (%v)", available, ok)
+	}
+	if !g.Throttled || g.RetryAfter != 3*time.Second || g.RemainingUsage != nil {
+		t.Errorf("unexpected governance: %+v", g)
+	}
+	if len(observed) != 1 {
+		t.Errorf("expected governance to be observed once, got %d", len(observed))
+	}
+}
+
+func TestGovernanceRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+	}))
+	defer server.Close()
+
+	limiter := netsuite.NewRateLimiter(100, 10)
+	if _, ok := limiter.(netsuite.GovernanceObserver); !ok {
+		t.Fatal("expected the rate limiter to observe governance")
+	}
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetRateLimiter(limiter)
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	req.Do(context.Background())
+
+	// the limiter pauses until Retry-After has passed
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Error("expected the rate limiter to wait for Retry-After")
+	}
+}
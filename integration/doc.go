@@ -0,0 +1,14 @@
+// Package integration holds an opt-in test suite that runs against a real
+// (sandbox) account, to verify changes against NetSuite's actual behavior:
+// CRUD, SuiteQL, pagination and error responses. Records created by the tests
+// are deleted when they finish.
+//
+// The suite only runs when NETSUITE_INTEGRATION is set and uses the same
+// credential variables as the package tests:
+//
+//	NETSUITE_INTEGRATION=1 AUTH_TYPE=token COMPANY_ID=1234567_SB1 CLIENT_ID=... \
+//		CLIENT_SECRET=... TOKEN_ID=... TOKEN_SECRET=... go test ./integration
+//
+// It refuses to run against an account that isn't a sandbox (the account id
+// has no _SB suffix) unless NETSUITE_INTEGRATION_ALLOW_PRODUCTION is set.
+package integration
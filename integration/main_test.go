@@ -0,0 +1,165 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"golang.org/x/oauth2"
+)
+
+var client *netsuite.Client
+
+func TestMain(m *testing.M) {
+	if os.Getenv("NETSUITE_INTEGRATION") == "" {
+		// every test skips without a client
+		os.Exit(m.Run())
+	}
+
+	companyID := os.Getenv("COMPANY_ID")
+	if !strings.Contains(strings.ToUpper(companyID), "_SB") && os.Getenv("NETSUITE_INTEGRATION_ALLOW_PRODUCTION") == "" {
+		log.Fatalf("%s isn't a sandbox account, set NETSUITE_INTEGRATION_ALLOW_PRODUCTION to run anyway", companyID)
+	}
+
+	client = netsuite.NewClient()
+	switch os.Getenv("AUTH_TYPE") {
+	case "oauth":
+		oauthConfig := netsuite.NewOauth2Config(companyID)
+		oauthConfig.ClientID = os.Getenv("CLIENT_ID")
+		oauthConfig.ClientSecret = os.Getenv("CLIENT_SECRET")
+		if tokenURL := os.Getenv("TOKEN_URL"); tokenURL != "" {
+			oauthConfig.Endpoint.TokenURL = tokenURL
+		}
+		token := &oauth2.Token{RefreshToken: os.Getenv("REFRESH_TOKEN")}
+		client = netsuite.NewClient(netsuite.WithHTTPClient(oauthConfig.Client(context.Background(), token)))
+	case "token":
+		client.SetUseTokenAuth(true)
+		client.SetClientID(os.Getenv("CLIENT_ID"))
+		client.SetClientSecret(os.Getenv("CLIENT_SECRET"))
+		client.SetTokenID(os.Getenv("TOKEN_ID"))
+		client.SetTokenSecret(os.Getenv("TOKEN_SECRET"))
+	default:
+		log.Fatalf("Unknown auth type: %s", os.Getenv("AUTH_TYPE"))
+	}
+
+	client.SetCompanyID(companyID)
+	if baseURL := os.Getenv("BASE_URL"); baseURL != "" {
+		client.SetBaseURL(baseURL)
+	}
+	if os.Getenv("DEBUG") != "" {
+		client.SetDebug(true)
+	}
+
+	code := m.Run()
+	cleanup.run()
+	os.Exit(code)
+}
+
+// sandbox skips the test when the integration suite isn't enabled.
+func sandbox(t *testing.T) *netsuite.Client {
+	t.Helper()
+	if client == nil {
+		t.Skip("set NETSUITE_INTEGRATION to run against a sandbox account")
+	}
+	return client
+}
+
+// cleanup deletes the records that are left behind by failed tests.
+var cleanup = &cleaner{}
+
+type cleaner struct {
+	mu      sync.Mutex
+	records []createdRecord
+}
+
+type createdRecord struct {
+	recordType string
+	id         int
+}
+
+func (c *cleaner) add(recordType string, id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.records = append(c.records, createdRecord{recordType, id})
+}
+
+func (c *cleaner) remove(recordType string, id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, r := range c.records {
+		if r.recordType == recordType && r.id == id {
+			c.records = append(c.records[:i], c.records[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *cleaner) run() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.records) - 1; i >= 0; i-- {
+		r := c.records[i]
+		err := deleteRecord(r.recordType, r.id)
+		if err != nil && !isNotFound(err) {
+			log.Printf("cleanup of %s %d failed: %s", r.recordType, r.id, err)
+		}
+	}
+	c.records = nil
+}
+
+// create posts the request and returns the id of the created record, which
+// is deleted at the end of the test (and at the latest at the end of the
+// suite).
+func create(t *testing.T, recordType string, req netsuite.Request) int {
+	t.Helper()
+
+	httpReq, err := client.NewRequest(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(httpReq, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", resp.StatusCode)
+	}
+
+	id, err := strconv.Atoi(path.Base(resp.Header.Get("Location")))
+	if err != nil {
+		t.Fatalf("no id in location %q", resp.Header.Get("Location"))
+	}
+
+	cleanup.add(recordType, id)
+	t.Cleanup(func() {
+		err := deleteRecord(recordType, id)
+		if err != nil && !isNotFound(err) {
+			t.Errorf("cleanup of %s %d failed: %s", recordType, id, err)
+		}
+		cleanup.remove(recordType, id)
+	})
+	return id
+}
+
+func deleteRecord(recordType string, id int) error {
+	_, err := client.CustomRecord(recordType).Delete(context.Background(), id)
+	return err
+}
+
+func isNotFound(err error) bool {
+	errResp := &netsuite.ErrorResponse{}
+	return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound
+}
+
+func uniqueName(prefix string) string {
+	return fmt.Sprintf("%s %s", prefix, netsuite.GenerateNonce())
+}
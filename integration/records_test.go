@@ -0,0 +1,89 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestPhoneCallCRUD(t *testing.T) {
+	client := sandbox(t)
+	calls := client.PhoneCalls()
+
+	title := uniqueName("go-netsuite integration")
+	post := client.NewPhoneCallPostRequest()
+	post.RequestBody().Title = title
+	id := create(t, "phoneCall", &post)
+
+	call, err := calls.Get(context.Background(), id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.Title != title {
+		t.Errorf("expected title %q, got %q", title, call.Title)
+	}
+
+	body := netsuite.PhoneCallPatchRequestBody{}
+	body.Title = title + " (updated)"
+	_, err = calls.Update(context.Background(), id, body, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	call, err = calls.Get(context.Background(), id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.Title != body.Title {
+		t.Errorf("expected title %q, got %q", body.Title, call.Title)
+	}
+
+	_, err = calls.Delete(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = calls.Get(context.Background(), id, nil)
+	if !isNotFound(err) {
+		t.Errorf("expected the deleted record to be gone, got %v", err)
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	client := sandbox(t)
+
+	first, err := client.Currencies().List(context.Background(), &netsuite.CurrenciesGetRequestQueryParams{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Count != 1 || len(first.Items) != 1 {
+		t.Fatalf("expected a single currency, got %d", len(first.Items))
+	}
+	if !first.HasMore {
+		t.Skip("the account has a single currency")
+	}
+
+	second, err := client.Currencies().List(context.Background(), &netsuite.CurrenciesGetRequestQueryParams{Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Offset != 1 || len(second.Items) != 1 || second.Items[0].ID == first.Items[0].ID {
+		t.Errorf("expected the second page to hold the next currency: %+v", second)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	client := sandbox(t)
+
+	_, err := client.Customers().Get(context.Background(), 999999999, nil)
+	errResp := &netsuite.ErrorResponse{}
+	if !errors.As(err, &errResp) {
+		t.Fatalf("expected an error response, got %v", err)
+	}
+	if errResp.Response.StatusCode != http.StatusNotFound || len(errResp.ErrorDetails) == 0 {
+		t.Errorf("unexpected error response: %+v", errResp)
+	}
+}
@@ -0,0 +1,63 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestSuiteQLPagination(t *testing.T) {
+	client := sandbox(t)
+
+	seen := map[string]bool{}
+	offset := 0
+	for page := 0; page < 3; page++ {
+		resp, err := client.SuiteQL().Query(context.Background(), "SELECT id FROM currency ORDER BY id", 1, offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Offset != offset {
+			t.Errorf("expected offset %d, got %d", offset, resp.Offset)
+		}
+
+		items := []struct {
+			ID string `json:"id"`
+		}{}
+		err = json.Unmarshal(resp.Items, &items)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, item := range items {
+			if seen[item.ID] {
+				t.Errorf("currency %s returned twice", item.ID)
+			}
+			seen[item.ID] = true
+		}
+
+		if !resp.HasMore {
+			break
+		}
+		offset += resp.Count
+	}
+
+	if len(seen) == 0 {
+		t.Error("expected at least one currency")
+	}
+}
+
+func TestSuiteQLInvalidQuery(t *testing.T) {
+	client := sandbox(t)
+
+	_, err := client.SuiteQL().Query(context.Background(), "SELECT nonexistent FROM nowhere", 1, 0)
+	errResp := &netsuite.ErrorResponse{}
+	if !errors.As(err, &errResp) {
+		t.Fatalf("expected an error response, got %v", err)
+	}
+	if errResp.Response.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", errResp.Response.StatusCode)
+	}
+}
@@ -0,0 +1,158 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInterCompanyJournalEntriesGetRequest() InterCompanyJournalEntriesGetRequest {
+	r := InterCompanyJournalEntriesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InterCompanyJournalEntriesGetRequest struct {
+	client      *Client
+	queryParams *InterCompanyJournalEntriesGetRequestQueryParams
+	pathParams  *InterCompanyJournalEntriesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InterCompanyJournalEntriesGetRequestBody
+}
+
+func (r InterCompanyJournalEntriesGetRequest) NewQueryParams() *InterCompanyJournalEntriesGetRequestQueryParams {
+	return &InterCompanyJournalEntriesGetRequestQueryParams{}
+}
+
+type InterCompanyJournalEntriesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p InterCompanyJournalEntriesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) QueryParams() *InterCompanyJournalEntriesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InterCompanyJournalEntriesGetRequest) NewPathParams() *InterCompanyJournalEntriesGetRequestPathParams {
+	return &InterCompanyJournalEntriesGetRequestPathParams{}
+}
+
+type InterCompanyJournalEntriesGetRequestPathParams struct {
+}
+
+func (p *InterCompanyJournalEntriesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) PathParams() *InterCompanyJournalEntriesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InterCompanyJournalEntriesGetRequest) NewRequestBody() InterCompanyJournalEntriesGetRequestBody {
+	return InterCompanyJournalEntriesGetRequestBody{}
+}
+
+type InterCompanyJournalEntriesGetRequestBody struct {
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) RequestBody() *InterCompanyJournalEntriesGetRequestBody {
+	return nil
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) SetRequestBody(body InterCompanyJournalEntriesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) NewResponseBody() *InterCompanyJournalEntriesGetResponseBody {
+	return &InterCompanyJournalEntriesGetResponseBody{}
+}
+
+type InterCompanyJournalEntriesGetResponseBody struct {
+	Links []struct {
+		Rel  string `json:"rel"`
+		Href string `json:"href"`
+	} `json:"links"`
+	Count   int  `json:"count"`
+	HasMore bool `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/interCompanyJournalEntry", r.PathParams())
+	return &u, err
+}
+
+func (r *InterCompanyJournalEntriesGetRequest) Do(ctx context.Context) (InterCompanyJournalEntriesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,66 @@
+package netsuite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AddLine adds a line booked in the subsidiary with the given internal id, the
+// subsidiary or the to subsidiary of the entry.
+func (j *InterCompanyJournalEntry) AddLine(subsidiaryID string, line InterCompanyJournalEntryLineElement) {
+	line.LineSubsidiary = NewRecordRef(subsidiaryID)
+	j.Lines.Items = append(j.Lines.Items, line)
+}
+
+// Balances returns debit minus credit of the lines per subsidiary (internal
+// id). Lines without a line subsidiary book in the subsidiary of the entry.
+func (j InterCompanyJournalEntry) Balances() map[string]Decimal {
+	balances := map[string]Decimal{}
+	for _, l := range j.Lines.Items {
+		subsidiary := l.LineSubsidiary.ID
+		if subsidiary == "" {
+			subsidiary = j.Subsidiary.ID
+		}
+		balances[subsidiary] = balances[subsidiary].Add(l.Debit).Sub(l.Credit)
+	}
+	return balances
+}
+
+// Validate checks what NetSuite rejects an intercompany journal entry for:
+// the entry needs two different subsidiaries, every line has to book in one
+// of them and the lines of each subsidiary have to balance.
+func (j InterCompanyJournalEntry) Validate() error {
+	if j.Subsidiary.ID == "" || j.ToSubsidiary.ID == "" {
+		return errors.New("intercompany journal entry needs a subsidiary and a to subsidiary")
+	}
+	if j.Subsidiary.ID == j.ToSubsidiary.ID {
+		return errors.Errorf("intercompany journal entry between subsidiary %s and itself", j.Subsidiary.ID)
+	}
+
+	problems := []string{}
+	for i, l := range j.Lines.Items {
+		if s := l.LineSubsidiary.ID; s != "" && s != j.Subsidiary.ID && s != j.ToSubsidiary.ID {
+			problems = append(problems, fmt.Sprintf("line %d books in subsidiary %s", i+1, s))
+		}
+	}
+
+	balances := j.Balances()
+	subsidiaries := make([]string, 0, len(balances))
+	for s := range balances {
+		subsidiaries = append(subsidiaries, s)
+	}
+	sort.Strings(subsidiaries)
+	for _, s := range subsidiaries {
+		if b := balances[s]; !b.IsZero() {
+			problems = append(problems, fmt.Sprintf("lines of subsidiary %s are off by %s", s, b))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.Errorf("invalid intercompany journal entry: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInterCompanyJournalEntryGetRequest() InterCompanyJournalEntryGetRequest {
+	r := InterCompanyJournalEntryGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InterCompanyJournalEntryGetRequest struct {
+	client      *Client
+	queryParams *InterCompanyJournalEntryGetRequestQueryParams
+	pathParams  *InterCompanyJournalEntryGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InterCompanyJournalEntryGetRequestBody
+}
+
+func (r InterCompanyJournalEntryGetRequest) NewQueryParams() *InterCompanyJournalEntryGetRequestQueryParams {
+	return &InterCompanyJournalEntryGetRequestQueryParams{}
+}
+
+type InterCompanyJournalEntryGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p InterCompanyJournalEntryGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InterCompanyJournalEntryGetRequest) QueryParams() *InterCompanyJournalEntryGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InterCompanyJournalEntryGetRequest) NewPathParams() *InterCompanyJournalEntryGetRequestPathParams {
+	return &InterCompanyJournalEntryGetRequestPathParams{}
+}
+
+type InterCompanyJournalEntryGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *InterCompanyJournalEntryGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *InterCompanyJournalEntryGetRequest) PathParams() *InterCompanyJournalEntryGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntryGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntryGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InterCompanyJournalEntryGetRequest) Method() string {
+	return r.method
+}
+
+func (r *InterCompanyJournalEntryGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InterCompanyJournalEntryGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InterCompanyJournalEntryGetRequest) NewRequestBody() InterCompanyJournalEntryGetRequestBody {
+	return InterCompanyJournalEntryGetRequestBody{}
+}
+
+type InterCompanyJournalEntryGetRequestBody struct {
+}
+
+func (r *InterCompanyJournalEntryGetRequest) RequestBody() *InterCompanyJournalEntryGetRequestBody {
+	return nil
+}
+
+func (r *InterCompanyJournalEntryGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *InterCompanyJournalEntryGetRequest) SetRequestBody(body InterCompanyJournalEntryGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InterCompanyJournalEntryGetRequest) NewResponseBody() *InterCompanyJournalEntryGetResponseBody {
+	return &InterCompanyJournalEntryGetResponseBody{}
+}
+
+type InterCompanyJournalEntryGetResponseBody struct {
+	Links Links `json:"links"`
+	InterCompanyJournalEntry
+}
+
+// UnmarshalJSON decodes the links next to the embedded InterCompanyJournalEntry, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *InterCompanyJournalEntryGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.InterCompanyJournalEntry)
+}
+
+func (r *InterCompanyJournalEntryGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/interCompanyJournalEntry/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *InterCompanyJournalEntryGetRequest) Do(ctx context.Context) (InterCompanyJournalEntryGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
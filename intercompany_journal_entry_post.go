@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInterCompanyJournalEntryPostRequest() InterCompanyJournalEntryPostRequest {
+	r := InterCompanyJournalEntryPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InterCompanyJournalEntryPostRequest struct {
+	client      *Client
+	queryParams *InterCompanyJournalEntryPostRequestQueryParams
+	pathParams  *InterCompanyJournalEntryPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InterCompanyJournalEntryPostRequestBody
+}
+
+func (r InterCompanyJournalEntryPostRequest) NewQueryParams() *InterCompanyJournalEntryPostRequestQueryParams {
+	return &InterCompanyJournalEntryPostRequestQueryParams{}
+}
+
+type InterCompanyJournalEntryPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p InterCompanyJournalEntryPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InterCompanyJournalEntryPostRequest) QueryParams() *InterCompanyJournalEntryPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InterCompanyJournalEntryPostRequest) NewPathParams() *InterCompanyJournalEntryPostRequestPathParams {
+	return &InterCompanyJournalEntryPostRequestPathParams{}
+}
+
+type InterCompanyJournalEntryPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *InterCompanyJournalEntryPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *InterCompanyJournalEntryPostRequest) PathParams() *InterCompanyJournalEntryPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntryPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InterCompanyJournalEntryPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InterCompanyJournalEntryPostRequest) Method() string {
+	return r.method
+}
+
+func (r *InterCompanyJournalEntryPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InterCompanyJournalEntryPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InterCompanyJournalEntryPostRequest) NewRequestBody() InterCompanyJournalEntryPostRequestBody {
+	return InterCompanyJournalEntryPostRequestBody{}
+}
+
+type InterCompanyJournalEntryPostRequestBody struct {
+	InterCompanyJournalEntry
+}
+
+func (r *InterCompanyJournalEntryPostRequest) RequestBody() *InterCompanyJournalEntryPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *InterCompanyJournalEntryPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *InterCompanyJournalEntryPostRequest) SetRequestBody(body InterCompanyJournalEntryPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InterCompanyJournalEntryPostRequest) NewResponseBody() *InterCompanyJournalEntryPostResponseBody {
+	return &InterCompanyJournalEntryPostResponseBody{}
+}
+
+type InterCompanyJournalEntryPostResponseBody struct {
+}
+
+func (r *InterCompanyJournalEntryPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/interCompanyJournalEntry", r.PathParams())
+	return &u, err
+}
+
+func (r *InterCompanyJournalEntryPostRequest) Do(ctx context.Context) (InterCompanyJournalEntryPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,39 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestInterCompanyJournalEntryPost(t *testing.T) {
+	req := client.NewInterCompanyJournalEntryPostRequest()
+	req.RequestBody().Subsidiary.ID = "3"
+	req.RequestBody().ToSubsidiary.ID = "46"
+	req.RequestBody().AddLine("3", netsuite.InterCompanyJournalEntryLineElement{
+		Account: netsuite.Account{ID: "213"},
+		Debit:   netsuite.MustDecimal("100"),
+	})
+	req.RequestBody().AddLine("3", netsuite.InterCompanyJournalEntryLineElement{
+		Account: netsuite.Account{ID: "214"},
+		Credit:  netsuite.MustDecimal("100"),
+	})
+	req.RequestBody().AddLine("46", netsuite.InterCompanyJournalEntryLineElement{
+		Account: netsuite.Account{ID: "214"},
+		Debit:   netsuite.MustDecimal("100"),
+	})
+	req.RequestBody().AddLine("46", netsuite.InterCompanyJournalEntryLineElement{
+		Account: netsuite.Account{ID: "213"},
+		Credit:  netsuite.MustDecimal("100"),
+	})
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
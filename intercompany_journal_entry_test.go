@@ -0,0 +1,82 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestInterCompanyJournalEntryValidate(t *testing.T) {
+	entry := netsuite.InterCompanyJournalEntry{}
+	err := json.Unmarshal([]byte(`{
+		"subsidiary": {"id": "1"},
+		"toSubsidiary": {"id": "2"},
+		"line": {"links": [], "items": [
+			{"links": [], "line": 1, "account": {"id": "10"}, "debit": 100, "lineSubsidiary": {"id": "1"}, "dueToFromSubsidiary": {"id": "2"}},
+			{"links": [], "line": 2, "account": {"id": "11"}, "credit": 100, "lineSubsidiary": {"id": "1"}}
+		], "totalResults": 2}
+	}`), &entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Lines.Items[0].DueToFromSubsidiary.ID != "2" {
+		t.Errorf("expected due to/from subsidiary 2, got %+v", entry.Lines.Items[0])
+	}
+
+	entry.AddLine("2", netsuite.InterCompanyJournalEntryLineElement{Account: netsuite.Account{ID: "11"}, Debit: netsuite.MustDecimal("100")})
+	err = entry.Validate()
+	if err == nil || !strings.Contains(err.Error(), "lines of subsidiary 2 are off by 100") {
+		t.Errorf("expected subsidiary 2 to be unbalanced, got %v", err)
+	}
+
+	entry.AddLine("2", netsuite.InterCompanyJournalEntryLineElement{Account: netsuite.Account{ID: "10"}, Credit: netsuite.MustDecimal("100")})
+	err = entry.Validate()
+	if err != nil {
+		t.Error(err)
+	}
+
+	entry.AddLine("3", netsuite.InterCompanyJournalEntryLineElement{})
+	err = entry.Validate()
+	if err == nil || !strings.Contains(err.Error(), "line 5 books in subsidiary 3") {
+		t.Errorf("expected line 5 to be rejected, got %v", err)
+	}
+
+	entry.ToSubsidiary = entry.Subsidiary
+	if err = entry.Validate(); err == nil {
+		t.Error("expected an entry within one subsidiary to be rejected")
+	}
+}
+
+func TestInterCompanyJournalEntryMarshal(t *testing.T) {
+	entry := netsuite.InterCompanyJournalEntry{Subsidiary: netsuite.NewRecordRef("1"), ToSubsidiary: netsuite.NewRecordRef("2")}
+	entry.Lines.Links = netsuite.Links{{Rel: "self", Href: "https://example.com/line"}}
+	entry.AddLine("2", netsuite.InterCompanyJournalEntryLineElement{Account: netsuite.Account{ID: "11"}, Debit: netsuite.MustDecimal("100")})
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"line":{"items":[{"account":{"id":"11"},"debit":100,"lineSubsidiary":{"id":"2"}}]},"subsidiary":{"id":"1"},"toSubsidiary":{"id":"2"}}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestInterCompanyJournalEntryRoundTrip(t *testing.T) {
+	entry := netsuite.InterCompanyJournalEntry{}
+	err := json.Unmarshal([]byte(`{"id":"9","line":{"links":[{"rel":"self","href":"https://x/interCompanyJournalEntry/9/line"}],"totalResults":1,"items":[{"links":[{"rel":"self","href":"https://x/interCompanyJournalEntry/9/line/0"}],"line":1,"memo":"fee","custcol_ref":"R1"}]}}`), &entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(entry.Lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":[{"custcol_ref":"R1","line":1,"memo":"fee"}]}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInventoryAdjustmentGetRequest() InventoryAdjustmentGetRequest {
+	r := InventoryAdjustmentGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InventoryAdjustmentGetRequest struct {
+	client      *Client
+	queryParams *InventoryAdjustmentGetRequestQueryParams
+	pathParams  *InventoryAdjustmentGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InventoryAdjustmentGetRequestBody
+}
+
+func (r InventoryAdjustmentGetRequest) NewQueryParams() *InventoryAdjustmentGetRequestQueryParams {
+	return &InventoryAdjustmentGetRequestQueryParams{}
+}
+
+type InventoryAdjustmentGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p InventoryAdjustmentGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InventoryAdjustmentGetRequest) QueryParams() *InventoryAdjustmentGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InventoryAdjustmentGetRequest) NewPathParams() *InventoryAdjustmentGetRequestPathParams {
+	return &InventoryAdjustmentGetRequestPathParams{}
+}
+
+type InventoryAdjustmentGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *InventoryAdjustmentGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *InventoryAdjustmentGetRequest) PathParams() *InventoryAdjustmentGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InventoryAdjustmentGetRequest) Method() string {
+	return r.method
+}
+
+func (r *InventoryAdjustmentGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InventoryAdjustmentGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InventoryAdjustmentGetRequest) NewRequestBody() InventoryAdjustmentGetRequestBody {
+	return InventoryAdjustmentGetRequestBody{}
+}
+
+type InventoryAdjustmentGetRequestBody struct {
+}
+
+func (r *InventoryAdjustmentGetRequest) RequestBody() *InventoryAdjustmentGetRequestBody {
+	return nil
+}
+
+func (r *InventoryAdjustmentGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *InventoryAdjustmentGetRequest) SetRequestBody(body InventoryAdjustmentGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InventoryAdjustmentGetRequest) NewResponseBody() *InventoryAdjustmentGetResponseBody {
+	return &InventoryAdjustmentGetResponseBody{}
+}
+
+type InventoryAdjustmentGetResponseBody struct {
+	Links Links `json:"links"`
+	InventoryAdjustment
+}
+
+func (r *InventoryAdjustmentGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/inventoryAdjustment/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *InventoryAdjustmentGetRequest) Do(ctx context.Context) (InventoryAdjustmentGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
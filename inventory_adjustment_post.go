@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInventoryAdjustmentPostRequest() InventoryAdjustmentPostRequest {
+	r := InventoryAdjustmentPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InventoryAdjustmentPostRequest struct {
+	client      *Client
+	queryParams *InventoryAdjustmentPostRequestQueryParams
+	pathParams  *InventoryAdjustmentPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InventoryAdjustmentPostRequestBody
+}
+
+func (r InventoryAdjustmentPostRequest) NewQueryParams() *InventoryAdjustmentPostRequestQueryParams {
+	return &InventoryAdjustmentPostRequestQueryParams{}
+}
+
+type InventoryAdjustmentPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p InventoryAdjustmentPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InventoryAdjustmentPostRequest) QueryParams() *InventoryAdjustmentPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InventoryAdjustmentPostRequest) NewPathParams() *InventoryAdjustmentPostRequestPathParams {
+	return &InventoryAdjustmentPostRequestPathParams{}
+}
+
+type InventoryAdjustmentPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *InventoryAdjustmentPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *InventoryAdjustmentPostRequest) PathParams() *InventoryAdjustmentPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InventoryAdjustmentPostRequest) Method() string {
+	return r.method
+}
+
+func (r *InventoryAdjustmentPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InventoryAdjustmentPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InventoryAdjustmentPostRequest) NewRequestBody() InventoryAdjustmentPostRequestBody {
+	return InventoryAdjustmentPostRequestBody{}
+}
+
+type InventoryAdjustmentPostRequestBody struct {
+	InventoryAdjustment
+}
+
+func (r *InventoryAdjustmentPostRequest) RequestBody() *InventoryAdjustmentPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *InventoryAdjustmentPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *InventoryAdjustmentPostRequest) SetRequestBody(body InventoryAdjustmentPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InventoryAdjustmentPostRequest) NewResponseBody() *InventoryAdjustmentPostResponseBody {
+	return &InventoryAdjustmentPostResponseBody{}
+}
+
+type InventoryAdjustmentPostResponseBody struct {
+}
+
+func (r *InventoryAdjustmentPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/inventoryAdjustment", r.PathParams())
+	return &u, err
+}
+
+func (r *InventoryAdjustmentPostRequest) Do(ctx context.Context) (InventoryAdjustmentPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
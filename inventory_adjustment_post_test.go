@@ -0,0 +1,43 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestInventoryAdjustmentPost(t *testing.T) {
+	req := client.NewInventoryAdjustmentPostRequest()
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Account.ID = "213"
+	req.RequestBody().Inventory = netsuite.InventoryAdjustmentInventory{
+		Items: netsuite.InventoryAdjustmentInventoryItems{
+			{
+				Item:        netsuite.RecordRef{ID: "131"},
+				Location:    netsuite.RecordRef{ID: "5"},
+				AdjustQtyBy: 2,
+				InventoryDetail: netsuite.InventoryDetail{
+					InventoryAssignment: netsuite.InventoryDetailAssignments{
+						Items: netsuite.InventoryDetailAssignmentItems{
+							{
+								BinNumber:              netsuite.RecordRef{ID: "12"},
+								ReceiptInventoryNumber: "LOT-2022-03",
+								Quantity:               2,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewInventoryAdjustmentsGetRequest() InventoryAdjustmentsGetRequest {
+	r := InventoryAdjustmentsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type InventoryAdjustmentsGetRequest struct {
+	client      *Client
+	queryParams *InventoryAdjustmentsGetRequestQueryParams
+	pathParams  *InventoryAdjustmentsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody InventoryAdjustmentsGetRequestBody
+}
+
+func (r InventoryAdjustmentsGetRequest) NewQueryParams() *InventoryAdjustmentsGetRequestQueryParams {
+	return &InventoryAdjustmentsGetRequestQueryParams{}
+}
+
+type InventoryAdjustmentsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p InventoryAdjustmentsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *InventoryAdjustmentsGetRequest) QueryParams() *InventoryAdjustmentsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r InventoryAdjustmentsGetRequest) NewPathParams() *InventoryAdjustmentsGetRequestPathParams {
+	return &InventoryAdjustmentsGetRequestPathParams{}
+}
+
+type InventoryAdjustmentsGetRequestPathParams struct {
+}
+
+func (p *InventoryAdjustmentsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *InventoryAdjustmentsGetRequest) PathParams() *InventoryAdjustmentsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *InventoryAdjustmentsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *InventoryAdjustmentsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *InventoryAdjustmentsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InventoryAdjustmentsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r InventoryAdjustmentsGetRequest) NewRequestBody() InventoryAdjustmentsGetRequestBody {
+	return InventoryAdjustmentsGetRequestBody{}
+}
+
+type InventoryAdjustmentsGetRequestBody struct {
+}
+
+func (r *InventoryAdjustmentsGetRequest) RequestBody() *InventoryAdjustmentsGetRequestBody {
+	return nil
+}
+
+func (r *InventoryAdjustmentsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *InventoryAdjustmentsGetRequest) SetRequestBody(body InventoryAdjustmentsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *InventoryAdjustmentsGetRequest) NewResponseBody() *InventoryAdjustmentsGetResponseBody {
+	return &InventoryAdjustmentsGetResponseBody{}
+}
+
+type InventoryAdjustmentsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *InventoryAdjustmentsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/inventoryAdjustment", r.PathParams())
+	return &u, err
+}
+
+func (r *InventoryAdjustmentsGetRequest) Do(ctx context.Context) (InventoryAdjustmentsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
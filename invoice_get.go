@@ -1,6 +1,8 @@
 package netsuite
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +90,14 @@ func (r *InvoiceGetRequest) Method() string {
 	return r.method
 }
 
+func (r *InvoiceGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InvoiceGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r InvoiceGetRequest) NewRequestBody() InvoiceGetRequestBody {
 	return InvoiceGetRequestBody{}
 }
@@ -116,14 +126,29 @@ type InvoiceGetResponseBody struct {
 	Invoice
 }
 
+// UnmarshalJSON decodes the links next to the embedded Invoice, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *InvoiceGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.Invoice)
+}
+
 func (r *InvoiceGetRequest) URL() (*url.URL, error) {
 	u, err := r.client.GetEndpointURL("/record/v1/invoice/{{.id}}", r.PathParams())
 	return &u, err
 }
 
-func (r *InvoiceGetRequest) Do() (InvoiceGetResponseBody, error) {
+func (r *InvoiceGetRequest) Do(ctx context.Context) (InvoiceGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +89,14 @@ func (r *InvoicePostRequest) Method() string {
 	return r.method
 }
 
+func (r *InvoicePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InvoicePostRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r InvoicePostRequest) NewRequestBody() InvoicePostRequestBody {
 	return InvoicePostRequestBody{}
 }
@@ -120,9 +129,9 @@ func (r *InvoicePostRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *InvoicePostRequest) Do() (InvoicePostResponseBody, error) {
+func (r *InvoicePostRequest) Do(ctx context.Context) (InvoicePostResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
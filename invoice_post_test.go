@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -19,16 +20,16 @@ func TestInvoicePost(t *testing.T) {
 				Account: netsuite.Account{
 					ID: "635",
 				},
-				Amount: 80000,
+				Amount: netsuite.MustDecimal("80000"),
 				Item: netsuite.InvoiceItemItemItem{
-					ID: 131,
+					ID: "131",
 				},
 				ItemSubType: "Resale",
 				ItemType:    "NonInvtPart",
 			},
 		},
 	}
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
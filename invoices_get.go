@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *InvoicesGetRequest) Method() string {
 	return r.method
 }
 
+func (r *InvoicesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *InvoicesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r InvoicesGetRequest) NewRequestBody() InvoicesGetRequestBody {
 	return InvoicesGetRequestBody{}
 }
@@ -130,9 +139,9 @@ func (r *InvoicesGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *InvoicesGetRequest) Do() (InvoicesGetResponseBody, error) {
+func (r *InvoicesGetRequest) Do(ctx context.Context) (InvoicesGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -0,0 +1,152 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewItemPricesGetRequest() ItemPricesGetRequest {
+	r := ItemPricesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ItemPricesGetRequest struct {
+	client      *Client
+	queryParams *ItemPricesGetRequestQueryParams
+	pathParams  *ItemPricesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ItemPricesGetRequestBody
+}
+
+func (r ItemPricesGetRequest) NewQueryParams() *ItemPricesGetRequestQueryParams {
+	return &ItemPricesGetRequestQueryParams{}
+}
+
+type ItemPricesGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ItemPricesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ItemPricesGetRequest) QueryParams() *ItemPricesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ItemPricesGetRequest) NewPathParams() *ItemPricesGetRequestPathParams {
+	return &ItemPricesGetRequestPathParams{}
+}
+
+type ItemPricesGetRequestPathParams struct {
+	ItemType string `schema:"item_type"`
+	ID       int    `schema:"id"`
+}
+
+func (p *ItemPricesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"item_type": p.ItemType,
+		"id":        strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ItemPricesGetRequest) PathParams() *ItemPricesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ItemPricesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ItemPricesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ItemPricesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ItemPricesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ItemPricesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ItemPricesGetRequest) NewRequestBody() ItemPricesGetRequestBody {
+	return ItemPricesGetRequestBody{}
+}
+
+type ItemPricesGetRequestBody struct {
+}
+
+func (r *ItemPricesGetRequest) RequestBody() *ItemPricesGetRequestBody {
+	return nil
+}
+
+func (r *ItemPricesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ItemPricesGetRequest) SetRequestBody(body ItemPricesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ItemPricesGetRequest) NewResponseBody() *ItemPricesGetResponseBody {
+	return &ItemPricesGetResponseBody{}
+}
+
+type ItemPricesGetResponseBody struct {
+	Links        Links      `json:"links"`
+	Items        ItemPrices `json:"items"`
+	TotalResults int        `json:"totalResults"`
+}
+
+func (r *ItemPricesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.item_type}}/{{.id}}/price", r.PathParams())
+	return &u, err
+}
+
+func (r *ItemPricesGetRequest) Do(ctx context.Context) (ItemPricesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
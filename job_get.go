@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewJobGetRequest() JobGetRequest {
+	r := JobGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type JobGetRequest struct {
+	client      *Client
+	queryParams *JobGetRequestQueryParams
+	pathParams  *JobGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody JobGetRequestBody
+}
+
+func (r JobGetRequest) NewQueryParams() *JobGetRequestQueryParams {
+	return &JobGetRequestQueryParams{}
+}
+
+type JobGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p JobGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *JobGetRequest) QueryParams() *JobGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r JobGetRequest) NewPathParams() *JobGetRequestPathParams {
+	return &JobGetRequestPathParams{}
+}
+
+type JobGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *JobGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *JobGetRequest) PathParams() *JobGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *JobGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *JobGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *JobGetRequest) Method() string {
+	return r.method
+}
+
+func (r *JobGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JobGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r JobGetRequest) NewRequestBody() JobGetRequestBody {
+	return JobGetRequestBody{}
+}
+
+type JobGetRequestBody struct {
+}
+
+func (r *JobGetRequest) RequestBody() *JobGetRequestBody {
+	return nil
+}
+
+func (r *JobGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *JobGetRequest) SetRequestBody(body JobGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *JobGetRequest) NewResponseBody() *JobGetResponseBody {
+	return &JobGetResponseBody{}
+}
+
+type JobGetResponseBody struct {
+	Links Links `json:"links"`
+	Job
+}
+
+func (r *JobGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/job/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *JobGetRequest) Do(ctx context.Context) (JobGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewJobPatchRequest() JobPatchRequest {
+	r := JobPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type JobPatchRequest struct {
+	client      *Client
+	queryParams *JobPatchRequestQueryParams
+	pathParams  *JobPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody JobPatchRequestBody
+}
+
+func (r JobPatchRequest) NewQueryParams() *JobPatchRequestQueryParams {
+	return &JobPatchRequestQueryParams{}
+}
+
+type JobPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p JobPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *JobPatchRequest) QueryParams() *JobPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r JobPatchRequest) NewPathParams() *JobPatchRequestPathParams {
+	return &JobPatchRequestPathParams{}
+}
+
+type JobPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *JobPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *JobPatchRequest) PathParams() *JobPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *JobPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *JobPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *JobPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *JobPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JobPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r JobPatchRequest) NewRequestBody() JobPatchRequestBody {
+	return JobPatchRequestBody{}
+}
+
+type JobPatchRequestBody struct {
+	Job
+}
+
+func (r *JobPatchRequest) RequestBody() *JobPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *JobPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *JobPatchRequest) SetRequestBody(body JobPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *JobPatchRequest) NewResponseBody() *JobPatchResponseBody {
+	return &JobPatchResponseBody{}
+}
+
+type JobPatchResponseBody struct {
+}
+
+func (r *JobPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/job/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *JobPatchRequest) Do(ctx context.Context) (JobPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestJobPatch(t *testing.T) {
+	req := client.NewJobPatchRequest()
+	req.PathParams().ID = 3214
+	req.RequestBody().PercentCompleteOverride = 50
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewJobPostRequest() JobPostRequest {
+	r := JobPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type JobPostRequest struct {
+	client      *Client
+	queryParams *JobPostRequestQueryParams
+	pathParams  *JobPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody JobPostRequestBody
+}
+
+func (r JobPostRequest) NewQueryParams() *JobPostRequestQueryParams {
+	return &JobPostRequestQueryParams{}
+}
+
+type JobPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p JobPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *JobPostRequest) QueryParams() *JobPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r JobPostRequest) NewPathParams() *JobPostRequestPathParams {
+	return &JobPostRequestPathParams{}
+}
+
+type JobPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *JobPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *JobPostRequest) PathParams() *JobPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *JobPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *JobPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *JobPostRequest) Method() string {
+	return r.method
+}
+
+func (r *JobPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JobPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r JobPostRequest) NewRequestBody() JobPostRequestBody {
+	return JobPostRequestBody{}
+}
+
+type JobPostRequestBody struct {
+	Job
+}
+
+func (r *JobPostRequest) RequestBody() *JobPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *JobPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *JobPostRequest) SetRequestBody(body JobPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *JobPostRequest) NewResponseBody() *JobPostResponseBody {
+	return &JobPostResponseBody{}
+}
+
+type JobPostResponseBody struct {
+}
+
+func (r *JobPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/job", r.PathParams())
+	return &u, err
+}
+
+func (r *JobPostRequest) Do(ctx context.Context) (JobPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
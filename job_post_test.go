@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestJobPost(t *testing.T) {
+	req := client.NewJobPostRequest()
+	req.RequestBody().CompanyName = "Omniboost implementation"
+	req.RequestBody().Parent.ID = "70202"
+	req.RequestBody().Subsidiary.ID = "46"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewJobsGetRequest() JobsGetRequest {
+	r := JobsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type JobsGetRequest struct {
+	client      *Client
+	queryParams *JobsGetRequestQueryParams
+	pathParams  *JobsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody JobsGetRequestBody
+}
+
+func (r JobsGetRequest) NewQueryParams() *JobsGetRequestQueryParams {
+	return &JobsGetRequestQueryParams{}
+}
+
+type JobsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p JobsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *JobsGetRequest) QueryParams() *JobsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r JobsGetRequest) NewPathParams() *JobsGetRequestPathParams {
+	return &JobsGetRequestPathParams{}
+}
+
+type JobsGetRequestPathParams struct {
+}
+
+func (p *JobsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *JobsGetRequest) PathParams() *JobsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *JobsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *JobsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *JobsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *JobsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JobsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r JobsGetRequest) NewRequestBody() JobsGetRequestBody {
+	return JobsGetRequestBody{}
+}
+
+type JobsGetRequestBody struct {
+}
+
+func (r *JobsGetRequest) RequestBody() *JobsGetRequestBody {
+	return nil
+}
+
+func (r *JobsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *JobsGetRequest) SetRequestBody(body JobsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *JobsGetRequest) NewResponseBody() *JobsGetResponseBody {
+	return &JobsGetResponseBody{}
+}
+
+type JobsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *JobsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/job", r.PathParams())
+	return &u, err
+}
+
+func (r *JobsGetRequest) Do(ctx context.Context) (JobsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *JournalEntriesGetRequest) Method() string {
 	return r.method
 }
 
+func (r *JournalEntriesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JournalEntriesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r JournalEntriesGetRequest) NewRequestBody() JournalEntriesGetRequestBody {
 	return JournalEntriesGetRequestBody{}
 }
@@ -130,9 +139,9 @@ func (r *JournalEntriesGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *JournalEntriesGetRequest) Do() (JournalEntriesGetResponseBody, error) {
+func (r *JournalEntriesGetRequest) Do(ctx context.Context) (JournalEntriesGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
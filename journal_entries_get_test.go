@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -8,7 +9,7 @@ import (
 
 func TestJournalEntriesGet(t *testing.T) {
 	req := client.NewJournalEntriesGetRequest()
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
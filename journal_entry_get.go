@@ -1,6 +1,8 @@
 package netsuite
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +90,14 @@ func (r *JournalEntryGetRequest) Method() string {
 	return r.method
 }
 
+func (r *JournalEntryGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JournalEntryGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r JournalEntryGetRequest) NewRequestBody() JournalEntryGetRequestBody {
 	return JournalEntryGetRequestBody{}
 }
@@ -116,14 +126,29 @@ type JournalEntryGetResponseBody struct {
 	JournalEntry
 }
 
+// UnmarshalJSON decodes the links next to the embedded JournalEntry, which has its
+// own UnmarshalJSON to capture custom fields.
+func (r *JournalEntryGetResponseBody) UnmarshalJSON(data []byte) error {
+	links := struct {
+		Links Links `json:"links"`
+	}{}
+	err := json.Unmarshal(data, &links)
+	if err != nil {
+		return err
+	}
+
+	r.Links = links.Links
+	return json.Unmarshal(data, &r.JournalEntry)
+}
+
 func (r *JournalEntryGetRequest) URL() (*url.URL, error) {
 	u, err := r.client.GetEndpointURL("/record/v1/journalEntry/{{.id}}", r.PathParams())
 	return &u, err
 }
 
-func (r *JournalEntryGetRequest) Do() (JournalEntryGetResponseBody, error) {
+func (r *JournalEntryGetRequest) Do(ctx context.Context) (JournalEntryGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
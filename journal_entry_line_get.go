@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -89,6 +90,14 @@ func (r *JournalEntryLineGetRequest) Method() string {
 	return r.method
 }
 
+func (r *JournalEntryLineGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JournalEntryLineGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r JournalEntryLineGetRequest) NewRequestBody() JournalEntryLineGetRequestBody {
 	return JournalEntryLineGetRequestBody{}
 }
@@ -120,8 +129,8 @@ type JournalEntryLineGetResponseBody struct {
 		RefName string        `json:"refName"`
 	} `json:"account"`
 	Cleared             bool    `json:"cleared"`
-	Credit              float64 `json:"credit"`
-	Debit               float64 `json:"debit"`
+	Credit              Decimal `json:"credit"`
+	Debit               Decimal `json:"debit"`
 	Custcol2663Isperson bool    `json:"custcol_2663_isperson"`
 	Eliminate           bool    `json:"eliminate"`
 	Line                int     `json:"line"`
@@ -132,9 +141,9 @@ func (r *JournalEntryLineGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *JournalEntryLineGetRequest) Do() (JournalEntryLineGetResponseBody, error) {
+func (r *JournalEntryLineGetRequest) Do(ctx context.Context) (JournalEntryLineGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -87,6 +88,14 @@ func (r *JournalEntryLinesGetRequest) Method() string {
 	return r.method
 }
 
+func (r *JournalEntryLinesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JournalEntryLinesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r JournalEntryLinesGetRequest) NewRequestBody() JournalEntryLinesGetRequestBody {
 	return JournalEntryLinesGetRequestBody{}
 }
@@ -126,9 +135,9 @@ func (r *JournalEntryLinesGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *JournalEntryLinesGetRequest) Do() (JournalEntryLinesGetResponseBody, error) {
+func (r *JournalEntryLinesGetRequest) Do(ctx context.Context) (JournalEntryLinesGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -10,7 +11,7 @@ func TestJournalEntryLinesGet(t *testing.T) {
 	req := client.NewJournalEntryLinesGetRequest()
 	req.PathParams().ID = 2248
 	// req.QueryParams().Fields = netsuite.Fields{"line"}
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
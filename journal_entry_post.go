@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -88,6 +89,14 @@ func (r *JournalEntryPostRequest) Method() string {
 	return r.method
 }
 
+func (r *JournalEntryPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *JournalEntryPostRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r JournalEntryPostRequest) NewRequestBody() JournalEntryPostRequestBody {
 	return JournalEntryPostRequestBody{}
 }
@@ -120,9 +129,9 @@ func (r *JournalEntryPostRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *JournalEntryPostRequest) Do() (JournalEntryPostResponseBody, error) {
+func (r *JournalEntryPostRequest) Do(ctx context.Context) (JournalEntryPostResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
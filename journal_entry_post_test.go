@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"testing"
@@ -19,8 +20,8 @@ func TestJournalEntryPost(t *testing.T) {
 					// RefName: "722280 Other Exp. : IntExp : IntExp Loan Borrowings (IC)",
 					// AcctNumber: "121110",
 				},
-				Debit:  100,
-				Credit: 0,
+				Debit:  netsuite.MustDecimal("100"),
+				Credit: netsuite.MustDecimal("0"),
 			},
 			{
 				Account: netsuite.Account{
@@ -28,12 +29,12 @@ func TestJournalEntryPost(t *testing.T) {
 					// RefName: "722280 Other Exp. : IntExp : IntExp Loan Borrowings (IC)",
 					// AcctNumber: "121110",
 				},
-				Debit:  0,
-				Credit: 100,
+				Debit:  netsuite.MustDecimal("0"),
+				Credit: netsuite.MustDecimal("100"),
 			},
 		},
 	}
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -2,33 +2,86 @@ package netsuite
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	// DateFormat is the format of date fields
+	DateFormat = "2006-01-02"
+	// DateTimeFormat is the format of datetime fields
+	DateTimeFormat = time.RFC3339
+)
+
+var (
+	// dateLayouts are tried in order when a date is decoded: the REST API
+	// returns dates as 2006-01-02 but datetimes, SuiteQL results and
+	// (saved) searches use other formats.
+	dateLayouts = []string{
+		DateFormat,
+		time.RFC3339,
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"02/01/2006",
+		"02.01.2006",
+	}
+
+	// dateTimeLayouts are tried in order when a datetime is decoded. A
+	// datetime without timezone is in the timezone of the account and decoded
+	// as UTC.
+	dateTimeLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04",
+		DateFormat,
+	}
+)
+
+// Date is a date field. It's encoded as 2006-01-02 and an empty Date as
+// null; empty strings are decoded as an empty Date.
 type Date struct {
 	time.Time
 }
 
+// NewDate returns the date year-month-day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseDate parses value in one of the formats NetSuite uses for dates.
+func ParseDate(value string) (Date, error) {
+	t, err := parseTime(value, dateLayouts)
+	return Date{Time: t}, err
+}
+
 func (d Date) MarshalSchema() string {
-	return d.Time.Format("2006-01-02")
+	return d.Time.Format(DateFormat)
 }
 
 func (d Date) IsEmpty() bool {
 	return d.Time.IsZero()
 }
 
-type DateTime struct {
-	time.Time
+func (d Date) String() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	return d.Time.Format(DateFormat)
 }
 
-func (d *Date) MarshalJSON() ([]byte, error) {
+func (d Date) MarshalJSON() ([]byte, error) {
 	if d.Time.IsZero() {
 		return json.Marshal(nil)
 	}
 
-	return json.Marshal(d.Time.Format("2006-01-02T15:04:05"))
+	return json.Marshal(d.Time.Format(DateFormat))
 }
 
 func (d *Date) UnmarshalJSON(text []byte) (err error) {
@@ -38,45 +91,44 @@ func (d *Date) UnmarshalJSON(text []byte) (err error) {
 		return err
 	}
 
-	if value == "" {
-		return nil
-	}
-
-	// first try standard date
-	d.Time, err = time.Parse(time.RFC3339, value)
-	if err == nil {
-		return nil
-	}
+	*d, err = ParseDate(value)
+	return err
+}
 
-	d.Time, err = time.Parse("2006-01-02", value)
-	if err == nil {
-		return nil
-	}
+// DateTime is a datetime field. It's encoded as RFC 3339 and an empty
+// DateTime as null; empty strings are decoded as an empty DateTime.
+type DateTime struct {
+	time.Time
+}
 
-	d.Time, err = time.Parse("02/01/2006", value)
-	if err == nil {
-		return nil
-	}
+// ParseDateTime parses value in one of the formats NetSuite uses for
+// datetimes.
+func ParseDateTime(value string) (DateTime, error) {
+	t, err := parseTime(value, dateTimeLayouts)
+	return DateTime{Time: t}, err
+}
 
-	d.Time, err = time.Parse("02.01.2006", value)
-	if err == nil {
-		return nil
-	}
+func (dt DateTime) MarshalSchema() string {
+	return dt.Time.Format(DateTimeFormat)
+}
 
-	d.Time, err = time.Parse("2006-01-02T15:04:05", value)
-	return err
+func (dt DateTime) IsEmpty() bool {
+	return dt.Time.IsZero()
 }
 
-func (d DateTime) MarshalSchema() string {
-	return d.Time.Format(time.RFC3339)
+func (dt DateTime) String() string {
+	if dt.IsEmpty() {
+		return ""
+	}
+	return dt.Time.Format(DateTimeFormat)
 }
 
-func (dt *DateTime) MarshalJSON() ([]byte, error) {
+func (dt DateTime) MarshalJSON() ([]byte, error) {
 	if dt.Time.IsZero() {
 		return json.Marshal(nil)
 	}
 
-	return json.Marshal(dt.Time.Format("2006-01-02T15:04:05"))
+	return json.Marshal(dt.Time.Format(DateTimeFormat))
 }
 
 func (dt *DateTime) UnmarshalJSON(text []byte) (err error) {
@@ -86,20 +138,107 @@ func (dt *DateTime) UnmarshalJSON(text []byte) (err error) {
 		return err
 	}
 
+	*dt, err = ParseDateTime(value)
+	return err
+}
+
+func parseTime(value string, layouts []string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("unknown date format: %s", value)
+}
+
+// Duration is a duration field, e.g. the hours of a time bill. NetSuite
+// returns durations as a number of hours (1.5) or as hours and minutes
+// ("1:30"); it's encoded as a number of hours.
+type Duration struct {
+	time.Duration
+}
+
+// ParseDuration parses a number of hours (1.5) or hours and minutes (1:30).
+func ParseDuration(value string) (Duration, error) {
+	value = strings.TrimSpace(value)
 	if value == "" {
-		return nil
+		return Duration{}, nil
 	}
 
-	// first try standard date
-	dt.Time, err = time.Parse(time.RFC3339, value)
-	if err == nil {
+	if hours, minutes, ok := strings.Cut(value, ":"); ok {
+		h, err := strconv.Atoi(hours)
+		if err != nil {
+			return Duration{}, errors.Errorf("invalid duration: %s", value)
+		}
+		m, err := strconv.Atoi(minutes)
+		if err != nil || m < 0 || m >= 60 {
+			return Duration{}, errors.Errorf("invalid duration: %s", value)
+		}
+
+		d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+		if strings.HasPrefix(hours, "-") {
+			d = time.Duration(h)*time.Hour - time.Duration(m)*time.Minute
+		}
+		return Duration{Duration: d}, nil
+	}
+
+	hours, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return Duration{}, errors.Errorf("invalid duration: %s", value)
+	}
+	return Duration{Duration: time.Duration(hours * float64(time.Hour)).Round(time.Second)}, nil
+}
+
+func (d Duration) IsEmpty() bool {
+	return d.Duration == 0
+}
+
+// String returns the duration as hours and minutes, e.g. 1:30.
+func (d Duration) String() string {
+	sign := ""
+	minutes := int64(d.Duration.Round(time.Minute) / time.Minute)
+	if minutes < 0 {
+		sign = "-"
+		minutes = -minutes
+	}
+	return fmt.Sprintf("%s%d:%02d", sign, minutes/60, minutes%60)
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Duration.Hours())
+}
+
+func (d *Duration) UnmarshalJSON(text []byte) (err error) {
+	var value interface{}
+	err = json.Unmarshal(text, &value)
+	if err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		*d = Duration{}
 		return nil
+	case float64:
+		*d = Duration{Duration: time.Duration(v * float64(time.Hour)).Round(time.Second)}
+		return nil
+	case string:
+		*d, err = ParseDuration(v)
+		return err
 	}
 
-	dt.Time, err = time.Parse("2006-01-02T15:04:05", value)
-	return err
+	return errors.Errorf("invalid duration: %s", text)
 }
 
+// Bool decodes json booleans as well as the "T" and "F" strings SuiteQL
+// returns for checkbox columns.
 type Bool bool
 
 func (b *Bool) UnmarshalJSON(text []byte) (err error) {
@@ -112,17 +251,18 @@ func (b *Bool) UnmarshalJSON(text []byte) (err error) {
 
 	var str string
 	err = json.Unmarshal(text, &str)
-	if err == nil {
-		return nil
+	if err != nil {
+		return err
 	}
 
-	if str == "" {
+	switch str {
+	case "T", "true":
+		*b = true
 		return nil
-	}
-
-	if str == "F" {
+	case "F", "false", "":
 		*b = false
+		return nil
 	}
 
-	return errors.New("FML")
+	return errors.Errorf("invalid bool: %s", text)
 }
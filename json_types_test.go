@@ -0,0 +1,119 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/omitempty"
+)
+
+type timeTestRecord struct {
+	TranDate    netsuite.Date     `json:"tranDate,omitempty"`
+	DueDate     netsuite.Date     `json:"dueDate,omitempty"`
+	DateCreated netsuite.DateTime `json:"dateCreated,omitempty"`
+	Hours       netsuite.Duration `json:"hours,omitempty"`
+}
+
+func (r timeTestRecord) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func TestTimeTypesUnmarshal(t *testing.T) {
+	tests := []struct {
+		data     string
+		tranDate time.Time
+		created  time.Time
+		hours    time.Duration
+	}{
+		{
+			data:     `{"tranDate":"2023-03-15","dateCreated":"2023-03-15T10:30:00Z","hours":1.5}`,
+			tranDate: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC),
+			created:  time.Date(2023, 3, 15, 10, 30, 0, 0, time.UTC),
+			hours:    90 * time.Minute,
+		},
+		{
+			data:    `{"tranDate":"","dateCreated":"2023-03-15T10:30:00.000+0100","hours":"2:15"}`,
+			created: time.Date(2023, 3, 15, 9, 30, 0, 0, time.UTC),
+			hours:   135 * time.Minute,
+		},
+		{
+			data:     `{"tranDate":"15.03.2023","dateCreated":"2023-03-15 10:30:00","hours":null}`,
+			tranDate: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC),
+			created:  time.Date(2023, 3, 15, 10, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		r := timeTestRecord{}
+		err := json.Unmarshal([]byte(tt.data), &r)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.data, err)
+		}
+
+		if !r.TranDate.Equal(tt.tranDate) {
+			t.Errorf("%s: expected tranDate %s, got %s", tt.data, tt.tranDate, r.TranDate)
+		}
+		if !r.DateCreated.Equal(tt.created) {
+			t.Errorf("%s: expected dateCreated %s, got %s", tt.data, tt.created, r.DateCreated)
+		}
+		if r.Hours.Duration != tt.hours {
+			t.Errorf("%s: expected hours %s, got %s", tt.data, tt.hours, r.Hours.Duration)
+		}
+	}
+
+	r := timeTestRecord{}
+	if err := json.Unmarshal([]byte(`{"tranDate":"yesterday"}`), &r); err == nil {
+		t.Errorf("expected an error for an unknown date format")
+	}
+}
+
+func TestBoolUnmarshal(t *testing.T) {
+	tests := map[string]bool{
+		`true`:  true,
+		`false`: false,
+		`"T"`:   true,
+		`"F"`:   false,
+		`""`:    false,
+		`null`:  false,
+	}
+
+	for data, expected := range tests {
+		var b netsuite.Bool
+		err := json.Unmarshal([]byte(data), &b)
+		if err != nil {
+			t.Fatalf("%s: %s", data, err)
+		}
+		if bool(b) != expected {
+			t.Errorf("%s: expected %v, got %v", data, expected, b)
+		}
+	}
+
+	var b netsuite.Bool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Errorf("expected an error for an unknown bool")
+	}
+}
+
+func TestTimeTypesMarshal(t *testing.T) {
+	r := timeTestRecord{
+		TranDate:    netsuite.NewDate(2023, time.March, 15),
+		DateCreated: netsuite.DateTime{Time: time.Date(2023, 3, 15, 10, 30, 0, 0, time.UTC)},
+		Hours:       netsuite.Duration{Duration: 90 * time.Minute},
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"tranDate":"2023-03-15","dateCreated":"2023-03-15T10:30:00Z","hours":1.5}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+
+	if r.Hours.String() != "1:30" {
+		t.Errorf("expected 1:30, got %s", r.Hours)
+	}
+}
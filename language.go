@@ -0,0 +1,28 @@
+package netsuite
+
+import "context"
+
+type languageKey struct{}
+
+// WithRequestLanguage returns a context that makes the requests sent with it
+// use language (e.g. "nl-NL") for the translatable fields instead of the
+// content language of the client, e.g. to fetch the display names of items
+// in several languages with one client:
+//
+//	for _, lang := range []string{"en-US", "nl-NL", "de-DE"} {
+//		item, err := items.Get(netsuite.WithRequestLanguage(ctx, lang), id, nil)
+//	}
+func WithRequestLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, languageKey{}, language)
+}
+
+// requestLanguage returns the language of ctx, or the default.
+func requestLanguage(ctx context.Context, def string) string {
+	if ctx == nil {
+		return def
+	}
+	if language, ok := ctx.Value(languageKey{}).(string); ok && language != "" {
+		return language
+	}
+	return def
+}
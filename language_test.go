@@ -0,0 +1,43 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestRequestLanguage(t *testing.T) {
+	names := map[string]string{"en-US": "Net 30", "nl-NL": "Netto 30", "de-DE": "Netto 30 Tage"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Write([]byte(`{"id":"1","name":"` + names[r.Header.Get("Accept-Language")] + `"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithContentLanguage("en-US"),
+		netsuite.WithCache(netsuite.CachePolicy{TTLs: map[string]time.Duration{"term": time.Hour}}),
+	)
+	terms := netsuite.NewService[netsuite.Term](c, "term")
+	ctx := context.Background()
+
+	// the cache keeps a response per language
+	for i := 0; i < 2; i++ {
+		for lang, name := range map[string]string{"": "Net 30", "nl-NL": "Netto 30", "de-DE": "Netto 30 Tage"} {
+			term, err := terms.Get(netsuite.WithRequestLanguage(ctx, lang), "1", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if term.Name != name {
+				t.Errorf("expected %q for %q, got %q", name, lang, term.Name)
+			}
+		}
+	}
+}
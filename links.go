@@ -0,0 +1,71 @@
+package netsuite
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Link relations NetSuite returns
+const (
+	LinkRelSelf        = "self"
+	LinkRelNext        = "next"
+	LinkRelPrevious    = "previous"
+	LinkRelFirst       = "first"
+	LinkRelLast        = "last"
+	LinkRelDescribedBy = "describedby"
+)
+
+// Find returns the first link with the relation rel.
+func (l Links) Find(rel string) (Link, bool) {
+	for _, link := range l {
+		if link.Rel == rel {
+			return link, true
+		}
+	}
+	return Link{}, false
+}
+
+// Href returns the url of the first link with the relation rel, or an empty
+// string when there is none.
+func (l Links) Href(rel string) string {
+	link, _ := l.Find(rel)
+	return link.Href
+}
+
+// Self returns the url of the resource the links belong to, e.g. a record or
+// one of its sublists.
+func (l Links) Self() string {
+	return l.Href(LinkRelSelf)
+}
+
+// Next returns the url of the next page of a collection, or an empty string
+// on the last page.
+func (l Links) Next() string {
+	return l.Href(LinkRelNext)
+}
+
+// Follow gets the resource of the link with the relation rel and decodes it
+// into v, e.g. the next page of a list or the lines of a sublist:
+//
+//	page := netsuite.ListPage{}
+//	err := current.Links.Follow(ctx, client, netsuite.LinkRelNext, &page)
+func (l Links) Follow(ctx context.Context, client *Client, rel string, v interface{}) error {
+	link, ok := l.Find(rel)
+	if !ok {
+		return fmt.Errorf("no %s link", rel)
+	}
+	return link.Follow(ctx, client, v)
+}
+
+// Follow gets the resource the link points to and decodes it into v.
+func (l Link) Follow(ctx context.Context, client *Client, v interface{}) error {
+	u, err := url.Parse(l.Href)
+	if err != nil {
+		return err
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("link %s has no absolute url: %q", l.Rel, l.Href)
+	}
+	return client.Get(ctx, l.Href, nil, v)
+}
@@ -0,0 +1,48 @@
+package netsuite_test
+
+import (
+	"context"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestLinksFollow(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("term", "1", map[string]interface{}{"name": "Net 30"})
+	srv.AddRecord("term", "2", map[string]interface{}{"name": "Net 60"})
+
+	ctx := context.Background()
+	c := srv.Client()
+	terms := netsuite.NewService[netsuite.Term](c, "term")
+
+	page, err := terms.List(ctx, &netsuite.ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Links.Self() == "" || page.Links.Next() == "" {
+		t.Fatalf("expected a self and next link, got %+v", page.Links)
+	}
+
+	next := netsuite.ListPage{}
+	err = page.Links.Follow(ctx, c, netsuite.LinkRelNext, &next)
+	if err != nil || len(next.Items) != 1 || next.Items[0].ID != "2" {
+		t.Fatalf("unexpected next page: %+v (%v)", next, err)
+	}
+	if next.Links.Next() != "" {
+		t.Errorf("expected no next link on the last page, got %s", next.Links.Next())
+	}
+
+	term := netsuite.Term{}
+	err = next.Items[0].Links.Follow(ctx, c, netsuite.LinkRelSelf, &term)
+	if err != nil || term.Name != "Net 60" {
+		t.Fatalf("unexpected term: %+v (%v)", term, err)
+	}
+
+	err = next.Links.Follow(ctx, c, netsuite.LinkRelNext, &next)
+	if err == nil {
+		t.Errorf("expected an error for a missing link")
+	}
+}
@@ -0,0 +1,164 @@
+package netsuite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LogLevel uses the same values as the log/slog levels
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = -4
+	LogLevelInfo  LogLevel = 0
+	LogLevelWarn  LogLevel = 4
+	LogLevelError LogLevel = 8
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+type LogAttr struct {
+	Key   string
+	Value interface{}
+}
+
+// Logger receives a log line for every request the client sends. Use
+// NewSlogLogger to log to a log/slog logger.
+type Logger interface {
+	Log(ctx context.Context, level LogLevel, msg string, attrs ...LogAttr)
+}
+
+// LoggerFunc adapts a function to the Logger interface
+type LoggerFunc func(ctx context.Context, level LogLevel, msg string, attrs ...LogAttr)
+
+func (f LoggerFunc) Log(ctx context.Context, level LogLevel, msg string, attrs ...LogAttr) {
+	f(ctx, level, msg, attrs...)
+}
+
+// SetLogger sets the logger requests are logged to. Without logger nothing is
+// logged, apart from the dumps enabled with SetDebug.
+func (c *Client) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = logger
+}
+
+func (c *Client) Logger() Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.logger
+}
+
+// SetLogLevel sets the minimum level that's passed to the logger (default
+// LogLevelInfo).
+func (c *Client) SetLogLevel(level LogLevel) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logLevel = level
+}
+
+func (c *Client) LogLevel() LogLevel {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.logLevel
+}
+
+// Log passes the line on to the logger when its level is enabled.
+func (c *Client) Log(ctx context.Context, level LogLevel, msg string, attrs ...LogAttr) {
+	c.mu.RLock()
+	logger, logLevel := c.logger, c.logLevel
+	c.mu.RUnlock()
+
+	if logger == nil || level < logLevel {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger.Log(ctx, level, msg, attrs...)
+}
+
+// LogRequest logs the method, url, status, duration and retry attempt of the
+// request plus the X-NetSuite-* response headers. Failed requests are logged
+// as warnings (4xx) or errors (5xx, transport errors).
+func (c *Client) LogRequest(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	if c.Logger() == nil {
+		return
+	}
+
+	level := LogLevelInfo
+	attrs := []LogAttr{
+		{Key: "method", Value: req.Method},
+		{Key: "url", Value: req.URL.String()},
+		{Key: "duration", Value: duration},
+	}
+
+	if attempt := requestAttempt(req.Context()); attempt > 1 {
+		attrs = append(attrs, LogAttr{Key: "retry", Value: attempt - 1})
+	}
+
+	if resp != nil {
+		attrs = append(attrs, LogAttr{Key: "status", Value: resp.StatusCode})
+		if resp.StatusCode >= 500 {
+			level = LogLevelError
+		} else if resp.StatusCode >= 400 {
+			level = LogLevelWarn
+		}
+
+		keys := []string{}
+		for k := range resp.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-netsuite-") {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			attrs = append(attrs, LogAttr{Key: k, Value: resp.Header.Get(k)})
+		}
+
+		// e.g. the unknown fields NetSuite ignored
+		if warnings := Warnings(resp); len(warnings) > 0 {
+			attrs = append(attrs, LogAttr{Key: "warnings", Value: warnings})
+			if level < LogLevelWarn {
+				level = LogLevelWarn
+			}
+		}
+	}
+
+	if err != nil {
+		if resp == nil {
+			level = LogLevelError
+		}
+		attrs = append(attrs, LogAttr{Key: "error", Value: err.Error()})
+	}
+
+	c.Log(req.Context(), level, "netsuite request", attrs...)
+}
+
+type attemptKey struct{}
+
+// withRequestAttempt marks the requests sent with ctx as the nth attempt.
+func withRequestAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+func requestAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt
+}
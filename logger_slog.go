@@ -0,0 +1,29 @@
+//go:build go1.21
+
+package netsuite
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger that logs to l, or to slog.Default() when l
+// is nil.
+func NewSlogLogger(l *slog.Logger) Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return slogLogger{logger: l}
+}
+
+func (l slogLogger) Log(ctx context.Context, level LogLevel, msg string, attrs ...LogAttr) {
+	as := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		as[i] = slog.Any(a.Key, a.Value)
+	}
+	l.logger.LogAttrs(ctx, slog.Level(level), msg, as...)
+}
@@ -0,0 +1,24 @@
+//go:build go1.21
+
+package netsuite_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := netsuite.NewSlogLogger(slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	logger.Log(context.Background(), netsuite.LogLevelWarn, "netsuite request", netsuite.LogAttr{Key: "status", Value: 429})
+
+	if !strings.Contains(buf.String(), `level=WARN msg="netsuite request" status=429`) {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
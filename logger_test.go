@@ -0,0 +1,60 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Header().Set("X-NetSuite-JobId", "abc")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":404,"o:errorDetails":[{"detail":"Record not found","o:errorCode":"NONEXISTENT_ID"}]}`))
+	}))
+	defer server.Close()
+
+	type line struct {
+		level netsuite.LogLevel
+		msg   string
+		attrs map[string]interface{}
+	}
+	lines := []line{}
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetLogger(netsuite.LoggerFunc(func(ctx context.Context, level netsuite.LogLevel, msg string, attrs ...netsuite.LogAttr) {
+		l := line{level: level, msg: msg, attrs: map[string]interface{}{}}
+		for _, a := range attrs {
+			l.attrs[a.Key] = a.Value
+		}
+		lines = append(lines, l)
+	}))
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	_, err := req.Do(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 log line, got %d", len(lines))
+	}
+	l := lines[0]
+	if l.level != netsuite.LogLevelWarn || l.attrs["method"] != http.MethodGet || l.attrs["status"] != http.StatusNotFound || l.attrs["X-Netsuite-Jobid"] != "abc" || l.attrs["error"] == nil {
+		t.Errorf("unexpected log line: %+v", l)
+	}
+
+	// info lines are left out at warn level
+	lines = nil
+	c.SetLogLevel(netsuite.LogLevelError)
+	req.Do(context.Background())
+	if len(lines) != 0 {
+		t.Errorf("expected no log lines, got %+v", lines)
+	}
+}
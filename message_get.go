@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMessageGetRequest() MessageGetRequest {
+	r := MessageGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MessageGetRequest struct {
+	client      *Client
+	queryParams *MessageGetRequestQueryParams
+	pathParams  *MessageGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MessageGetRequestBody
+}
+
+func (r MessageGetRequest) NewQueryParams() *MessageGetRequestQueryParams {
+	return &MessageGetRequestQueryParams{}
+}
+
+type MessageGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p MessageGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MessageGetRequest) QueryParams() *MessageGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MessageGetRequest) NewPathParams() *MessageGetRequestPathParams {
+	return &MessageGetRequestPathParams{}
+}
+
+type MessageGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *MessageGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *MessageGetRequest) PathParams() *MessageGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MessageGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MessageGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MessageGetRequest) Method() string {
+	return r.method
+}
+
+func (r *MessageGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MessageGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MessageGetRequest) NewRequestBody() MessageGetRequestBody {
+	return MessageGetRequestBody{}
+}
+
+type MessageGetRequestBody struct {
+}
+
+func (r *MessageGetRequest) RequestBody() *MessageGetRequestBody {
+	return nil
+}
+
+func (r *MessageGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *MessageGetRequest) SetRequestBody(body MessageGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MessageGetRequest) NewResponseBody() *MessageGetResponseBody {
+	return &MessageGetResponseBody{}
+}
+
+type MessageGetResponseBody struct {
+	Links Links `json:"links"`
+	Message
+}
+
+func (r *MessageGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/message/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *MessageGetRequest) Do(ctx context.Context) (MessageGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
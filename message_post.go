@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMessagePostRequest() MessagePostRequest {
+	r := MessagePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MessagePostRequest struct {
+	client      *Client
+	queryParams *MessagePostRequestQueryParams
+	pathParams  *MessagePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MessagePostRequestBody
+}
+
+func (r MessagePostRequest) NewQueryParams() *MessagePostRequestQueryParams {
+	return &MessagePostRequestQueryParams{}
+}
+
+type MessagePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p MessagePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MessagePostRequest) QueryParams() *MessagePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MessagePostRequest) NewPathParams() *MessagePostRequestPathParams {
+	return &MessagePostRequestPathParams{}
+}
+
+type MessagePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *MessagePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *MessagePostRequest) PathParams() *MessagePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MessagePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MessagePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MessagePostRequest) Method() string {
+	return r.method
+}
+
+func (r *MessagePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MessagePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MessagePostRequest) NewRequestBody() MessagePostRequestBody {
+	return MessagePostRequestBody{}
+}
+
+type MessagePostRequestBody struct {
+	Message
+}
+
+func (r *MessagePostRequest) RequestBody() *MessagePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *MessagePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *MessagePostRequest) SetRequestBody(body MessagePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MessagePostRequest) NewResponseBody() *MessagePostResponseBody {
+	return &MessagePostResponseBody{}
+}
+
+type MessagePostResponseBody struct {
+}
+
+func (r *MessagePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/message", r.PathParams())
+	return &u, err
+}
+
+func (r *MessagePostRequest) Do(ctx context.Context) (MessagePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
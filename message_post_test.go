@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestMessagePost(t *testing.T) {
+	req := client.NewMessagePostRequest()
+	req.RequestBody().Author.ID = "1642"
+	req.RequestBody().Recipient.ID = "70202"
+	req.RequestBody().Subject = "Re: ticket #4512"
+	req.RequestBody().Body = "Your replacement has been shipped."
+	req.RequestBody().Transaction.ID = "8120"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMessagesGetRequest() MessagesGetRequest {
+	r := MessagesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MessagesGetRequest struct {
+	client      *Client
+	queryParams *MessagesGetRequestQueryParams
+	pathParams  *MessagesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MessagesGetRequestBody
+}
+
+func (r MessagesGetRequest) NewQueryParams() *MessagesGetRequestQueryParams {
+	return &MessagesGetRequestQueryParams{}
+}
+
+type MessagesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p MessagesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MessagesGetRequest) QueryParams() *MessagesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MessagesGetRequest) NewPathParams() *MessagesGetRequestPathParams {
+	return &MessagesGetRequestPathParams{}
+}
+
+type MessagesGetRequestPathParams struct {
+}
+
+func (p *MessagesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *MessagesGetRequest) PathParams() *MessagesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MessagesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MessagesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MessagesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *MessagesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MessagesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MessagesGetRequest) NewRequestBody() MessagesGetRequestBody {
+	return MessagesGetRequestBody{}
+}
+
+type MessagesGetRequestBody struct {
+}
+
+func (r *MessagesGetRequest) RequestBody() *MessagesGetRequestBody {
+	return nil
+}
+
+func (r *MessagesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *MessagesGetRequest) SetRequestBody(body MessagesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MessagesGetRequest) NewResponseBody() *MessagesGetResponseBody {
+	return &MessagesGetResponseBody{}
+}
+
+type MessagesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *MessagesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/message", r.PathParams())
+	return &u, err
+}
+
+func (r *MessagesGetRequest) Do(ctx context.Context) (MessagesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,153 @@
+package netsuite
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+type MetadataCatalogItems []MetadataCatalogItem
+
+// Names returns the record types in the catalog.
+func (ii MetadataCatalogItems) Names() []string {
+	names := []string{}
+	for _, i := range ii {
+		names = append(names, i.Name)
+	}
+	return names
+}
+
+type MetadataCatalogItem struct {
+	Name  string `json:"name"`
+	Links Links  `json:"links"`
+}
+
+// Link returns the url of the metadata in the given media type, e.g.
+// "application/schema+json" or "application/swagger+json".
+func (i MetadataCatalogItem) Link(mediaType string) string {
+	for _, l := range i.Links {
+		if l.MediaType == mediaType {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+// JSONSchema is a (subset of a) JSON Schema as returned by the metadata
+// catalog. The schema vocabulary is open ended, so unknown keywords are always
+// ignored when decoding.
+type JSONSchema struct {
+	Schema      string                `json:"$schema,omitempty"`
+	ID          string                `json:"$id,omitempty"`
+	Ref         string                `json:"$ref,omitempty"`
+	Type        string                `json:"type,omitempty"`
+	Title       string                `json:"title,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Format      string                `json:"format,omitempty"`
+	Properties  map[string]JSONSchema `json:"properties,omitempty"`
+	Items       *JSONSchema           `json:"items,omitempty"`
+	Required    []string              `json:"required,omitempty"`
+	Enum        []interface{}         `json:"enum,omitempty"`
+	ReadOnly    bool                  `json:"readOnly,omitempty"`
+	Nullable    bool                  `json:"nullable,omitempty"`
+	AnyOf       []JSONSchema          `json:"anyOf,omitempty"`
+	OneOf       []JSONSchema          `json:"oneOf,omitempty"`
+	Definitions map[string]JSONSchema `json:"definitions,omitempty"`
+	MinLength   *int                  `json:"minLength,omitempty"`
+	MaxLength   *int                  `json:"maxLength,omitempty"`
+
+	// NetSuite extensions
+	CustomField bool `json:"x-ns-custom-field,omitempty"`
+	Filterable  bool `json:"x-ns-filterable,omitempty"`
+}
+
+func (s *JSONSchema) UnmarshalJSON(data []byte) error {
+	type alias JSONSchema
+	a := alias{}
+	err := json.Unmarshal(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*s = JSONSchema(a)
+	return nil
+}
+
+// PropertyNames returns the sorted property names of the schema.
+func (s JSONSchema) PropertyNames() []string {
+	names := []string{}
+	for k := range s.Properties {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Property returns the schema of the property with the given name.
+func (s JSONSchema) Property(name string) (JSONSchema, bool) {
+	p, ok := s.Properties[name]
+	return p, ok
+}
+
+// IsRequired reports whether the property with the given name is required.
+func (s JSONSchema) IsRequired(name string) bool {
+	for _, r := range s.Required {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RefName returns the last path segment of the $ref, e.g. "customer" for
+// "#/components/schemas/customer".
+func (s JSONSchema) RefName() string {
+	if s.Ref == "" {
+		return ""
+	}
+	parts := strings.Split(s.Ref, "/")
+	return parts[len(parts)-1]
+}
+
+// OpenAPIDocument is (a subset of) the OpenAPI 3 document returned by the
+// metadata catalog.
+type OpenAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Servers    []OpenAPIServer            `json:"servers,omitempty"`
+	Paths      map[string]json.RawMessage `json:"paths,omitempty"`
+	Components OpenAPIComponents          `json:"components"`
+}
+
+func (d *OpenAPIDocument) UnmarshalJSON(data []byte) error {
+	type alias OpenAPIDocument
+	a := alias{}
+	err := json.Unmarshal(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*d = OpenAPIDocument(a)
+	return nil
+}
+
+// Schema returns the component schema with the given name (usually the
+// record type).
+func (d OpenAPIDocument) Schema(name string) (JSONSchema, bool) {
+	s, ok := d.Components.Schemas[name]
+	return s, ok
+}
+
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]JSONSchema `json:"schemas,omitempty"`
+}
@@ -0,0 +1,151 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMetadataCatalogGetRequest() MetadataCatalogGetRequest {
+	r := MetadataCatalogGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MetadataCatalogGetRequest struct {
+	client      *Client
+	queryParams *MetadataCatalogGetRequestQueryParams
+	pathParams  *MetadataCatalogGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MetadataCatalogGetRequestBody
+}
+
+func (r MetadataCatalogGetRequest) NewQueryParams() *MetadataCatalogGetRequestQueryParams {
+	return &MetadataCatalogGetRequestQueryParams{}
+}
+
+type MetadataCatalogGetRequestQueryParams struct {
+	// Select limits the catalog to the given record types
+	Select RecordTypes `schema:"select,omitempty"`
+}
+
+func (p MetadataCatalogGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(RecordTypes{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MetadataCatalogGetRequest) QueryParams() *MetadataCatalogGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MetadataCatalogGetRequest) NewPathParams() *MetadataCatalogGetRequestPathParams {
+	return &MetadataCatalogGetRequestPathParams{}
+}
+
+type MetadataCatalogGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *MetadataCatalogGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *MetadataCatalogGetRequest) PathParams() *MetadataCatalogGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MetadataCatalogGetRequest) Method() string {
+	return r.method
+}
+
+func (r *MetadataCatalogGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MetadataCatalogGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MetadataCatalogGetRequest) NewRequestBody() MetadataCatalogGetRequestBody {
+	return MetadataCatalogGetRequestBody{}
+}
+
+type MetadataCatalogGetRequestBody struct {
+}
+
+func (r *MetadataCatalogGetRequest) RequestBody() *MetadataCatalogGetRequestBody {
+	return nil
+}
+
+func (r *MetadataCatalogGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *MetadataCatalogGetRequest) SetRequestBody(body MetadataCatalogGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MetadataCatalogGetRequest) NewResponseBody() *MetadataCatalogGetResponseBody {
+	return &MetadataCatalogGetResponseBody{}
+}
+
+type MetadataCatalogGetResponseBody struct {
+	Links Links                `json:"links"`
+	Items MetadataCatalogItems `json:"items"`
+}
+
+func (r *MetadataCatalogGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/metadata-catalog", r.PathParams())
+	return &u, err
+}
+
+func (r *MetadataCatalogGetRequest) Do(ctx context.Context) (MetadataCatalogGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
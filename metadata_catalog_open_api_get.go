@@ -0,0 +1,146 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMetadataCatalogOpenAPIGetRequest() MetadataCatalogOpenAPIGetRequest {
+	r := MetadataCatalogOpenAPIGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MetadataCatalogOpenAPIGetRequest struct {
+	client      *Client
+	queryParams *MetadataCatalogOpenAPIGetRequestQueryParams
+	pathParams  *MetadataCatalogOpenAPIGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MetadataCatalogOpenAPIGetRequestBody
+}
+
+func (r MetadataCatalogOpenAPIGetRequest) NewQueryParams() *MetadataCatalogOpenAPIGetRequestQueryParams {
+	return &MetadataCatalogOpenAPIGetRequestQueryParams{}
+}
+
+type MetadataCatalogOpenAPIGetRequestQueryParams struct {
+}
+
+func (p MetadataCatalogOpenAPIGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) QueryParams() *MetadataCatalogOpenAPIGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MetadataCatalogOpenAPIGetRequest) NewPathParams() *MetadataCatalogOpenAPIGetRequestPathParams {
+	return &MetadataCatalogOpenAPIGetRequestPathParams{}
+}
+
+type MetadataCatalogOpenAPIGetRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+}
+
+func (p *MetadataCatalogOpenAPIGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+	}
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) PathParams() *MetadataCatalogOpenAPIGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) Method() string {
+	return r.method
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MetadataCatalogOpenAPIGetRequest) NewRequestBody() MetadataCatalogOpenAPIGetRequestBody {
+	return MetadataCatalogOpenAPIGetRequestBody{}
+}
+
+type MetadataCatalogOpenAPIGetRequestBody struct {
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) RequestBody() *MetadataCatalogOpenAPIGetRequestBody {
+	return nil
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) SetRequestBody(body MetadataCatalogOpenAPIGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) NewResponseBody() *MetadataCatalogOpenAPIGetResponseBody {
+	return &MetadataCatalogOpenAPIGetResponseBody{}
+}
+
+type MetadataCatalogOpenAPIGetResponseBody struct {
+	OpenAPIDocument
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/metadata-catalog/{{.record_type}}", r.PathParams())
+	return &u, err
+}
+
+func (r *MetadataCatalogOpenAPIGetRequest) Do(ctx context.Context) (MetadataCatalogOpenAPIGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	req.Header.Set("Accept", "application/swagger+json")
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestMetadataCatalogOpenAPIGet(t *testing.T) {
+	req := client.NewMetadataCatalogOpenAPIGetRequest()
+	req.PathParams().RecordType = "customer"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
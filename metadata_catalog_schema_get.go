@@ -0,0 +1,146 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewMetadataCatalogSchemaGetRequest() MetadataCatalogSchemaGetRequest {
+	r := MetadataCatalogSchemaGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type MetadataCatalogSchemaGetRequest struct {
+	client      *Client
+	queryParams *MetadataCatalogSchemaGetRequestQueryParams
+	pathParams  *MetadataCatalogSchemaGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody MetadataCatalogSchemaGetRequestBody
+}
+
+func (r MetadataCatalogSchemaGetRequest) NewQueryParams() *MetadataCatalogSchemaGetRequestQueryParams {
+	return &MetadataCatalogSchemaGetRequestQueryParams{}
+}
+
+type MetadataCatalogSchemaGetRequestQueryParams struct {
+}
+
+func (p MetadataCatalogSchemaGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *MetadataCatalogSchemaGetRequest) QueryParams() *MetadataCatalogSchemaGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r MetadataCatalogSchemaGetRequest) NewPathParams() *MetadataCatalogSchemaGetRequestPathParams {
+	return &MetadataCatalogSchemaGetRequestPathParams{}
+}
+
+type MetadataCatalogSchemaGetRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+}
+
+func (p *MetadataCatalogSchemaGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+	}
+}
+
+func (r *MetadataCatalogSchemaGetRequest) PathParams() *MetadataCatalogSchemaGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogSchemaGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *MetadataCatalogSchemaGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *MetadataCatalogSchemaGetRequest) Method() string {
+	return r.method
+}
+
+func (r *MetadataCatalogSchemaGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *MetadataCatalogSchemaGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r MetadataCatalogSchemaGetRequest) NewRequestBody() MetadataCatalogSchemaGetRequestBody {
+	return MetadataCatalogSchemaGetRequestBody{}
+}
+
+type MetadataCatalogSchemaGetRequestBody struct {
+}
+
+func (r *MetadataCatalogSchemaGetRequest) RequestBody() *MetadataCatalogSchemaGetRequestBody {
+	return nil
+}
+
+func (r *MetadataCatalogSchemaGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *MetadataCatalogSchemaGetRequest) SetRequestBody(body MetadataCatalogSchemaGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *MetadataCatalogSchemaGetRequest) NewResponseBody() *MetadataCatalogSchemaGetResponseBody {
+	return &MetadataCatalogSchemaGetResponseBody{}
+}
+
+type MetadataCatalogSchemaGetResponseBody struct {
+	JSONSchema
+}
+
+func (r *MetadataCatalogSchemaGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/metadata-catalog/{{.record_type}}", r.PathParams())
+	return &u, err
+}
+
+func (r *MetadataCatalogSchemaGetRequest) Do(ctx context.Context) (MetadataCatalogSchemaGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	req.Header.Set("Accept", "application/schema+json")
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,95 @@
+package netsuite
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Metrics receives measurements of the requests sent by the client (and the
+// restlet and soap clients built on it). See the prometheus package for a
+// ready-made implementation.
+type Metrics interface {
+	// ObserveRequest is called once per request; status is 0 when no response
+	// was received
+	ObserveRequest(endpoint, method string, status int, duration time.Duration)
+	// IncRetries is called for every request that is a retry of an earlier
+	// attempt
+	IncRetries(endpoint string)
+	// IncThrottled is called for every request NetSuite rejected because of
+	// the concurrency or rate limits
+	IncThrottled(endpoint string)
+	// AddBytes is called with the size of request ("sent") and response
+	// ("received") bodies, when known
+	AddBytes(endpoint, direction string, n int64)
+}
+
+func (c *Client) SetMetrics(metrics Metrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metrics = metrics
+}
+
+func (c *Client) Metrics() Metrics {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.metrics
+}
+
+// RecordRequest logs the request (see LogRequest), reports it to the metrics
+// and keeps the governance information of the response.
+func (c *Client) RecordRequest(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	c.LogRequest(req, resp, err, duration)
+	if resp != nil {
+		c.observeGovernance(req, resp)
+	}
+
+	metrics := c.Metrics()
+	if metrics == nil {
+		return
+	}
+
+	endpoint := Endpoint(req.URL)
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	metrics.ObserveRequest(endpoint, req.Method, status, duration)
+
+	if requestAttempt(req.Context()) > 1 {
+		metrics.IncRetries(endpoint)
+	}
+	if status == http.StatusTooManyRequests {
+		metrics.IncThrottled(endpoint)
+	}
+
+	if req.ContentLength > 0 {
+		metrics.AddBytes(endpoint, "sent", req.ContentLength)
+	}
+	if resp != nil && resp.ContentLength > 0 {
+		metrics.AddBytes(endpoint, "received", resp.ContentLength)
+	}
+}
+
+var (
+	endpointIDRe = regexp.MustCompile(`^(\d+|eid:.+)$`)
+)
+
+// Endpoint returns the path of u with the record ids replaced by {id}, e.g.
+// /services/rest/record/v1/customer/{id}, to be used as a low cardinality
+// label. RESTlets are identified by their script id.
+func Endpoint(u *url.URL) string {
+	if script := u.Query().Get("script"); script != "" {
+		return u.Path + "?script=" + script
+	}
+
+	parts := strings.Split(u.Path, "/")
+	for i, p := range parts {
+		if endpointIDRe.MatchString(p) {
+			parts[i] = "{id}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,88 @@
+package netsuitetest_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	srv := netsuitetest.NewServer()
+	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Omniboost"})
+	srv.AddSuiteQL("from customer", map[string]string{"id": "1"})
+
+	// record
+	cassette, err := netsuitetest.LoadCassette(path, netsuitetest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cassette.Scrub = func(in *netsuitetest.Interaction) {
+		in.ResponseBody = strings.Replace(in.ResponseBody, "Omniboost", "ACME", -1)
+	}
+
+	client := srv.Client()
+	client.SetHTTPClient(&http.Client{Transport: cassette.Transport(nil)})
+
+	req := client.NewCustomerGetRequest()
+	req.PathParams().ID = 1
+	_, err = req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := client.NewSuiteqlPostRequest()
+	q.RequestBody().Q = "SELECT id FROM customer"
+	_, err = q.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cassette.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "Omniboost") || strings.Contains(string(b), srv.TokenID) {
+		t.Errorf("cassette wasn't scrubbed: %s", b)
+	}
+
+	// replay, without the server
+	cassette, err = netsuitetest.LoadCassette(path, netsuitetest.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetHTTPClient(&http.Client{Transport: cassette.Transport(nil)})
+
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.CompanyName != "ACME" {
+		t.Errorf("expected ACME, got %q", resp.CompanyName)
+	}
+
+	// json bodies match regardless of formatting
+	q.RequestBody().Q = "SELECT id FROM customer"
+	_, err = q.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.PathParams().ID = 2
+	_, err = req.Do(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no interaction") {
+		t.Errorf("expected missing interaction error, got %v", err)
+	}
+}
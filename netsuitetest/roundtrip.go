@@ -0,0 +1,126 @@
+package netsuitetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// RoundTrip decodes the json fixture into v, failing on fields v doesn't
+// know (custom fields aside, see netsuite.UnknownFields), encodes v again and checks that every field in the output has the
+// value of the fixture. Use it to test models against real payloads (see
+// cmd/netsuite-fixtures).
+func RoundTrip(t testing.TB, filename string, v interface{}) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(v)
+	if err != nil {
+		t.Fatalf("decoding %s: %s", filename, err)
+	}
+	// the decoder can't check the models that decode themselves, like the
+	// ones capturing custom fields
+	if fields := netsuite.UnknownFields(b, v); len(fields) > 0 {
+		t.Fatalf("decoding %s: unknown fields %s", filename, strings.Join(fields, ", "))
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("encoding %s: %s", filename, err)
+	}
+
+	var expected, actual interface{}
+	json.Unmarshal(b, &expected)
+	json.Unmarshal(out, &actual)
+	for _, diff := range diffJSON("", expected, actual) {
+		t.Errorf("%s: %s", filename, diff)
+	}
+}
+
+// diffJSON returns the differences of the values in actual with expected.
+// Fields missing from actual are ignored: they're empty and omitted.
+func diffJSON(path string, expected, actual interface{}) []string {
+	switch a := actual.(type) {
+	case map[string]interface{}:
+		e, ok := expected.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %v, got an object", pathOrRoot(path), expected)}
+		}
+		keys := []string{}
+		for k := range a {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		diffs := []string{}
+		for _, k := range keys {
+			ev, ok := e[k]
+			if !ok {
+				if !isEmpty(a[k]) {
+					diffs = append(diffs, fmt.Sprintf("%s.%s: not in fixture, got %v", path, k, a[k]))
+				}
+				continue
+			}
+			diffs = append(diffs, diffJSON(path+"."+k, ev, a[k])...)
+		}
+		return diffs
+	case []interface{}:
+		e, ok := expected.([]interface{})
+		if !ok || len(e) != len(a) {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", pathOrRoot(path), expected, actual)}
+		}
+		diffs := []string{}
+		for i := range a {
+			diffs = append(diffs, diffJSON(fmt.Sprintf("%s[%d]", path, i), e[i], a[i])...)
+		}
+		return diffs
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		return []string{fmt.Sprintf("%s: expected %v, got %v", pathOrRoot(path), expected, actual)}
+	}
+	return nil
+}
+
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, vv := range v {
+			if !isEmpty(vv) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
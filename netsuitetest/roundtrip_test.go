@@ -0,0 +1,71 @@
+package netsuitetest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestRoundTrip(t *testing.T) {
+	netsuitetest.RoundTrip(t, "testdata/term.json", &netsuite.Term{})
+}
+
+func TestRoundTripUnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "term.json")
+	err := os.WriteFile(path, []byte(`{"id":"1","name":"Net 30","newField":true}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !roundTripFails(t, path, &netsuite.Term{}) {
+		t.Error("expected the unknown field to fail the round trip")
+	}
+}
+
+func TestRoundTripCustomFields(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "customer.json")
+	err := os.WriteFile(path, []byte(`{"id":"1","companyName":"Acme","custentity_region":"EU"}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	netsuitetest.RoundTrip(t, path, &netsuite.Customer{})
+
+	path = filepath.Join(dir, "customer_drift.json")
+	err = os.WriteFile(path, []byte(`{"id":"1","companyName":"Acme","custentity_region":"EU","newField":true}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !roundTripFails(t, path, &netsuite.Customer{}) {
+		t.Error("expected the unknown field to fail the round trip")
+	}
+}
+
+func roundTripFails(t *testing.T, path string, v interface{}) bool {
+	rt := &recordingT{TB: t}
+	func() {
+		// Fatal stops the goroutine
+		defer func() { recover() }()
+		netsuitetest.RoundTrip(rt, path, v)
+	}()
+	return rt.failed
+}
+
+type recordingT struct {
+	testing.TB
+	failed bool
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Fatalf(format string, args ...interface{}) {
+	t.failed = true
+	panic("fatal")
+}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.failed = true
+}
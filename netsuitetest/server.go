@@ -0,0 +1,533 @@
+// Package netsuitetest provides a fake NetSuite REST server for tests of code
+// that uses the netsuite client, so they can run without a real account.
+//
+//	srv := netsuitetest.NewServer()
+//	defer srv.Close()
+//	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Omniboost"})
+//
+//	client := srv.Client()
+//	req := client.NewCustomerGetRequest()
+//	...
+package netsuitetest
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+const (
+	// AccountID is the account id of the clients returned by Server.Client
+	AccountID = "1234567_SB1"
+
+	mediaType = "application/vnd.oracle.resource+json"
+	restPath  = "/services/rest"
+)
+
+// Request is a request received by the server
+type Request struct {
+	Method string
+	// Path is relative to /services/rest, e.g. /record/v1/customer/1
+	Path  string
+	Query url.Values
+	Body  []byte
+}
+
+// Server is a fake NetSuite REST server. Records are kept in memory and
+// SuiteQL queries return the canned results added with AddSuiteQL. Requests
+// must be signed with the token based auth credentials of the server (or use
+// the access token), like NetSuite requires.
+type Server struct {
+	*httptest.Server
+
+	// Credentials that are verified; leave ConsumerKey empty to skip the
+	// verification
+	ConsumerKey    string
+	ConsumerSecret string
+	TokenID        string
+	TokenSecret    string
+	// AccessToken is accepted as OAuth 2.0 bearer token when set
+	AccessToken string
+
+	mux      *http.ServeMux
+	mu       sync.Mutex
+	records  map[string]map[string]json.RawMessage
+	suiteql  []suiteqlResult
+	errors   map[string]errorResponse
+	requests []Request
+	nextID   int
+}
+
+type suiteqlResult struct {
+	match string
+	items []json.RawMessage
+}
+
+type errorResponse struct {
+	status int
+	code   string
+	detail string
+}
+
+// NewServer starts a server with random token based auth credentials.
+// Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		ConsumerKey:    netsuite.GenerateNonce(),
+		ConsumerSecret: netsuite.GenerateNonce(),
+		TokenID:        netsuite.GenerateNonce(),
+		TokenSecret:    netsuite.GenerateNonce(),
+		mux:            http.NewServeMux(),
+		records:        map[string]map[string]json.RawMessage{},
+		errors:         map[string]errorResponse{},
+		nextID:         1000,
+	}
+	s.mux.HandleFunc(restPath+"/record/v1/", s.handleRecord)
+	s.mux.HandleFunc(restPath+"/query/v1/suiteql", s.handleSuiteQL)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the url to pass to Client.SetBaseURL.
+func (s *Server) BaseURL() string {
+	return s.URL + restPath
+}
+
+// Client returns a netsuite client that's configured to use the server with
+// token based auth.
+func (s *Server) Client() *netsuite.Client {
+	return netsuite.NewClient(
+		netsuite.WithHTTPClient(s.Server.Client()),
+		netsuite.WithBaseURL(s.BaseURL()),
+		netsuite.WithAccountID(AccountID),
+		netsuite.WithTokenAuth(s.ConsumerKey, s.ConsumerSecret, s.TokenID, s.TokenSecret),
+	)
+}
+
+// HandleFunc registers an extra handler, e.g. for a RESTlet. Handlers are
+// called after the credentials are verified.
+func (s *Server) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// AddRecord adds (or replaces) a record. The record is json encoded; its id
+// is set when it doesn't have one.
+func (s *Server) AddRecord(recordType, id string, record interface{}) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+	if _, ok := fields["id"]; !ok {
+		fields["id"], _ = json.Marshal(id)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.putRecord(recordType, id, fields)
+	return nil
+}
+
+// Record returns the json of a record, e.g. to check what was posted or
+// patched.
+func (s *Server) Record(recordType, id string) (json.RawMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.records[strings.ToLower(recordType)][id]
+	return r, ok
+}
+
+// AddSuiteQL returns items for every query that contains match (case
+// insensitive). Results added later take precedence.
+func (s *Server) AddSuiteQL(match string, items ...interface{}) error {
+	res := suiteqlResult{match: strings.ToLower(match), items: make([]json.RawMessage, len(items))}
+	for i, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		res.items[i] = b
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.suiteql = append([]suiteqlResult{res}, s.suiteql...)
+	return nil
+}
+
+// AddError makes the server respond to method and path (relative to
+// /services/rest) with a NetSuite error, e.g.
+//
+//	srv.AddError(http.MethodGet, "/record/v1/customer/1", http.StatusForbidden, "INSUFFICIENT_PERMISSION", "Permission Violation")
+func (s *Server) AddError(method, path string, status int, code, detail string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[method+" "+path] = errorResponse{status: status, code: code, detail: detail}
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// WriteError writes a response in the NetSuite error format.
+func WriteError(w http.ResponseWriter, status int, code, detail string) {
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"type":   "https://www.w3.org/Protocols/rfc9110.html#section-15",
+		"title":  http.StatusText(status),
+		"status": status,
+		"o:errorDetails": []map[string]string{
+			{"detail": detail, "o:errorCode": code},
+		},
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	path := strings.TrimPrefix(r.URL.Path, restPath)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path, Query: r.URL.Query(), Body: body})
+	e, ok := s.errors[r.Method+" "+path]
+	s.mu.Unlock()
+
+	if err := s.verify(r); err != nil {
+		WriteError(w, http.StatusUnauthorized, "INVALID_LOGIN", err.Error())
+		return
+	}
+
+	if ok {
+		WriteError(w, e.status, e.code, e.detail)
+		return
+	}
+
+	r.Body = ioutil.NopCloser(strings.NewReader(string(body)))
+	s.mux.ServeHTTP(w, r)
+}
+
+// verify checks the OAuth 1.0 signature (or bearer token) of the request. The
+// realm has to be AccountID and the signature is computed as described in
+// RFC 5849, independent of the signing of the netsuite package.
+func (s *Server) verify(r *http.Request) error {
+	if s.ConsumerKey == "" {
+		return nil
+	}
+
+	header := r.Header.Get("Authorization")
+	if s.AccessToken != "" && header == "Bearer "+s.AccessToken {
+		return nil
+	}
+	if !strings.HasPrefix(header, "OAuth ") {
+		return fmt.Errorf("missing authorization")
+	}
+
+	params := map[string]string{}
+	for _, p := range strings.Split(strings.TrimPrefix(header, "OAuth "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(p), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		v, _ := url.QueryUnescape(strings.Trim(kv[1], `"`))
+		params[kv[0]] = v
+	}
+
+	if params["realm"] != AccountID {
+		return fmt.Errorf("unknown realm %q", params["realm"])
+	}
+	if params["oauth_consumer_key"] != s.ConsumerKey {
+		return fmt.Errorf("unknown consumer key")
+	}
+	if params["oauth_token"] != s.TokenID {
+		return fmt.Errorf("unknown token")
+	}
+	if params["oauth_version"] != "1.0" {
+		return fmt.Errorf("unsupported oauth version %q", params["oauth_version"])
+	}
+	if params["oauth_nonce"] == "" || params["oauth_timestamp"] == "" {
+		return fmt.Errorf("missing nonce or timestamp")
+	}
+
+	var h func() hash.Hash
+	switch params["oauth_signature_method"] {
+	case "HMAC-SHA256":
+		h = sha256.New
+	case "HMAC-SHA1":
+		h = sha1.New
+	default:
+		return fmt.Errorf("unsupported signature method %q", params["oauth_signature_method"])
+	}
+
+	signed := r.URL.Query()
+	for k, v := range params {
+		if k != "realm" && k != "oauth_signature" {
+			signed.Set(k, v)
+		}
+	}
+	u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+	signature := Signature(h, r.Method, u.String(), signed, s.ConsumerSecret, s.TokenSecret)
+	if !hmac.Equal([]byte(signature), []byte(params["oauth_signature"])) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// Signature returns the OAuth 1.0 HMAC signature of a request to baseURL
+// (without query) with params (the query and the oauth parameters), see
+// https://tools.ietf.org/html/rfc5849#section-3.4.
+func Signature(h func() hash.Hash, method, baseURL string, params url.Values, consumerSecret, tokenSecret string) string {
+	pairs := []string{}
+	for k, vv := range params {
+		for _, v := range vv {
+			pairs = append(pairs, percentEncode(k)+"="+percentEncode(v))
+		}
+	}
+	sort.Strings(pairs)
+
+	base := strings.ToUpper(method) + "&" + percentEncode(baseURL) + "&" + percentEncode(strings.Join(pairs, "&"))
+	mac := hmac.New(h, []byte(percentEncode(consumerSecret)+"&"+percentEncode(tokenSecret)))
+	mac.Write([]byte(base))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// percentEncode encodes s as described in
+// https://tools.ietf.org/html/rfc5849#section-3.6.
+func percentEncode(s string) string {
+	b := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func (s *Server) handleRecord(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, restPath+"/record/v1/"), "/"), "/")
+	recordType := strings.ToLower(parts[0])
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listRecords(w, r, recordType)
+		case http.MethodPost:
+			s.nextID++
+			id := strconv.Itoa(s.nextID)
+			fields, err := decodeFields(r)
+			if err != nil {
+				WriteError(w, http.StatusBadRequest, "INVALID_CONTENT", err.Error())
+				return
+			}
+			fields["id"], _ = json.Marshal(id)
+			s.putRecord(recordType, id, fields)
+			w.Header().Set("Location", s.BaseURL()+"/record/v1/"+recordType+"/"+id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	id := parts[1]
+	record, ok := s.records[recordType][id]
+
+	// records have a version, changed on every update
+	if ok {
+		etag := recordETag(record)
+		if match := r.Header.Get("If-Match"); match != "" && r.Method != http.MethodGet && match != etag {
+			WriteError(w, http.StatusPreconditionFailed, "PRECONDITION_FAILED", "The record has been changed since it was retrieved.")
+			return
+		}
+		if r.Method == http.MethodGet {
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !ok {
+			writeNotFound(w, recordType, id)
+			return
+		}
+		w.Header().Set("Content-Type", mediaType)
+		w.Write(record)
+	case http.MethodPatch, http.MethodPut:
+		if !ok && r.Method == http.MethodPatch {
+			writeNotFound(w, recordType, id)
+			return
+		}
+		fields, err := decodeFields(r)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, "INVALID_CONTENT", err.Error())
+			return
+		}
+		if ok && r.Method == http.MethodPatch {
+			existing := map[string]json.RawMessage{}
+			json.Unmarshal(record, &existing)
+			for k, v := range fields {
+				existing[k] = v
+			}
+			fields = existing
+		}
+		fields["id"], _ = json.Marshal(id)
+		s.putRecord(recordType, id, fields)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !ok {
+			writeNotFound(w, recordType, id)
+			return
+		}
+		delete(s.records[recordType], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request, recordType string) {
+	ids := []string{}
+	for id := range s.records[recordType] {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, aerr := strconv.Atoi(ids[i])
+		b, berr := strconv.Atoi(ids[j])
+		if aerr == nil && berr == nil {
+			return a < b
+		}
+		return ids[i] < ids[j]
+	})
+
+	items := make([]json.RawMessage, len(ids))
+	for i, id := range ids {
+		href := s.BaseURL() + "/record/v1/" + recordType + "/" + id
+		items[i], _ = json.Marshal(map[string]interface{}{
+			"links": []map[string]string{{"rel": "self", "href": href}},
+			"id":    id,
+		})
+	}
+	s.writeCollection(w, r, items)
+}
+
+func (s *Server) handleSuiteQL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body := struct {
+		Q string `json:"q"`
+	}{}
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil || body.Q == "" {
+		WriteError(w, http.StatusBadRequest, "INVALID_PARAMETER", "Invalid search query. Provide a valid query.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := strings.ToLower(body.Q)
+	for _, res := range s.suiteql {
+		if strings.Contains(q, res.match) {
+			s.writeCollection(w, r, res.items)
+			return
+		}
+	}
+	s.writeCollection(w, r, []json.RawMessage{})
+}
+
+// writeCollection writes a page of items with the limit and offset query
+// params applied.
+func (s *Server) writeCollection(w http.ResponseWriter, r *http.Request, items []json.RawMessage) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 {
+		limit = 1000
+	}
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	page := items[offset:end]
+
+	links := []map[string]string{{"rel": "self", "href": s.URL + r.URL.RequestURI()}}
+	if end < len(items) {
+		next := *r.URL
+		q := next.Query()
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("offset", strconv.Itoa(end))
+		next.RawQuery = q.Encode()
+		links = append(links, map[string]string{"rel": "next", "href": s.URL + next.RequestURI()})
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"links":        links,
+		"count":        len(page),
+		"hasMore":      end < len(items),
+		"items":        page,
+		"offset":       offset,
+		"totalResults": len(items),
+	})
+}
+
+func (s *Server) putRecord(recordType, id string, fields map[string]json.RawMessage) {
+	recordType = strings.ToLower(recordType)
+	if s.records[recordType] == nil {
+		s.records[recordType] = map[string]json.RawMessage{}
+	}
+	b, _ := json.Marshal(fields)
+	s.records[recordType][id] = b
+}
+
+// recordETag returns the ETag of the stored record, a hash of its fields.
+func recordETag(record json.RawMessage) string {
+	return fmt.Sprintf(`"%x"`, sha1.Sum(record))
+}
+
+func decodeFields(r *http.Request) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	err := json.NewDecoder(r.Body).Decode(&fields)
+	return fields, err
+}
+
+func writeNotFound(w http.ResponseWriter, recordType, id string) {
+	WriteError(w, http.StatusNotFound, "NONEXISTENT_ID", fmt.Sprintf("The record instance does not exist. Provide a valid record instance ID. (%s %s)", recordType, id))
+}
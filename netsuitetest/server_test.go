@@ -0,0 +1,175 @@
+package netsuitetest_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestServerRecords(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+
+	err := srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Omniboost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := srv.Client()
+	req := client.NewCustomerGetRequest()
+	req.PathParams().ID = 1
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.CompanyName != "Omniboost" {
+		t.Errorf("expected Omniboost, got %q", resp.CompanyName)
+	}
+
+	post := client.NewCustomerPostRequest()
+	post.RequestBody().CompanyName = "Acme"
+	_, err = post.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listReq := client.NewCustomersGetRequest()
+	list, err := listReq.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.TotalResults != 2 || list.Items[1].ID != "1001" {
+		t.Errorf("unexpected list: %+v", list)
+	}
+
+	record, ok := srv.Record("customer", "1001")
+	if !ok || !strings.Contains(string(record), `"companyName":"Acme"`) {
+		t.Errorf("unexpected record: %s", record)
+	}
+
+	req.PathParams().ID = 2
+	_, err = req.Do(context.Background())
+	errResp := &netsuite.ErrorResponse{}
+	if !errors.As(err, &errResp) || errResp.ErrorDetails[0].ErrorCode != "NONEXISTENT_ID" {
+		t.Errorf("expected NONEXISTENT_ID error, got %v", err)
+	}
+}
+
+func TestServerSuiteQL(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+
+	srv.AddSuiteQL("FROM currency", map[string]string{"id": "1", "symbol": "EUR"}, map[string]string{"id": "2", "symbol": "USD"})
+
+	req := srv.Client().NewSuiteqlPostRequest()
+	req.RequestBody().Q = "SELECT id, symbol FROM currency"
+	req.QueryParams().Limit = 1
+	req.QueryParams().Offset = 1
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := []map[string]string{}
+	err = json.Unmarshal(resp.Items, &items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.HasMore || resp.TotalResults != 2 || len(items) != 1 || items[0]["symbol"] != "USD" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+
+	srv.AddError(http.MethodGet, "/record/v1/customer/1", http.StatusForbidden, "INSUFFICIENT_PERMISSION", "Permission Violation")
+
+	client := srv.Client()
+	req := client.NewCustomerGetRequest()
+	req.PathParams().ID = 1
+	_, err := req.Do(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "INSUFFICIENT_PERMISSION") {
+		t.Errorf("expected INSUFFICIENT_PERMISSION, got %v", err)
+	}
+
+	client.SetTokenSecret("wrong")
+	_, err = req.Do(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "INVALID_LOGIN") {
+		t.Errorf("expected INVALID_LOGIN, got %v", err)
+	}
+
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestServerVerify(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Omniboost"})
+
+	tests := map[string]*netsuite.Client{
+		"realm": netsuite.NewClient(
+			netsuite.WithHTTPClient(srv.Server.Client()),
+			netsuite.WithBaseURL(srv.BaseURL()),
+			netsuite.WithAccountID("7654321"),
+			netsuite.WithTokenAuth(srv.ConsumerKey, srv.ConsumerSecret, srv.TokenID, srv.TokenSecret),
+		),
+		"consumer key": netsuite.NewClient(
+			netsuite.WithHTTPClient(srv.Server.Client()),
+			netsuite.WithBaseURL(srv.BaseURL()),
+			netsuite.WithAccountID(netsuitetest.AccountID),
+			netsuite.WithTokenAuth("wrong", srv.ConsumerSecret, srv.TokenID, srv.TokenSecret),
+		),
+		"token": netsuite.NewClient(
+			netsuite.WithHTTPClient(srv.Server.Client()),
+			netsuite.WithBaseURL(srv.BaseURL()),
+			netsuite.WithAccountID(netsuitetest.AccountID),
+			netsuite.WithTokenAuth(srv.ConsumerKey, srv.ConsumerSecret, "wrong", srv.TokenSecret),
+		),
+	}
+	for name, client := range tests {
+		req := client.NewCustomerGetRequest()
+		req.PathParams().ID = 1
+		_, err := req.Do(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "INVALID_LOGIN") {
+			t.Errorf("%s: expected INVALID_LOGIN, got %v", name, err)
+		}
+	}
+
+	// a query with spaces is signed with %20, not +
+	req := srv.Client().NewCustomersGetRequest()
+	req.QueryParams().Q = "companyName CONTAIN Omni+boost"
+	if _, err := req.Do(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSignature(t *testing.T) {
+	// https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
+	params := url.Values{
+		"include_entities":       {"true"},
+		"status":                 {"Hello Ladies + Gentlemen, a signed OAuth request!"},
+		"oauth_consumer_key":     {"xvz1evFS4wEEPTGEFPHBog"},
+		"oauth_nonce":            {"kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg"},
+		"oauth_signature_method": {"HMAC-SHA1"},
+		"oauth_timestamp":        {"1318622958"},
+		"oauth_token":            {"370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb"},
+		"oauth_version":          {"1.0"},
+	}
+	signature := netsuitetest.Signature(sha1.New, "POST", "https://api.twitter.com/1.1/statuses/update.json", params,
+		"kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw", "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE")
+	if signature != "hCtSmYh+iHYCEqBWrE7C7hYmtUk=" {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
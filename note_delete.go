@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewNoteDeleteRequest() NoteDeleteRequest {
+	r := NoteDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type NoteDeleteRequest struct {
+	client      *Client
+	queryParams *NoteDeleteRequestQueryParams
+	pathParams  *NoteDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody NoteDeleteRequestBody
+}
+
+func (r NoteDeleteRequest) NewQueryParams() *NoteDeleteRequestQueryParams {
+	return &NoteDeleteRequestQueryParams{}
+}
+
+type NoteDeleteRequestQueryParams struct {
+}
+
+func (p NoteDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *NoteDeleteRequest) QueryParams() *NoteDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r NoteDeleteRequest) NewPathParams() *NoteDeleteRequestPathParams {
+	return &NoteDeleteRequestPathParams{}
+}
+
+type NoteDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *NoteDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *NoteDeleteRequest) PathParams() *NoteDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *NoteDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *NoteDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *NoteDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *NoteDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *NoteDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r NoteDeleteRequest) NewRequestBody() NoteDeleteRequestBody {
+	return NoteDeleteRequestBody{}
+}
+
+type NoteDeleteRequestBody struct {
+}
+
+func (r *NoteDeleteRequest) RequestBody() *NoteDeleteRequestBody {
+	return nil
+}
+
+func (r *NoteDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *NoteDeleteRequest) SetRequestBody(body NoteDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *NoteDeleteRequest) NewResponseBody() *NoteDeleteResponseBody {
+	return &NoteDeleteResponseBody{}
+}
+
+type NoteDeleteResponseBody struct {
+}
+
+func (r *NoteDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/note/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *NoteDeleteRequest) Do(ctx context.Context) (NoteDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewNoteGetRequest() NoteGetRequest {
+	r := NoteGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type NoteGetRequest struct {
+	client      *Client
+	queryParams *NoteGetRequestQueryParams
+	pathParams  *NoteGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody NoteGetRequestBody
+}
+
+func (r NoteGetRequest) NewQueryParams() *NoteGetRequestQueryParams {
+	return &NoteGetRequestQueryParams{}
+}
+
+type NoteGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p NoteGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *NoteGetRequest) QueryParams() *NoteGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r NoteGetRequest) NewPathParams() *NoteGetRequestPathParams {
+	return &NoteGetRequestPathParams{}
+}
+
+type NoteGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *NoteGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *NoteGetRequest) PathParams() *NoteGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *NoteGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *NoteGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *NoteGetRequest) Method() string {
+	return r.method
+}
+
+func (r *NoteGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *NoteGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r NoteGetRequest) NewRequestBody() NoteGetRequestBody {
+	return NoteGetRequestBody{}
+}
+
+type NoteGetRequestBody struct {
+}
+
+func (r *NoteGetRequest) RequestBody() *NoteGetRequestBody {
+	return nil
+}
+
+func (r *NoteGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *NoteGetRequest) SetRequestBody(body NoteGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *NoteGetRequest) NewResponseBody() *NoteGetResponseBody {
+	return &NoteGetResponseBody{}
+}
+
+type NoteGetResponseBody struct {
+	Links Links `json:"links"`
+	Note
+}
+
+func (r *NoteGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/note/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *NoteGetRequest) Do(ctx context.Context) (NoteGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewNotePatchRequest() NotePatchRequest {
+	r := NotePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type NotePatchRequest struct {
+	client      *Client
+	queryParams *NotePatchRequestQueryParams
+	pathParams  *NotePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody NotePatchRequestBody
+}
+
+func (r NotePatchRequest) NewQueryParams() *NotePatchRequestQueryParams {
+	return &NotePatchRequestQueryParams{}
+}
+
+type NotePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p NotePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *NotePatchRequest) QueryParams() *NotePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r NotePatchRequest) NewPathParams() *NotePatchRequestPathParams {
+	return &NotePatchRequestPathParams{}
+}
+
+type NotePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *NotePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *NotePatchRequest) PathParams() *NotePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *NotePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *NotePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *NotePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *NotePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *NotePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r NotePatchRequest) NewRequestBody() NotePatchRequestBody {
+	return NotePatchRequestBody{}
+}
+
+type NotePatchRequestBody struct {
+	Note
+}
+
+func (r *NotePatchRequest) RequestBody() *NotePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *NotePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *NotePatchRequest) SetRequestBody(body NotePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *NotePatchRequest) NewResponseBody() *NotePatchResponseBody {
+	return &NotePatchResponseBody{}
+}
+
+type NotePatchResponseBody struct {
+}
+
+func (r *NotePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/note/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *NotePatchRequest) Do(ctx context.Context) (NotePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestNotePatch(t *testing.T) {
+	req := client.NewNotePatchRequest()
+	req.PathParams().ID = 3310
+	req.RequestBody().Title = "Call summary (updated)"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewNotePostRequest() NotePostRequest {
+	r := NotePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type NotePostRequest struct {
+	client      *Client
+	queryParams *NotePostRequestQueryParams
+	pathParams  *NotePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody NotePostRequestBody
+}
+
+func (r NotePostRequest) NewQueryParams() *NotePostRequestQueryParams {
+	return &NotePostRequestQueryParams{}
+}
+
+type NotePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p NotePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *NotePostRequest) QueryParams() *NotePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r NotePostRequest) NewPathParams() *NotePostRequestPathParams {
+	return &NotePostRequestPathParams{}
+}
+
+type NotePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *NotePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *NotePostRequest) PathParams() *NotePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *NotePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *NotePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *NotePostRequest) Method() string {
+	return r.method
+}
+
+func (r *NotePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *NotePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r NotePostRequest) NewRequestBody() NotePostRequestBody {
+	return NotePostRequestBody{}
+}
+
+type NotePostRequestBody struct {
+	Note
+}
+
+func (r *NotePostRequest) RequestBody() *NotePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *NotePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *NotePostRequest) SetRequestBody(body NotePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *NotePostRequest) NewResponseBody() *NotePostResponseBody {
+	return &NotePostResponseBody{}
+}
+
+type NotePostResponseBody struct {
+}
+
+func (r *NotePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/note", r.PathParams())
+	return &u, err
+}
+
+func (r *NotePostRequest) Do(ctx context.Context) (NotePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestNotePost(t *testing.T) {
+	req := client.NewNotePostRequest()
+	req.RequestBody().Entity.ID = "70202"
+	req.RequestBody().Title = "Call summary"
+	req.RequestBody().Body = "Note asked for a quote on the annual plan."
+	req.RequestBody().NoteType.ID = "7"
+	req.RequestBody().Direction.ID = "1"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewNotesGetRequest() NotesGetRequest {
+	r := NotesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type NotesGetRequest struct {
+	client      *Client
+	queryParams *NotesGetRequestQueryParams
+	pathParams  *NotesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody NotesGetRequestBody
+}
+
+func (r NotesGetRequest) NewQueryParams() *NotesGetRequestQueryParams {
+	return &NotesGetRequestQueryParams{}
+}
+
+type NotesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p NotesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *NotesGetRequest) QueryParams() *NotesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r NotesGetRequest) NewPathParams() *NotesGetRequestPathParams {
+	return &NotesGetRequestPathParams{}
+}
+
+type NotesGetRequestPathParams struct {
+}
+
+func (p *NotesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *NotesGetRequest) PathParams() *NotesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *NotesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *NotesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *NotesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *NotesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *NotesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r NotesGetRequest) NewRequestBody() NotesGetRequestBody {
+	return NotesGetRequestBody{}
+}
+
+type NotesGetRequestBody struct {
+}
+
+func (r *NotesGetRequest) RequestBody() *NotesGetRequestBody {
+	return nil
+}
+
+func (r *NotesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *NotesGetRequest) SetRequestBody(body NotesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *NotesGetRequest) NewResponseBody() *NotesGetResponseBody {
+	return &NotesGetResponseBody{}
+}
+
+type NotesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *NotesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/note", r.PathParams())
+	return &u, err
+}
+
+func (r *NotesGetRequest) Do(ctx context.Context) (NotesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
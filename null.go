@@ -0,0 +1,67 @@
+package netsuite
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Null is a model field that can be left out, set to a value or explicitly
+// set to null. Plain fields with omitempty can't clear a value on a PATCH
+// because their zero value is never sent; a Null set with NullValue is
+// marshaled as JSON null.
+type Null[T any] struct {
+	Value T
+	// Valid is true when Value holds a value and false when the field is null
+	Valid bool
+	// Set is true when the field has to be written (value or null)
+	Set bool
+}
+
+// NewNull returns a Null holding v.
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{Value: v, Valid: true, Set: true}
+}
+
+// NullValue returns a Null that is written as JSON null, clearing the field.
+func NullValue[T any]() Null[T] {
+	return Null[T]{Set: true}
+}
+
+// Get returns the value and whether it's valid (non null).
+func (n Null[T]) Get() (T, bool) {
+	return n.Value, n.Valid
+}
+
+// IsNull reports whether the field is explicitly null.
+func (n Null[T]) IsNull() bool {
+	return n.Set && !n.Valid
+}
+
+// IsEmpty makes the omitempty marshaler leave out fields that aren't set.
+func (n Null[T]) IsEmpty() bool {
+	return !n.Set
+}
+
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		var empty T
+		n.Value = empty
+		n.Valid = false
+		return nil
+	}
+
+	err := json.Unmarshal(data, &n.Value)
+	if err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,52 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/omitempty"
+)
+
+type nullTestRecord struct {
+	Memo       netsuite.Null[string]             `json:"memo,omitempty"`
+	Department netsuite.Null[netsuite.RecordRef] `json:"department,omitempty"`
+	Class      netsuite.Null[netsuite.RecordRef] `json:"class,omitempty"`
+}
+
+func (r nullTestRecord) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func TestNull(t *testing.T) {
+	r := nullTestRecord{
+		Memo:       netsuite.NullValue[string](),
+		Department: netsuite.NewNull(netsuite.NewRecordRef("3")),
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"memo":null,"department":{"id":"3"}}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, string(b))
+	}
+
+	r = nullTestRecord{}
+	err = json.Unmarshal([]byte(`{"memo":null,"department":{"id":"3","refName":"Sales"}}`), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.Memo.IsNull() {
+		t.Error("expected memo to be null")
+	}
+	if d, ok := r.Department.Get(); !ok || d.RefName != "Sales" {
+		t.Errorf("unexpected department %v", d)
+	}
+	if r.Class.Set {
+		t.Error("expected class not to be set")
+	}
+}
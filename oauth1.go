@@ -103,10 +103,15 @@ func (g *SignatureGenerator) Generate() (string, error) {
 	// 	requestParameters[i] = url.QueryEscape(v)
 	// }
 
+	// Encode escapes spaces as "+", the signature base string requires "%20"
+	// (see http://tools.ietf.org/html/rfc5849#section-3.6); a "+" in a value
+	// is escaped as "%2B", so every remaining "+" is a space.
+	normalizedParameters := strings.Replace(requestParameters.Encode(), "+", "%20", -1)
+
 	dataPieces := []string{
-		g.HTTPRequestMethod,        // http-request-method
-		baseURL.String(),           // base-string-uri
-		requestParameters.Encode(), // normalized-request-parameters
+		g.HTTPRequestMethod,  // http-request-method
+		baseURL.String(),     // base-string-uri
+		normalizedParameters, // normalized-request-parameters
 	}
 
 	for i, v := range dataPieces {
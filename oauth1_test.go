@@ -0,0 +1,31 @@
+package netsuite_test
+
+import (
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestSignatureGenerator(t *testing.T) {
+	// https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
+	g := &netsuite.SignatureGenerator{
+		SignatureMethod:   netsuite.HMACSHA1,
+		BaseURL:           "https://api.twitter.com/1.1/statuses/update.json?include_entities=true&status=Hello%20Ladies%20%2B%20Gentlemen%2C%20a%20signed%20OAuth%20request%21",
+		HTTPRequestMethod: "POST",
+		ClientID:          "xvz1evFS4wEEPTGEFPHBog",
+		ClientSecret:      "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+		TokenID:           "370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb",
+		TokenSecret:       "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE",
+		Nonce:             "kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg",
+		Version:           "1.0",
+		Timestamp:         1318622958,
+	}
+
+	signature, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != "hCtSmYh+iHYCEqBWrE7C7hYmtUk=" {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
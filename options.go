@@ -0,0 +1,169 @@
+package netsuite
+
+import (
+	"net/http"
+)
+
+// Option configures a client in NewClient
+type Option func(*Client)
+
+// WithHTTPClient sets the http client requests are sent with, e.g. the client
+// of an oauth2.Config for OAuth 2.0. A nil client keeps http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.SetHTTPClient(httpClient)
+		}
+	}
+}
+
+// WithBaseURL sets the base url; {{.account_id}} is replaced by the account
+// id.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.SetBaseURL(baseURL)
+	}
+}
+
+// WithAccountID sets the account (company) id, e.g. 1234567 or 1234567_SB1.
+func WithAccountID(accountID string) Option {
+	return func(c *Client) {
+		c.SetCompanyID(accountID)
+	}
+}
+
+// WithTokenAuth enables token based auth with the consumer key and secret of
+// the integration record and the token id and secret of the access token.
+func WithTokenAuth(consumerKey, consumerSecret, tokenID, tokenSecret string) Option {
+	return func(c *Client) {
+		c.SetUseTokenAuth(true)
+		c.SetClientID(consumerKey)
+		c.SetClientSecret(consumerSecret)
+		c.SetTokenID(tokenID)
+		c.SetTokenSecret(tokenSecret)
+	}
+}
+
+// WithContentLanguage sets the language of the translatable fields.
+func WithContentLanguage(contentLanguage string) Option {
+	return func(c *Client) {
+		c.SetContentLanguage(contentLanguage)
+	}
+}
+
+// WithPropertyNameValidation sets how NetSuite handles unknown field names,
+// see Client.SetPropertyNameValidation.
+func WithPropertyNameValidation(validation PropertyNameValidation) Option {
+	return func(c *Client) {
+		c.SetPropertyNameValidation(validation)
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.SetUserAgent(userAgent)
+	}
+}
+
+func WithDebug(debug bool) Option {
+	return func(c *Client) {
+		c.SetDebug(debug)
+	}
+}
+
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.SetLogger(logger)
+	}
+}
+
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) {
+		c.SetMetrics(metrics)
+	}
+}
+
+// WithRetry retries transiently failed requests, see RetryPolicy.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.SetRetryPolicy(policy)
+	}
+}
+
+// WithCache caches the GET responses of the record types in policy.TTLs, see
+// CachePolicy:
+//
+//	netsuite.WithCache(netsuite.CachePolicy{TTLs: netsuite.DefaultCacheTTLs()})
+func WithCache(policy CachePolicy) Option {
+	return func(c *Client) {
+		c.SetCachePolicy(policy)
+	}
+}
+
+// WithRequestCoalescing makes concurrent identical GET and SuiteQL requests
+// share one request, see Client.SetCoalesceRequests.
+func WithRequestCoalescing(coalesce bool) Option {
+	return func(c *Client) {
+		c.SetCoalesceRequests(coalesce)
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond on average with bursts
+// of up to burst requests, see NewRateLimiter.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.SetRateLimiter(NewRateLimiter(requestsPerSecond, burst))
+	}
+}
+
+// WithRateLimiter waits on limiter before every request, e.g. to share a
+// limit between clients.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) {
+		c.SetRateLimiter(limiter)
+	}
+}
+
+// WithConcurrencyLimit limits the client to limit requests in flight, see
+// NewConcurrencyLimiter.
+func WithConcurrencyLimit(limit int) Option {
+	return func(c *Client) {
+		c.SetConcurrencyLimiter(NewConcurrencyLimiter(limit))
+	}
+}
+
+// WithConcurrencyLimiter acquires a slot of limiter for every request, e.g. to
+// share the concurrency limit of the account between processes.
+func WithConcurrencyLimiter(limiter ConcurrencyLimiter) Option {
+	return func(c *Client) {
+		c.SetConcurrencyLimiter(limiter)
+	}
+}
+
+func WithDisallowUnknownFields(disallowUnknownFields bool) Option {
+	return func(c *Client) {
+		c.SetDisallowUnknownFields(disallowUnknownFields)
+	}
+}
+
+// WithOnUnknownFields reports the fields of responses their types don't have,
+// see Client.SetOnUnknownFields.
+func WithOnUnknownFields(fun func(req *http.Request, fields []string)) Option {
+	return func(c *Client) {
+		c.SetOnUnknownFields(fun)
+	}
+}
+
+// WithUseNumber decodes untyped numbers as json.Number, see
+// Client.SetUseNumber.
+func WithUseNumber(useNumber bool) Option {
+	return func(c *Client) {
+		c.SetUseNumber(useNumber)
+	}
+}
+
+func WithValidateRequests(validateRequests bool) Option {
+	return func(c *Client) {
+		c.SetValidateRequests(validateRequests)
+	}
+}
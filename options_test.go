@@ -0,0 +1,181 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	c := netsuite.NewClient(
+		netsuite.WithAccountID("1234567_SB1"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithUserAgent("test/1.0"),
+		netsuite.WithRetry(netsuite.RetryPolicy{MaxRetries: 2}),
+		netsuite.WithRateLimit(10, 5),
+	)
+
+	if c.CompanyID() != "1234567_SB1" || !c.UseTokenAuth() {
+		t.Errorf("expected account and token auth to be configured")
+	}
+	if c.ClientID() != "ck" || c.ClientSecret() != "cs" || c.TokenID() != "tid" || c.TokenSecret() != "ts" {
+		t.Errorf("unexpected token auth credentials")
+	}
+	if c.UserAgent() != "test/1.0" {
+		t.Errorf("expected configured user agent, got %s", c.UserAgent())
+	}
+	if c.RetryPolicy().MaxRetries != 2 || c.RateLimiter() == nil {
+		t.Errorf("expected retry policy and rate limiter to be configured")
+	}
+
+	c.SetMediaType("application/xml")
+	c.SetCharset("iso-8859-1")
+	if c.MediaType() != "application/xml" || c.Charset() != "iso-8859-1" {
+		t.Errorf("expected configured media type and charset, got %s %s", c.MediaType(), c.Charset())
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithRetry(netsuite.RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}),
+	)
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Name != "Net 30" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetryNotIdempotent(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"status":500,"o:errorDetails":[{"detail":"Unexpected error","o:errorCode":"UNEXPECTED_ERROR"}]}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithRetry(netsuite.RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}),
+	)
+
+	req := c.NewTermPostRequest()
+	_, err := req.Do(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "Unexpected error") {
+		t.Errorf("expected server error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a POST not to be retried, got %d attempts", calls)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := netsuite.NewRateLimiter(100, 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("expected requests to be limited, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("expected canceled context, got %v", err)
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	var inFlight, max int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		if strings.HasSuffix(r.URL.Path, "/2") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":404,"o:errorDetails":[{"detail":"Not found","o:errorCode":"NONEXISTENT_ID"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithConcurrencyLimit(2),
+	)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := c.NewTermGetRequest()
+			req.PathParams().ID = 1
+			if _, err := req.Do(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if max > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", max)
+	}
+	// error responses free their slot as well
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req := c.NewTermGetRequest()
+		req.PathParams().ID = 2
+		_, err := req.Do(ctx)
+		cancel()
+		if err == nil || err == context.DeadlineExceeded {
+			t.Fatalf("expected a not found error, got %v", err)
+		}
+	}
+}
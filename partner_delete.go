@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPartnerDeleteRequest() PartnerDeleteRequest {
+	r := PartnerDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PartnerDeleteRequest struct {
+	client      *Client
+	queryParams *PartnerDeleteRequestQueryParams
+	pathParams  *PartnerDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PartnerDeleteRequestBody
+}
+
+func (r PartnerDeleteRequest) NewQueryParams() *PartnerDeleteRequestQueryParams {
+	return &PartnerDeleteRequestQueryParams{}
+}
+
+type PartnerDeleteRequestQueryParams struct {
+}
+
+func (p PartnerDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PartnerDeleteRequest) QueryParams() *PartnerDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PartnerDeleteRequest) NewPathParams() *PartnerDeleteRequestPathParams {
+	return &PartnerDeleteRequestPathParams{}
+}
+
+type PartnerDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PartnerDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PartnerDeleteRequest) PathParams() *PartnerDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PartnerDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PartnerDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PartnerDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *PartnerDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PartnerDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PartnerDeleteRequest) NewRequestBody() PartnerDeleteRequestBody {
+	return PartnerDeleteRequestBody{}
+}
+
+type PartnerDeleteRequestBody struct {
+}
+
+func (r *PartnerDeleteRequest) RequestBody() *PartnerDeleteRequestBody {
+	return nil
+}
+
+func (r *PartnerDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PartnerDeleteRequest) SetRequestBody(body PartnerDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PartnerDeleteRequest) NewResponseBody() *PartnerDeleteResponseBody {
+	return &PartnerDeleteResponseBody{}
+}
+
+type PartnerDeleteResponseBody struct {
+}
+
+func (r *PartnerDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/partner/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PartnerDeleteRequest) Do(ctx context.Context) (PartnerDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
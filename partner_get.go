@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPartnerGetRequest() PartnerGetRequest {
+	r := PartnerGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PartnerGetRequest struct {
+	client      *Client
+	queryParams *PartnerGetRequestQueryParams
+	pathParams  *PartnerGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PartnerGetRequestBody
+}
+
+func (r PartnerGetRequest) NewQueryParams() *PartnerGetRequestQueryParams {
+	return &PartnerGetRequestQueryParams{}
+}
+
+type PartnerGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PartnerGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PartnerGetRequest) QueryParams() *PartnerGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PartnerGetRequest) NewPathParams() *PartnerGetRequestPathParams {
+	return &PartnerGetRequestPathParams{}
+}
+
+type PartnerGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PartnerGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PartnerGetRequest) PathParams() *PartnerGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PartnerGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PartnerGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PartnerGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PartnerGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PartnerGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PartnerGetRequest) NewRequestBody() PartnerGetRequestBody {
+	return PartnerGetRequestBody{}
+}
+
+type PartnerGetRequestBody struct {
+}
+
+func (r *PartnerGetRequest) RequestBody() *PartnerGetRequestBody {
+	return nil
+}
+
+func (r *PartnerGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PartnerGetRequest) SetRequestBody(body PartnerGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PartnerGetRequest) NewResponseBody() *PartnerGetResponseBody {
+	return &PartnerGetResponseBody{}
+}
+
+type PartnerGetResponseBody struct {
+	Links Links `json:"links"`
+	Partner
+}
+
+func (r *PartnerGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/partner/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PartnerGetRequest) Do(ctx context.Context) (PartnerGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
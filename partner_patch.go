@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPartnerPatchRequest() PartnerPatchRequest {
+	r := PartnerPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PartnerPatchRequest struct {
+	client      *Client
+	queryParams *PartnerPatchRequestQueryParams
+	pathParams  *PartnerPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PartnerPatchRequestBody
+}
+
+func (r PartnerPatchRequest) NewQueryParams() *PartnerPatchRequestQueryParams {
+	return &PartnerPatchRequestQueryParams{}
+}
+
+type PartnerPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p PartnerPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PartnerPatchRequest) QueryParams() *PartnerPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PartnerPatchRequest) NewPathParams() *PartnerPatchRequestPathParams {
+	return &PartnerPatchRequestPathParams{}
+}
+
+type PartnerPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PartnerPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PartnerPatchRequest) PathParams() *PartnerPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PartnerPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PartnerPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PartnerPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *PartnerPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PartnerPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PartnerPatchRequest) NewRequestBody() PartnerPatchRequestBody {
+	return PartnerPatchRequestBody{}
+}
+
+type PartnerPatchRequestBody struct {
+	Partner
+}
+
+func (r *PartnerPatchRequest) RequestBody() *PartnerPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *PartnerPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PartnerPatchRequest) SetRequestBody(body PartnerPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PartnerPatchRequest) NewResponseBody() *PartnerPatchResponseBody {
+	return &PartnerPatchResponseBody{}
+}
+
+type PartnerPatchResponseBody struct {
+}
+
+func (r *PartnerPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/partner/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PartnerPatchRequest) Do(ctx context.Context) (PartnerPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPartnerPatch(t *testing.T) {
+	req := client.NewPartnerPatchRequest()
+	req.PathParams().ID = 1201
+	req.RequestBody().Email = "partners@omniboost.io"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPartnerPostRequest() PartnerPostRequest {
+	r := PartnerPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PartnerPostRequest struct {
+	client      *Client
+	queryParams *PartnerPostRequestQueryParams
+	pathParams  *PartnerPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PartnerPostRequestBody
+}
+
+func (r PartnerPostRequest) NewQueryParams() *PartnerPostRequestQueryParams {
+	return &PartnerPostRequestQueryParams{}
+}
+
+type PartnerPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PartnerPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PartnerPostRequest) QueryParams() *PartnerPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PartnerPostRequest) NewPathParams() *PartnerPostRequestPathParams {
+	return &PartnerPostRequestPathParams{}
+}
+
+type PartnerPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PartnerPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PartnerPostRequest) PathParams() *PartnerPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PartnerPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PartnerPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PartnerPostRequest) Method() string {
+	return r.method
+}
+
+func (r *PartnerPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PartnerPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PartnerPostRequest) NewRequestBody() PartnerPostRequestBody {
+	return PartnerPostRequestBody{}
+}
+
+type PartnerPostRequestBody struct {
+	Partner
+}
+
+func (r *PartnerPostRequest) RequestBody() *PartnerPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *PartnerPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PartnerPostRequest) SetRequestBody(body PartnerPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PartnerPostRequest) NewResponseBody() *PartnerPostResponseBody {
+	return &PartnerPostResponseBody{}
+}
+
+type PartnerPostResponseBody struct {
+}
+
+func (r *PartnerPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/partner", r.PathParams())
+	return &u, err
+}
+
+func (r *PartnerPostRequest) Do(ctx context.Context) (PartnerPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPartnerPost(t *testing.T) {
+	req := client.NewPartnerPostRequest()
+	req.RequestBody().CompanyName = "Omniboost Reseller B.V."
+	req.RequestBody().PartnerCode = "OMNI-RES"
+	req.RequestBody().Subsidiary.ID = "46"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
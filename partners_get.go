@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPartnersGetRequest() PartnersGetRequest {
+	r := PartnersGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PartnersGetRequest struct {
+	client      *Client
+	queryParams *PartnersGetRequestQueryParams
+	pathParams  *PartnersGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PartnersGetRequestBody
+}
+
+func (r PartnersGetRequest) NewQueryParams() *PartnersGetRequestQueryParams {
+	return &PartnersGetRequestQueryParams{}
+}
+
+type PartnersGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PartnersGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PartnersGetRequest) QueryParams() *PartnersGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PartnersGetRequest) NewPathParams() *PartnersGetRequestPathParams {
+	return &PartnersGetRequestPathParams{}
+}
+
+type PartnersGetRequestPathParams struct {
+}
+
+func (p *PartnersGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PartnersGetRequest) PathParams() *PartnersGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PartnersGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PartnersGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PartnersGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PartnersGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PartnersGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PartnersGetRequest) NewRequestBody() PartnersGetRequestBody {
+	return PartnersGetRequestBody{}
+}
+
+type PartnersGetRequestBody struct {
+}
+
+func (r *PartnersGetRequest) RequestBody() *PartnersGetRequestBody {
+	return nil
+}
+
+func (r *PartnersGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PartnersGetRequest) SetRequestBody(body PartnersGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PartnersGetRequest) NewResponseBody() *PartnersGetResponseBody {
+	return &PartnersGetResponseBody{}
+}
+
+type PartnersGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PartnersGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/partner", r.PathParams())
+	return &u, err
+}
+
+func (r *PartnersGetRequest) Do(ctx context.Context) (PartnersGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,46 @@
+package netsuite_test
+
+import (
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+type pathParams map[string]string
+
+func (p pathParams) Params() map[string]string {
+	return p
+}
+
+func TestGetEndpointURLPathParams(t *testing.T) {
+	c := netsuite.NewClient(netsuite.WithAccountID("1234567"))
+
+	u, err := c.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}", pathParams{"record_type": "customer", "id": "12"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(u.Path, "/record/v1/customer/12") {
+		t.Errorf("unexpected path %s", u.Path)
+	}
+
+	_, err = c.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}/{{.sublist}}", pathParams{"id": ""})
+	if err == nil || !strings.Contains(err.Error(), "record_type, id, sublist") {
+		t.Errorf("expected the missing params to be listed, got %v", err)
+	}
+
+	_, err = c.GetEndpointURL("/record/v1/{{.record_type}}", nil)
+	if err == nil || strings.Contains(err.Error(), "<no value>") {
+		t.Errorf("expected an error for the missing record type, got %v", err)
+	}
+
+	_, err = c.GetEndpointURL("/record/v1/{{.record_type", pathParams{"record_type": "customer"})
+	if err == nil || !strings.Contains(err.Error(), "/record/v1/{{.record_type") {
+		t.Errorf("expected an error for the invalid template, got %v", err)
+	}
+
+	_, err = c.GetEndpointURL("/record/v1/%zz", nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid endpoint") {
+		t.Errorf("expected an error for the invalid endpoint, got %v", err)
+	}
+}
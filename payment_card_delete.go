@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardDeleteRequest() PaymentCardDeleteRequest {
+	r := PaymentCardDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardDeleteRequest struct {
+	client      *Client
+	queryParams *PaymentCardDeleteRequestQueryParams
+	pathParams  *PaymentCardDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardDeleteRequestBody
+}
+
+func (r PaymentCardDeleteRequest) NewQueryParams() *PaymentCardDeleteRequestQueryParams {
+	return &PaymentCardDeleteRequestQueryParams{}
+}
+
+type PaymentCardDeleteRequestQueryParams struct {
+}
+
+func (p PaymentCardDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardDeleteRequest) QueryParams() *PaymentCardDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardDeleteRequest) NewPathParams() *PaymentCardDeleteRequestPathParams {
+	return &PaymentCardDeleteRequestPathParams{}
+}
+
+type PaymentCardDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardDeleteRequest) PathParams() *PaymentCardDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardDeleteRequest) NewRequestBody() PaymentCardDeleteRequestBody {
+	return PaymentCardDeleteRequestBody{}
+}
+
+type PaymentCardDeleteRequestBody struct {
+}
+
+func (r *PaymentCardDeleteRequest) RequestBody() *PaymentCardDeleteRequestBody {
+	return nil
+}
+
+func (r *PaymentCardDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardDeleteRequest) SetRequestBody(body PaymentCardDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardDeleteRequest) NewResponseBody() *PaymentCardDeleteResponseBody {
+	return &PaymentCardDeleteResponseBody{}
+}
+
+type PaymentCardDeleteResponseBody struct {
+}
+
+func (r *PaymentCardDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCard/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardDeleteRequest) Do(ctx context.Context) (PaymentCardDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardGetRequest() PaymentCardGetRequest {
+	r := PaymentCardGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardGetRequest struct {
+	client      *Client
+	queryParams *PaymentCardGetRequestQueryParams
+	pathParams  *PaymentCardGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardGetRequestBody
+}
+
+func (r PaymentCardGetRequest) NewQueryParams() *PaymentCardGetRequestQueryParams {
+	return &PaymentCardGetRequestQueryParams{}
+}
+
+type PaymentCardGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PaymentCardGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardGetRequest) QueryParams() *PaymentCardGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardGetRequest) NewPathParams() *PaymentCardGetRequestPathParams {
+	return &PaymentCardGetRequestPathParams{}
+}
+
+type PaymentCardGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardGetRequest) PathParams() *PaymentCardGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardGetRequest) NewRequestBody() PaymentCardGetRequestBody {
+	return PaymentCardGetRequestBody{}
+}
+
+type PaymentCardGetRequestBody struct {
+}
+
+func (r *PaymentCardGetRequest) RequestBody() *PaymentCardGetRequestBody {
+	return nil
+}
+
+func (r *PaymentCardGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardGetRequest) SetRequestBody(body PaymentCardGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardGetRequest) NewResponseBody() *PaymentCardGetResponseBody {
+	return &PaymentCardGetResponseBody{}
+}
+
+type PaymentCardGetResponseBody struct {
+	Links Links `json:"links"`
+	PaymentCard
+}
+
+func (r *PaymentCardGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCard/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardGetRequest) Do(ctx context.Context) (PaymentCardGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardPostRequest() PaymentCardPostRequest {
+	r := PaymentCardPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardPostRequest struct {
+	client      *Client
+	queryParams *PaymentCardPostRequestQueryParams
+	pathParams  *PaymentCardPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardPostRequestBody
+}
+
+func (r PaymentCardPostRequest) NewQueryParams() *PaymentCardPostRequestQueryParams {
+	return &PaymentCardPostRequestQueryParams{}
+}
+
+type PaymentCardPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PaymentCardPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardPostRequest) QueryParams() *PaymentCardPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardPostRequest) NewPathParams() *PaymentCardPostRequestPathParams {
+	return &PaymentCardPostRequestPathParams{}
+}
+
+type PaymentCardPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardPostRequest) PathParams() *PaymentCardPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardPostRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardPostRequest) NewRequestBody() PaymentCardPostRequestBody {
+	return PaymentCardPostRequestBody{}
+}
+
+type PaymentCardPostRequestBody struct {
+	PaymentCard
+}
+
+func (r *PaymentCardPostRequest) RequestBody() *PaymentCardPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *PaymentCardPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PaymentCardPostRequest) SetRequestBody(body PaymentCardPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardPostRequest) NewResponseBody() *PaymentCardPostResponseBody {
+	return &PaymentCardPostResponseBody{}
+}
+
+type PaymentCardPostResponseBody struct {
+}
+
+func (r *PaymentCardPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCard", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardPostRequest) Do(ctx context.Context) (PaymentCardPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,27 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestPaymentCardPost(t *testing.T) {
+	req := client.NewPaymentCardPostRequest()
+	req.RequestBody().Entity.ID = "70202"
+	req.RequestBody().PaymentMethod.ID = "5"
+	req.RequestBody().CardNumber = "4111111111111111"
+	req.RequestBody().NameOnCard = "Kees Zorge"
+	req.RequestBody().ExpirationDate = netsuite.Date{Time: time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardTokenDeleteRequest() PaymentCardTokenDeleteRequest {
+	r := PaymentCardTokenDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardTokenDeleteRequest struct {
+	client      *Client
+	queryParams *PaymentCardTokenDeleteRequestQueryParams
+	pathParams  *PaymentCardTokenDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardTokenDeleteRequestBody
+}
+
+func (r PaymentCardTokenDeleteRequest) NewQueryParams() *PaymentCardTokenDeleteRequestQueryParams {
+	return &PaymentCardTokenDeleteRequestQueryParams{}
+}
+
+type PaymentCardTokenDeleteRequestQueryParams struct {
+}
+
+func (p PaymentCardTokenDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardTokenDeleteRequest) QueryParams() *PaymentCardTokenDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardTokenDeleteRequest) NewPathParams() *PaymentCardTokenDeleteRequestPathParams {
+	return &PaymentCardTokenDeleteRequestPathParams{}
+}
+
+type PaymentCardTokenDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardTokenDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardTokenDeleteRequest) PathParams() *PaymentCardTokenDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardTokenDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardTokenDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardTokenDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardTokenDeleteRequest) NewRequestBody() PaymentCardTokenDeleteRequestBody {
+	return PaymentCardTokenDeleteRequestBody{}
+}
+
+type PaymentCardTokenDeleteRequestBody struct {
+}
+
+func (r *PaymentCardTokenDeleteRequest) RequestBody() *PaymentCardTokenDeleteRequestBody {
+	return nil
+}
+
+func (r *PaymentCardTokenDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardTokenDeleteRequest) SetRequestBody(body PaymentCardTokenDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardTokenDeleteRequest) NewResponseBody() *PaymentCardTokenDeleteResponseBody {
+	return &PaymentCardTokenDeleteResponseBody{}
+}
+
+type PaymentCardTokenDeleteResponseBody struct {
+}
+
+func (r *PaymentCardTokenDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCardToken/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardTokenDeleteRequest) Do(ctx context.Context) (PaymentCardTokenDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardTokenGetRequest() PaymentCardTokenGetRequest {
+	r := PaymentCardTokenGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardTokenGetRequest struct {
+	client      *Client
+	queryParams *PaymentCardTokenGetRequestQueryParams
+	pathParams  *PaymentCardTokenGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardTokenGetRequestBody
+}
+
+func (r PaymentCardTokenGetRequest) NewQueryParams() *PaymentCardTokenGetRequestQueryParams {
+	return &PaymentCardTokenGetRequestQueryParams{}
+}
+
+type PaymentCardTokenGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PaymentCardTokenGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardTokenGetRequest) QueryParams() *PaymentCardTokenGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardTokenGetRequest) NewPathParams() *PaymentCardTokenGetRequestPathParams {
+	return &PaymentCardTokenGetRequestPathParams{}
+}
+
+type PaymentCardTokenGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardTokenGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardTokenGetRequest) PathParams() *PaymentCardTokenGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardTokenGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardTokenGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardTokenGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardTokenGetRequest) NewRequestBody() PaymentCardTokenGetRequestBody {
+	return PaymentCardTokenGetRequestBody{}
+}
+
+type PaymentCardTokenGetRequestBody struct {
+}
+
+func (r *PaymentCardTokenGetRequest) RequestBody() *PaymentCardTokenGetRequestBody {
+	return nil
+}
+
+func (r *PaymentCardTokenGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardTokenGetRequest) SetRequestBody(body PaymentCardTokenGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardTokenGetRequest) NewResponseBody() *PaymentCardTokenGetResponseBody {
+	return &PaymentCardTokenGetResponseBody{}
+}
+
+type PaymentCardTokenGetResponseBody struct {
+	Links Links `json:"links"`
+	PaymentCardToken
+}
+
+func (r *PaymentCardTokenGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCardToken/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardTokenGetRequest) Do(ctx context.Context) (PaymentCardTokenGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardTokenPostRequest() PaymentCardTokenPostRequest {
+	r := PaymentCardTokenPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardTokenPostRequest struct {
+	client      *Client
+	queryParams *PaymentCardTokenPostRequestQueryParams
+	pathParams  *PaymentCardTokenPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardTokenPostRequestBody
+}
+
+func (r PaymentCardTokenPostRequest) NewQueryParams() *PaymentCardTokenPostRequestQueryParams {
+	return &PaymentCardTokenPostRequestQueryParams{}
+}
+
+type PaymentCardTokenPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PaymentCardTokenPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardTokenPostRequest) QueryParams() *PaymentCardTokenPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardTokenPostRequest) NewPathParams() *PaymentCardTokenPostRequestPathParams {
+	return &PaymentCardTokenPostRequestPathParams{}
+}
+
+type PaymentCardTokenPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentCardTokenPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentCardTokenPostRequest) PathParams() *PaymentCardTokenPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokenPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardTokenPostRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardTokenPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardTokenPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardTokenPostRequest) NewRequestBody() PaymentCardTokenPostRequestBody {
+	return PaymentCardTokenPostRequestBody{}
+}
+
+type PaymentCardTokenPostRequestBody struct {
+	PaymentCardToken
+}
+
+func (r *PaymentCardTokenPostRequest) RequestBody() *PaymentCardTokenPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *PaymentCardTokenPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PaymentCardTokenPostRequest) SetRequestBody(body PaymentCardTokenPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardTokenPostRequest) NewResponseBody() *PaymentCardTokenPostResponseBody {
+	return &PaymentCardTokenPostResponseBody{}
+}
+
+type PaymentCardTokenPostResponseBody struct {
+}
+
+func (r *PaymentCardTokenPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCardToken", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardTokenPostRequest) Do(ctx context.Context) (PaymentCardTokenPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
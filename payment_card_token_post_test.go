@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPaymentCardTokenPost(t *testing.T) {
+	req := client.NewPaymentCardTokenPostRequest()
+	req.RequestBody().Entity.ID = "70202"
+	req.RequestBody().PaymentMethod.ID = "5"
+	req.RequestBody().Token = "tok_1KxR2mExampleToken"
+	req.RequestBody().CardLastFourDigits = "4242"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardTokensGetRequest() PaymentCardTokensGetRequest {
+	r := PaymentCardTokensGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardTokensGetRequest struct {
+	client      *Client
+	queryParams *PaymentCardTokensGetRequestQueryParams
+	pathParams  *PaymentCardTokensGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardTokensGetRequestBody
+}
+
+func (r PaymentCardTokensGetRequest) NewQueryParams() *PaymentCardTokensGetRequestQueryParams {
+	return &PaymentCardTokensGetRequestQueryParams{}
+}
+
+type PaymentCardTokensGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PaymentCardTokensGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardTokensGetRequest) QueryParams() *PaymentCardTokensGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardTokensGetRequest) NewPathParams() *PaymentCardTokensGetRequestPathParams {
+	return &PaymentCardTokensGetRequestPathParams{}
+}
+
+type PaymentCardTokensGetRequestPathParams struct {
+}
+
+func (p *PaymentCardTokensGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PaymentCardTokensGetRequest) PathParams() *PaymentCardTokensGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokensGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardTokensGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardTokensGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardTokensGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardTokensGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardTokensGetRequest) NewRequestBody() PaymentCardTokensGetRequestBody {
+	return PaymentCardTokensGetRequestBody{}
+}
+
+type PaymentCardTokensGetRequestBody struct {
+}
+
+func (r *PaymentCardTokensGetRequest) RequestBody() *PaymentCardTokensGetRequestBody {
+	return nil
+}
+
+func (r *PaymentCardTokensGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardTokensGetRequest) SetRequestBody(body PaymentCardTokensGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardTokensGetRequest) NewResponseBody() *PaymentCardTokensGetResponseBody {
+	return &PaymentCardTokensGetResponseBody{}
+}
+
+type PaymentCardTokensGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PaymentCardTokensGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCardToken", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardTokensGetRequest) Do(ctx context.Context) (PaymentCardTokensGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentCardsGetRequest() PaymentCardsGetRequest {
+	r := PaymentCardsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentCardsGetRequest struct {
+	client      *Client
+	queryParams *PaymentCardsGetRequestQueryParams
+	pathParams  *PaymentCardsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentCardsGetRequestBody
+}
+
+func (r PaymentCardsGetRequest) NewQueryParams() *PaymentCardsGetRequestQueryParams {
+	return &PaymentCardsGetRequestQueryParams{}
+}
+
+type PaymentCardsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PaymentCardsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentCardsGetRequest) QueryParams() *PaymentCardsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentCardsGetRequest) NewPathParams() *PaymentCardsGetRequestPathParams {
+	return &PaymentCardsGetRequestPathParams{}
+}
+
+type PaymentCardsGetRequestPathParams struct {
+}
+
+func (p *PaymentCardsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PaymentCardsGetRequest) PathParams() *PaymentCardsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentCardsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentCardsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentCardsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentCardsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentCardsGetRequest) NewRequestBody() PaymentCardsGetRequestBody {
+	return PaymentCardsGetRequestBody{}
+}
+
+type PaymentCardsGetRequestBody struct {
+}
+
+func (r *PaymentCardsGetRequest) RequestBody() *PaymentCardsGetRequestBody {
+	return nil
+}
+
+func (r *PaymentCardsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentCardsGetRequest) SetRequestBody(body PaymentCardsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentCardsGetRequest) NewResponseBody() *PaymentCardsGetResponseBody {
+	return &PaymentCardsGetResponseBody{}
+}
+
+type PaymentCardsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PaymentCardsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentCard", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentCardsGetRequest) Do(ctx context.Context) (PaymentCardsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentMethodGetRequest() PaymentMethodGetRequest {
+	r := PaymentMethodGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentMethodGetRequest struct {
+	client      *Client
+	queryParams *PaymentMethodGetRequestQueryParams
+	pathParams  *PaymentMethodGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentMethodGetRequestBody
+}
+
+func (r PaymentMethodGetRequest) NewQueryParams() *PaymentMethodGetRequestQueryParams {
+	return &PaymentMethodGetRequestQueryParams{}
+}
+
+type PaymentMethodGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PaymentMethodGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentMethodGetRequest) QueryParams() *PaymentMethodGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentMethodGetRequest) NewPathParams() *PaymentMethodGetRequestPathParams {
+	return &PaymentMethodGetRequestPathParams{}
+}
+
+type PaymentMethodGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PaymentMethodGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PaymentMethodGetRequest) PathParams() *PaymentMethodGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentMethodGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentMethodGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentMethodGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentMethodGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentMethodGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentMethodGetRequest) NewRequestBody() PaymentMethodGetRequestBody {
+	return PaymentMethodGetRequestBody{}
+}
+
+type PaymentMethodGetRequestBody struct {
+}
+
+func (r *PaymentMethodGetRequest) RequestBody() *PaymentMethodGetRequestBody {
+	return nil
+}
+
+func (r *PaymentMethodGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentMethodGetRequest) SetRequestBody(body PaymentMethodGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentMethodGetRequest) NewResponseBody() *PaymentMethodGetResponseBody {
+	return &PaymentMethodGetResponseBody{}
+}
+
+type PaymentMethodGetResponseBody struct {
+	Links Links `json:"links"`
+	PaymentMethod
+}
+
+func (r *PaymentMethodGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentMethod/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentMethodGetRequest) Do(ctx context.Context) (PaymentMethodGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPaymentMethodsGetRequest() PaymentMethodsGetRequest {
+	r := PaymentMethodsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PaymentMethodsGetRequest struct {
+	client      *Client
+	queryParams *PaymentMethodsGetRequestQueryParams
+	pathParams  *PaymentMethodsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PaymentMethodsGetRequestBody
+}
+
+func (r PaymentMethodsGetRequest) NewQueryParams() *PaymentMethodsGetRequestQueryParams {
+	return &PaymentMethodsGetRequestQueryParams{}
+}
+
+type PaymentMethodsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PaymentMethodsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PaymentMethodsGetRequest) QueryParams() *PaymentMethodsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PaymentMethodsGetRequest) NewPathParams() *PaymentMethodsGetRequestPathParams {
+	return &PaymentMethodsGetRequestPathParams{}
+}
+
+type PaymentMethodsGetRequestPathParams struct {
+}
+
+func (p *PaymentMethodsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PaymentMethodsGetRequest) PathParams() *PaymentMethodsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PaymentMethodsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PaymentMethodsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PaymentMethodsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PaymentMethodsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PaymentMethodsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PaymentMethodsGetRequest) NewRequestBody() PaymentMethodsGetRequestBody {
+	return PaymentMethodsGetRequestBody{}
+}
+
+type PaymentMethodsGetRequestBody struct {
+}
+
+func (r *PaymentMethodsGetRequest) RequestBody() *PaymentMethodsGetRequestBody {
+	return nil
+}
+
+func (r *PaymentMethodsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PaymentMethodsGetRequest) SetRequestBody(body PaymentMethodsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PaymentMethodsGetRequest) NewResponseBody() *PaymentMethodsGetResponseBody {
+	return &PaymentMethodsGetResponseBody{}
+}
+
+type PaymentMethodsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PaymentMethodsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/paymentMethod", r.PathParams())
+	return &u, err
+}
+
+func (r *PaymentMethodsGetRequest) Do(ctx context.Context) (PaymentMethodsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
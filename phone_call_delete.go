@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPhoneCallDeleteRequest() PhoneCallDeleteRequest {
+	r := PhoneCallDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PhoneCallDeleteRequest struct {
+	client      *Client
+	queryParams *PhoneCallDeleteRequestQueryParams
+	pathParams  *PhoneCallDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PhoneCallDeleteRequestBody
+}
+
+func (r PhoneCallDeleteRequest) NewQueryParams() *PhoneCallDeleteRequestQueryParams {
+	return &PhoneCallDeleteRequestQueryParams{}
+}
+
+type PhoneCallDeleteRequestQueryParams struct {
+}
+
+func (p PhoneCallDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PhoneCallDeleteRequest) QueryParams() *PhoneCallDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PhoneCallDeleteRequest) NewPathParams() *PhoneCallDeleteRequestPathParams {
+	return &PhoneCallDeleteRequestPathParams{}
+}
+
+type PhoneCallDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PhoneCallDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PhoneCallDeleteRequest) PathParams() *PhoneCallDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PhoneCallDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *PhoneCallDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PhoneCallDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PhoneCallDeleteRequest) NewRequestBody() PhoneCallDeleteRequestBody {
+	return PhoneCallDeleteRequestBody{}
+}
+
+type PhoneCallDeleteRequestBody struct {
+}
+
+func (r *PhoneCallDeleteRequest) RequestBody() *PhoneCallDeleteRequestBody {
+	return nil
+}
+
+func (r *PhoneCallDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PhoneCallDeleteRequest) SetRequestBody(body PhoneCallDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PhoneCallDeleteRequest) NewResponseBody() *PhoneCallDeleteResponseBody {
+	return &PhoneCallDeleteResponseBody{}
+}
+
+type PhoneCallDeleteResponseBody struct {
+}
+
+func (r *PhoneCallDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/phoneCall/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PhoneCallDeleteRequest) Do(ctx context.Context) (PhoneCallDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPhoneCallGetRequest() PhoneCallGetRequest {
+	r := PhoneCallGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PhoneCallGetRequest struct {
+	client      *Client
+	queryParams *PhoneCallGetRequestQueryParams
+	pathParams  *PhoneCallGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PhoneCallGetRequestBody
+}
+
+func (r PhoneCallGetRequest) NewQueryParams() *PhoneCallGetRequestQueryParams {
+	return &PhoneCallGetRequestQueryParams{}
+}
+
+type PhoneCallGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PhoneCallGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PhoneCallGetRequest) QueryParams() *PhoneCallGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PhoneCallGetRequest) NewPathParams() *PhoneCallGetRequestPathParams {
+	return &PhoneCallGetRequestPathParams{}
+}
+
+type PhoneCallGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PhoneCallGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PhoneCallGetRequest) PathParams() *PhoneCallGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PhoneCallGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PhoneCallGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PhoneCallGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PhoneCallGetRequest) NewRequestBody() PhoneCallGetRequestBody {
+	return PhoneCallGetRequestBody{}
+}
+
+type PhoneCallGetRequestBody struct {
+}
+
+func (r *PhoneCallGetRequest) RequestBody() *PhoneCallGetRequestBody {
+	return nil
+}
+
+func (r *PhoneCallGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PhoneCallGetRequest) SetRequestBody(body PhoneCallGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PhoneCallGetRequest) NewResponseBody() *PhoneCallGetResponseBody {
+	return &PhoneCallGetResponseBody{}
+}
+
+type PhoneCallGetResponseBody struct {
+	Links Links `json:"links"`
+	PhoneCall
+}
+
+func (r *PhoneCallGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/phoneCall/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PhoneCallGetRequest) Do(ctx context.Context) (PhoneCallGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPhoneCallPatchRequest() PhoneCallPatchRequest {
+	r := PhoneCallPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PhoneCallPatchRequest struct {
+	client      *Client
+	queryParams *PhoneCallPatchRequestQueryParams
+	pathParams  *PhoneCallPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PhoneCallPatchRequestBody
+}
+
+func (r PhoneCallPatchRequest) NewQueryParams() *PhoneCallPatchRequestQueryParams {
+	return &PhoneCallPatchRequestQueryParams{}
+}
+
+type PhoneCallPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p PhoneCallPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PhoneCallPatchRequest) QueryParams() *PhoneCallPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PhoneCallPatchRequest) NewPathParams() *PhoneCallPatchRequestPathParams {
+	return &PhoneCallPatchRequestPathParams{}
+}
+
+type PhoneCallPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PhoneCallPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PhoneCallPatchRequest) PathParams() *PhoneCallPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PhoneCallPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *PhoneCallPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PhoneCallPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PhoneCallPatchRequest) NewRequestBody() PhoneCallPatchRequestBody {
+	return PhoneCallPatchRequestBody{}
+}
+
+type PhoneCallPatchRequestBody struct {
+	PhoneCall
+}
+
+func (r *PhoneCallPatchRequest) RequestBody() *PhoneCallPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *PhoneCallPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PhoneCallPatchRequest) SetRequestBody(body PhoneCallPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PhoneCallPatchRequest) NewResponseBody() *PhoneCallPatchResponseBody {
+	return &PhoneCallPatchResponseBody{}
+}
+
+type PhoneCallPatchResponseBody struct {
+}
+
+func (r *PhoneCallPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/phoneCall/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PhoneCallPatchRequest) Do(ctx context.Context) (PhoneCallPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPhoneCallPatch(t *testing.T) {
+	req := client.NewPhoneCallPatchRequest()
+	req.PathParams().ID = 2203
+	req.RequestBody().Status.ID = "COMPLETE"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
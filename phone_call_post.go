@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPhoneCallPostRequest() PhoneCallPostRequest {
+	r := PhoneCallPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PhoneCallPostRequest struct {
+	client      *Client
+	queryParams *PhoneCallPostRequestQueryParams
+	pathParams  *PhoneCallPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PhoneCallPostRequestBody
+}
+
+func (r PhoneCallPostRequest) NewQueryParams() *PhoneCallPostRequestQueryParams {
+	return &PhoneCallPostRequestQueryParams{}
+}
+
+type PhoneCallPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PhoneCallPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PhoneCallPostRequest) QueryParams() *PhoneCallPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PhoneCallPostRequest) NewPathParams() *PhoneCallPostRequestPathParams {
+	return &PhoneCallPostRequestPathParams{}
+}
+
+type PhoneCallPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PhoneCallPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PhoneCallPostRequest) PathParams() *PhoneCallPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PhoneCallPostRequest) Method() string {
+	return r.method
+}
+
+func (r *PhoneCallPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PhoneCallPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PhoneCallPostRequest) NewRequestBody() PhoneCallPostRequestBody {
+	return PhoneCallPostRequestBody{}
+}
+
+type PhoneCallPostRequestBody struct {
+	PhoneCall
+}
+
+func (r *PhoneCallPostRequest) RequestBody() *PhoneCallPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *PhoneCallPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PhoneCallPostRequest) SetRequestBody(body PhoneCallPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PhoneCallPostRequest) NewResponseBody() *PhoneCallPostResponseBody {
+	return &PhoneCallPostResponseBody{}
+}
+
+type PhoneCallPostResponseBody struct {
+}
+
+func (r *PhoneCallPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/phoneCall", r.PathParams())
+	return &u, err
+}
+
+func (r *PhoneCallPostRequest) Do(ctx context.Context) (PhoneCallPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
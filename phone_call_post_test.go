@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPhoneCallPost(t *testing.T) {
+	req := client.NewPhoneCallPostRequest()
+	req.RequestBody().Title = "Follow-up call"
+	req.RequestBody().Assigned.ID = "1642"
+	req.RequestBody().Company.ID = "70202"
+	req.RequestBody().Phone = "1335132342"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
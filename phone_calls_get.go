@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPhoneCallsGetRequest() PhoneCallsGetRequest {
+	r := PhoneCallsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PhoneCallsGetRequest struct {
+	client      *Client
+	queryParams *PhoneCallsGetRequestQueryParams
+	pathParams  *PhoneCallsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PhoneCallsGetRequestBody
+}
+
+func (r PhoneCallsGetRequest) NewQueryParams() *PhoneCallsGetRequestQueryParams {
+	return &PhoneCallsGetRequestQueryParams{}
+}
+
+type PhoneCallsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PhoneCallsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PhoneCallsGetRequest) QueryParams() *PhoneCallsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PhoneCallsGetRequest) NewPathParams() *PhoneCallsGetRequestPathParams {
+	return &PhoneCallsGetRequestPathParams{}
+}
+
+type PhoneCallsGetRequestPathParams struct {
+}
+
+func (p *PhoneCallsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PhoneCallsGetRequest) PathParams() *PhoneCallsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PhoneCallsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PhoneCallsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PhoneCallsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PhoneCallsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PhoneCallsGetRequest) NewRequestBody() PhoneCallsGetRequestBody {
+	return PhoneCallsGetRequestBody{}
+}
+
+type PhoneCallsGetRequestBody struct {
+}
+
+func (r *PhoneCallsGetRequest) RequestBody() *PhoneCallsGetRequestBody {
+	return nil
+}
+
+func (r *PhoneCallsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PhoneCallsGetRequest) SetRequestBody(body PhoneCallsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PhoneCallsGetRequest) NewResponseBody() *PhoneCallsGetResponseBody {
+	return &PhoneCallsGetResponseBody{}
+}
+
+type PhoneCallsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PhoneCallsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/phoneCall", r.PathParams())
+	return &u, err
+}
+
+func (r *PhoneCallsGetRequest) Do(ctx context.Context) (PhoneCallsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
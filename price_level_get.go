@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPriceLevelGetRequest() PriceLevelGetRequest {
+	r := PriceLevelGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PriceLevelGetRequest struct {
+	client      *Client
+	queryParams *PriceLevelGetRequestQueryParams
+	pathParams  *PriceLevelGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PriceLevelGetRequestBody
+}
+
+func (r PriceLevelGetRequest) NewQueryParams() *PriceLevelGetRequestQueryParams {
+	return &PriceLevelGetRequestQueryParams{}
+}
+
+type PriceLevelGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PriceLevelGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PriceLevelGetRequest) QueryParams() *PriceLevelGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PriceLevelGetRequest) NewPathParams() *PriceLevelGetRequestPathParams {
+	return &PriceLevelGetRequestPathParams{}
+}
+
+type PriceLevelGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PriceLevelGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PriceLevelGetRequest) PathParams() *PriceLevelGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PriceLevelGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PriceLevelGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PriceLevelGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PriceLevelGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PriceLevelGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PriceLevelGetRequest) NewRequestBody() PriceLevelGetRequestBody {
+	return PriceLevelGetRequestBody{}
+}
+
+type PriceLevelGetRequestBody struct {
+}
+
+func (r *PriceLevelGetRequest) RequestBody() *PriceLevelGetRequestBody {
+	return nil
+}
+
+func (r *PriceLevelGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PriceLevelGetRequest) SetRequestBody(body PriceLevelGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PriceLevelGetRequest) NewResponseBody() *PriceLevelGetResponseBody {
+	return &PriceLevelGetResponseBody{}
+}
+
+type PriceLevelGetResponseBody struct {
+	Links Links `json:"links"`
+	PriceLevel
+}
+
+func (r *PriceLevelGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/priceLevel/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PriceLevelGetRequest) Do(ctx context.Context) (PriceLevelGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
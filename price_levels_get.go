@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPriceLevelsGetRequest() PriceLevelsGetRequest {
+	r := PriceLevelsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PriceLevelsGetRequest struct {
+	client      *Client
+	queryParams *PriceLevelsGetRequestQueryParams
+	pathParams  *PriceLevelsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PriceLevelsGetRequestBody
+}
+
+func (r PriceLevelsGetRequest) NewQueryParams() *PriceLevelsGetRequestQueryParams {
+	return &PriceLevelsGetRequestQueryParams{}
+}
+
+type PriceLevelsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PriceLevelsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PriceLevelsGetRequest) QueryParams() *PriceLevelsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PriceLevelsGetRequest) NewPathParams() *PriceLevelsGetRequestPathParams {
+	return &PriceLevelsGetRequestPathParams{}
+}
+
+type PriceLevelsGetRequestPathParams struct {
+}
+
+func (p *PriceLevelsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PriceLevelsGetRequest) PathParams() *PriceLevelsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PriceLevelsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PriceLevelsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PriceLevelsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PriceLevelsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PriceLevelsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PriceLevelsGetRequest) NewRequestBody() PriceLevelsGetRequestBody {
+	return PriceLevelsGetRequestBody{}
+}
+
+type PriceLevelsGetRequestBody struct {
+}
+
+func (r *PriceLevelsGetRequest) RequestBody() *PriceLevelsGetRequestBody {
+	return nil
+}
+
+func (r *PriceLevelsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PriceLevelsGetRequest) SetRequestBody(body PriceLevelsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PriceLevelsGetRequest) NewResponseBody() *PriceLevelsGetResponseBody {
+	return &PriceLevelsGetResponseBody{}
+}
+
+type PriceLevelsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PriceLevelsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/priceLevel", r.PathParams())
+	return &u, err
+}
+
+func (r *PriceLevelsGetRequest) Do(ctx context.Context) (PriceLevelsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
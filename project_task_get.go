@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewProjectTaskGetRequest() ProjectTaskGetRequest {
+	r := ProjectTaskGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ProjectTaskGetRequest struct {
+	client      *Client
+	queryParams *ProjectTaskGetRequestQueryParams
+	pathParams  *ProjectTaskGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ProjectTaskGetRequestBody
+}
+
+func (r ProjectTaskGetRequest) NewQueryParams() *ProjectTaskGetRequestQueryParams {
+	return &ProjectTaskGetRequestQueryParams{}
+}
+
+type ProjectTaskGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ProjectTaskGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ProjectTaskGetRequest) QueryParams() *ProjectTaskGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ProjectTaskGetRequest) NewPathParams() *ProjectTaskGetRequestPathParams {
+	return &ProjectTaskGetRequestPathParams{}
+}
+
+type ProjectTaskGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ProjectTaskGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ProjectTaskGetRequest) PathParams() *ProjectTaskGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTaskGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTaskGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ProjectTaskGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ProjectTaskGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ProjectTaskGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ProjectTaskGetRequest) NewRequestBody() ProjectTaskGetRequestBody {
+	return ProjectTaskGetRequestBody{}
+}
+
+type ProjectTaskGetRequestBody struct {
+}
+
+func (r *ProjectTaskGetRequest) RequestBody() *ProjectTaskGetRequestBody {
+	return nil
+}
+
+func (r *ProjectTaskGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ProjectTaskGetRequest) SetRequestBody(body ProjectTaskGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ProjectTaskGetRequest) NewResponseBody() *ProjectTaskGetResponseBody {
+	return &ProjectTaskGetResponseBody{}
+}
+
+type ProjectTaskGetResponseBody struct {
+	Links Links `json:"links"`
+	ProjectTask
+}
+
+func (r *ProjectTaskGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/projectTask/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ProjectTaskGetRequest) Do(ctx context.Context) (ProjectTaskGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewProjectTaskPostRequest() ProjectTaskPostRequest {
+	r := ProjectTaskPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ProjectTaskPostRequest struct {
+	client      *Client
+	queryParams *ProjectTaskPostRequestQueryParams
+	pathParams  *ProjectTaskPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ProjectTaskPostRequestBody
+}
+
+func (r ProjectTaskPostRequest) NewQueryParams() *ProjectTaskPostRequestQueryParams {
+	return &ProjectTaskPostRequestQueryParams{}
+}
+
+type ProjectTaskPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ProjectTaskPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ProjectTaskPostRequest) QueryParams() *ProjectTaskPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ProjectTaskPostRequest) NewPathParams() *ProjectTaskPostRequestPathParams {
+	return &ProjectTaskPostRequestPathParams{}
+}
+
+type ProjectTaskPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ProjectTaskPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ProjectTaskPostRequest) PathParams() *ProjectTaskPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTaskPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTaskPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ProjectTaskPostRequest) Method() string {
+	return r.method
+}
+
+func (r *ProjectTaskPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ProjectTaskPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ProjectTaskPostRequest) NewRequestBody() ProjectTaskPostRequestBody {
+	return ProjectTaskPostRequestBody{}
+}
+
+type ProjectTaskPostRequestBody struct {
+	ProjectTask
+}
+
+func (r *ProjectTaskPostRequest) RequestBody() *ProjectTaskPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *ProjectTaskPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ProjectTaskPostRequest) SetRequestBody(body ProjectTaskPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ProjectTaskPostRequest) NewResponseBody() *ProjectTaskPostResponseBody {
+	return &ProjectTaskPostResponseBody{}
+}
+
+type ProjectTaskPostResponseBody struct {
+}
+
+func (r *ProjectTaskPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/projectTask", r.PathParams())
+	return &u, err
+}
+
+func (r *ProjectTaskPostRequest) Do(ctx context.Context) (ProjectTaskPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestProjectTaskPost(t *testing.T) {
+	req := client.NewProjectTaskPostRequest()
+	req.RequestBody().Company.ID = "3214"
+	req.RequestBody().Title = "Kick-off"
+	req.RequestBody().EstimatedWork = 8
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewProjectTasksGetRequest() ProjectTasksGetRequest {
+	r := ProjectTasksGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ProjectTasksGetRequest struct {
+	client      *Client
+	queryParams *ProjectTasksGetRequestQueryParams
+	pathParams  *ProjectTasksGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ProjectTasksGetRequestBody
+}
+
+func (r ProjectTasksGetRequest) NewQueryParams() *ProjectTasksGetRequestQueryParams {
+	return &ProjectTasksGetRequestQueryParams{}
+}
+
+type ProjectTasksGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ProjectTasksGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ProjectTasksGetRequest) QueryParams() *ProjectTasksGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ProjectTasksGetRequest) NewPathParams() *ProjectTasksGetRequestPathParams {
+	return &ProjectTasksGetRequestPathParams{}
+}
+
+type ProjectTasksGetRequestPathParams struct {
+}
+
+func (p *ProjectTasksGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ProjectTasksGetRequest) PathParams() *ProjectTasksGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTasksGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ProjectTasksGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ProjectTasksGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ProjectTasksGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ProjectTasksGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ProjectTasksGetRequest) NewRequestBody() ProjectTasksGetRequestBody {
+	return ProjectTasksGetRequestBody{}
+}
+
+type ProjectTasksGetRequestBody struct {
+}
+
+func (r *ProjectTasksGetRequest) RequestBody() *ProjectTasksGetRequestBody {
+	return nil
+}
+
+func (r *ProjectTasksGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ProjectTasksGetRequest) SetRequestBody(body ProjectTasksGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ProjectTasksGetRequest) NewResponseBody() *ProjectTasksGetResponseBody {
+	return &ProjectTasksGetResponseBody{}
+}
+
+type ProjectTasksGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ProjectTasksGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/projectTask", r.PathParams())
+	return &u, err
+}
+
+func (r *ProjectTasksGetRequest) Do(ctx context.Context) (ProjectTasksGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,89 @@
+// Package prometheus implements netsuite.Metrics with Prometheus counters and
+// histograms.
+//
+//	collector := prometheus.NewCollector("netsuite")
+//	registry.MustRegister(collector)
+//	client.SetMetrics(collector)
+package prometheus
+
+import (
+	"strconv"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector implements both netsuite.Metrics and prometheus.Collector
+type Collector struct {
+	requests  *prom.CounterVec
+	duration  *prom.HistogramVec
+	retries   *prom.CounterVec
+	throttled *prom.CounterVec
+	bytes     *prom.CounterVec
+}
+
+// NewCollector returns a collector with its metrics prefixed with namespace,
+// e.g. netsuite_requests_total.
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests sent to NetSuite by endpoint, method and status code.",
+		}, []string{"endpoint", "method", "status"}),
+		duration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests sent to NetSuite.",
+			Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"endpoint", "method"}),
+		retries: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of retried requests by endpoint.",
+		}, []string{"endpoint"}),
+		throttled: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "throttled_total",
+			Help:      "Number of requests rejected by the NetSuite concurrency or rate limits.",
+		}, []string{"endpoint"}),
+		bytes: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "bytes_total",
+			Help:      "Size of the request (sent) and response (received) bodies.",
+		}, []string{"endpoint", "direction"}),
+	}
+}
+
+func (c *Collector) ObserveRequest(endpoint, method string, status int, duration time.Duration) {
+	c.requests.WithLabelValues(endpoint, method, strconv.Itoa(status)).Inc()
+	c.duration.WithLabelValues(endpoint, method).Observe(duration.Seconds())
+}
+
+func (c *Collector) IncRetries(endpoint string) {
+	c.retries.WithLabelValues(endpoint).Inc()
+}
+
+func (c *Collector) IncThrottled(endpoint string) {
+	c.throttled.WithLabelValues(endpoint).Inc()
+}
+
+func (c *Collector) AddBytes(endpoint, direction string, n int64) {
+	c.bytes.WithLabelValues(endpoint, direction).Add(float64(n))
+}
+
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.retries.Describe(ch)
+	c.throttled.Describe(ch)
+	c.bytes.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.retries.Collect(ch)
+	c.throttled.Collect(ch)
+	c.bytes.Collect(ch)
+}
@@ -0,0 +1,49 @@
+package prometheus_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/prometheus"
+)
+
+func TestCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":429,"o:errorDetails":[{"detail":"Too many requests","o:errorCode":"CONCURRENCY_LIMIT_EXCEEDED"}]}`))
+	}))
+	defer server.Close()
+
+	collector := prometheus.NewCollector("netsuite")
+	registry := prom.NewRegistry()
+	registry.MustRegister(collector)
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetMetrics(collector)
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 12
+	req.Do(context.Background())
+
+	expected := `
+# HELP netsuite_requests_total Number of requests sent to NetSuite by endpoint, method and status code.
+# TYPE netsuite_requests_total counter
+netsuite_requests_total{endpoint="/record/v1/term/{id}",method="GET",status="429"} 1
+# HELP netsuite_throttled_total Number of requests rejected by the NetSuite concurrency or rate limits.
+# TYPE netsuite_throttled_total counter
+netsuite_throttled_total{endpoint="/record/v1/term/{id}"} 1
+`
+	err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "netsuite_requests_total", "netsuite_throttled_total")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,30 @@
+module github.com/omniboost/go-netsuite-rest/prometheus
+
+go 1.18
+
+require (
+	github.com/omniboost/go-netsuite-rest v0.0.0-20261017030318-7d36456202b8
+	github.com/prometheus/client_golang v1.12.2
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/cydev/zero v0.0.0-20160322155811-4a4535dd56e7 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/gorilla/schema v0.0.0-20171211162101-9fa3b6af65dc // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/prometheus/client_model v0.2.0 // indirect
+	github.com/prometheus/common v0.32.1 // indirect
+	github.com/prometheus/procfs v0.7.3 // indirect
+	github.com/shopspring/decimal v1.3.1 // indirect
+	golang.org/x/net v0.0.0-20210525063256-abc453219eb5 // indirect
+	golang.org/x/oauth2 v0.0.0-20210628180205-a41e5a781914 // indirect
+	golang.org/x/sys v0.0.0-20220114195835-da31bd327af9 // indirect
+	google.golang.org/appengine v1.6.6 // indirect
+	google.golang.org/protobuf v1.26.0 // indirect
+	gopkg.in/guregu/null.v3 v3.5.0 // indirect
+)
+
+replace github.com/gorilla/schema => github.com/omniboost/schema v1.1.1-0.20191030093734-a170fe1a7240
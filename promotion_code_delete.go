@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPromotionCodeDeleteRequest() PromotionCodeDeleteRequest {
+	r := PromotionCodeDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PromotionCodeDeleteRequest struct {
+	client      *Client
+	queryParams *PromotionCodeDeleteRequestQueryParams
+	pathParams  *PromotionCodeDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PromotionCodeDeleteRequestBody
+}
+
+func (r PromotionCodeDeleteRequest) NewQueryParams() *PromotionCodeDeleteRequestQueryParams {
+	return &PromotionCodeDeleteRequestQueryParams{}
+}
+
+type PromotionCodeDeleteRequestQueryParams struct {
+}
+
+func (p PromotionCodeDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PromotionCodeDeleteRequest) QueryParams() *PromotionCodeDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PromotionCodeDeleteRequest) NewPathParams() *PromotionCodeDeleteRequestPathParams {
+	return &PromotionCodeDeleteRequestPathParams{}
+}
+
+type PromotionCodeDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PromotionCodeDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PromotionCodeDeleteRequest) PathParams() *PromotionCodeDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodeDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodeDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PromotionCodeDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *PromotionCodeDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PromotionCodeDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PromotionCodeDeleteRequest) NewRequestBody() PromotionCodeDeleteRequestBody {
+	return PromotionCodeDeleteRequestBody{}
+}
+
+type PromotionCodeDeleteRequestBody struct {
+}
+
+func (r *PromotionCodeDeleteRequest) RequestBody() *PromotionCodeDeleteRequestBody {
+	return nil
+}
+
+func (r *PromotionCodeDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PromotionCodeDeleteRequest) SetRequestBody(body PromotionCodeDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PromotionCodeDeleteRequest) NewResponseBody() *PromotionCodeDeleteResponseBody {
+	return &PromotionCodeDeleteResponseBody{}
+}
+
+type PromotionCodeDeleteResponseBody struct {
+}
+
+func (r *PromotionCodeDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/promotionCode/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PromotionCodeDeleteRequest) Do(ctx context.Context) (PromotionCodeDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPromotionCodeGetRequest() PromotionCodeGetRequest {
+	r := PromotionCodeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PromotionCodeGetRequest struct {
+	client      *Client
+	queryParams *PromotionCodeGetRequestQueryParams
+	pathParams  *PromotionCodeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PromotionCodeGetRequestBody
+}
+
+func (r PromotionCodeGetRequest) NewQueryParams() *PromotionCodeGetRequestQueryParams {
+	return &PromotionCodeGetRequestQueryParams{}
+}
+
+type PromotionCodeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PromotionCodeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PromotionCodeGetRequest) QueryParams() *PromotionCodeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PromotionCodeGetRequest) NewPathParams() *PromotionCodeGetRequestPathParams {
+	return &PromotionCodeGetRequestPathParams{}
+}
+
+type PromotionCodeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PromotionCodeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PromotionCodeGetRequest) PathParams() *PromotionCodeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PromotionCodeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PromotionCodeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PromotionCodeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PromotionCodeGetRequest) NewRequestBody() PromotionCodeGetRequestBody {
+	return PromotionCodeGetRequestBody{}
+}
+
+type PromotionCodeGetRequestBody struct {
+}
+
+func (r *PromotionCodeGetRequest) RequestBody() *PromotionCodeGetRequestBody {
+	return nil
+}
+
+func (r *PromotionCodeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PromotionCodeGetRequest) SetRequestBody(body PromotionCodeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PromotionCodeGetRequest) NewResponseBody() *PromotionCodeGetResponseBody {
+	return &PromotionCodeGetResponseBody{}
+}
+
+type PromotionCodeGetResponseBody struct {
+	Links Links `json:"links"`
+	PromotionCode
+}
+
+func (r *PromotionCodeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/promotionCode/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PromotionCodeGetRequest) Do(ctx context.Context) (PromotionCodeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPromotionCodePatchRequest() PromotionCodePatchRequest {
+	r := PromotionCodePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PromotionCodePatchRequest struct {
+	client      *Client
+	queryParams *PromotionCodePatchRequestQueryParams
+	pathParams  *PromotionCodePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PromotionCodePatchRequestBody
+}
+
+func (r PromotionCodePatchRequest) NewQueryParams() *PromotionCodePatchRequestQueryParams {
+	return &PromotionCodePatchRequestQueryParams{}
+}
+
+type PromotionCodePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p PromotionCodePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PromotionCodePatchRequest) QueryParams() *PromotionCodePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PromotionCodePatchRequest) NewPathParams() *PromotionCodePatchRequestPathParams {
+	return &PromotionCodePatchRequestPathParams{}
+}
+
+type PromotionCodePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PromotionCodePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PromotionCodePatchRequest) PathParams() *PromotionCodePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PromotionCodePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *PromotionCodePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PromotionCodePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PromotionCodePatchRequest) NewRequestBody() PromotionCodePatchRequestBody {
+	return PromotionCodePatchRequestBody{}
+}
+
+type PromotionCodePatchRequestBody struct {
+	PromotionCode
+}
+
+func (r *PromotionCodePatchRequest) RequestBody() *PromotionCodePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *PromotionCodePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PromotionCodePatchRequest) SetRequestBody(body PromotionCodePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PromotionCodePatchRequest) NewResponseBody() *PromotionCodePatchResponseBody {
+	return &PromotionCodePatchResponseBody{}
+}
+
+type PromotionCodePatchResponseBody struct {
+}
+
+func (r *PromotionCodePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/promotionCode/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *PromotionCodePatchRequest) Do(ctx context.Context) (PromotionCodePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPromotionCodePatch(t *testing.T) {
+	req := client.NewPromotionCodePatchRequest()
+	req.PathParams().ID = 31
+	req.RequestBody().IsInactive = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPromotionCodePostRequest() PromotionCodePostRequest {
+	r := PromotionCodePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PromotionCodePostRequest struct {
+	client      *Client
+	queryParams *PromotionCodePostRequestQueryParams
+	pathParams  *PromotionCodePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PromotionCodePostRequestBody
+}
+
+func (r PromotionCodePostRequest) NewQueryParams() *PromotionCodePostRequestQueryParams {
+	return &PromotionCodePostRequestQueryParams{}
+}
+
+type PromotionCodePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p PromotionCodePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PromotionCodePostRequest) QueryParams() *PromotionCodePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PromotionCodePostRequest) NewPathParams() *PromotionCodePostRequestPathParams {
+	return &PromotionCodePostRequestPathParams{}
+}
+
+type PromotionCodePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *PromotionCodePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *PromotionCodePostRequest) PathParams() *PromotionCodePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PromotionCodePostRequest) Method() string {
+	return r.method
+}
+
+func (r *PromotionCodePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PromotionCodePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PromotionCodePostRequest) NewRequestBody() PromotionCodePostRequestBody {
+	return PromotionCodePostRequestBody{}
+}
+
+type PromotionCodePostRequestBody struct {
+	PromotionCode
+}
+
+func (r *PromotionCodePostRequest) RequestBody() *PromotionCodePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *PromotionCodePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *PromotionCodePostRequest) SetRequestBody(body PromotionCodePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PromotionCodePostRequest) NewResponseBody() *PromotionCodePostResponseBody {
+	return &PromotionCodePostResponseBody{}
+}
+
+type PromotionCodePostResponseBody struct {
+}
+
+func (r *PromotionCodePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/promotionCode", r.PathParams())
+	return &u, err
+}
+
+func (r *PromotionCodePostRequest) Do(ctx context.Context) (PromotionCodePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
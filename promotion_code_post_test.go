@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestPromotionCodePost(t *testing.T) {
+	req := client.NewPromotionCodePostRequest()
+	req.RequestBody().Name = "Spring sale"
+	req.RequestBody().Code = "SPRING22"
+	req.RequestBody().Discount.ID = "512"
+	req.RequestBody().Rate = "10%"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
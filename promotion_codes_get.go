@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewPromotionCodesGetRequest() PromotionCodesGetRequest {
+	r := PromotionCodesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type PromotionCodesGetRequest struct {
+	client      *Client
+	queryParams *PromotionCodesGetRequestQueryParams
+	pathParams  *PromotionCodesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody PromotionCodesGetRequestBody
+}
+
+func (r PromotionCodesGetRequest) NewQueryParams() *PromotionCodesGetRequestQueryParams {
+	return &PromotionCodesGetRequestQueryParams{}
+}
+
+type PromotionCodesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p PromotionCodesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *PromotionCodesGetRequest) QueryParams() *PromotionCodesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r PromotionCodesGetRequest) NewPathParams() *PromotionCodesGetRequestPathParams {
+	return &PromotionCodesGetRequestPathParams{}
+}
+
+type PromotionCodesGetRequestPathParams struct {
+}
+
+func (p *PromotionCodesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *PromotionCodesGetRequest) PathParams() *PromotionCodesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *PromotionCodesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *PromotionCodesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *PromotionCodesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *PromotionCodesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r PromotionCodesGetRequest) NewRequestBody() PromotionCodesGetRequestBody {
+	return PromotionCodesGetRequestBody{}
+}
+
+type PromotionCodesGetRequestBody struct {
+}
+
+func (r *PromotionCodesGetRequest) RequestBody() *PromotionCodesGetRequestBody {
+	return nil
+}
+
+func (r *PromotionCodesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *PromotionCodesGetRequest) SetRequestBody(body PromotionCodesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *PromotionCodesGetRequest) NewResponseBody() *PromotionCodesGetResponseBody {
+	return &PromotionCodesGetResponseBody{}
+}
+
+type PromotionCodesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *PromotionCodesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/promotionCode", r.PathParams())
+	return &u, err
+}
+
+func (r *PromotionCodesGetRequest) Do(ctx context.Context) (PromotionCodesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,112 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// PropertyNameValidationHeader sets how NetSuite handles unknown field names
+// in the body of a request.
+const PropertyNameValidationHeader = "X-NetSuite-PropertyNameValidation"
+
+// PropertyNameValidation is how NetSuite handles unknown field names in the
+// body of a request, e.g. a misspelled custom field.
+type PropertyNameValidation string
+
+const (
+	// PropertyNameValidationError fails the request
+	PropertyNameValidationError PropertyNameValidation = "Error"
+	// PropertyNameValidationWarning ignores the fields and returns a warning
+	// (see Warnings), NetSuite's default
+	PropertyNameValidationWarning PropertyNameValidation = "Warning"
+	// PropertyNameValidationIgnore ignores the fields
+	PropertyNameValidationIgnore PropertyNameValidation = "Ignore"
+)
+
+// SetPropertyNameValidation sets the X-NetSuite-PropertyNameValidation header
+// of every request; empty leaves it to NetSuite's default.
+func (c *Client) SetPropertyNameValidation(validation PropertyNameValidation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.propertyNameValidation = validation
+}
+
+func (c *Client) PropertyNameValidation() PropertyNameValidation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.propertyNameValidation
+}
+
+type propertyNameValidationKey struct{}
+
+// WithRequestPropertyNameValidation returns a context that makes the requests
+// sent with it use validation instead of the client's, see
+// Client.SetPropertyNameValidation:
+//
+//	ctx = netsuite.WithRequestPropertyNameValidation(ctx, netsuite.PropertyNameValidationError)
+//	_, err := customers.Update(ctx, id, customer, nil)
+func WithRequestPropertyNameValidation(ctx context.Context, validation PropertyNameValidation) context.Context {
+	return context.WithValue(ctx, propertyNameValidationKey{}, validation)
+}
+
+// setPropertyNameValidation sets the X-NetSuite-PropertyNameValidation header
+// of req, the one of its context wins over the default.
+func setPropertyNameValidation(req *http.Request, validation PropertyNameValidation) {
+	if v, ok := req.Context().Value(propertyNameValidationKey{}).(PropertyNameValidation); ok {
+		validation = v
+	}
+	if validation != "" {
+		req.Header.Set(PropertyNameValidationHeader, string(validation))
+	}
+}
+
+// Warnings returns the texts of the Warning headers of a response, e.g. the
+// unknown field names NetSuite ignored with PropertyNameValidationWarning.
+func Warnings(resp *http.Response) []string {
+	if resp == nil {
+		return nil
+	}
+
+	warnings := []string{}
+	for _, v := range resp.Header.Values("Warning") {
+		for _, w := range splitWarnings(v) {
+			if w = warningText(w); w != "" {
+				warnings = append(warnings, w)
+			}
+		}
+	}
+	return warnings
+}
+
+// splitWarnings splits a header value on the commas outside quotes.
+func splitWarnings(v string) []string {
+	parts := []string{}
+	quoted, start := false, 0
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				parts = append(parts, v[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, v[start:])
+}
+
+// warningText returns the text of a warning as `299 - "text"`, or the warning
+// as is when it isn't formatted like that.
+func warningText(w string) string {
+	w = strings.TrimSpace(w)
+	i := strings.Index(w, `"`)
+	j := strings.LastIndex(w, `"`)
+	if i < 0 || j <= i {
+		return w
+	}
+	return strings.ReplaceAll(w[i+1:j], `\"`, `"`)
+}
@@ -0,0 +1,52 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestPropertyNameValidation(t *testing.T) {
+	var validation string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validation = r.Header.Get(netsuite.PropertyNameValidationHeader)
+		w.Header().Add("Warning", `299 - "Unknown property custentity_tpyo was ignored."`)
+		w.Header().Add("Warning", `299 - "Unknown property a, b", 299 - "second"`)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+		netsuite.WithPropertyNameValidation(netsuite.PropertyNameValidationWarning),
+	)
+	ctx := context.Background()
+
+	meta := &netsuite.ResponseMeta{}
+	err := c.Patch(netsuite.WithResponseMeta(ctx, meta), "/record/v1/customer/1", map[string]string{"custentity_tpyo": "x"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validation != "Warning" {
+		t.Errorf("expected the client's validation, got %q", validation)
+	}
+	expected := []string{"Unknown property custentity_tpyo was ignored.", "Unknown property a, b", "second"}
+	if !reflect.DeepEqual(meta.Warnings, expected) {
+		t.Errorf("expected %q, got %q", expected, meta.Warnings)
+	}
+
+	ctx = netsuite.WithRequestPropertyNameValidation(ctx, netsuite.PropertyNameValidationError)
+	err = c.Patch(ctx, "/record/v1/customer/1", map[string]string{"custentity_tpyo": "x"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validation != "Error" {
+		t.Errorf("expected the validation of the request, got %q", validation)
+	}
+}
@@ -0,0 +1,209 @@
+package netsuite
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is waited on before every request is sent, e.g. to stay below
+// the concurrency and request limits of the account.
+type RateLimiter interface {
+	// Wait blocks until the request may be sent or ctx is done
+	Wait(ctx context.Context) error
+}
+
+func (c *Client) SetRateLimiter(limiter RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimiter = limiter
+}
+
+func (c *Client) RateLimiter() RateLimiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rateLimiter
+}
+
+// WaitForRateLimit waits on the rate limiter, if any. The restlet and soap
+// clients call it before they send a request.
+func (c *Client) WaitForRateLimit(ctx context.Context) error {
+	limiter := c.RateLimiter()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	// until is the time requests are paused until after a throttled response
+	until time.Time
+}
+
+// NewRateLimiter returns a token bucket that allows requestsPerSecond on
+// average with bursts of up to burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait := b.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token and returns how long to wait until it's available.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	pause := b.until.Sub(now)
+	if b.rate <= 0 {
+		return pause
+	}
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return pause
+	}
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	if pause > wait {
+		return pause
+	}
+	return wait
+}
+
+// cancel returns a reserved token.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// ObserveGovernance pauses the bucket until Retry-After has passed on
+// throttled responses and drops the burst while the account has no
+// concurrency or usage left, so the next requests are sent at the rate only.
+func (b *tokenBucket) ObserveGovernance(g Governance) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if g.Throttled && g.RetryAfter > 0 {
+		until := time.Now().Add(g.RetryAfter)
+		if until.After(b.until) {
+			b.until = until
+		}
+	}
+
+	available, ok := g.ConcurrencyAvailable()
+	exhausted := (ok && available <= 0) || (g.RemainingUsage != nil && *g.RemainingUsage <= 0)
+	if exhausted || g.Throttled {
+		b.tokens = math.Min(b.tokens, 0)
+	}
+}
+
+// ConcurrencyLimiter limits the number of requests in flight, e.g. to the
+// concurrency limit of the account. Unlike a RateLimiter it's told when a
+// request is done, so a limiter shared by several processes (see the
+// redislimit package) keeps them within the limit of the account together.
+type ConcurrencyLimiter interface {
+	// Acquire blocks until a request may be sent or ctx is done. release is
+	// called once the response has been read.
+	Acquire(ctx context.Context) (release func(), err error)
+}
+
+func (c *Client) SetConcurrencyLimiter(limiter ConcurrencyLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.concurrencyLimiter = limiter
+}
+
+func (c *Client) ConcurrencyLimiter() ConcurrencyLimiter {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.concurrencyLimiter
+}
+
+// AcquireConcurrency acquires a slot of the concurrency limiter, if any. The
+// restlet and soap clients call it before they send a request and call
+// release when they're done with the response.
+func (c *Client) AcquireConcurrency(ctx context.Context) (release func(), err error) {
+	return acquire(ctx, c.ConcurrencyLimiter())
+}
+
+func acquire(ctx context.Context, limiter ConcurrencyLimiter) (func(), error) {
+	if limiter == nil {
+		return func() {}, nil
+	}
+	release, err := limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// make release safe to call more than once
+	once := sync.Once{}
+	return func() { once.Do(release) }, nil
+}
+
+type semaphore chan struct{}
+
+// NewConcurrencyLimiter returns a limiter that allows up to limit requests in
+// flight in this process.
+func NewConcurrencyLimiter(limit int) ConcurrencyLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+	return make(semaphore, limit)
+}
+
+func (s semaphore) Acquire(ctx context.Context) (func(), error) {
+	select {
+	case s <- struct{}{}:
+		return func() { <-s }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// releaseBody calls release when the body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,43 @@
+package netsuite
+
+import (
+	"bytes"
+	"io"
+)
+
+// RawBody is a pre-encoded request body that's sent as is with its own
+// content type, e.g. a file, a CSV payload for a RESTlet or a canned JSON
+// blob. Return it from a request's RequestBodyInterface (or pass it as the
+// body of a RESTlet call) instead of a value that's encoded as json.
+//
+// Requests with a body that can't be read again (anything but a
+// *bytes.Buffer, *bytes.Reader or *strings.Reader) aren't retried.
+type RawBody struct {
+	Reader io.Reader
+	// ContentType defaults to the media type of the client
+	ContentType string
+}
+
+// NewRawBody returns a body that sends r with contentType.
+func NewRawBody(r io.Reader, contentType string) RawBody {
+	return RawBody{Reader: r, ContentType: contentType}
+}
+
+// NewJSONBody returns a body that sends already encoded json.
+func NewJSONBody(data []byte) RawBody {
+	return RawBody{Reader: bytes.NewReader(data)}
+}
+
+// AsRawBody reports whether body is a RawBody (or a pointer to one) and
+// returns it.
+func AsRawBody(body interface{}) (RawBody, bool) {
+	switch b := body.(type) {
+	case RawBody:
+		return b, true
+	case *RawBody:
+		if b != nil {
+			return *b, true
+		}
+	}
+	return RawBody{}, false
+}
@@ -0,0 +1,68 @@
+package netsuite_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/restlet"
+)
+
+func TestRawBody(t *testing.T) {
+	bodies := []string{}
+	contentTypes := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(netsuite.WithBaseURL(server.URL))
+
+	req := c.NewCustomRecordPostRequest()
+	req.PathParams().RecordType = "customrecord_rate"
+	_, err := c.Do(mustNewRequest(t, c, rawRequest{req: &req, body: netsuite.NewJSONBody([]byte(`{"name":"canned"}`))}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	script := restlet.NewClient(c)
+	script.SetBaseURL(server.URL)
+	err = script.Post(context.Background(), "customscript_import", "1", netsuite.NewRawBody(strings.NewReader("id,name\n1,a\n"), "text/csv"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bodies[0] != `{"name":"canned"}` || !strings.HasPrefix(contentTypes[0], "application/json") {
+		t.Errorf("unexpected canned json request: %s (%s)", bodies[0], contentTypes[0])
+	}
+	if bodies[1] != "id,name\n1,a\n" || contentTypes[1] != "text/csv" {
+		t.Errorf("unexpected csv request: %q (%s)", bodies[1], contentTypes[1])
+	}
+}
+
+// rawRequest replaces the body of a request
+type rawRequest struct {
+	req  netsuite.Request
+	body netsuite.RawBody
+}
+
+func (r rawRequest) Method() string                           { return r.req.Method() }
+func (r rawRequest) PathParamsInterface() netsuite.PathParams { return r.req.PathParamsInterface() }
+func (r rawRequest) RequestBodyInterface() interface{}        { return r.body }
+func (r rawRequest) URL() (*url.URL, error)                   { return r.req.URL() }
+
+func mustNewRequest(t *testing.T, c *netsuite.Client, r netsuite.Request) *http.Request {
+	req, err := c.NewRequest(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
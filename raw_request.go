@@ -0,0 +1,115 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Get sends a GET to an endpoint the library has no request type for and
+// decodes the response into v. path is relative to the base url, e.g.
+// "/record/v1/customer/12/addressBook", or an absolute url like the href of a
+// link; query is added to the query params of path.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	return c.call(ctx, http.MethodGet, path, query, nil, v)
+}
+
+// Post sends body encoded as json, or as is when it's a RawBody, and decodes
+// the response into v. The id of a created record is in the Location header,
+// see WithResponseMeta.
+func (c *Client) Post(ctx context.Context, path string, body interface{}, v interface{}) error {
+	return c.call(ctx, http.MethodPost, path, nil, body, v)
+}
+
+// Patch sends body encoded as json, or as is when it's a RawBody, and decodes
+// the response into v.
+func (c *Client) Patch(ctx context.Context, path string, body interface{}, v interface{}) error {
+	return c.call(ctx, http.MethodPatch, path, nil, body, v)
+}
+
+// Delete sends a DELETE to path.
+func (c *Client) Delete(ctx context.Context, path string) error {
+	return c.call(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// GetPages calls fn with every page of a collection, following the next links
+// of the pages, starting at path with query.
+func (c *Client) GetPages(ctx context.Context, path string, query url.Values, fn func(page json.RawMessage) error) error {
+	for path != "" {
+		page := json.RawMessage{}
+		err := c.Get(ctx, path, query, &page)
+		if err != nil {
+			return err
+		}
+
+		err = fn(page)
+		if err != nil {
+			return err
+		}
+
+		// the next link carries the query
+		links := struct {
+			Links Links `json:"links"`
+		}{}
+		err = json.Unmarshal(page, &links)
+		if err != nil {
+			return err
+		}
+		path, query = links.Links.Next(), nil
+	}
+	return nil
+}
+
+func (c *Client) call(ctx context.Context, method, path string, query url.Values, body interface{}, v interface{}) error {
+	req, err := c.NewRequest(ctx, &rawRequest{client: c, method: method, path: path, body: body})
+	if err != nil {
+		return err
+	}
+
+	if len(query) > 0 {
+		q := req.URL.Query()
+		for k, vv := range query {
+			for _, val := range vv {
+				q.Add(k, val)
+			}
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	_, err = c.Do(req, v)
+	return err
+}
+
+// rawRequest is a request on an arbitrary path
+type rawRequest struct {
+	client *Client
+	method string
+	path   string
+	body   interface{}
+}
+
+func (r *rawRequest) Method() string {
+	return r.method
+}
+
+func (r *rawRequest) PathParamsInterface() PathParams {
+	return recordPathParams{}
+}
+
+func (r *rawRequest) RequestBodyInterface() interface{} {
+	return r.body
+}
+
+func (r *rawRequest) URL() (*url.URL, error) {
+	u, err := url.Parse(r.path)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsAbs() {
+		return u, nil
+	}
+
+	endpoint, err := r.client.GetEndpointURL(r.path, r.PathParamsInterface())
+	return &endpoint, err
+}
@@ -0,0 +1,66 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestRawRequests(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("term", "1", map[string]interface{}{"name": "Net 30"})
+	srv.AddRecord("term", "2", map[string]interface{}{"name": "Net 60"})
+	srv.AddRecord("term", "3", map[string]interface{}{"name": "Net 90"})
+
+	ctx := context.Background()
+	c := srv.Client()
+
+	term := map[string]interface{}{}
+	err := c.Get(ctx, "/record/v1/term/1", url.Values{"fields": {"name"}}, &term)
+	if err != nil || term["name"] != "Net 30" {
+		t.Fatalf("unexpected term: %v (%v)", term, err)
+	}
+
+	meta := &netsuite.ResponseMeta{}
+	err = c.Post(netsuite.WithResponseMeta(ctx, meta), "/record/v1/term", map[string]string{"name": "Net 15"}, nil)
+	if err != nil || meta.ID == "" {
+		t.Fatalf("expected the id of the new term, got %q (%v)", meta.ID, err)
+	}
+
+	err = c.Patch(ctx, "/record/v1/term/"+meta.ID, map[string]string{"name": "Net 10"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Get(ctx, srv.BaseURL()+"/record/v1/term/"+meta.ID, nil, &term)
+	if err != nil || term["name"] != "Net 10" {
+		t.Fatalf("expected the patched term by its absolute url, got %v (%v)", term, err)
+	}
+
+	err = c.Delete(ctx, "/record/v1/term/"+meta.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{}
+	err = c.GetPages(ctx, "/record/v1/term", url.Values{"limit": {"2"}}, func(page json.RawMessage) error {
+		p := netsuite.ListPage{}
+		err := json.Unmarshal(page, &p)
+		for _, item := range p.Items {
+			ids = append(ids, item.ID)
+		}
+		return err
+	})
+	if err != nil || len(ids) != 3 {
+		t.Fatalf("expected 3 terms over 2 pages, got %v (%v)", ids, err)
+	}
+
+	err = c.Get(ctx, "/record/v1/term/404", nil, &term)
+	if err == nil {
+		t.Errorf("expected an error response")
+	}
+}
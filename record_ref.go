@@ -0,0 +1,58 @@
+package netsuite
+
+import "encoding/json"
+
+// RecordRef is a reference to another record. NetSuite returns the expanded
+// form (links, id and refName) on reads but only needs the internal or the
+// external id on writes.
+type RecordRef struct {
+	Links      Links  `json:"links,omitempty"`
+	ID         string `json:"id,omitempty"`
+	ExternalID string `json:"externalId,omitempty"`
+	RefName    string `json:"refName,omitempty"`
+}
+
+// NewRecordRef returns a reference to the record with the given internal id.
+func NewRecordRef(id string) RecordRef {
+	return RecordRef{ID: id}
+}
+
+// NewExternalRecordRef returns a reference to the record with the given
+// external id.
+func NewExternalRecordRef(externalID string) RecordRef {
+	return RecordRef{ExternalID: externalID}
+}
+
+// IsEmpty reports whether the reference has no id to write: a reference with
+// only a refName or links is left out like an empty one.
+func (r RecordRef) IsEmpty() bool {
+	return r.ID == "" && r.ExternalID == ""
+}
+
+// Link returns the url of the referenced record.
+func (r RecordRef) Link() string {
+	for _, l := range r.Links {
+		if l.Rel == "self" {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+// MarshalJSON writes the reference in the form NetSuite accepts on writes:
+// {"id": "..."} or, when no internal id is known, {"externalId": "..."}.
+func (r RecordRef) MarshalJSON() ([]byte, error) {
+	if r.ID == "" && r.ExternalID != "" {
+		return json.Marshal(struct {
+			ExternalID string `json:"externalId"`
+		}{ExternalID: r.ExternalID})
+	}
+
+	if r.ID == "" {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(struct {
+		ID string `json:"id"`
+	}{ID: r.ID})
+}
@@ -0,0 +1,79 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestRecordRef(t *testing.T) {
+	ref := netsuite.RecordRef{}
+	err := json.Unmarshal([]byte(`{"links":[{"rel":"self","href":"https://example.suitetalk.api.netsuite.com/services/rest/record/v1/subsidiary/46"}],"id":"46","refName":"Omniboost B.V."}`), &ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ref.Link() != "https://example.suitetalk.api.netsuite.com/services/rest/record/v1/subsidiary/46" {
+		t.Errorf("unexpected link %s", ref.Link())
+	}
+
+	tests := []struct {
+		ref      netsuite.RecordRef
+		expected string
+	}{
+		{ref, `{"id":"46"}`},
+		{netsuite.NewRecordRef("13"), `{"id":"13"}`},
+		{netsuite.NewExternalRecordRef("EUR"), `{"externalId":"EUR"}`},
+		{netsuite.RecordRef{}, `{}`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, string(b))
+		}
+	}
+}
+
+func TestRecordRefOmitted(t *testing.T) {
+	ref := netsuite.RecordRef{RefName: "Sales"}
+	if !ref.IsEmpty() {
+		t.Error("expected a reference without id to be empty")
+	}
+
+	b, err := json.Marshal(netsuite.JournalEntryLineElement{Memo: "x", Department: ref})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"memo":"x"}`; string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, string(b))
+	}
+}
+
+func TestInvoiceRecordRefs(t *testing.T) {
+	invoice := netsuite.Invoice{}
+	err := json.Unmarshal([]byte(`{"entity":{"links":[],"id":"145","refName":"Acme"},"item":{"items":[{"item":{"id":"131","refName":"Room"}}]}}`), &invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Entity.ID != "145" || invoice.Item.Items[0].Item.ID != "131" {
+		t.Fatalf("unexpected references %+v", invoice)
+	}
+
+	invoice.Entity = netsuite.RecordRef{RefName: "Acme"}
+	b, err := json.Marshal(invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"entity"`) {
+		t.Errorf("expected the entity without id to be left out, got %s", b)
+	}
+	if !strings.Contains(string(b), `"item":{"id":"131"}`) {
+		t.Errorf("expected the item reference by id, got %s", b)
+	}
+}
@@ -0,0 +1,156 @@
+// Package redislimit shares the limits of a NetSuite account between
+// processes through Redis, so worker pods that use the same account (or
+// integration) stay within its concurrency limit together instead of each
+// assuming it has the full limit:
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//	limiter := redislimit.NewConcurrencyLimiter(rdb, "netsuite:1234567:concurrency", 5)
+//	client.SetConcurrencyLimiter(limiter)
+//	client.SetOnGovernance(limiter.ObserveGovernance)
+//
+// The limiters run Lua scripts on the time of the Redis server, which needs
+// Redis 5 or later.
+package redislimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultLease is how long a slot is held at most, so the slots of a
+	// process that crashed are freed
+	DefaultLease = 5 * time.Minute
+	// DefaultPollInterval is how often a full limiter is tried again
+	DefaultPollInterval = 50 * time.Millisecond
+)
+
+var (
+	_ netsuite.ConcurrencyLimiter = (*ConcurrencyLimiter)(nil)
+	_ netsuite.RateLimiter        = (*RateLimiter)(nil)
+)
+
+// acquireScript adds a slot that expires after the lease (ARGV[2], in
+// milliseconds) to the sorted set when it holds fewer than ARGV[1] slots.
+var acquireScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], now + tonumber(ARGV[2]), ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+var releaseScript = redis.NewScript(`return redis.call('ZREM', KEYS[1], ARGV[1])`)
+
+// ConcurrencyLimiter is a semaphore in a Redis sorted set that limits the
+// requests in flight of all the processes sharing its key.
+type ConcurrencyLimiter struct {
+	client redis.Scripter
+	key    string
+	limit  int64
+
+	// Lease defaults to DefaultLease, it should be longer than the slowest
+	// request
+	Lease time.Duration
+	// PollInterval defaults to DefaultPollInterval
+	PollInterval time.Duration
+}
+
+// NewConcurrencyLimiter returns a limiter that allows up to limit requests in
+// flight over all the limiters with the same key.
+func NewConcurrencyLimiter(client redis.Scripter, key string, limit int) *ConcurrencyLimiter {
+	l := &ConcurrencyLimiter{client: client, key: key}
+	l.SetLimit(limit)
+	return l
+}
+
+func (l *ConcurrencyLimiter) Limit() int {
+	return int(atomic.LoadInt64(&l.limit))
+}
+
+// SetLimit changes the limit; the slots in use above it are freed as their
+// requests are done.
+func (l *ConcurrencyLimiter) SetLimit(limit int) {
+	if limit < 1 {
+		limit = 1
+	}
+	atomic.StoreInt64(&l.limit, int64(limit))
+}
+
+// ObserveGovernance sets the limit to the concurrency limit NetSuite reports,
+// pass it to Client.SetOnGovernance.
+func (l *ConcurrencyLimiter) ObserveGovernance(req *http.Request, g netsuite.Governance) {
+	if g.ConcurrencyLimit != nil && *g.ConcurrencyLimit > 0 {
+		l.SetLimit(*g.ConcurrencyLimit)
+	}
+}
+
+// Acquire blocks until a slot is free or ctx is done.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) (func(), error) {
+	lease := l.Lease
+	if lease <= 0 {
+		lease = DefaultLease
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		ok, err := acquireScript.Run(ctx, l.client, []string{l.key}, l.Limit(), lease.Milliseconds(), token).Bool()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return func() { l.release(token) }, nil
+		}
+
+		err = sleep(ctx, pollInterval(l.PollInterval))
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// release frees the slot; if that fails the slot is freed when its lease
+// expires.
+func (l *ConcurrencyLimiter) release(token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	releaseScript.Run(ctx, l.client, []string{l.key}, token)
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	return hex.EncodeToString(b), err
+}
+
+func pollInterval(d time.Duration) time.Duration {
+	if d <= 0 {
+		return DefaultPollInterval
+	}
+	return d
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
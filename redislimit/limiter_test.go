@@ -0,0 +1,102 @@
+package redislimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/redislimit"
+	"github.com/redis/go-redis/v9"
+)
+
+func newRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return mr, rdb
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	mr, rdb := newRedis(t)
+	ctx := context.Background()
+
+	// two processes sharing a limit of two
+	a := redislimit.NewConcurrencyLimiter(rdb, "netsuite:concurrency", 2)
+	b := redislimit.NewConcurrencyLimiter(rdb, "netsuite:concurrency", 2)
+	a.PollInterval = time.Millisecond
+	b.PollInterval = time.Millisecond
+
+	releaseA, err := a.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseB, err := b.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := a.Acquire(timeout); err != context.DeadlineExceeded {
+		t.Fatalf("expected the third request to wait, got %v", err)
+	}
+
+	releaseA()
+	release, err := b.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+	releaseB()
+
+	// the slots of a crashed process are freed when their lease expires
+	for i := 0; i < 2; i++ {
+		if _, err := a.Acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mr.SetTime(time.Now().Add(redislimit.DefaultLease + time.Second))
+	release, err = b.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
+
+func TestConcurrencyLimiterGovernance(t *testing.T) {
+	_, rdb := newRedis(t)
+	l := redislimit.NewConcurrencyLimiter(rdb, "netsuite:concurrency", 5)
+
+	limit := 15
+	l.ObserveGovernance(nil, netsuite.Governance{ConcurrencyLimit: &limit})
+	if l.Limit() != 15 {
+		t.Errorf("expected the limit of the account, got %d", l.Limit())
+	}
+	l.ObserveGovernance(nil, netsuite.Governance{})
+	if l.Limit() != 15 {
+		t.Errorf("expected the limit to be kept, got %d", l.Limit())
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	_, rdb := newRedis(t)
+	ctx := context.Background()
+
+	a := redislimit.NewRateLimiter(rdb, "netsuite:rate", 50, 1)
+	b := redislimit.NewRateLimiter(rdb, "netsuite:rate", 50, 1)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := a.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected the limiters to share the rate, took %s", elapsed)
+	}
+}
@@ -0,0 +1,72 @@
+package redislimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// rateScript takes a token from the bucket in the hash, refilled at ARGV[1]
+// tokens per second up to ARGV[2]. It returns 0 when a token was taken, or
+// the milliseconds until one is available.
+var rateScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+local wait = 0
+if tokens < 1 then
+	wait = math.ceil((1 - tokens) / rate * 1000)
+else
+	tokens = tokens - 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return wait
+`)
+
+// RateLimiter is a token bucket in a Redis hash that limits the request rate
+// of all the processes sharing its key.
+type RateLimiter struct {
+	client redis.Scripter
+	key    string
+	rate   float64
+	burst  int
+}
+
+// NewRateLimiter returns a limiter that allows requestsPerSecond on average
+// with bursts of up to burst requests over all the limiters with the same
+// key, see netsuite.NewRateLimiter.
+func NewRateLimiter(client redis.Scripter, key string, requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{client: client, key: key, rate: requestsPerSecond, burst: burst}
+}
+
+// Wait blocks until the request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	for {
+		wait, err := rateScript.Run(ctx, l.client, []string{l.key}, l.rate, l.burst).Int64()
+		if err != nil {
+			return err
+		}
+		if wait <= 0 {
+			return nil
+		}
+
+		err = sleep(ctx, time.Duration(wait)*time.Millisecond)
+		if err != nil {
+			return err
+		}
+	}
+}
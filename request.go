@@ -1,6 +1,9 @@
 package netsuite
 
-import "net/url"
+import (
+	"net/http"
+	"net/url"
+)
 
 type Request interface {
 	Method() string
@@ -10,6 +13,14 @@ type Request interface {
 	URL() (*url.URL, error)
 }
 
+// RequestHeaders is implemented by requests that carry extra headers, e.g.
+// Prefer or X-NetSuite-PropertyNameValidation (see SetHeader on the request
+// types). NewRequest sets them after the default headers, so they can
+// override those.
+type RequestHeaders interface {
+	Headers() http.Header
+}
+
 type QueryParams interface {
 	ToURLValues() (url.Values, error)
 }
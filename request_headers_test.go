@@ -0,0 +1,38 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestRequestHeaders(t *testing.T) {
+	headers := http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	c := netsuite.NewClient(netsuite.WithBaseURL(server.URL), netsuite.WithUserAgent("default/1.0"))
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	req.SetHeader("X-NetSuite-PropertyNameValidation", "Warning")
+	req.SetHeader("User-Agent", "override/1.0")
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if headers.Get("X-NetSuite-PropertyNameValidation") != "Warning" {
+		t.Errorf("expected the request header to be sent, got %v", headers)
+	}
+	if headers.Get("User-Agent") != "override/1.0" {
+		t.Errorf("expected the user agent to be overridden, got %s", headers.Get("User-Agent"))
+	}
+}
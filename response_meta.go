@@ -0,0 +1,79 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ResponseMeta describes the response to a request, next to the body that was
+// decoded. Pass one to WithResponseMeta to have it filled in by Client.Do.
+type ResponseMeta struct {
+	// StatusCode is the status of the last attempt
+	StatusCode int
+	Header     http.Header
+	// Location is the url of the record a POST created
+	Location string
+	// ID is the internal id of the record a POST created, taken from Location
+	ID   string
+	ETag string
+	// Warnings are the texts of the Warning headers, see Warnings
+	Warnings []string
+	// Duration is the time spent on the request, including retries
+	Duration time.Duration
+	// Retries is the number of times the request was retried
+	Retries int
+	// Cached is set when the body came from the cache, see CachePolicy. The
+	// status is 304 when the cached body was revalidated.
+	Cached bool
+	// Coalesced is set when the response was shared with an identical
+	// request that was in flight, see Client.SetCoalesceRequests
+	Coalesced bool
+	// UnknownFields are the fields of the response its type doesn't have,
+	// see UnknownFields
+	UnknownFields []string
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a context that makes Client.Do fill in meta with
+// the response to the request sent with it:
+//
+//	meta := &netsuite.ResponseMeta{}
+//	_, err := req.Do(netsuite.WithResponseMeta(ctx, meta))
+//	fmt.Println(meta.ID)
+//
+// meta is also filled in when the request failed with an error response.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+func responseMetaFromContext(ctx context.Context) *ResponseMeta {
+	meta, _ := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	return meta
+}
+
+// setResponseMeta fills in the ResponseMeta of the context of req, if any.
+func setResponseMeta(req *http.Request, resp *http.Response, duration time.Duration, retries int) {
+	meta := responseMetaFromContext(req.Context())
+	if meta == nil {
+		return
+	}
+
+	*meta = ResponseMeta{
+		Duration: duration,
+		Retries:  retries,
+	}
+	if resp == nil {
+		return
+	}
+
+	meta.StatusCode = resp.StatusCode
+	meta.Header = resp.Header
+	meta.ETag = resp.Header.Get("ETag")
+	meta.Warnings = Warnings(resp)
+	meta.Location = resp.Header.Get("Location")
+	if meta.Location != "" {
+		meta.ID, _ = IDFromLocation(resp)
+	}
+}
@@ -0,0 +1,39 @@
+package netsuite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestResponseMeta(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+
+	terms := netsuite.NewService[netsuite.Term](srv.Client(), "term")
+
+	meta := &netsuite.ResponseMeta{}
+	id, err := terms.Create(netsuite.WithResponseMeta(context.Background(), meta), netsuite.Term{Name: "Net 30"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status 204, got %d", meta.StatusCode)
+	}
+	if meta.ID != id || meta.Location == "" {
+		t.Errorf("expected id %s from location, got %q (%q)", id, meta.ID, meta.Location)
+	}
+	if meta.Retries != 0 || meta.Duration <= 0 {
+		t.Errorf("unexpected retries %d or duration %s", meta.Retries, meta.Duration)
+	}
+
+	meta = &netsuite.ResponseMeta{}
+	_, err = terms.Get(netsuite.WithResponseMeta(context.Background(), meta), "404", nil)
+	if err == nil || meta.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a 404 in the meta, got %d (%v)", meta.StatusCode, err)
+	}
+}
@@ -0,0 +1,255 @@
+// Package restlet calls custom RESTlets deployed in a NetSuite account, with
+// the credentials (token based auth or OAuth 2.0) of a netsuite.Client.
+package restlet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+const mediaType = "application/json"
+
+// Client manages communication with the RESTlets of an account
+type Client struct {
+	netsuite *netsuite.Client
+	baseURL  string
+}
+
+// NewClient returns a RESTlet client that reuses the account, credentials and
+// http client of c.
+func NewClient(c *netsuite.Client) *Client {
+	return &Client{
+		netsuite: c,
+		baseURL:  netsuite.RestletBaseURL,
+	}
+}
+
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = baseURL
+}
+
+func (c Client) BaseURL() (*url.URL, error) {
+	tmpl, err := template.New("host").Parse(c.baseURL)
+	if err != nil {
+		return &url.URL{}, err
+	}
+	buf := new(bytes.Buffer)
+	// the restlet domain is lowercase, also for sandbox accounts (1234567_SB1)
+	err = tmpl.Execute(buf, map[string]interface{}{"account_id": strings.ToLower(strings.Replace(c.netsuite.CompanyID(), "_", "-", -1))})
+	if err != nil {
+		return &url.URL{}, err
+	}
+	return url.Parse(buf.String())
+}
+
+// URL returns the url of the deployed script with the extra query params.
+func (c *Client) URL(scriptID, deployID string, params url.Values) (*url.URL, error) {
+	u, err := c.BaseURL()
+	if err != nil {
+		return u, err
+	}
+
+	q := u.Query()
+	for k, vv := range params {
+		for _, v := range vv {
+			q.Add(k, v)
+		}
+	}
+	q.Set("script", scriptID)
+	q.Set("deploy", deployID)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// Script returns a handle on a single RESTlet deployment.
+func (c *Client) Script(scriptID, deployID string) *Script {
+	return &Script{
+		client:   c,
+		ScriptID: scriptID,
+		DeployID: deployID,
+	}
+}
+
+// Get calls the get entry point of the RESTlet; params are passed as query
+// params.
+func (c *Client) Get(ctx context.Context, scriptID, deployID string, params url.Values, v interface{}) error {
+	return c.call(ctx, http.MethodGet, scriptID, deployID, params, nil, v)
+}
+
+// Post calls the post entry point of the RESTlet with body encoded as json,
+// or sent as is when it's a netsuite.RawBody.
+func (c *Client) Post(ctx context.Context, scriptID, deployID string, body interface{}, v interface{}) error {
+	return c.call(ctx, http.MethodPost, scriptID, deployID, nil, body, v)
+}
+
+// Put calls the put entry point of the RESTlet with body encoded as json.
+func (c *Client) Put(ctx context.Context, scriptID, deployID string, body interface{}, v interface{}) error {
+	return c.call(ctx, http.MethodPut, scriptID, deployID, nil, body, v)
+}
+
+// Delete calls the delete entry point of the RESTlet; params are passed as
+// query params.
+func (c *Client) Delete(ctx context.Context, scriptID, deployID string, params url.Values, v interface{}) error {
+	return c.call(ctx, http.MethodDelete, scriptID, deployID, params, nil, v)
+}
+
+func (c *Client) call(ctx context.Context, method, scriptID, deployID string, params url.Values, body interface{}, v interface{}) error {
+	req, err := c.NewRequest(ctx, method, scriptID, deployID, params, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(req, v)
+	return err
+}
+
+// NewRequest builds the http request for the RESTlet.
+func (c *Client) NewRequest(ctx context.Context, method, scriptID, deployID string, params url.Values, body interface{}) (*http.Request, error) {
+	u, err := c.URL(scriptID, deployID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// RESTlets require a content type, also on GET and DELETE
+	var reader io.Reader
+	contentType := fmt.Sprintf("%s; charset=%s", mediaType, c.netsuite.Charset())
+	if raw, ok := netsuite.AsRawBody(body); ok {
+		reader = raw.Reader
+		if raw.ContentType != "" {
+			contentType = raw.ContentType
+		}
+	} else {
+		buf := new(bytes.Buffer)
+		if body != nil {
+			err := json.NewEncoder(buf).Encode(body)
+			if err != nil {
+				return nil, err
+			}
+		}
+		reader = buf
+	}
+
+	r, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx != nil {
+		r = r.WithContext(ctx)
+	}
+
+	r.Header.Add("Content-Type", contentType)
+	r.Header.Add("Accept", mediaType)
+	r.Header.Add("User-Agent", c.netsuite.UserAgent())
+
+	if c.netsuite.ContentLanguage() != "" {
+		r.Header.Add("Accept-Language", c.netsuite.ContentLanguage())
+	}
+
+	return r, nil
+}
+
+// Do sends the request and decodes the json response into v. If v implements
+// io.Writer the raw response is written to it instead. RESTlet errors are
+// returned as *Error.
+func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.netsuite.WaitForRateLimit(req.Context()); err != nil {
+		return nil, err
+	}
+
+	release, err := c.netsuite.AcquireConcurrency(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if c.netsuite.UseTokenAuth() {
+		headerValue, err := c.netsuite.TokenBasedAuthorizationHeader(req)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Authorization", headerValue)
+	}
+
+	dump := c.netsuite.ShouldDump()
+	if dump {
+		c.netsuite.DumpRequest(req)
+	}
+
+	start := time.Now()
+	httpResp, err := c.netsuite.HTTPClient().Do(req)
+	if err != nil {
+		c.netsuite.RecordRequest(req, nil, err, time.Since(start))
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if dump {
+		c.netsuite.DumpResponse(httpResp)
+	}
+
+	data, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return httpResp, err
+	}
+
+	err = CheckResponse(httpResp, data)
+	c.netsuite.RecordRequest(req, httpResp, err, time.Since(start))
+	if err != nil {
+		return httpResp, err
+	}
+
+	if v == nil || len(data) == 0 {
+		return httpResp, nil
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		_, err = w.Write(data)
+		return httpResp, err
+	}
+
+	return httpResp, json.Unmarshal(data, v)
+}
+
+// Script is a single RESTlet deployment
+type Script struct {
+	client *Client
+
+	ScriptID string
+	DeployID string
+}
+
+func (s *Script) URL(params url.Values) (*url.URL, error) {
+	return s.client.URL(s.ScriptID, s.DeployID, params)
+}
+
+func (s *Script) Get(ctx context.Context, params url.Values, v interface{}) error {
+	return s.client.Get(ctx, s.ScriptID, s.DeployID, params, v)
+}
+
+func (s *Script) Post(ctx context.Context, body interface{}, v interface{}) error {
+	return s.client.Post(ctx, s.ScriptID, s.DeployID, body, v)
+}
+
+func (s *Script) Put(ctx context.Context, body interface{}, v interface{}) error {
+	return s.client.Put(ctx, s.ScriptID, s.DeployID, body, v)
+}
+
+func (s *Script) Delete(ctx context.Context, params url.Values, v interface{}) error {
+	return s.client.Delete(ctx, s.ScriptID, s.DeployID, params, v)
+}
@@ -0,0 +1,87 @@
+package restlet_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/restlet"
+)
+
+func TestURL(t *testing.T) {
+	c := netsuite.NewClient()
+	c.SetCompanyID("1234567_SB1")
+
+	u, err := restlet.NewClient(c).URL("customscript_orders", "1", url.Values{"id": []string{"12"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "https://1234567-sb1.restlets.api.netsuite.com/app/site/hosting/restlet.nl?deploy=1&id=12&script=customscript_orders"
+	if u.String() != expected {
+		t.Errorf("expected %s, got %s", expected, u)
+	}
+}
+
+func TestScript(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("script") != "customscript_orders" || r.URL.Query().Get("deploy") != "1" {
+			t.Errorf("unexpected script/deploy: %s", r.URL.RawQuery)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			t.Errorf("expected json content type, got %s", r.Header.Get("Content-Type"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id":"` + r.URL.Query().Get("id") + `","status":"pendingFulfillment"}`))
+		case http.MethodPost:
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			body["id"] = "13"
+			b, _ := json.Marshal(body)
+			w.Write(b)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":"RCRD_DSNT_EXIST","message":"That record does not exist."}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := restlet.NewClient(netsuite.NewClient())
+	c.SetBaseURL(server.URL)
+	script := c.Script("customscript_orders", "1")
+
+	order := struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}{}
+	err := script.Get(context.Background(), url.Values{"id": []string{"12"}}, &order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != "12" || order.Status != "pendingFulfillment" {
+		t.Errorf("unexpected order: %+v", order)
+	}
+
+	err = script.Post(context.Background(), map[string]string{"status": "pendingApproval"}, &order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.ID != "13" || order.Status != "pendingApproval" {
+		t.Errorf("unexpected order: %+v", order)
+	}
+
+	err = script.Delete(context.Background(), url.Values{"id": []string{"14"}}, nil)
+	restletErr := &restlet.Error{}
+	if !errors.As(err, &restletErr) || restletErr.Code != "RCRD_DSNT_EXIST" || restletErr.Response.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected RCRD_DSNT_EXIST error, got %v", err)
+	}
+}
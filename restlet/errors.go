@@ -0,0 +1,55 @@
+package restlet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// {
+//   "error": {
+//     "code": "SSS_MISSING_REQD_ARGUMENT",
+//     "message": "id is required"
+//   }
+// }
+
+// Error is an error thrown by a RESTlet (or by NetSuite before the script ran,
+// e.g. INVALID_LOGIN_ATTEMPT)
+type Error struct {
+	// HTTP response that caused this error
+	Response *http.Response
+
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// CheckResponse returns an *Error when the response has a status code outside
+// the 200 range. The body is expected to be a json error object; any other body
+// is used as the message.
+func CheckResponse(r *http.Response, data []byte) error {
+	if c := r.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+
+	errResp := struct {
+		Error *Error `json:"error"`
+	}{}
+	err := json.Unmarshal(data, &errResp)
+	if err != nil || errResp.Error == nil {
+		msg := string(data)
+		if msg == "" {
+			msg = r.Status
+		}
+		return &Error{Response: r, Message: msg}
+	}
+
+	errResp.Error.Response = r
+	return errResp.Error
+}
@@ -0,0 +1,152 @@
+package restlet
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// DefaultSavedSearchPageSize is the number of results fetched per call when
+// no page size is given. NetSuite allows 5 to 1000.
+var DefaultSavedSearchPageSize = 1000
+
+// SavedSearchFilter is added to the filters of the saved search, e.g.
+// {Name: "trandate", Operator: "within", Values: []string{"1/1/2021", "31/1/2021"}}
+type SavedSearchFilter struct {
+	Name     string   `json:"name"`
+	Join     string   `json:"join,omitempty"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
+
+type SavedSearchFilters []SavedSearchFilter
+
+// SavedSearchColumn describes a result column. Key is the name the values are
+// stored under: the column name, prefixed with "{join}." for joined columns.
+type SavedSearchColumn struct {
+	Key     string `json:"key"`
+	Name    string `json:"name"`
+	Join    string `json:"join"`
+	Label   string `json:"label"`
+	Summary string `json:"summary"`
+}
+
+type SavedSearchColumns []SavedSearchColumn
+
+type SavedSearchValue struct {
+	Value json.RawMessage `json:"value"`
+	// Text is the display value of select columns
+	Text string `json:"text"`
+}
+
+type SavedSearchResult struct {
+	ID         string                      `json:"id"`
+	RecordType string                      `json:"recordType"`
+	Values     map[string]SavedSearchValue `json:"values"`
+}
+
+// Text returns the display value of the column, or the value when the column
+// has no display value.
+func (r SavedSearchResult) Text(key string) string {
+	v, ok := r.Values[key]
+	if !ok {
+		return ""
+	}
+	if v.Text != "" {
+		return v.Text
+	}
+
+	s := ""
+	if json.Unmarshal(v.Value, &s) == nil {
+		return s
+	}
+	return string(v.Value)
+}
+
+// Unmarshal maps the result onto v: a json object with the values by column
+// key, plus "id" and "recordType", is decoded into v.
+func (r SavedSearchResult) Unmarshal(v interface{}) error {
+	b, err := json.Marshal(r.props())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func (r SavedSearchResult) props() map[string]json.RawMessage {
+	props := map[string]json.RawMessage{}
+	for k, val := range r.Values {
+		if len(val.Value) > 0 {
+			props[k] = val.Value
+		}
+	}
+	props["id"], _ = json.Marshal(r.ID)
+	props["recordType"], _ = json.Marshal(r.RecordType)
+	return props
+}
+
+type SavedSearchResults []SavedSearchResult
+
+// Unmarshal maps all results onto v, a pointer to a slice of structs.
+func (rr SavedSearchResults) Unmarshal(v interface{}) error {
+	rows := make([]map[string]json.RawMessage, len(rr))
+	for i, r := range rr {
+		rows[i] = r.props()
+	}
+
+	b, err := json.Marshal(rows)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// SavedSearchPage is the response of the saved search RESTlet
+type SavedSearchPage struct {
+	PageIndex int                `json:"pageIndex"`
+	PageCount int                `json:"pageCount"`
+	Count     int                `json:"count"`
+	Columns   SavedSearchColumns `json:"columns"`
+	Results   SavedSearchResults `json:"results"`
+}
+
+type savedSearchRequest struct {
+	SearchID  string             `json:"searchId"`
+	Filters   SavedSearchFilters `json:"filters,omitempty"`
+	PageSize  int                `json:"pageSize"`
+	PageIndex int                `json:"pageIndex"`
+}
+
+// SavedSearchPage runs the saved search (script id or internal id) with the
+// extra filters and returns a single page of results. The script has to be a
+// deployment of suitescript/saved_search_restlet.js.
+func (s *Script) SavedSearchPage(ctx context.Context, searchID string, filters SavedSearchFilters, pageSize, pageIndex int) (SavedSearchPage, error) {
+	if pageSize == 0 {
+		pageSize = DefaultSavedSearchPageSize
+	}
+
+	page := SavedSearchPage{}
+	err := s.Post(ctx, savedSearchRequest{
+		SearchID:  searchID,
+		Filters:   filters,
+		PageSize:  pageSize,
+		PageIndex: pageIndex,
+	}, &page)
+	return page, err
+}
+
+// SavedSearch runs the saved search with the extra filters and returns the
+// results of all pages.
+func (s *Script) SavedSearch(ctx context.Context, searchID string, filters SavedSearchFilters) (SavedSearchResults, error) {
+	results := SavedSearchResults{}
+	for i := 0; ; i++ {
+		page, err := s.SavedSearchPage(ctx, searchID, filters, DefaultSavedSearchPageSize, i)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, page.Results...)
+		if i+1 >= page.PageCount {
+			return results, nil
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package restlet_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/restlet"
+)
+
+func TestSavedSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			SearchID  string                     `json:"searchId"`
+			Filters   restlet.SavedSearchFilters `json:"filters"`
+			PageIndex int                        `json:"pageIndex"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.SearchID != "customsearch_open_orders" || len(body.Filters) != 1 || body.Filters[0].Operator != "onorafter" {
+			t.Errorf("unexpected request: %+v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"pageIndex":%d,"pageCount":2,"count":2,"results":[{"id":"%d","recordType":"salesorder","values":{"tranid":{"value":"SO%d","text":""},"customer.email":{"value":"info@example.com","text":""},"entity":{"value":"12","text":"Omniboost"}}}]}`, body.PageIndex, body.PageIndex+10, body.PageIndex)
+	}))
+	defer server.Close()
+
+	c := restlet.NewClient(netsuite.NewClient())
+	c.SetBaseURL(server.URL)
+
+	results, err := c.Script("customscript_saved_search", "1").SavedSearch(context.Background(), "customsearch_open_orders", restlet.SavedSearchFilters{
+		{Name: "trandate", Operator: "onorafter", Values: []string{"1/1/2021"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected results of both pages, got %d", len(results))
+	}
+	if results[0].Text("entity") != "Omniboost" || results[0].Text("tranid") != "SO0" {
+		t.Errorf("unexpected text values: %+v", results[0])
+	}
+
+	orders := []struct {
+		ID            string `json:"id"`
+		TranID        string `json:"tranid"`
+		Entity        string `json:"entity"`
+		CustomerEmail string `json:"customer.email"`
+	}{}
+	err = results.Unmarshal(&orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 2 || orders[1].ID != "11" || orders[1].TranID != "SO1" || orders[1].Entity != "12" || orders[1].CustomerEmail != "info@example.com" {
+		t.Errorf("unexpected orders: %+v", orders)
+	}
+}
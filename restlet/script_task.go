@@ -0,0 +1,92 @@
+package restlet
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// DefaultScriptTaskPollInterval is the interval WaitForScriptTask checks the
+// task status with
+var DefaultScriptTaskPollInterval = 5 * time.Second
+
+const (
+	ScriptTaskTypeMapReduce = "MAP_REDUCE"
+	ScriptTaskTypeScheduled = "SCHEDULED_SCRIPT"
+
+	ScriptTaskStatusPending    = "PENDING"
+	ScriptTaskStatusProcessing = "PROCESSING"
+	ScriptTaskStatusComplete   = "COMPLETE"
+	ScriptTaskStatusFailed     = "FAILED"
+
+	// map/reduce stages
+	ScriptTaskStageGetInput  = "GET_INPUT"
+	ScriptTaskStageMap       = "MAP"
+	ScriptTaskStageShuffle   = "SHUFFLE"
+	ScriptTaskStageReduce    = "REDUCE"
+	ScriptTaskStageSummarize = "SUMMARIZE"
+)
+
+// ScriptTask is a map/reduce or scheduled script to run
+type ScriptTask struct {
+	TaskType string `json:"taskType"`
+	ScriptID string `json:"scriptId"`
+	// DeploymentID is optional: NetSuite picks an available deployment when
+	// it's left empty
+	DeploymentID string `json:"deploymentId,omitempty"`
+	// Params are the script parameters (custscript_...)
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type ScriptTaskStatus struct {
+	TaskID       string `json:"taskId"`
+	ScriptID     string `json:"scriptId"`
+	DeploymentID string `json:"deploymentId"`
+	Status       string `json:"status"`
+	// Stage is only set for map/reduce scripts
+	Stage            string  `json:"stage"`
+	PercentCompleted float64 `json:"percentCompleted"`
+}
+
+func (s ScriptTaskStatus) Done() bool {
+	return s.Status == ScriptTaskStatusComplete || s.Status == ScriptTaskStatusFailed
+}
+
+// SubmitScriptTask starts the map/reduce or scheduled script and returns the
+// task id. The script has to be a deployment of
+// suitescript/script_task_restlet.js.
+func (s *Script) SubmitScriptTask(ctx context.Context, task ScriptTask) (string, error) {
+	resp := struct {
+		TaskID string `json:"taskId"`
+	}{}
+	err := s.Post(ctx, task, &resp)
+	return resp.TaskID, err
+}
+
+// ScriptTaskStatus returns the status of the submitted task.
+func (s *Script) ScriptTaskStatus(ctx context.Context, taskID string) (ScriptTaskStatus, error) {
+	status := ScriptTaskStatus{}
+	err := s.Get(ctx, url.Values{"taskId": []string{taskID}}, &status)
+	return status, err
+}
+
+// WaitForScriptTask polls the task until it's complete or failed. A zero
+// interval uses DefaultScriptTaskPollInterval.
+func (s *Script) WaitForScriptTask(ctx context.Context, taskID string, interval time.Duration) (ScriptTaskStatus, error) {
+	if interval == 0 {
+		interval = DefaultScriptTaskPollInterval
+	}
+
+	for {
+		status, err := s.ScriptTaskStatus(ctx, taskID)
+		if err != nil || status.Done() {
+			return status, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
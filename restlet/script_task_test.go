@@ -0,0 +1,61 @@
+package restlet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/restlet"
+)
+
+func TestScriptTask(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			task := restlet.ScriptTask{}
+			json.NewDecoder(r.Body).Decode(&task)
+			if task.TaskType != restlet.ScriptTaskTypeMapReduce || task.ScriptID != "customscript_mr_sync" || task.Params["custscript_date"] != "1/1/2021" {
+				t.Errorf("unexpected task: %+v", task)
+			}
+			w.Write([]byte(`{"taskId":"MAPREDUCETASK_1"}`))
+		case http.MethodGet:
+			if r.URL.Query().Get("taskId") != "MAPREDUCETASK_1" {
+				t.Errorf("unexpected task id: %s", r.URL.Query().Get("taskId"))
+			}
+			polls++
+			if polls < 3 {
+				w.Write([]byte(`{"taskId":"MAPREDUCETASK_1","status":"PROCESSING","stage":"MAP","percentCompleted":40}`))
+				return
+			}
+			w.Write([]byte(`{"taskId":"MAPREDUCETASK_1","status":"COMPLETE","stage":"SUMMARIZE","percentCompleted":100}`))
+		}
+	}))
+	defer server.Close()
+
+	c := restlet.NewClient(netsuite.NewClient())
+	c.SetBaseURL(server.URL)
+	script := c.Script("customscript_script_task", "1")
+
+	taskID, err := script.SubmitScriptTask(context.Background(), restlet.ScriptTask{
+		TaskType: restlet.ScriptTaskTypeMapReduce,
+		ScriptID: "customscript_mr_sync",
+		Params:   map[string]interface{}{"custscript_date": "1/1/2021"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := script.WaitForScriptTask(context.Background(), taskID, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != restlet.ScriptTaskStatusComplete || polls != 3 {
+		t.Errorf("expected complete task after 3 polls, got %+v (%d polls)", status, polls)
+	}
+}
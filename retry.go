@@ -0,0 +1,126 @@
+package netsuite
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	DefaultRetryBackoff    = time.Second
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy makes the client retry requests that failed transiently.
+// Throttled requests (429) are always retried, server errors (5xx) and
+// network errors only for idempotent methods (GET, PUT, DELETE), so a POST
+// never creates a record twice.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt; 0
+	// disables retrying (the default)
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled on every next
+	// retry (default 1s). The Retry-After of a throttled response takes
+	// precedence.
+	Backoff time.Duration
+	// MaxBackoff caps the wait between retries (default 30s)
+	MaxBackoff time.Duration
+}
+
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retryPolicy = policy
+}
+
+func (c *Client) RetryPolicy() RetryPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.retryPolicy
+}
+
+// backoff returns the wait before the nth retry (1 based).
+func (p RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if g := ParseGovernance(resp); g.RetryAfter > 0 {
+			return g.RetryAfter
+		}
+	}
+
+	backoff := p.Backoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = DefaultRetryMaxBackoff
+	}
+
+	for i := 1; i < retry && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// shouldRetry reports whether the request that got resp or err can be sent
+// again.
+func (p RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	// the body can't be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+		return resp.StatusCode >= 500 && isIdempotent(req.Method)
+	}
+
+	urlErr := &url.Error{}
+	return errors.As(err, &urlErr) && isIdempotent(req.Method)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rewind prepares req to be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return req, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// drain reads the rest of the body of a response that's discarded for a
+// retry, so the connection can be reused.
+func drain(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+}
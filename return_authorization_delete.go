@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewReturnAuthorizationDeleteRequest() ReturnAuthorizationDeleteRequest {
+	r := ReturnAuthorizationDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ReturnAuthorizationDeleteRequest struct {
+	client      *Client
+	queryParams *ReturnAuthorizationDeleteRequestQueryParams
+	pathParams  *ReturnAuthorizationDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ReturnAuthorizationDeleteRequestBody
+}
+
+func (r ReturnAuthorizationDeleteRequest) NewQueryParams() *ReturnAuthorizationDeleteRequestQueryParams {
+	return &ReturnAuthorizationDeleteRequestQueryParams{}
+}
+
+type ReturnAuthorizationDeleteRequestQueryParams struct {
+}
+
+func (p ReturnAuthorizationDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ReturnAuthorizationDeleteRequest) QueryParams() *ReturnAuthorizationDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ReturnAuthorizationDeleteRequest) NewPathParams() *ReturnAuthorizationDeleteRequestPathParams {
+	return &ReturnAuthorizationDeleteRequestPathParams{}
+}
+
+type ReturnAuthorizationDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ReturnAuthorizationDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ReturnAuthorizationDeleteRequest) PathParams() *ReturnAuthorizationDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ReturnAuthorizationDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *ReturnAuthorizationDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ReturnAuthorizationDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ReturnAuthorizationDeleteRequest) NewRequestBody() ReturnAuthorizationDeleteRequestBody {
+	return ReturnAuthorizationDeleteRequestBody{}
+}
+
+type ReturnAuthorizationDeleteRequestBody struct {
+}
+
+func (r *ReturnAuthorizationDeleteRequest) RequestBody() *ReturnAuthorizationDeleteRequestBody {
+	return nil
+}
+
+func (r *ReturnAuthorizationDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ReturnAuthorizationDeleteRequest) SetRequestBody(body ReturnAuthorizationDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ReturnAuthorizationDeleteRequest) NewResponseBody() *ReturnAuthorizationDeleteResponseBody {
+	return &ReturnAuthorizationDeleteResponseBody{}
+}
+
+type ReturnAuthorizationDeleteResponseBody struct {
+}
+
+func (r *ReturnAuthorizationDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/returnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ReturnAuthorizationDeleteRequest) Do(ctx context.Context) (ReturnAuthorizationDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewReturnAuthorizationGetRequest() ReturnAuthorizationGetRequest {
+	r := ReturnAuthorizationGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ReturnAuthorizationGetRequest struct {
+	client      *Client
+	queryParams *ReturnAuthorizationGetRequestQueryParams
+	pathParams  *ReturnAuthorizationGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ReturnAuthorizationGetRequestBody
+}
+
+func (r ReturnAuthorizationGetRequest) NewQueryParams() *ReturnAuthorizationGetRequestQueryParams {
+	return &ReturnAuthorizationGetRequestQueryParams{}
+}
+
+type ReturnAuthorizationGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ReturnAuthorizationGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ReturnAuthorizationGetRequest) QueryParams() *ReturnAuthorizationGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ReturnAuthorizationGetRequest) NewPathParams() *ReturnAuthorizationGetRequestPathParams {
+	return &ReturnAuthorizationGetRequestPathParams{}
+}
+
+type ReturnAuthorizationGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ReturnAuthorizationGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ReturnAuthorizationGetRequest) PathParams() *ReturnAuthorizationGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ReturnAuthorizationGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ReturnAuthorizationGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ReturnAuthorizationGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ReturnAuthorizationGetRequest) NewRequestBody() ReturnAuthorizationGetRequestBody {
+	return ReturnAuthorizationGetRequestBody{}
+}
+
+type ReturnAuthorizationGetRequestBody struct {
+}
+
+func (r *ReturnAuthorizationGetRequest) RequestBody() *ReturnAuthorizationGetRequestBody {
+	return nil
+}
+
+func (r *ReturnAuthorizationGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ReturnAuthorizationGetRequest) SetRequestBody(body ReturnAuthorizationGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ReturnAuthorizationGetRequest) NewResponseBody() *ReturnAuthorizationGetResponseBody {
+	return &ReturnAuthorizationGetResponseBody{}
+}
+
+type ReturnAuthorizationGetResponseBody struct {
+	Links Links `json:"links"`
+	ReturnAuthorization
+}
+
+func (r *ReturnAuthorizationGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/returnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ReturnAuthorizationGetRequest) Do(ctx context.Context) (ReturnAuthorizationGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
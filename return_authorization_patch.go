@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewReturnAuthorizationPatchRequest() ReturnAuthorizationPatchRequest {
+	r := ReturnAuthorizationPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ReturnAuthorizationPatchRequest struct {
+	client      *Client
+	queryParams *ReturnAuthorizationPatchRequestQueryParams
+	pathParams  *ReturnAuthorizationPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ReturnAuthorizationPatchRequestBody
+}
+
+func (r ReturnAuthorizationPatchRequest) NewQueryParams() *ReturnAuthorizationPatchRequestQueryParams {
+	return &ReturnAuthorizationPatchRequestQueryParams{}
+}
+
+type ReturnAuthorizationPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p ReturnAuthorizationPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ReturnAuthorizationPatchRequest) QueryParams() *ReturnAuthorizationPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ReturnAuthorizationPatchRequest) NewPathParams() *ReturnAuthorizationPatchRequestPathParams {
+	return &ReturnAuthorizationPatchRequestPathParams{}
+}
+
+type ReturnAuthorizationPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ReturnAuthorizationPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ReturnAuthorizationPatchRequest) PathParams() *ReturnAuthorizationPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ReturnAuthorizationPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *ReturnAuthorizationPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ReturnAuthorizationPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ReturnAuthorizationPatchRequest) NewRequestBody() ReturnAuthorizationPatchRequestBody {
+	return ReturnAuthorizationPatchRequestBody{}
+}
+
+type ReturnAuthorizationPatchRequestBody struct {
+	ReturnAuthorization
+}
+
+func (r *ReturnAuthorizationPatchRequest) RequestBody() *ReturnAuthorizationPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *ReturnAuthorizationPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ReturnAuthorizationPatchRequest) SetRequestBody(body ReturnAuthorizationPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ReturnAuthorizationPatchRequest) NewResponseBody() *ReturnAuthorizationPatchResponseBody {
+	return &ReturnAuthorizationPatchResponseBody{}
+}
+
+type ReturnAuthorizationPatchResponseBody struct {
+}
+
+func (r *ReturnAuthorizationPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/returnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ReturnAuthorizationPatchRequest) Do(ctx context.Context) (ReturnAuthorizationPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestReturnAuthorizationPatch(t *testing.T) {
+	req := client.NewReturnAuthorizationPatchRequest()
+	req.PathParams().ID = 8120
+	req.RequestBody().Memo = "Damaged in transit"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
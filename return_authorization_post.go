@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewReturnAuthorizationPostRequest() ReturnAuthorizationPostRequest {
+	r := ReturnAuthorizationPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ReturnAuthorizationPostRequest struct {
+	client      *Client
+	queryParams *ReturnAuthorizationPostRequestQueryParams
+	pathParams  *ReturnAuthorizationPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ReturnAuthorizationPostRequestBody
+}
+
+func (r ReturnAuthorizationPostRequest) NewQueryParams() *ReturnAuthorizationPostRequestQueryParams {
+	return &ReturnAuthorizationPostRequestQueryParams{}
+}
+
+type ReturnAuthorizationPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ReturnAuthorizationPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ReturnAuthorizationPostRequest) QueryParams() *ReturnAuthorizationPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ReturnAuthorizationPostRequest) NewPathParams() *ReturnAuthorizationPostRequestPathParams {
+	return &ReturnAuthorizationPostRequestPathParams{}
+}
+
+type ReturnAuthorizationPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ReturnAuthorizationPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ReturnAuthorizationPostRequest) PathParams() *ReturnAuthorizationPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ReturnAuthorizationPostRequest) Method() string {
+	return r.method
+}
+
+func (r *ReturnAuthorizationPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ReturnAuthorizationPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ReturnAuthorizationPostRequest) NewRequestBody() ReturnAuthorizationPostRequestBody {
+	return ReturnAuthorizationPostRequestBody{}
+}
+
+type ReturnAuthorizationPostRequestBody struct {
+	ReturnAuthorization
+}
+
+func (r *ReturnAuthorizationPostRequest) RequestBody() *ReturnAuthorizationPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *ReturnAuthorizationPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *ReturnAuthorizationPostRequest) SetRequestBody(body ReturnAuthorizationPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ReturnAuthorizationPostRequest) NewResponseBody() *ReturnAuthorizationPostResponseBody {
+	return &ReturnAuthorizationPostResponseBody{}
+}
+
+type ReturnAuthorizationPostResponseBody struct {
+}
+
+func (r *ReturnAuthorizationPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/returnAuthorization", r.PathParams())
+	return &u, err
+}
+
+func (r *ReturnAuthorizationPostRequest) Do(ctx context.Context) (ReturnAuthorizationPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
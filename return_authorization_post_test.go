@@ -0,0 +1,32 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestReturnAuthorizationPost(t *testing.T) {
+	req := client.NewReturnAuthorizationPostRequest()
+	req.RequestBody().Entity.ID = "70202"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Item = netsuite.ReturnAuthorizationItems{
+		Items: netsuite.ReturnAuthorizationItemItems{
+			{
+				Item:     netsuite.RecordRef{ID: "131"},
+				Quantity: 1,
+				Rate:     netsuite.MustDecimal("80"),
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewReturnAuthorizationsGetRequest() ReturnAuthorizationsGetRequest {
+	r := ReturnAuthorizationsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ReturnAuthorizationsGetRequest struct {
+	client      *Client
+	queryParams *ReturnAuthorizationsGetRequestQueryParams
+	pathParams  *ReturnAuthorizationsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ReturnAuthorizationsGetRequestBody
+}
+
+func (r ReturnAuthorizationsGetRequest) NewQueryParams() *ReturnAuthorizationsGetRequestQueryParams {
+	return &ReturnAuthorizationsGetRequestQueryParams{}
+}
+
+type ReturnAuthorizationsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ReturnAuthorizationsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ReturnAuthorizationsGetRequest) QueryParams() *ReturnAuthorizationsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ReturnAuthorizationsGetRequest) NewPathParams() *ReturnAuthorizationsGetRequestPathParams {
+	return &ReturnAuthorizationsGetRequestPathParams{}
+}
+
+type ReturnAuthorizationsGetRequestPathParams struct {
+}
+
+func (p *ReturnAuthorizationsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ReturnAuthorizationsGetRequest) PathParams() *ReturnAuthorizationsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ReturnAuthorizationsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ReturnAuthorizationsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ReturnAuthorizationsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ReturnAuthorizationsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ReturnAuthorizationsGetRequest) NewRequestBody() ReturnAuthorizationsGetRequestBody {
+	return ReturnAuthorizationsGetRequestBody{}
+}
+
+type ReturnAuthorizationsGetRequestBody struct {
+}
+
+func (r *ReturnAuthorizationsGetRequest) RequestBody() *ReturnAuthorizationsGetRequestBody {
+	return nil
+}
+
+func (r *ReturnAuthorizationsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ReturnAuthorizationsGetRequest) SetRequestBody(body ReturnAuthorizationsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ReturnAuthorizationsGetRequest) NewResponseBody() *ReturnAuthorizationsGetResponseBody {
+	return &ReturnAuthorizationsGetResponseBody{}
+}
+
+type ReturnAuthorizationsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ReturnAuthorizationsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/returnAuthorization", r.PathParams())
+	return &u, err
+}
+
+func (r *ReturnAuthorizationsGetRequest) Do(ctx context.Context) (ReturnAuthorizationsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
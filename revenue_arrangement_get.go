@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueArrangementGetRequest() RevenueArrangementGetRequest {
+	r := RevenueArrangementGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueArrangementGetRequest struct {
+	client      *Client
+	queryParams *RevenueArrangementGetRequestQueryParams
+	pathParams  *RevenueArrangementGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueArrangementGetRequestBody
+}
+
+func (r RevenueArrangementGetRequest) NewQueryParams() *RevenueArrangementGetRequestQueryParams {
+	return &RevenueArrangementGetRequestQueryParams{}
+}
+
+type RevenueArrangementGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p RevenueArrangementGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueArrangementGetRequest) QueryParams() *RevenueArrangementGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueArrangementGetRequest) NewPathParams() *RevenueArrangementGetRequestPathParams {
+	return &RevenueArrangementGetRequestPathParams{}
+}
+
+type RevenueArrangementGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenueArrangementGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenueArrangementGetRequest) PathParams() *RevenueArrangementGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueArrangementGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueArrangementGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueArrangementGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueArrangementGetRequest) NewRequestBody() RevenueArrangementGetRequestBody {
+	return RevenueArrangementGetRequestBody{}
+}
+
+type RevenueArrangementGetRequestBody struct {
+}
+
+func (r *RevenueArrangementGetRequest) RequestBody() *RevenueArrangementGetRequestBody {
+	return nil
+}
+
+func (r *RevenueArrangementGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenueArrangementGetRequest) SetRequestBody(body RevenueArrangementGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueArrangementGetRequest) NewResponseBody() *RevenueArrangementGetResponseBody {
+	return &RevenueArrangementGetResponseBody{}
+}
+
+type RevenueArrangementGetResponseBody struct {
+	Links Links `json:"links"`
+	RevenueArrangement
+}
+
+func (r *RevenueArrangementGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueArrangement/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueArrangementGetRequest) Do(ctx context.Context) (RevenueArrangementGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
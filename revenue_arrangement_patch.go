@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueArrangementPatchRequest() RevenueArrangementPatchRequest {
+	r := RevenueArrangementPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueArrangementPatchRequest struct {
+	client      *Client
+	queryParams *RevenueArrangementPatchRequestQueryParams
+	pathParams  *RevenueArrangementPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueArrangementPatchRequestBody
+}
+
+func (r RevenueArrangementPatchRequest) NewQueryParams() *RevenueArrangementPatchRequestQueryParams {
+	return &RevenueArrangementPatchRequestQueryParams{}
+}
+
+type RevenueArrangementPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p RevenueArrangementPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueArrangementPatchRequest) QueryParams() *RevenueArrangementPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueArrangementPatchRequest) NewPathParams() *RevenueArrangementPatchRequestPathParams {
+	return &RevenueArrangementPatchRequestPathParams{}
+}
+
+type RevenueArrangementPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenueArrangementPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenueArrangementPatchRequest) PathParams() *RevenueArrangementPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueArrangementPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueArrangementPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueArrangementPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueArrangementPatchRequest) NewRequestBody() RevenueArrangementPatchRequestBody {
+	return RevenueArrangementPatchRequestBody{}
+}
+
+type RevenueArrangementPatchRequestBody struct {
+	RevenueArrangement
+}
+
+func (r *RevenueArrangementPatchRequest) RequestBody() *RevenueArrangementPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *RevenueArrangementPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *RevenueArrangementPatchRequest) SetRequestBody(body RevenueArrangementPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueArrangementPatchRequest) NewResponseBody() *RevenueArrangementPatchResponseBody {
+	return &RevenueArrangementPatchResponseBody{}
+}
+
+type RevenueArrangementPatchResponseBody struct {
+}
+
+func (r *RevenueArrangementPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueArrangement/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueArrangementPatchRequest) Do(ctx context.Context) (RevenueArrangementPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestRevenueArrangementPatch(t *testing.T) {
+	req := client.NewRevenueArrangementPatchRequest()
+	req.PathParams().ID = 1001
+	req.RequestBody().Memo = "ASC 606 review"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueArrangementsGetRequest() RevenueArrangementsGetRequest {
+	r := RevenueArrangementsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueArrangementsGetRequest struct {
+	client      *Client
+	queryParams *RevenueArrangementsGetRequestQueryParams
+	pathParams  *RevenueArrangementsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueArrangementsGetRequestBody
+}
+
+func (r RevenueArrangementsGetRequest) NewQueryParams() *RevenueArrangementsGetRequestQueryParams {
+	return &RevenueArrangementsGetRequestQueryParams{}
+}
+
+type RevenueArrangementsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p RevenueArrangementsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueArrangementsGetRequest) QueryParams() *RevenueArrangementsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueArrangementsGetRequest) NewPathParams() *RevenueArrangementsGetRequestPathParams {
+	return &RevenueArrangementsGetRequestPathParams{}
+}
+
+type RevenueArrangementsGetRequestPathParams struct {
+}
+
+func (p *RevenueArrangementsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *RevenueArrangementsGetRequest) PathParams() *RevenueArrangementsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueArrangementsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueArrangementsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueArrangementsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueArrangementsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueArrangementsGetRequest) NewRequestBody() RevenueArrangementsGetRequestBody {
+	return RevenueArrangementsGetRequestBody{}
+}
+
+type RevenueArrangementsGetRequestBody struct {
+}
+
+func (r *RevenueArrangementsGetRequest) RequestBody() *RevenueArrangementsGetRequestBody {
+	return nil
+}
+
+func (r *RevenueArrangementsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenueArrangementsGetRequest) SetRequestBody(body RevenueArrangementsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueArrangementsGetRequest) NewResponseBody() *RevenueArrangementsGetResponseBody {
+	return &RevenueArrangementsGetResponseBody{}
+}
+
+type RevenueArrangementsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *RevenueArrangementsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueArrangement", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueArrangementsGetRequest) Do(ctx context.Context) (RevenueArrangementsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueElementGetRequest() RevenueElementGetRequest {
+	r := RevenueElementGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueElementGetRequest struct {
+	client      *Client
+	queryParams *RevenueElementGetRequestQueryParams
+	pathParams  *RevenueElementGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueElementGetRequestBody
+}
+
+func (r RevenueElementGetRequest) NewQueryParams() *RevenueElementGetRequestQueryParams {
+	return &RevenueElementGetRequestQueryParams{}
+}
+
+type RevenueElementGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p RevenueElementGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueElementGetRequest) QueryParams() *RevenueElementGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueElementGetRequest) NewPathParams() *RevenueElementGetRequestPathParams {
+	return &RevenueElementGetRequestPathParams{}
+}
+
+type RevenueElementGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenueElementGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenueElementGetRequest) PathParams() *RevenueElementGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueElementGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueElementGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueElementGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueElementGetRequest) NewRequestBody() RevenueElementGetRequestBody {
+	return RevenueElementGetRequestBody{}
+}
+
+type RevenueElementGetRequestBody struct {
+}
+
+func (r *RevenueElementGetRequest) RequestBody() *RevenueElementGetRequestBody {
+	return nil
+}
+
+func (r *RevenueElementGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenueElementGetRequest) SetRequestBody(body RevenueElementGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueElementGetRequest) NewResponseBody() *RevenueElementGetResponseBody {
+	return &RevenueElementGetResponseBody{}
+}
+
+type RevenueElementGetResponseBody struct {
+	Links Links `json:"links"`
+	RevenueElement
+}
+
+func (r *RevenueElementGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueElement/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueElementGetRequest) Do(ctx context.Context) (RevenueElementGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueElementPatchRequest() RevenueElementPatchRequest {
+	r := RevenueElementPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueElementPatchRequest struct {
+	client      *Client
+	queryParams *RevenueElementPatchRequestQueryParams
+	pathParams  *RevenueElementPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueElementPatchRequestBody
+}
+
+func (r RevenueElementPatchRequest) NewQueryParams() *RevenueElementPatchRequestQueryParams {
+	return &RevenueElementPatchRequestQueryParams{}
+}
+
+type RevenueElementPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p RevenueElementPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueElementPatchRequest) QueryParams() *RevenueElementPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueElementPatchRequest) NewPathParams() *RevenueElementPatchRequestPathParams {
+	return &RevenueElementPatchRequestPathParams{}
+}
+
+type RevenueElementPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenueElementPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenueElementPatchRequest) PathParams() *RevenueElementPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueElementPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueElementPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueElementPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueElementPatchRequest) NewRequestBody() RevenueElementPatchRequestBody {
+	return RevenueElementPatchRequestBody{}
+}
+
+type RevenueElementPatchRequestBody struct {
+	RevenueElement
+}
+
+func (r *RevenueElementPatchRequest) RequestBody() *RevenueElementPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *RevenueElementPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *RevenueElementPatchRequest) SetRequestBody(body RevenueElementPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueElementPatchRequest) NewResponseBody() *RevenueElementPatchResponseBody {
+	return &RevenueElementPatchResponseBody{}
+}
+
+type RevenueElementPatchResponseBody struct {
+}
+
+func (r *RevenueElementPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueElement/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueElementPatchRequest) Do(ctx context.Context) (RevenueElementPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestRevenueElementPatch(t *testing.T) {
+	req := client.NewRevenueElementPatchRequest()
+	req.PathParams().ID = 1002
+	req.RequestBody().RevRecStartDate = netsuite.Date{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenueElementsGetRequest() RevenueElementsGetRequest {
+	r := RevenueElementsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenueElementsGetRequest struct {
+	client      *Client
+	queryParams *RevenueElementsGetRequestQueryParams
+	pathParams  *RevenueElementsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenueElementsGetRequestBody
+}
+
+func (r RevenueElementsGetRequest) NewQueryParams() *RevenueElementsGetRequestQueryParams {
+	return &RevenueElementsGetRequestQueryParams{}
+}
+
+type RevenueElementsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p RevenueElementsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenueElementsGetRequest) QueryParams() *RevenueElementsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenueElementsGetRequest) NewPathParams() *RevenueElementsGetRequestPathParams {
+	return &RevenueElementsGetRequestPathParams{}
+}
+
+type RevenueElementsGetRequestPathParams struct {
+}
+
+func (p *RevenueElementsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *RevenueElementsGetRequest) PathParams() *RevenueElementsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenueElementsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenueElementsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenueElementsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenueElementsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenueElementsGetRequest) NewRequestBody() RevenueElementsGetRequestBody {
+	return RevenueElementsGetRequestBody{}
+}
+
+type RevenueElementsGetRequestBody struct {
+}
+
+func (r *RevenueElementsGetRequest) RequestBody() *RevenueElementsGetRequestBody {
+	return nil
+}
+
+func (r *RevenueElementsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenueElementsGetRequest) SetRequestBody(body RevenueElementsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenueElementsGetRequest) NewResponseBody() *RevenueElementsGetResponseBody {
+	return &RevenueElementsGetResponseBody{}
+}
+
+type RevenueElementsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *RevenueElementsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenueElement", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenueElementsGetRequest) Do(ctx context.Context) (RevenueElementsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
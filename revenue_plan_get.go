@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenuePlanGetRequest() RevenuePlanGetRequest {
+	r := RevenuePlanGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenuePlanGetRequest struct {
+	client      *Client
+	queryParams *RevenuePlanGetRequestQueryParams
+	pathParams  *RevenuePlanGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenuePlanGetRequestBody
+}
+
+func (r RevenuePlanGetRequest) NewQueryParams() *RevenuePlanGetRequestQueryParams {
+	return &RevenuePlanGetRequestQueryParams{}
+}
+
+type RevenuePlanGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p RevenuePlanGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenuePlanGetRequest) QueryParams() *RevenuePlanGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenuePlanGetRequest) NewPathParams() *RevenuePlanGetRequestPathParams {
+	return &RevenuePlanGetRequestPathParams{}
+}
+
+type RevenuePlanGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenuePlanGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenuePlanGetRequest) PathParams() *RevenuePlanGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlanGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlanGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenuePlanGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenuePlanGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenuePlanGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenuePlanGetRequest) NewRequestBody() RevenuePlanGetRequestBody {
+	return RevenuePlanGetRequestBody{}
+}
+
+type RevenuePlanGetRequestBody struct {
+}
+
+func (r *RevenuePlanGetRequest) RequestBody() *RevenuePlanGetRequestBody {
+	return nil
+}
+
+func (r *RevenuePlanGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenuePlanGetRequest) SetRequestBody(body RevenuePlanGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenuePlanGetRequest) NewResponseBody() *RevenuePlanGetResponseBody {
+	return &RevenuePlanGetResponseBody{}
+}
+
+type RevenuePlanGetResponseBody struct {
+	Links Links `json:"links"`
+	RevenuePlan
+}
+
+func (r *RevenuePlanGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenuePlan/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenuePlanGetRequest) Do(ctx context.Context) (RevenuePlanGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
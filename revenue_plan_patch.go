@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenuePlanPatchRequest() RevenuePlanPatchRequest {
+	r := RevenuePlanPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenuePlanPatchRequest struct {
+	client      *Client
+	queryParams *RevenuePlanPatchRequestQueryParams
+	pathParams  *RevenuePlanPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenuePlanPatchRequestBody
+}
+
+func (r RevenuePlanPatchRequest) NewQueryParams() *RevenuePlanPatchRequestQueryParams {
+	return &RevenuePlanPatchRequestQueryParams{}
+}
+
+type RevenuePlanPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p RevenuePlanPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenuePlanPatchRequest) QueryParams() *RevenuePlanPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenuePlanPatchRequest) NewPathParams() *RevenuePlanPatchRequestPathParams {
+	return &RevenuePlanPatchRequestPathParams{}
+}
+
+type RevenuePlanPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *RevenuePlanPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *RevenuePlanPatchRequest) PathParams() *RevenuePlanPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlanPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlanPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenuePlanPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenuePlanPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenuePlanPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenuePlanPatchRequest) NewRequestBody() RevenuePlanPatchRequestBody {
+	return RevenuePlanPatchRequestBody{}
+}
+
+type RevenuePlanPatchRequestBody struct {
+	RevenuePlan
+}
+
+func (r *RevenuePlanPatchRequest) RequestBody() *RevenuePlanPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *RevenuePlanPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *RevenuePlanPatchRequest) SetRequestBody(body RevenuePlanPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenuePlanPatchRequest) NewResponseBody() *RevenuePlanPatchResponseBody {
+	return &RevenuePlanPatchResponseBody{}
+}
+
+type RevenuePlanPatchResponseBody struct {
+}
+
+func (r *RevenuePlanPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenuePlan/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenuePlanPatchRequest) Do(ctx context.Context) (RevenuePlanPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestRevenuePlanPatch(t *testing.T) {
+	req := client.NewRevenuePlanPatchRequest()
+	req.PathParams().ID = 1003
+	req.RequestBody().HoldRevenueRecognition = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
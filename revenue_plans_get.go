@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewRevenuePlansGetRequest() RevenuePlansGetRequest {
+	r := RevenuePlansGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type RevenuePlansGetRequest struct {
+	client      *Client
+	queryParams *RevenuePlansGetRequestQueryParams
+	pathParams  *RevenuePlansGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody RevenuePlansGetRequestBody
+}
+
+func (r RevenuePlansGetRequest) NewQueryParams() *RevenuePlansGetRequestQueryParams {
+	return &RevenuePlansGetRequestQueryParams{}
+}
+
+type RevenuePlansGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p RevenuePlansGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *RevenuePlansGetRequest) QueryParams() *RevenuePlansGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r RevenuePlansGetRequest) NewPathParams() *RevenuePlansGetRequestPathParams {
+	return &RevenuePlansGetRequestPathParams{}
+}
+
+type RevenuePlansGetRequestPathParams struct {
+}
+
+func (p *RevenuePlansGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *RevenuePlansGetRequest) PathParams() *RevenuePlansGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlansGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *RevenuePlansGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *RevenuePlansGetRequest) Method() string {
+	return r.method
+}
+
+func (r *RevenuePlansGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *RevenuePlansGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r RevenuePlansGetRequest) NewRequestBody() RevenuePlansGetRequestBody {
+	return RevenuePlansGetRequestBody{}
+}
+
+type RevenuePlansGetRequestBody struct {
+}
+
+func (r *RevenuePlansGetRequest) RequestBody() *RevenuePlansGetRequestBody {
+	return nil
+}
+
+func (r *RevenuePlansGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *RevenuePlansGetRequest) SetRequestBody(body RevenuePlansGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *RevenuePlansGetRequest) NewResponseBody() *RevenuePlansGetResponseBody {
+	return &RevenuePlansGetResponseBody{}
+}
+
+type RevenuePlansGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *RevenuePlansGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/revenuePlan", r.PathParams())
+	return &u, err
+}
+
+func (r *RevenuePlansGetRequest) Do(ctx context.Context) (RevenuePlansGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
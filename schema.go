@@ -0,0 +1,79 @@
+package netsuite
+
+import (
+	"context"
+	"sync"
+)
+
+// schemaCache holds the JSON Schemas fetched with Client.Schema by record
+// type.
+type schemaCache struct {
+	mu      sync.RWMutex
+	schemas map[string]JSONSchema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{schemas: map[string]JSONSchema{}}
+}
+
+func (c *schemaCache) get(recordType string) (JSONSchema, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.schemas[recordType]
+	return s, ok
+}
+
+func (c *schemaCache) set(recordType string, s JSONSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schemas[recordType] = s
+}
+
+func (c *schemaCache) delete(recordType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if recordType == "" {
+		c.schemas = map[string]JSONSchema{}
+		return
+	}
+	delete(c.schemas, recordType)
+}
+
+// Schema returns the JSON Schema of the record type from the metadata catalog.
+// Schemas are cached on the client, so only the first call per record type
+// hits NetSuite.
+func (c *Client) Schema(ctx context.Context, recordType string) (JSONSchema, error) {
+	if c.schemaCache != nil {
+		if s, ok := c.schemaCache.get(recordType); ok {
+			return s, nil
+		}
+	}
+
+	r := c.NewMetadataCatalogSchemaGetRequest()
+	r.PathParams().RecordType = recordType
+
+	req, err := c.NewRequest(ctx, &r)
+	if err != nil {
+		return JSONSchema{}, err
+	}
+	req.Header.Set("Accept", "application/schema+json")
+
+	responseBody := r.NewResponseBody()
+	_, err = c.Do(req, responseBody)
+	if err != nil {
+		return JSONSchema{}, err
+	}
+
+	if c.schemaCache != nil {
+		c.schemaCache.set(recordType, responseBody.JSONSchema)
+	}
+	return responseBody.JSONSchema, nil
+}
+
+// InvalidateSchema removes the cached schema of the record type, or all cached
+// schemas when recordType is empty.
+func (c *Client) InvalidateSchema(recordType string) {
+	if c.schemaCache != nil {
+		c.schemaCache.delete(recordType)
+	}
+}
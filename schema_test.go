@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	schema, err := client.Schema(context.Background(), "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// second call is served from the cache
+	_, err = client.Schema(context.Background(), "customer")
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(schema.PropertyNames(), "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,2868 @@
+package netsuite
+
+import "context"
+
+// The service interfaces group the requests per record type, so code that
+// uses the client can depend on (and mock) e.g. a CustomerService instead of
+// the concrete *Client:
+//
+//	type Importer struct {
+//		Customers netsuite.CustomerService
+//	}
+//
+//	importer := Importer{Customers: client.Customers()}
+//
+// Params may be nil. The requests themselves (NewCustomerGetRequest etc.)
+// remain available for anything the services don't cover. Custom records are
+// handled per type by CustomRecordService, which implements
+// CustomRecordTypeService.
+
+// API is implemented by *Client.
+type API interface {
+	AccountingPeriods() AccountingPeriodService
+	BillingAccounts() BillingAccountService
+	Bins() BinService
+	BinTransfers() BinTransferService
+	BinWorksheets() BinWorksheetService
+	Budgets() BudgetService
+	CalendarEvents() CalendarEventService
+	Campaigns() CampaignService
+	CampaignResponses() CampaignResponseService
+	Checks() CheckService
+	ConsolidatedExchangeRates() ConsolidatedExchangeRateService
+	Contacts() ContactService
+	CouponCodes() CouponCodeService
+	CreditCardCharges() CreditCardChargeService
+	CreditCardRefunds() CreditCardRefundService
+	Currencies() CurrencyService
+	Customers() CustomerService
+	Deposits() DepositService
+	Employees() EmployeeService
+	ExpenseReports() ExpenseReportService
+	FiscalCalendars() FiscalCalendarService
+	GiftCertificates() GiftCertificateService
+	GiftCertificateItems() GiftCertificateItemService
+	InterCompanyJournalEntries() InterCompanyJournalEntryService
+	InventoryAdjustments() InventoryAdjustmentService
+	Invoices() InvoiceService
+	Jobs() JobService
+	JournalEntries() JournalEntryService
+	Messages() MessageService
+	Notes() NoteService
+	Partners() PartnerService
+	PaymentCards() PaymentCardService
+	PaymentCardTokens() PaymentCardTokenService
+	PaymentMethods() PaymentMethodService
+	PhoneCalls() PhoneCallService
+	PriceLevels() PriceLevelService
+	ProjectTasks() ProjectTaskService
+	PromotionCodes() PromotionCodeService
+	ReturnAuthorizations() ReturnAuthorizationService
+	RevenueArrangements() RevenueArrangementService
+	RevenueElements() RevenueElementService
+	RevenuePlans() RevenuePlanService
+	ShipItems() ShipItemService
+	Subscriptions() SubscriptionService
+	SubscriptionLines() SubscriptionLineService
+	Subsidiaries() SubsidiaryService
+	SupportCases() SupportCaseService
+	Tasks() TaskService
+	TaxCodes() TaxCodeService
+	TaxGroups() TaxGroupService
+	TaxPeriods() TaxPeriodService
+	TaxTypes() TaxTypeService
+	Terms() TermService
+	TimeBills() TimeBillService
+	TransferOrders() TransferOrderService
+	UnitsTypes() UnitsTypeService
+	Usages() UsageService
+	VendorReturnAuthorizations() VendorReturnAuthorizationService
+	WorkOrderCloses() WorkOrderCloseService
+	WorkOrderCompletions() WorkOrderCompletionService
+	WorkOrders() WorkOrderService
+	WorkOrderIssues() WorkOrderIssueService
+	SuiteQL() SuiteQLService
+}
+
+var _ API = (*Client)(nil)
+
+// AccountingPeriodService handles accountingPeriod records
+type AccountingPeriodService interface {
+	Get(ctx context.Context, id int, params *AccountingPeriodGetRequestQueryParams) (AccountingPeriodGetResponseBody, error)
+	List(ctx context.Context, params *AccountingPeriodsGetRequestQueryParams) (AccountingPeriodsGetResponseBody, error)
+}
+
+func (c *Client) AccountingPeriods() AccountingPeriodService {
+	return accountingPeriodService{client: c}
+}
+
+type accountingPeriodService struct {
+	client *Client
+}
+
+func (s accountingPeriodService) Get(ctx context.Context, id int, params *AccountingPeriodGetRequestQueryParams) (AccountingPeriodGetResponseBody, error) {
+	req := s.client.NewAccountingPeriodGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s accountingPeriodService) List(ctx context.Context, params *AccountingPeriodsGetRequestQueryParams) (AccountingPeriodsGetResponseBody, error) {
+	req := s.client.NewAccountingPeriodsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// BillingAccountService handles billingAccount records
+type BillingAccountService interface {
+	Get(ctx context.Context, id int, params *BillingAccountGetRequestQueryParams) (BillingAccountGetResponseBody, error)
+	List(ctx context.Context, params *BillingAccountsGetRequestQueryParams) (BillingAccountsGetResponseBody, error)
+	Create(ctx context.Context, body BillingAccountPostRequestBody) (BillingAccountPostResponseBody, error)
+	Update(ctx context.Context, id int, body BillingAccountPatchRequestBody, params *BillingAccountPatchRequestQueryParams) (BillingAccountPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (BillingAccountDeleteResponseBody, error)
+}
+
+func (c *Client) BillingAccounts() BillingAccountService {
+	return billingAccountService{client: c}
+}
+
+type billingAccountService struct {
+	client *Client
+}
+
+func (s billingAccountService) Get(ctx context.Context, id int, params *BillingAccountGetRequestQueryParams) (BillingAccountGetResponseBody, error) {
+	req := s.client.NewBillingAccountGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s billingAccountService) List(ctx context.Context, params *BillingAccountsGetRequestQueryParams) (BillingAccountsGetResponseBody, error) {
+	req := s.client.NewBillingAccountsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s billingAccountService) Create(ctx context.Context, body BillingAccountPostRequestBody) (BillingAccountPostResponseBody, error) {
+	req := s.client.NewBillingAccountPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s billingAccountService) Update(ctx context.Context, id int, body BillingAccountPatchRequestBody, params *BillingAccountPatchRequestQueryParams) (BillingAccountPatchResponseBody, error) {
+	req := s.client.NewBillingAccountPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s billingAccountService) Delete(ctx context.Context, id int) (BillingAccountDeleteResponseBody, error) {
+	req := s.client.NewBillingAccountDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// BinService handles bin records
+type BinService interface {
+	Get(ctx context.Context, id int, params *BinGetRequestQueryParams) (BinGetResponseBody, error)
+	List(ctx context.Context, params *BinsGetRequestQueryParams) (BinsGetResponseBody, error)
+	Create(ctx context.Context, body BinPostRequestBody) (BinPostResponseBody, error)
+	Update(ctx context.Context, id int, body BinPatchRequestBody, params *BinPatchRequestQueryParams) (BinPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (BinDeleteResponseBody, error)
+}
+
+func (c *Client) Bins() BinService {
+	return binService{client: c}
+}
+
+type binService struct {
+	client *Client
+}
+
+func (s binService) Get(ctx context.Context, id int, params *BinGetRequestQueryParams) (BinGetResponseBody, error) {
+	req := s.client.NewBinGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binService) List(ctx context.Context, params *BinsGetRequestQueryParams) (BinsGetResponseBody, error) {
+	req := s.client.NewBinsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binService) Create(ctx context.Context, body BinPostRequestBody) (BinPostResponseBody, error) {
+	req := s.client.NewBinPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s binService) Update(ctx context.Context, id int, body BinPatchRequestBody, params *BinPatchRequestQueryParams) (BinPatchResponseBody, error) {
+	req := s.client.NewBinPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s binService) Delete(ctx context.Context, id int) (BinDeleteResponseBody, error) {
+	req := s.client.NewBinDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// BinTransferService handles binTransfer records
+type BinTransferService interface {
+	Get(ctx context.Context, id int, params *BinTransferGetRequestQueryParams) (BinTransferGetResponseBody, error)
+	List(ctx context.Context, params *BinTransfersGetRequestQueryParams) (BinTransfersGetResponseBody, error)
+	Create(ctx context.Context, body BinTransferPostRequestBody) (BinTransferPostResponseBody, error)
+	Delete(ctx context.Context, id int) (BinTransferDeleteResponseBody, error)
+}
+
+func (c *Client) BinTransfers() BinTransferService {
+	return binTransferService{client: c}
+}
+
+type binTransferService struct {
+	client *Client
+}
+
+func (s binTransferService) Get(ctx context.Context, id int, params *BinTransferGetRequestQueryParams) (BinTransferGetResponseBody, error) {
+	req := s.client.NewBinTransferGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binTransferService) List(ctx context.Context, params *BinTransfersGetRequestQueryParams) (BinTransfersGetResponseBody, error) {
+	req := s.client.NewBinTransfersGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binTransferService) Create(ctx context.Context, body BinTransferPostRequestBody) (BinTransferPostResponseBody, error) {
+	req := s.client.NewBinTransferPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s binTransferService) Delete(ctx context.Context, id int) (BinTransferDeleteResponseBody, error) {
+	req := s.client.NewBinTransferDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// BinWorksheetService handles binWorksheet records
+type BinWorksheetService interface {
+	Get(ctx context.Context, id int, params *BinWorksheetGetRequestQueryParams) (BinWorksheetGetResponseBody, error)
+	List(ctx context.Context, params *BinWorksheetsGetRequestQueryParams) (BinWorksheetsGetResponseBody, error)
+	Create(ctx context.Context, body BinWorksheetPostRequestBody) (BinWorksheetPostResponseBody, error)
+}
+
+func (c *Client) BinWorksheets() BinWorksheetService {
+	return binWorksheetService{client: c}
+}
+
+type binWorksheetService struct {
+	client *Client
+}
+
+func (s binWorksheetService) Get(ctx context.Context, id int, params *BinWorksheetGetRequestQueryParams) (BinWorksheetGetResponseBody, error) {
+	req := s.client.NewBinWorksheetGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binWorksheetService) List(ctx context.Context, params *BinWorksheetsGetRequestQueryParams) (BinWorksheetsGetResponseBody, error) {
+	req := s.client.NewBinWorksheetsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s binWorksheetService) Create(ctx context.Context, body BinWorksheetPostRequestBody) (BinWorksheetPostResponseBody, error) {
+	req := s.client.NewBinWorksheetPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// BudgetService handles budget records
+type BudgetService interface {
+	Get(ctx context.Context, id int, params *BudgetGetRequestQueryParams) (BudgetGetResponseBody, error)
+	List(ctx context.Context, params *BudgetsGetRequestQueryParams) (BudgetsGetResponseBody, error)
+	Create(ctx context.Context, body BudgetPostRequestBody) (BudgetPostResponseBody, error)
+	Update(ctx context.Context, id int, body BudgetPatchRequestBody, params *BudgetPatchRequestQueryParams) (BudgetPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (BudgetDeleteResponseBody, error)
+}
+
+func (c *Client) Budgets() BudgetService {
+	return budgetService{client: c}
+}
+
+type budgetService struct {
+	client *Client
+}
+
+func (s budgetService) Get(ctx context.Context, id int, params *BudgetGetRequestQueryParams) (BudgetGetResponseBody, error) {
+	req := s.client.NewBudgetGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s budgetService) List(ctx context.Context, params *BudgetsGetRequestQueryParams) (BudgetsGetResponseBody, error) {
+	req := s.client.NewBudgetsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s budgetService) Create(ctx context.Context, body BudgetPostRequestBody) (BudgetPostResponseBody, error) {
+	req := s.client.NewBudgetPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s budgetService) Update(ctx context.Context, id int, body BudgetPatchRequestBody, params *BudgetPatchRequestQueryParams) (BudgetPatchResponseBody, error) {
+	req := s.client.NewBudgetPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s budgetService) Delete(ctx context.Context, id int) (BudgetDeleteResponseBody, error) {
+	req := s.client.NewBudgetDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CalendarEventService handles calendarEvent records
+type CalendarEventService interface {
+	Get(ctx context.Context, id int, params *CalendarEventGetRequestQueryParams) (CalendarEventGetResponseBody, error)
+	List(ctx context.Context, params *CalendarEventsGetRequestQueryParams) (CalendarEventsGetResponseBody, error)
+	Create(ctx context.Context, body CalendarEventPostRequestBody) (CalendarEventPostResponseBody, error)
+	Update(ctx context.Context, id int, body CalendarEventPatchRequestBody, params *CalendarEventPatchRequestQueryParams) (CalendarEventPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CalendarEventDeleteResponseBody, error)
+}
+
+func (c *Client) CalendarEvents() CalendarEventService {
+	return calendarEventService{client: c}
+}
+
+type calendarEventService struct {
+	client *Client
+}
+
+func (s calendarEventService) Get(ctx context.Context, id int, params *CalendarEventGetRequestQueryParams) (CalendarEventGetResponseBody, error) {
+	req := s.client.NewCalendarEventGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s calendarEventService) List(ctx context.Context, params *CalendarEventsGetRequestQueryParams) (CalendarEventsGetResponseBody, error) {
+	req := s.client.NewCalendarEventsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s calendarEventService) Create(ctx context.Context, body CalendarEventPostRequestBody) (CalendarEventPostResponseBody, error) {
+	req := s.client.NewCalendarEventPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s calendarEventService) Update(ctx context.Context, id int, body CalendarEventPatchRequestBody, params *CalendarEventPatchRequestQueryParams) (CalendarEventPatchResponseBody, error) {
+	req := s.client.NewCalendarEventPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s calendarEventService) Delete(ctx context.Context, id int) (CalendarEventDeleteResponseBody, error) {
+	req := s.client.NewCalendarEventDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CampaignService handles campaign records
+type CampaignService interface {
+	Get(ctx context.Context, id int, params *CampaignGetRequestQueryParams) (CampaignGetResponseBody, error)
+	List(ctx context.Context, params *CampaignsGetRequestQueryParams) (CampaignsGetResponseBody, error)
+	Create(ctx context.Context, body CampaignPostRequestBody) (CampaignPostResponseBody, error)
+	Update(ctx context.Context, id int, body CampaignPatchRequestBody, params *CampaignPatchRequestQueryParams) (CampaignPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CampaignDeleteResponseBody, error)
+}
+
+func (c *Client) Campaigns() CampaignService {
+	return campaignService{client: c}
+}
+
+type campaignService struct {
+	client *Client
+}
+
+func (s campaignService) Get(ctx context.Context, id int, params *CampaignGetRequestQueryParams) (CampaignGetResponseBody, error) {
+	req := s.client.NewCampaignGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s campaignService) List(ctx context.Context, params *CampaignsGetRequestQueryParams) (CampaignsGetResponseBody, error) {
+	req := s.client.NewCampaignsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s campaignService) Create(ctx context.Context, body CampaignPostRequestBody) (CampaignPostResponseBody, error) {
+	req := s.client.NewCampaignPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s campaignService) Update(ctx context.Context, id int, body CampaignPatchRequestBody, params *CampaignPatchRequestQueryParams) (CampaignPatchResponseBody, error) {
+	req := s.client.NewCampaignPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s campaignService) Delete(ctx context.Context, id int) (CampaignDeleteResponseBody, error) {
+	req := s.client.NewCampaignDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CampaignResponseService handles campaignResponse records
+type CampaignResponseService interface {
+	Get(ctx context.Context, id int, params *CampaignResponseGetRequestQueryParams) (CampaignResponseGetResponseBody, error)
+	List(ctx context.Context, params *CampaignResponsesGetRequestQueryParams) (CampaignResponsesGetResponseBody, error)
+	Create(ctx context.Context, body CampaignResponsePostRequestBody) (CampaignResponsePostResponseBody, error)
+}
+
+func (c *Client) CampaignResponses() CampaignResponseService {
+	return campaignResponseService{client: c}
+}
+
+type campaignResponseService struct {
+	client *Client
+}
+
+func (s campaignResponseService) Get(ctx context.Context, id int, params *CampaignResponseGetRequestQueryParams) (CampaignResponseGetResponseBody, error) {
+	req := s.client.NewCampaignResponseGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s campaignResponseService) List(ctx context.Context, params *CampaignResponsesGetRequestQueryParams) (CampaignResponsesGetResponseBody, error) {
+	req := s.client.NewCampaignResponsesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s campaignResponseService) Create(ctx context.Context, body CampaignResponsePostRequestBody) (CampaignResponsePostResponseBody, error) {
+	req := s.client.NewCampaignResponsePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// CheckService handles check records
+type CheckService interface {
+	Get(ctx context.Context, id int, params *CheckGetRequestQueryParams) (CheckGetResponseBody, error)
+	List(ctx context.Context, params *ChecksGetRequestQueryParams) (ChecksGetResponseBody, error)
+	Create(ctx context.Context, body CheckPostRequestBody) (CheckPostResponseBody, error)
+	Update(ctx context.Context, id int, body CheckPatchRequestBody, params *CheckPatchRequestQueryParams) (CheckPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CheckDeleteResponseBody, error)
+}
+
+func (c *Client) Checks() CheckService {
+	return checkService{client: c}
+}
+
+type checkService struct {
+	client *Client
+}
+
+func (s checkService) Get(ctx context.Context, id int, params *CheckGetRequestQueryParams) (CheckGetResponseBody, error) {
+	req := s.client.NewCheckGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s checkService) List(ctx context.Context, params *ChecksGetRequestQueryParams) (ChecksGetResponseBody, error) {
+	req := s.client.NewChecksGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s checkService) Create(ctx context.Context, body CheckPostRequestBody) (CheckPostResponseBody, error) {
+	req := s.client.NewCheckPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s checkService) Update(ctx context.Context, id int, body CheckPatchRequestBody, params *CheckPatchRequestQueryParams) (CheckPatchResponseBody, error) {
+	req := s.client.NewCheckPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s checkService) Delete(ctx context.Context, id int) (CheckDeleteResponseBody, error) {
+	req := s.client.NewCheckDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// ConsolidatedExchangeRateService handles consolidatedExchangeRate records
+type ConsolidatedExchangeRateService interface {
+	Get(ctx context.Context, id int, params *ConsolidatedExchangeRateGetRequestQueryParams) (ConsolidatedExchangeRateGetResponseBody, error)
+	List(ctx context.Context, params *ConsolidatedExchangeRatesGetRequestQueryParams) (ConsolidatedExchangeRatesGetResponseBody, error)
+}
+
+func (c *Client) ConsolidatedExchangeRates() ConsolidatedExchangeRateService {
+	return consolidatedExchangeRateService{client: c}
+}
+
+type consolidatedExchangeRateService struct {
+	client *Client
+}
+
+func (s consolidatedExchangeRateService) Get(ctx context.Context, id int, params *ConsolidatedExchangeRateGetRequestQueryParams) (ConsolidatedExchangeRateGetResponseBody, error) {
+	req := s.client.NewConsolidatedExchangeRateGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s consolidatedExchangeRateService) List(ctx context.Context, params *ConsolidatedExchangeRatesGetRequestQueryParams) (ConsolidatedExchangeRatesGetResponseBody, error) {
+	req := s.client.NewConsolidatedExchangeRatesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// ContactService handles contact records
+type ContactService interface {
+	Get(ctx context.Context, id int, params *ContactGetRequestQueryParams) (ContactGetResponseBody, error)
+	List(ctx context.Context, params *ContactsGetRequestQueryParams) (ContactsGetResponseBody, error)
+	Create(ctx context.Context, body ContactPostRequestBody) (ContactPostResponseBody, error)
+	Update(ctx context.Context, id int, body ContactPatchRequestBody, params *ContactPatchRequestQueryParams) (ContactPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (ContactDeleteResponseBody, error)
+}
+
+func (c *Client) Contacts() ContactService {
+	return contactService{client: c}
+}
+
+type contactService struct {
+	client *Client
+}
+
+func (s contactService) Get(ctx context.Context, id int, params *ContactGetRequestQueryParams) (ContactGetResponseBody, error) {
+	req := s.client.NewContactGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s contactService) List(ctx context.Context, params *ContactsGetRequestQueryParams) (ContactsGetResponseBody, error) {
+	req := s.client.NewContactsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s contactService) Create(ctx context.Context, body ContactPostRequestBody) (ContactPostResponseBody, error) {
+	req := s.client.NewContactPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s contactService) Update(ctx context.Context, id int, body ContactPatchRequestBody, params *ContactPatchRequestQueryParams) (ContactPatchResponseBody, error) {
+	req := s.client.NewContactPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s contactService) Delete(ctx context.Context, id int) (ContactDeleteResponseBody, error) {
+	req := s.client.NewContactDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CouponCodeService handles couponCode records
+type CouponCodeService interface {
+	Get(ctx context.Context, id int, params *CouponCodeGetRequestQueryParams) (CouponCodeGetResponseBody, error)
+	List(ctx context.Context, params *CouponCodesGetRequestQueryParams) (CouponCodesGetResponseBody, error)
+	Create(ctx context.Context, body CouponCodePostRequestBody) (CouponCodePostResponseBody, error)
+	Delete(ctx context.Context, id int) (CouponCodeDeleteResponseBody, error)
+}
+
+func (c *Client) CouponCodes() CouponCodeService {
+	return couponCodeService{client: c}
+}
+
+type couponCodeService struct {
+	client *Client
+}
+
+func (s couponCodeService) Get(ctx context.Context, id int, params *CouponCodeGetRequestQueryParams) (CouponCodeGetResponseBody, error) {
+	req := s.client.NewCouponCodeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s couponCodeService) List(ctx context.Context, params *CouponCodesGetRequestQueryParams) (CouponCodesGetResponseBody, error) {
+	req := s.client.NewCouponCodesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s couponCodeService) Create(ctx context.Context, body CouponCodePostRequestBody) (CouponCodePostResponseBody, error) {
+	req := s.client.NewCouponCodePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s couponCodeService) Delete(ctx context.Context, id int) (CouponCodeDeleteResponseBody, error) {
+	req := s.client.NewCouponCodeDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CreditCardChargeService handles creditCardCharge records
+type CreditCardChargeService interface {
+	Get(ctx context.Context, id int, params *CreditCardChargeGetRequestQueryParams) (CreditCardChargeGetResponseBody, error)
+	List(ctx context.Context, params *CreditCardChargesGetRequestQueryParams) (CreditCardChargesGetResponseBody, error)
+	Create(ctx context.Context, body CreditCardChargePostRequestBody) (CreditCardChargePostResponseBody, error)
+	Update(ctx context.Context, id int, body CreditCardChargePatchRequestBody, params *CreditCardChargePatchRequestQueryParams) (CreditCardChargePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CreditCardChargeDeleteResponseBody, error)
+}
+
+func (c *Client) CreditCardCharges() CreditCardChargeService {
+	return creditCardChargeService{client: c}
+}
+
+type creditCardChargeService struct {
+	client *Client
+}
+
+func (s creditCardChargeService) Get(ctx context.Context, id int, params *CreditCardChargeGetRequestQueryParams) (CreditCardChargeGetResponseBody, error) {
+	req := s.client.NewCreditCardChargeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s creditCardChargeService) List(ctx context.Context, params *CreditCardChargesGetRequestQueryParams) (CreditCardChargesGetResponseBody, error) {
+	req := s.client.NewCreditCardChargesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s creditCardChargeService) Create(ctx context.Context, body CreditCardChargePostRequestBody) (CreditCardChargePostResponseBody, error) {
+	req := s.client.NewCreditCardChargePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s creditCardChargeService) Update(ctx context.Context, id int, body CreditCardChargePatchRequestBody, params *CreditCardChargePatchRequestQueryParams) (CreditCardChargePatchResponseBody, error) {
+	req := s.client.NewCreditCardChargePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s creditCardChargeService) Delete(ctx context.Context, id int) (CreditCardChargeDeleteResponseBody, error) {
+	req := s.client.NewCreditCardChargeDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CreditCardRefundService handles creditCardRefund records
+type CreditCardRefundService interface {
+	Get(ctx context.Context, id int, params *CreditCardRefundGetRequestQueryParams) (CreditCardRefundGetResponseBody, error)
+	List(ctx context.Context, params *CreditCardRefundsGetRequestQueryParams) (CreditCardRefundsGetResponseBody, error)
+	Create(ctx context.Context, body CreditCardRefundPostRequestBody) (CreditCardRefundPostResponseBody, error)
+	Update(ctx context.Context, id int, body CreditCardRefundPatchRequestBody, params *CreditCardRefundPatchRequestQueryParams) (CreditCardRefundPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (CreditCardRefundDeleteResponseBody, error)
+}
+
+func (c *Client) CreditCardRefunds() CreditCardRefundService {
+	return creditCardRefundService{client: c}
+}
+
+type creditCardRefundService struct {
+	client *Client
+}
+
+func (s creditCardRefundService) Get(ctx context.Context, id int, params *CreditCardRefundGetRequestQueryParams) (CreditCardRefundGetResponseBody, error) {
+	req := s.client.NewCreditCardRefundGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s creditCardRefundService) List(ctx context.Context, params *CreditCardRefundsGetRequestQueryParams) (CreditCardRefundsGetResponseBody, error) {
+	req := s.client.NewCreditCardRefundsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s creditCardRefundService) Create(ctx context.Context, body CreditCardRefundPostRequestBody) (CreditCardRefundPostResponseBody, error) {
+	req := s.client.NewCreditCardRefundPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s creditCardRefundService) Update(ctx context.Context, id int, body CreditCardRefundPatchRequestBody, params *CreditCardRefundPatchRequestQueryParams) (CreditCardRefundPatchResponseBody, error) {
+	req := s.client.NewCreditCardRefundPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s creditCardRefundService) Delete(ctx context.Context, id int) (CreditCardRefundDeleteResponseBody, error) {
+	req := s.client.NewCreditCardRefundDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// CurrencyService handles currency records
+type CurrencyService interface {
+	Get(ctx context.Context, id int, params *CurrencyGetRequestQueryParams) (CurrencyGetResponseBody, error)
+	List(ctx context.Context, params *CurrenciesGetRequestQueryParams) (CurrenciesGetResponseBody, error)
+}
+
+func (c *Client) Currencies() CurrencyService {
+	return currencyService{client: c}
+}
+
+type currencyService struct {
+	client *Client
+}
+
+func (s currencyService) Get(ctx context.Context, id int, params *CurrencyGetRequestQueryParams) (CurrencyGetResponseBody, error) {
+	req := s.client.NewCurrencyGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s currencyService) List(ctx context.Context, params *CurrenciesGetRequestQueryParams) (CurrenciesGetResponseBody, error) {
+	req := s.client.NewCurrenciesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// CustomerService handles customer records
+type CustomerService interface {
+	Get(ctx context.Context, id int, params *CustomerGetRequestQueryParams) (CustomerGetResponseBody, error)
+	List(ctx context.Context, params *CustomersGetRequestQueryParams) (CustomersGetResponseBody, error)
+	Create(ctx context.Context, body CustomerPostRequestBody) (CustomerPostResponseBody, error)
+	UpdateStatus(ctx context.Context, id int, body CustomerStatusPatchRequestBody, params *CustomerStatusPatchRequestQueryParams) (CustomerStatusPatchResponseBody, error)
+}
+
+func (c *Client) Customers() CustomerService {
+	return customerService{client: c}
+}
+
+type customerService struct {
+	client *Client
+}
+
+func (s customerService) Get(ctx context.Context, id int, params *CustomerGetRequestQueryParams) (CustomerGetResponseBody, error) {
+	req := s.client.NewCustomerGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s customerService) List(ctx context.Context, params *CustomersGetRequestQueryParams) (CustomersGetResponseBody, error) {
+	req := s.client.NewCustomersGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s customerService) Create(ctx context.Context, body CustomerPostRequestBody) (CustomerPostResponseBody, error) {
+	req := s.client.NewCustomerPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s customerService) UpdateStatus(ctx context.Context, id int, body CustomerStatusPatchRequestBody, params *CustomerStatusPatchRequestQueryParams) (CustomerStatusPatchResponseBody, error) {
+	req := s.client.NewCustomerStatusPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// DepositService handles deposit records
+type DepositService interface {
+	Get(ctx context.Context, id int, params *DepositGetRequestQueryParams) (DepositGetResponseBody, error)
+	List(ctx context.Context, params *DepositsGetRequestQueryParams) (DepositsGetResponseBody, error)
+	Create(ctx context.Context, body DepositPostRequestBody) (DepositPostResponseBody, error)
+	Update(ctx context.Context, id int, body DepositPatchRequestBody, params *DepositPatchRequestQueryParams) (DepositPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (DepositDeleteResponseBody, error)
+}
+
+func (c *Client) Deposits() DepositService {
+	return depositService{client: c}
+}
+
+type depositService struct {
+	client *Client
+}
+
+func (s depositService) Get(ctx context.Context, id int, params *DepositGetRequestQueryParams) (DepositGetResponseBody, error) {
+	req := s.client.NewDepositGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s depositService) List(ctx context.Context, params *DepositsGetRequestQueryParams) (DepositsGetResponseBody, error) {
+	req := s.client.NewDepositsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s depositService) Create(ctx context.Context, body DepositPostRequestBody) (DepositPostResponseBody, error) {
+	req := s.client.NewDepositPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s depositService) Update(ctx context.Context, id int, body DepositPatchRequestBody, params *DepositPatchRequestQueryParams) (DepositPatchResponseBody, error) {
+	req := s.client.NewDepositPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s depositService) Delete(ctx context.Context, id int) (DepositDeleteResponseBody, error) {
+	req := s.client.NewDepositDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// EmployeeService handles employee records
+type EmployeeService interface {
+	Get(ctx context.Context, id int, params *EmployeeGetRequestQueryParams) (EmployeeGetResponseBody, error)
+	List(ctx context.Context, params *EmployeesGetRequestQueryParams) (EmployeesGetResponseBody, error)
+	Create(ctx context.Context, body EmployeePostRequestBody) (EmployeePostResponseBody, error)
+	Update(ctx context.Context, id int, body EmployeePatchRequestBody, params *EmployeePatchRequestQueryParams) (EmployeePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (EmployeeDeleteResponseBody, error)
+}
+
+func (c *Client) Employees() EmployeeService {
+	return employeeService{client: c}
+}
+
+type employeeService struct {
+	client *Client
+}
+
+func (s employeeService) Get(ctx context.Context, id int, params *EmployeeGetRequestQueryParams) (EmployeeGetResponseBody, error) {
+	req := s.client.NewEmployeeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s employeeService) List(ctx context.Context, params *EmployeesGetRequestQueryParams) (EmployeesGetResponseBody, error) {
+	req := s.client.NewEmployeesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s employeeService) Create(ctx context.Context, body EmployeePostRequestBody) (EmployeePostResponseBody, error) {
+	req := s.client.NewEmployeePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s employeeService) Update(ctx context.Context, id int, body EmployeePatchRequestBody, params *EmployeePatchRequestQueryParams) (EmployeePatchResponseBody, error) {
+	req := s.client.NewEmployeePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s employeeService) Delete(ctx context.Context, id int) (EmployeeDeleteResponseBody, error) {
+	req := s.client.NewEmployeeDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// ExpenseReportService handles expenseReport records
+type ExpenseReportService interface {
+	Get(ctx context.Context, id int, params *ExpenseReportGetRequestQueryParams) (ExpenseReportGetResponseBody, error)
+	List(ctx context.Context, params *ExpenseReportsGetRequestQueryParams) (ExpenseReportsGetResponseBody, error)
+	Create(ctx context.Context, body ExpenseReportPostRequestBody) (ExpenseReportPostResponseBody, error)
+	Update(ctx context.Context, id int, body ExpenseReportPatchRequestBody, params *ExpenseReportPatchRequestQueryParams) (ExpenseReportPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (ExpenseReportDeleteResponseBody, error)
+}
+
+func (c *Client) ExpenseReports() ExpenseReportService {
+	return expenseReportService{client: c}
+}
+
+type expenseReportService struct {
+	client *Client
+}
+
+func (s expenseReportService) Get(ctx context.Context, id int, params *ExpenseReportGetRequestQueryParams) (ExpenseReportGetResponseBody, error) {
+	req := s.client.NewExpenseReportGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s expenseReportService) List(ctx context.Context, params *ExpenseReportsGetRequestQueryParams) (ExpenseReportsGetResponseBody, error) {
+	req := s.client.NewExpenseReportsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s expenseReportService) Create(ctx context.Context, body ExpenseReportPostRequestBody) (ExpenseReportPostResponseBody, error) {
+	req := s.client.NewExpenseReportPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s expenseReportService) Update(ctx context.Context, id int, body ExpenseReportPatchRequestBody, params *ExpenseReportPatchRequestQueryParams) (ExpenseReportPatchResponseBody, error) {
+	req := s.client.NewExpenseReportPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s expenseReportService) Delete(ctx context.Context, id int) (ExpenseReportDeleteResponseBody, error) {
+	req := s.client.NewExpenseReportDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// FiscalCalendarService handles fiscalCalendar records
+type FiscalCalendarService interface {
+	Get(ctx context.Context, id int, params *FiscalCalendarGetRequestQueryParams) (FiscalCalendarGetResponseBody, error)
+	List(ctx context.Context, params *FiscalCalendarsGetRequestQueryParams) (FiscalCalendarsGetResponseBody, error)
+}
+
+func (c *Client) FiscalCalendars() FiscalCalendarService {
+	return fiscalCalendarService{client: c}
+}
+
+type fiscalCalendarService struct {
+	client *Client
+}
+
+func (s fiscalCalendarService) Get(ctx context.Context, id int, params *FiscalCalendarGetRequestQueryParams) (FiscalCalendarGetResponseBody, error) {
+	req := s.client.NewFiscalCalendarGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s fiscalCalendarService) List(ctx context.Context, params *FiscalCalendarsGetRequestQueryParams) (FiscalCalendarsGetResponseBody, error) {
+	req := s.client.NewFiscalCalendarsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// GiftCertificateService handles giftCertificate records
+type GiftCertificateService interface {
+	Get(ctx context.Context, id int, params *GiftCertificateGetRequestQueryParams) (GiftCertificateGetResponseBody, error)
+	List(ctx context.Context, params *GiftCertificatesGetRequestQueryParams) (GiftCertificatesGetResponseBody, error)
+	Update(ctx context.Context, id int, body GiftCertificatePatchRequestBody, params *GiftCertificatePatchRequestQueryParams) (GiftCertificatePatchResponseBody, error)
+}
+
+func (c *Client) GiftCertificates() GiftCertificateService {
+	return giftCertificateService{client: c}
+}
+
+type giftCertificateService struct {
+	client *Client
+}
+
+func (s giftCertificateService) Get(ctx context.Context, id int, params *GiftCertificateGetRequestQueryParams) (GiftCertificateGetResponseBody, error) {
+	req := s.client.NewGiftCertificateGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s giftCertificateService) List(ctx context.Context, params *GiftCertificatesGetRequestQueryParams) (GiftCertificatesGetResponseBody, error) {
+	req := s.client.NewGiftCertificatesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s giftCertificateService) Update(ctx context.Context, id int, body GiftCertificatePatchRequestBody, params *GiftCertificatePatchRequestQueryParams) (GiftCertificatePatchResponseBody, error) {
+	req := s.client.NewGiftCertificatePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// GiftCertificateItemService handles giftCertificateItem records
+type GiftCertificateItemService interface {
+	Get(ctx context.Context, id int, params *GiftCertificateItemGetRequestQueryParams) (GiftCertificateItemGetResponseBody, error)
+	List(ctx context.Context, params *GiftCertificateItemsGetRequestQueryParams) (GiftCertificateItemsGetResponseBody, error)
+	Create(ctx context.Context, body GiftCertificateItemPostRequestBody) (GiftCertificateItemPostResponseBody, error)
+	Update(ctx context.Context, id int, body GiftCertificateItemPatchRequestBody, params *GiftCertificateItemPatchRequestQueryParams) (GiftCertificateItemPatchResponseBody, error)
+}
+
+func (c *Client) GiftCertificateItems() GiftCertificateItemService {
+	return giftCertificateItemService{client: c}
+}
+
+type giftCertificateItemService struct {
+	client *Client
+}
+
+func (s giftCertificateItemService) Get(ctx context.Context, id int, params *GiftCertificateItemGetRequestQueryParams) (GiftCertificateItemGetResponseBody, error) {
+	req := s.client.NewGiftCertificateItemGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s giftCertificateItemService) List(ctx context.Context, params *GiftCertificateItemsGetRequestQueryParams) (GiftCertificateItemsGetResponseBody, error) {
+	req := s.client.NewGiftCertificateItemsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s giftCertificateItemService) Create(ctx context.Context, body GiftCertificateItemPostRequestBody) (GiftCertificateItemPostResponseBody, error) {
+	req := s.client.NewGiftCertificateItemPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s giftCertificateItemService) Update(ctx context.Context, id int, body GiftCertificateItemPatchRequestBody, params *GiftCertificateItemPatchRequestQueryParams) (GiftCertificateItemPatchResponseBody, error) {
+	req := s.client.NewGiftCertificateItemPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// InterCompanyJournalEntryService handles interCompanyJournalEntry records
+type InterCompanyJournalEntryService interface {
+	Get(ctx context.Context, id int, params *InterCompanyJournalEntryGetRequestQueryParams) (InterCompanyJournalEntryGetResponseBody, error)
+	List(ctx context.Context, params *InterCompanyJournalEntriesGetRequestQueryParams) (InterCompanyJournalEntriesGetResponseBody, error)
+	Create(ctx context.Context, body InterCompanyJournalEntryPostRequestBody) (InterCompanyJournalEntryPostResponseBody, error)
+}
+
+func (c *Client) InterCompanyJournalEntries() InterCompanyJournalEntryService {
+	return interCompanyJournalEntryService{client: c}
+}
+
+type interCompanyJournalEntryService struct {
+	client *Client
+}
+
+func (s interCompanyJournalEntryService) Get(ctx context.Context, id int, params *InterCompanyJournalEntryGetRequestQueryParams) (InterCompanyJournalEntryGetResponseBody, error) {
+	req := s.client.NewInterCompanyJournalEntryGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s interCompanyJournalEntryService) List(ctx context.Context, params *InterCompanyJournalEntriesGetRequestQueryParams) (InterCompanyJournalEntriesGetResponseBody, error) {
+	req := s.client.NewInterCompanyJournalEntriesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s interCompanyJournalEntryService) Create(ctx context.Context, body InterCompanyJournalEntryPostRequestBody) (InterCompanyJournalEntryPostResponseBody, error) {
+	req := s.client.NewInterCompanyJournalEntryPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// InventoryAdjustmentService handles inventoryAdjustment records
+type InventoryAdjustmentService interface {
+	Get(ctx context.Context, id int, params *InventoryAdjustmentGetRequestQueryParams) (InventoryAdjustmentGetResponseBody, error)
+	List(ctx context.Context, params *InventoryAdjustmentsGetRequestQueryParams) (InventoryAdjustmentsGetResponseBody, error)
+	Create(ctx context.Context, body InventoryAdjustmentPostRequestBody) (InventoryAdjustmentPostResponseBody, error)
+}
+
+func (c *Client) InventoryAdjustments() InventoryAdjustmentService {
+	return inventoryAdjustmentService{client: c}
+}
+
+type inventoryAdjustmentService struct {
+	client *Client
+}
+
+func (s inventoryAdjustmentService) Get(ctx context.Context, id int, params *InventoryAdjustmentGetRequestQueryParams) (InventoryAdjustmentGetResponseBody, error) {
+	req := s.client.NewInventoryAdjustmentGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s inventoryAdjustmentService) List(ctx context.Context, params *InventoryAdjustmentsGetRequestQueryParams) (InventoryAdjustmentsGetResponseBody, error) {
+	req := s.client.NewInventoryAdjustmentsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s inventoryAdjustmentService) Create(ctx context.Context, body InventoryAdjustmentPostRequestBody) (InventoryAdjustmentPostResponseBody, error) {
+	req := s.client.NewInventoryAdjustmentPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// InvoiceService handles invoice records
+type InvoiceService interface {
+	Get(ctx context.Context, id int, params *InvoiceGetRequestQueryParams) (InvoiceGetResponseBody, error)
+	List(ctx context.Context, params *InvoicesGetRequestQueryParams) (InvoicesGetResponseBody, error)
+	Create(ctx context.Context, body InvoicePostRequestBody) (InvoicePostResponseBody, error)
+}
+
+func (c *Client) Invoices() InvoiceService {
+	return invoiceService{client: c}
+}
+
+type invoiceService struct {
+	client *Client
+}
+
+func (s invoiceService) Get(ctx context.Context, id int, params *InvoiceGetRequestQueryParams) (InvoiceGetResponseBody, error) {
+	req := s.client.NewInvoiceGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s invoiceService) List(ctx context.Context, params *InvoicesGetRequestQueryParams) (InvoicesGetResponseBody, error) {
+	req := s.client.NewInvoicesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s invoiceService) Create(ctx context.Context, body InvoicePostRequestBody) (InvoicePostResponseBody, error) {
+	req := s.client.NewInvoicePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// JobService handles job records
+type JobService interface {
+	Get(ctx context.Context, id int, params *JobGetRequestQueryParams) (JobGetResponseBody, error)
+	List(ctx context.Context, params *JobsGetRequestQueryParams) (JobsGetResponseBody, error)
+	Create(ctx context.Context, body JobPostRequestBody) (JobPostResponseBody, error)
+	Update(ctx context.Context, id int, body JobPatchRequestBody, params *JobPatchRequestQueryParams) (JobPatchResponseBody, error)
+}
+
+func (c *Client) Jobs() JobService {
+	return jobService{client: c}
+}
+
+type jobService struct {
+	client *Client
+}
+
+func (s jobService) Get(ctx context.Context, id int, params *JobGetRequestQueryParams) (JobGetResponseBody, error) {
+	req := s.client.NewJobGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s jobService) List(ctx context.Context, params *JobsGetRequestQueryParams) (JobsGetResponseBody, error) {
+	req := s.client.NewJobsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s jobService) Create(ctx context.Context, body JobPostRequestBody) (JobPostResponseBody, error) {
+	req := s.client.NewJobPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s jobService) Update(ctx context.Context, id int, body JobPatchRequestBody, params *JobPatchRequestQueryParams) (JobPatchResponseBody, error) {
+	req := s.client.NewJobPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// JournalEntryService handles journalEntry records
+type JournalEntryService interface {
+	Get(ctx context.Context, id int, params *JournalEntryGetRequestQueryParams) (JournalEntryGetResponseBody, error)
+	List(ctx context.Context, params *JournalEntriesGetRequestQueryParams) (JournalEntriesGetResponseBody, error)
+	Create(ctx context.Context, body JournalEntryPostRequestBody) (JournalEntryPostResponseBody, error)
+}
+
+func (c *Client) JournalEntries() JournalEntryService {
+	return journalEntryService{client: c}
+}
+
+type journalEntryService struct {
+	client *Client
+}
+
+func (s journalEntryService) Get(ctx context.Context, id int, params *JournalEntryGetRequestQueryParams) (JournalEntryGetResponseBody, error) {
+	req := s.client.NewJournalEntryGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s journalEntryService) List(ctx context.Context, params *JournalEntriesGetRequestQueryParams) (JournalEntriesGetResponseBody, error) {
+	req := s.client.NewJournalEntriesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s journalEntryService) Create(ctx context.Context, body JournalEntryPostRequestBody) (JournalEntryPostResponseBody, error) {
+	req := s.client.NewJournalEntryPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// MessageService handles message records
+type MessageService interface {
+	Get(ctx context.Context, id int, params *MessageGetRequestQueryParams) (MessageGetResponseBody, error)
+	List(ctx context.Context, params *MessagesGetRequestQueryParams) (MessagesGetResponseBody, error)
+	Create(ctx context.Context, body MessagePostRequestBody) (MessagePostResponseBody, error)
+}
+
+func (c *Client) Messages() MessageService {
+	return messageService{client: c}
+}
+
+type messageService struct {
+	client *Client
+}
+
+func (s messageService) Get(ctx context.Context, id int, params *MessageGetRequestQueryParams) (MessageGetResponseBody, error) {
+	req := s.client.NewMessageGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s messageService) List(ctx context.Context, params *MessagesGetRequestQueryParams) (MessagesGetResponseBody, error) {
+	req := s.client.NewMessagesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s messageService) Create(ctx context.Context, body MessagePostRequestBody) (MessagePostResponseBody, error) {
+	req := s.client.NewMessagePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// NoteService handles note records
+type NoteService interface {
+	Get(ctx context.Context, id int, params *NoteGetRequestQueryParams) (NoteGetResponseBody, error)
+	List(ctx context.Context, params *NotesGetRequestQueryParams) (NotesGetResponseBody, error)
+	Create(ctx context.Context, body NotePostRequestBody) (NotePostResponseBody, error)
+	Update(ctx context.Context, id int, body NotePatchRequestBody, params *NotePatchRequestQueryParams) (NotePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (NoteDeleteResponseBody, error)
+}
+
+func (c *Client) Notes() NoteService {
+	return noteService{client: c}
+}
+
+type noteService struct {
+	client *Client
+}
+
+func (s noteService) Get(ctx context.Context, id int, params *NoteGetRequestQueryParams) (NoteGetResponseBody, error) {
+	req := s.client.NewNoteGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s noteService) List(ctx context.Context, params *NotesGetRequestQueryParams) (NotesGetResponseBody, error) {
+	req := s.client.NewNotesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s noteService) Create(ctx context.Context, body NotePostRequestBody) (NotePostResponseBody, error) {
+	req := s.client.NewNotePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s noteService) Update(ctx context.Context, id int, body NotePatchRequestBody, params *NotePatchRequestQueryParams) (NotePatchResponseBody, error) {
+	req := s.client.NewNotePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s noteService) Delete(ctx context.Context, id int) (NoteDeleteResponseBody, error) {
+	req := s.client.NewNoteDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// PartnerService handles partner records
+type PartnerService interface {
+	Get(ctx context.Context, id int, params *PartnerGetRequestQueryParams) (PartnerGetResponseBody, error)
+	List(ctx context.Context, params *PartnersGetRequestQueryParams) (PartnersGetResponseBody, error)
+	Create(ctx context.Context, body PartnerPostRequestBody) (PartnerPostResponseBody, error)
+	Update(ctx context.Context, id int, body PartnerPatchRequestBody, params *PartnerPatchRequestQueryParams) (PartnerPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (PartnerDeleteResponseBody, error)
+}
+
+func (c *Client) Partners() PartnerService {
+	return partnerService{client: c}
+}
+
+type partnerService struct {
+	client *Client
+}
+
+func (s partnerService) Get(ctx context.Context, id int, params *PartnerGetRequestQueryParams) (PartnerGetResponseBody, error) {
+	req := s.client.NewPartnerGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s partnerService) List(ctx context.Context, params *PartnersGetRequestQueryParams) (PartnersGetResponseBody, error) {
+	req := s.client.NewPartnersGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s partnerService) Create(ctx context.Context, body PartnerPostRequestBody) (PartnerPostResponseBody, error) {
+	req := s.client.NewPartnerPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s partnerService) Update(ctx context.Context, id int, body PartnerPatchRequestBody, params *PartnerPatchRequestQueryParams) (PartnerPatchResponseBody, error) {
+	req := s.client.NewPartnerPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s partnerService) Delete(ctx context.Context, id int) (PartnerDeleteResponseBody, error) {
+	req := s.client.NewPartnerDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// PaymentCardService handles paymentCard records
+type PaymentCardService interface {
+	Get(ctx context.Context, id int, params *PaymentCardGetRequestQueryParams) (PaymentCardGetResponseBody, error)
+	List(ctx context.Context, params *PaymentCardsGetRequestQueryParams) (PaymentCardsGetResponseBody, error)
+	Create(ctx context.Context, body PaymentCardPostRequestBody) (PaymentCardPostResponseBody, error)
+	Delete(ctx context.Context, id int) (PaymentCardDeleteResponseBody, error)
+}
+
+func (c *Client) PaymentCards() PaymentCardService {
+	return paymentCardService{client: c}
+}
+
+type paymentCardService struct {
+	client *Client
+}
+
+func (s paymentCardService) Get(ctx context.Context, id int, params *PaymentCardGetRequestQueryParams) (PaymentCardGetResponseBody, error) {
+	req := s.client.NewPaymentCardGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s paymentCardService) List(ctx context.Context, params *PaymentCardsGetRequestQueryParams) (PaymentCardsGetResponseBody, error) {
+	req := s.client.NewPaymentCardsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s paymentCardService) Create(ctx context.Context, body PaymentCardPostRequestBody) (PaymentCardPostResponseBody, error) {
+	req := s.client.NewPaymentCardPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s paymentCardService) Delete(ctx context.Context, id int) (PaymentCardDeleteResponseBody, error) {
+	req := s.client.NewPaymentCardDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// PaymentCardTokenService handles paymentCardToken records
+type PaymentCardTokenService interface {
+	Get(ctx context.Context, id int, params *PaymentCardTokenGetRequestQueryParams) (PaymentCardTokenGetResponseBody, error)
+	List(ctx context.Context, params *PaymentCardTokensGetRequestQueryParams) (PaymentCardTokensGetResponseBody, error)
+	Create(ctx context.Context, body PaymentCardTokenPostRequestBody) (PaymentCardTokenPostResponseBody, error)
+	Delete(ctx context.Context, id int) (PaymentCardTokenDeleteResponseBody, error)
+}
+
+func (c *Client) PaymentCardTokens() PaymentCardTokenService {
+	return paymentCardTokenService{client: c}
+}
+
+type paymentCardTokenService struct {
+	client *Client
+}
+
+func (s paymentCardTokenService) Get(ctx context.Context, id int, params *PaymentCardTokenGetRequestQueryParams) (PaymentCardTokenGetResponseBody, error) {
+	req := s.client.NewPaymentCardTokenGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s paymentCardTokenService) List(ctx context.Context, params *PaymentCardTokensGetRequestQueryParams) (PaymentCardTokensGetResponseBody, error) {
+	req := s.client.NewPaymentCardTokensGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s paymentCardTokenService) Create(ctx context.Context, body PaymentCardTokenPostRequestBody) (PaymentCardTokenPostResponseBody, error) {
+	req := s.client.NewPaymentCardTokenPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s paymentCardTokenService) Delete(ctx context.Context, id int) (PaymentCardTokenDeleteResponseBody, error) {
+	req := s.client.NewPaymentCardTokenDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// PaymentMethodService handles paymentMethod records
+type PaymentMethodService interface {
+	Get(ctx context.Context, id int, params *PaymentMethodGetRequestQueryParams) (PaymentMethodGetResponseBody, error)
+	List(ctx context.Context, params *PaymentMethodsGetRequestQueryParams) (PaymentMethodsGetResponseBody, error)
+}
+
+func (c *Client) PaymentMethods() PaymentMethodService {
+	return paymentMethodService{client: c}
+}
+
+type paymentMethodService struct {
+	client *Client
+}
+
+func (s paymentMethodService) Get(ctx context.Context, id int, params *PaymentMethodGetRequestQueryParams) (PaymentMethodGetResponseBody, error) {
+	req := s.client.NewPaymentMethodGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s paymentMethodService) List(ctx context.Context, params *PaymentMethodsGetRequestQueryParams) (PaymentMethodsGetResponseBody, error) {
+	req := s.client.NewPaymentMethodsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// PhoneCallService handles phoneCall records
+type PhoneCallService interface {
+	Get(ctx context.Context, id int, params *PhoneCallGetRequestQueryParams) (PhoneCallGetResponseBody, error)
+	List(ctx context.Context, params *PhoneCallsGetRequestQueryParams) (PhoneCallsGetResponseBody, error)
+	Create(ctx context.Context, body PhoneCallPostRequestBody) (PhoneCallPostResponseBody, error)
+	Update(ctx context.Context, id int, body PhoneCallPatchRequestBody, params *PhoneCallPatchRequestQueryParams) (PhoneCallPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (PhoneCallDeleteResponseBody, error)
+}
+
+func (c *Client) PhoneCalls() PhoneCallService {
+	return phoneCallService{client: c}
+}
+
+type phoneCallService struct {
+	client *Client
+}
+
+func (s phoneCallService) Get(ctx context.Context, id int, params *PhoneCallGetRequestQueryParams) (PhoneCallGetResponseBody, error) {
+	req := s.client.NewPhoneCallGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s phoneCallService) List(ctx context.Context, params *PhoneCallsGetRequestQueryParams) (PhoneCallsGetResponseBody, error) {
+	req := s.client.NewPhoneCallsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s phoneCallService) Create(ctx context.Context, body PhoneCallPostRequestBody) (PhoneCallPostResponseBody, error) {
+	req := s.client.NewPhoneCallPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s phoneCallService) Update(ctx context.Context, id int, body PhoneCallPatchRequestBody, params *PhoneCallPatchRequestQueryParams) (PhoneCallPatchResponseBody, error) {
+	req := s.client.NewPhoneCallPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s phoneCallService) Delete(ctx context.Context, id int) (PhoneCallDeleteResponseBody, error) {
+	req := s.client.NewPhoneCallDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// PriceLevelService handles priceLevel records
+type PriceLevelService interface {
+	Get(ctx context.Context, id int, params *PriceLevelGetRequestQueryParams) (PriceLevelGetResponseBody, error)
+	List(ctx context.Context, params *PriceLevelsGetRequestQueryParams) (PriceLevelsGetResponseBody, error)
+}
+
+func (c *Client) PriceLevels() PriceLevelService {
+	return priceLevelService{client: c}
+}
+
+type priceLevelService struct {
+	client *Client
+}
+
+func (s priceLevelService) Get(ctx context.Context, id int, params *PriceLevelGetRequestQueryParams) (PriceLevelGetResponseBody, error) {
+	req := s.client.NewPriceLevelGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s priceLevelService) List(ctx context.Context, params *PriceLevelsGetRequestQueryParams) (PriceLevelsGetResponseBody, error) {
+	req := s.client.NewPriceLevelsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// ProjectTaskService handles projectTask records
+type ProjectTaskService interface {
+	Get(ctx context.Context, id int, params *ProjectTaskGetRequestQueryParams) (ProjectTaskGetResponseBody, error)
+	List(ctx context.Context, params *ProjectTasksGetRequestQueryParams) (ProjectTasksGetResponseBody, error)
+	Create(ctx context.Context, body ProjectTaskPostRequestBody) (ProjectTaskPostResponseBody, error)
+}
+
+func (c *Client) ProjectTasks() ProjectTaskService {
+	return projectTaskService{client: c}
+}
+
+type projectTaskService struct {
+	client *Client
+}
+
+func (s projectTaskService) Get(ctx context.Context, id int, params *ProjectTaskGetRequestQueryParams) (ProjectTaskGetResponseBody, error) {
+	req := s.client.NewProjectTaskGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s projectTaskService) List(ctx context.Context, params *ProjectTasksGetRequestQueryParams) (ProjectTasksGetResponseBody, error) {
+	req := s.client.NewProjectTasksGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s projectTaskService) Create(ctx context.Context, body ProjectTaskPostRequestBody) (ProjectTaskPostResponseBody, error) {
+	req := s.client.NewProjectTaskPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// PromotionCodeService handles promotionCode records
+type PromotionCodeService interface {
+	Get(ctx context.Context, id int, params *PromotionCodeGetRequestQueryParams) (PromotionCodeGetResponseBody, error)
+	List(ctx context.Context, params *PromotionCodesGetRequestQueryParams) (PromotionCodesGetResponseBody, error)
+	Create(ctx context.Context, body PromotionCodePostRequestBody) (PromotionCodePostResponseBody, error)
+	Update(ctx context.Context, id int, body PromotionCodePatchRequestBody, params *PromotionCodePatchRequestQueryParams) (PromotionCodePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (PromotionCodeDeleteResponseBody, error)
+}
+
+func (c *Client) PromotionCodes() PromotionCodeService {
+	return promotionCodeService{client: c}
+}
+
+type promotionCodeService struct {
+	client *Client
+}
+
+func (s promotionCodeService) Get(ctx context.Context, id int, params *PromotionCodeGetRequestQueryParams) (PromotionCodeGetResponseBody, error) {
+	req := s.client.NewPromotionCodeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s promotionCodeService) List(ctx context.Context, params *PromotionCodesGetRequestQueryParams) (PromotionCodesGetResponseBody, error) {
+	req := s.client.NewPromotionCodesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s promotionCodeService) Create(ctx context.Context, body PromotionCodePostRequestBody) (PromotionCodePostResponseBody, error) {
+	req := s.client.NewPromotionCodePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s promotionCodeService) Update(ctx context.Context, id int, body PromotionCodePatchRequestBody, params *PromotionCodePatchRequestQueryParams) (PromotionCodePatchResponseBody, error) {
+	req := s.client.NewPromotionCodePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s promotionCodeService) Delete(ctx context.Context, id int) (PromotionCodeDeleteResponseBody, error) {
+	req := s.client.NewPromotionCodeDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// ReturnAuthorizationService handles returnAuthorization records
+type ReturnAuthorizationService interface {
+	Get(ctx context.Context, id int, params *ReturnAuthorizationGetRequestQueryParams) (ReturnAuthorizationGetResponseBody, error)
+	List(ctx context.Context, params *ReturnAuthorizationsGetRequestQueryParams) (ReturnAuthorizationsGetResponseBody, error)
+	Create(ctx context.Context, body ReturnAuthorizationPostRequestBody) (ReturnAuthorizationPostResponseBody, error)
+	Update(ctx context.Context, id int, body ReturnAuthorizationPatchRequestBody, params *ReturnAuthorizationPatchRequestQueryParams) (ReturnAuthorizationPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (ReturnAuthorizationDeleteResponseBody, error)
+}
+
+func (c *Client) ReturnAuthorizations() ReturnAuthorizationService {
+	return returnAuthorizationService{client: c}
+}
+
+type returnAuthorizationService struct {
+	client *Client
+}
+
+func (s returnAuthorizationService) Get(ctx context.Context, id int, params *ReturnAuthorizationGetRequestQueryParams) (ReturnAuthorizationGetResponseBody, error) {
+	req := s.client.NewReturnAuthorizationGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s returnAuthorizationService) List(ctx context.Context, params *ReturnAuthorizationsGetRequestQueryParams) (ReturnAuthorizationsGetResponseBody, error) {
+	req := s.client.NewReturnAuthorizationsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s returnAuthorizationService) Create(ctx context.Context, body ReturnAuthorizationPostRequestBody) (ReturnAuthorizationPostResponseBody, error) {
+	req := s.client.NewReturnAuthorizationPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s returnAuthorizationService) Update(ctx context.Context, id int, body ReturnAuthorizationPatchRequestBody, params *ReturnAuthorizationPatchRequestQueryParams) (ReturnAuthorizationPatchResponseBody, error) {
+	req := s.client.NewReturnAuthorizationPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s returnAuthorizationService) Delete(ctx context.Context, id int) (ReturnAuthorizationDeleteResponseBody, error) {
+	req := s.client.NewReturnAuthorizationDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// RevenueArrangementService handles revenueArrangement records
+type RevenueArrangementService interface {
+	Get(ctx context.Context, id int, params *RevenueArrangementGetRequestQueryParams) (RevenueArrangementGetResponseBody, error)
+	List(ctx context.Context, params *RevenueArrangementsGetRequestQueryParams) (RevenueArrangementsGetResponseBody, error)
+	Update(ctx context.Context, id int, body RevenueArrangementPatchRequestBody, params *RevenueArrangementPatchRequestQueryParams) (RevenueArrangementPatchResponseBody, error)
+}
+
+func (c *Client) RevenueArrangements() RevenueArrangementService {
+	return revenueArrangementService{client: c}
+}
+
+type revenueArrangementService struct {
+	client *Client
+}
+
+func (s revenueArrangementService) Get(ctx context.Context, id int, params *RevenueArrangementGetRequestQueryParams) (RevenueArrangementGetResponseBody, error) {
+	req := s.client.NewRevenueArrangementGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenueArrangementService) List(ctx context.Context, params *RevenueArrangementsGetRequestQueryParams) (RevenueArrangementsGetResponseBody, error) {
+	req := s.client.NewRevenueArrangementsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenueArrangementService) Update(ctx context.Context, id int, body RevenueArrangementPatchRequestBody, params *RevenueArrangementPatchRequestQueryParams) (RevenueArrangementPatchResponseBody, error) {
+	req := s.client.NewRevenueArrangementPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// RevenueElementService handles revenueElement records
+type RevenueElementService interface {
+	Get(ctx context.Context, id int, params *RevenueElementGetRequestQueryParams) (RevenueElementGetResponseBody, error)
+	List(ctx context.Context, params *RevenueElementsGetRequestQueryParams) (RevenueElementsGetResponseBody, error)
+	Update(ctx context.Context, id int, body RevenueElementPatchRequestBody, params *RevenueElementPatchRequestQueryParams) (RevenueElementPatchResponseBody, error)
+}
+
+func (c *Client) RevenueElements() RevenueElementService {
+	return revenueElementService{client: c}
+}
+
+type revenueElementService struct {
+	client *Client
+}
+
+func (s revenueElementService) Get(ctx context.Context, id int, params *RevenueElementGetRequestQueryParams) (RevenueElementGetResponseBody, error) {
+	req := s.client.NewRevenueElementGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenueElementService) List(ctx context.Context, params *RevenueElementsGetRequestQueryParams) (RevenueElementsGetResponseBody, error) {
+	req := s.client.NewRevenueElementsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenueElementService) Update(ctx context.Context, id int, body RevenueElementPatchRequestBody, params *RevenueElementPatchRequestQueryParams) (RevenueElementPatchResponseBody, error) {
+	req := s.client.NewRevenueElementPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// RevenuePlanService handles revenuePlan records
+type RevenuePlanService interface {
+	Get(ctx context.Context, id int, params *RevenuePlanGetRequestQueryParams) (RevenuePlanGetResponseBody, error)
+	List(ctx context.Context, params *RevenuePlansGetRequestQueryParams) (RevenuePlansGetResponseBody, error)
+	Update(ctx context.Context, id int, body RevenuePlanPatchRequestBody, params *RevenuePlanPatchRequestQueryParams) (RevenuePlanPatchResponseBody, error)
+}
+
+func (c *Client) RevenuePlans() RevenuePlanService {
+	return revenuePlanService{client: c}
+}
+
+type revenuePlanService struct {
+	client *Client
+}
+
+func (s revenuePlanService) Get(ctx context.Context, id int, params *RevenuePlanGetRequestQueryParams) (RevenuePlanGetResponseBody, error) {
+	req := s.client.NewRevenuePlanGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenuePlanService) List(ctx context.Context, params *RevenuePlansGetRequestQueryParams) (RevenuePlansGetResponseBody, error) {
+	req := s.client.NewRevenuePlansGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s revenuePlanService) Update(ctx context.Context, id int, body RevenuePlanPatchRequestBody, params *RevenuePlanPatchRequestQueryParams) (RevenuePlanPatchResponseBody, error) {
+	req := s.client.NewRevenuePlanPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// ShipItemService handles shipItem records
+type ShipItemService interface {
+	Get(ctx context.Context, id int, params *ShipItemGetRequestQueryParams) (ShipItemGetResponseBody, error)
+	List(ctx context.Context, params *ShipItemsGetRequestQueryParams) (ShipItemsGetResponseBody, error)
+}
+
+func (c *Client) ShipItems() ShipItemService {
+	return shipItemService{client: c}
+}
+
+type shipItemService struct {
+	client *Client
+}
+
+func (s shipItemService) Get(ctx context.Context, id int, params *ShipItemGetRequestQueryParams) (ShipItemGetResponseBody, error) {
+	req := s.client.NewShipItemGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s shipItemService) List(ctx context.Context, params *ShipItemsGetRequestQueryParams) (ShipItemsGetResponseBody, error) {
+	req := s.client.NewShipItemsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// SubscriptionService handles subscription records
+type SubscriptionService interface {
+	Get(ctx context.Context, id int, params *SubscriptionGetRequestQueryParams) (SubscriptionGetResponseBody, error)
+	List(ctx context.Context, params *SubscriptionsGetRequestQueryParams) (SubscriptionsGetResponseBody, error)
+	Create(ctx context.Context, body SubscriptionPostRequestBody) (SubscriptionPostResponseBody, error)
+	Update(ctx context.Context, id int, body SubscriptionPatchRequestBody, params *SubscriptionPatchRequestQueryParams) (SubscriptionPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (SubscriptionDeleteResponseBody, error)
+}
+
+func (c *Client) Subscriptions() SubscriptionService {
+	return subscriptionService{client: c}
+}
+
+type subscriptionService struct {
+	client *Client
+}
+
+func (s subscriptionService) Get(ctx context.Context, id int, params *SubscriptionGetRequestQueryParams) (SubscriptionGetResponseBody, error) {
+	req := s.client.NewSubscriptionGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s subscriptionService) List(ctx context.Context, params *SubscriptionsGetRequestQueryParams) (SubscriptionsGetResponseBody, error) {
+	req := s.client.NewSubscriptionsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s subscriptionService) Create(ctx context.Context, body SubscriptionPostRequestBody) (SubscriptionPostResponseBody, error) {
+	req := s.client.NewSubscriptionPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s subscriptionService) Update(ctx context.Context, id int, body SubscriptionPatchRequestBody, params *SubscriptionPatchRequestQueryParams) (SubscriptionPatchResponseBody, error) {
+	req := s.client.NewSubscriptionPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s subscriptionService) Delete(ctx context.Context, id int) (SubscriptionDeleteResponseBody, error) {
+	req := s.client.NewSubscriptionDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// SubscriptionLineService handles subscriptionLine records
+type SubscriptionLineService interface {
+	Get(ctx context.Context, id int, params *SubscriptionLineGetRequestQueryParams) (SubscriptionLineGetResponseBody, error)
+	List(ctx context.Context, params *SubscriptionLinesGetRequestQueryParams) (SubscriptionLinesGetResponseBody, error)
+	Update(ctx context.Context, id int, body SubscriptionLinePatchRequestBody, params *SubscriptionLinePatchRequestQueryParams) (SubscriptionLinePatchResponseBody, error)
+}
+
+func (c *Client) SubscriptionLines() SubscriptionLineService {
+	return subscriptionLineService{client: c}
+}
+
+type subscriptionLineService struct {
+	client *Client
+}
+
+func (s subscriptionLineService) Get(ctx context.Context, id int, params *SubscriptionLineGetRequestQueryParams) (SubscriptionLineGetResponseBody, error) {
+	req := s.client.NewSubscriptionLineGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s subscriptionLineService) List(ctx context.Context, params *SubscriptionLinesGetRequestQueryParams) (SubscriptionLinesGetResponseBody, error) {
+	req := s.client.NewSubscriptionLinesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s subscriptionLineService) Update(ctx context.Context, id int, body SubscriptionLinePatchRequestBody, params *SubscriptionLinePatchRequestQueryParams) (SubscriptionLinePatchResponseBody, error) {
+	req := s.client.NewSubscriptionLinePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// SubsidiaryService handles subsidiary records
+type SubsidiaryService interface {
+	List(ctx context.Context, params *SubsidiaryGetRequestQueryParams) (SubsidiaryGetResponseBody, error)
+}
+
+func (c *Client) Subsidiaries() SubsidiaryService {
+	return subsidiaryService{client: c}
+}
+
+type subsidiaryService struct {
+	client *Client
+}
+
+func (s subsidiaryService) List(ctx context.Context, params *SubsidiaryGetRequestQueryParams) (SubsidiaryGetResponseBody, error) {
+	req := s.client.NewSubsidiaryGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// SupportCaseService handles supportCase records
+type SupportCaseService interface {
+	Get(ctx context.Context, id int, params *SupportCaseGetRequestQueryParams) (SupportCaseGetResponseBody, error)
+	List(ctx context.Context, params *SupportCasesGetRequestQueryParams) (SupportCasesGetResponseBody, error)
+	Create(ctx context.Context, body SupportCasePostRequestBody) (SupportCasePostResponseBody, error)
+	Update(ctx context.Context, id int, body SupportCasePatchRequestBody, params *SupportCasePatchRequestQueryParams) (SupportCasePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (SupportCaseDeleteResponseBody, error)
+}
+
+func (c *Client) SupportCases() SupportCaseService {
+	return supportCaseService{client: c}
+}
+
+type supportCaseService struct {
+	client *Client
+}
+
+func (s supportCaseService) Get(ctx context.Context, id int, params *SupportCaseGetRequestQueryParams) (SupportCaseGetResponseBody, error) {
+	req := s.client.NewSupportCaseGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s supportCaseService) List(ctx context.Context, params *SupportCasesGetRequestQueryParams) (SupportCasesGetResponseBody, error) {
+	req := s.client.NewSupportCasesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s supportCaseService) Create(ctx context.Context, body SupportCasePostRequestBody) (SupportCasePostResponseBody, error) {
+	req := s.client.NewSupportCasePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s supportCaseService) Update(ctx context.Context, id int, body SupportCasePatchRequestBody, params *SupportCasePatchRequestQueryParams) (SupportCasePatchResponseBody, error) {
+	req := s.client.NewSupportCasePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s supportCaseService) Delete(ctx context.Context, id int) (SupportCaseDeleteResponseBody, error) {
+	req := s.client.NewSupportCaseDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// TaskService handles task records
+type TaskService interface {
+	Get(ctx context.Context, id int, params *TaskGetRequestQueryParams) (TaskGetResponseBody, error)
+	List(ctx context.Context, params *TasksGetRequestQueryParams) (TasksGetResponseBody, error)
+	Create(ctx context.Context, body TaskPostRequestBody) (TaskPostResponseBody, error)
+	Update(ctx context.Context, id int, body TaskPatchRequestBody, params *TaskPatchRequestQueryParams) (TaskPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (TaskDeleteResponseBody, error)
+}
+
+func (c *Client) Tasks() TaskService {
+	return taskService{client: c}
+}
+
+type taskService struct {
+	client *Client
+}
+
+func (s taskService) Get(ctx context.Context, id int, params *TaskGetRequestQueryParams) (TaskGetResponseBody, error) {
+	req := s.client.NewTaskGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taskService) List(ctx context.Context, params *TasksGetRequestQueryParams) (TasksGetResponseBody, error) {
+	req := s.client.NewTasksGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taskService) Create(ctx context.Context, body TaskPostRequestBody) (TaskPostResponseBody, error) {
+	req := s.client.NewTaskPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s taskService) Update(ctx context.Context, id int, body TaskPatchRequestBody, params *TaskPatchRequestQueryParams) (TaskPatchResponseBody, error) {
+	req := s.client.NewTaskPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s taskService) Delete(ctx context.Context, id int) (TaskDeleteResponseBody, error) {
+	req := s.client.NewTaskDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// TaxCodeService handles salesTaxItem records
+type TaxCodeService interface {
+	Get(ctx context.Context, id int, params *TaxCodeGetRequestQueryParams) (TaxCodeGetResponseBody, error)
+	List(ctx context.Context, params *TaxCodesGetRequestQueryParams) (TaxCodesGetResponseBody, error)
+}
+
+func (c *Client) TaxCodes() TaxCodeService {
+	return taxCodeService{client: c}
+}
+
+type taxCodeService struct {
+	client *Client
+}
+
+func (s taxCodeService) Get(ctx context.Context, id int, params *TaxCodeGetRequestQueryParams) (TaxCodeGetResponseBody, error) {
+	req := s.client.NewTaxCodeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taxCodeService) List(ctx context.Context, params *TaxCodesGetRequestQueryParams) (TaxCodesGetResponseBody, error) {
+	req := s.client.NewTaxCodesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// TaxGroupService handles taxGroup records
+type TaxGroupService interface {
+	Get(ctx context.Context, id int, params *TaxGroupGetRequestQueryParams) (TaxGroupGetResponseBody, error)
+	List(ctx context.Context, params *TaxGroupsGetRequestQueryParams) (TaxGroupsGetResponseBody, error)
+}
+
+func (c *Client) TaxGroups() TaxGroupService {
+	return taxGroupService{client: c}
+}
+
+type taxGroupService struct {
+	client *Client
+}
+
+func (s taxGroupService) Get(ctx context.Context, id int, params *TaxGroupGetRequestQueryParams) (TaxGroupGetResponseBody, error) {
+	req := s.client.NewTaxGroupGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taxGroupService) List(ctx context.Context, params *TaxGroupsGetRequestQueryParams) (TaxGroupsGetResponseBody, error) {
+	req := s.client.NewTaxGroupsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// TaxPeriodService handles taxPeriod records
+type TaxPeriodService interface {
+	Get(ctx context.Context, id int, params *TaxPeriodGetRequestQueryParams) (TaxPeriodGetResponseBody, error)
+	List(ctx context.Context, params *TaxPeriodsGetRequestQueryParams) (TaxPeriodsGetResponseBody, error)
+}
+
+func (c *Client) TaxPeriods() TaxPeriodService {
+	return taxPeriodService{client: c}
+}
+
+type taxPeriodService struct {
+	client *Client
+}
+
+func (s taxPeriodService) Get(ctx context.Context, id int, params *TaxPeriodGetRequestQueryParams) (TaxPeriodGetResponseBody, error) {
+	req := s.client.NewTaxPeriodGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taxPeriodService) List(ctx context.Context, params *TaxPeriodsGetRequestQueryParams) (TaxPeriodsGetResponseBody, error) {
+	req := s.client.NewTaxPeriodsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// TaxTypeService handles taxType records
+type TaxTypeService interface {
+	Get(ctx context.Context, id int, params *TaxTypeGetRequestQueryParams) (TaxTypeGetResponseBody, error)
+	List(ctx context.Context, params *TaxTypesGetRequestQueryParams) (TaxTypesGetResponseBody, error)
+}
+
+func (c *Client) TaxTypes() TaxTypeService {
+	return taxTypeService{client: c}
+}
+
+type taxTypeService struct {
+	client *Client
+}
+
+func (s taxTypeService) Get(ctx context.Context, id int, params *TaxTypeGetRequestQueryParams) (TaxTypeGetResponseBody, error) {
+	req := s.client.NewTaxTypeGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s taxTypeService) List(ctx context.Context, params *TaxTypesGetRequestQueryParams) (TaxTypesGetResponseBody, error) {
+	req := s.client.NewTaxTypesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// TermService handles term records
+type TermService interface {
+	Get(ctx context.Context, id int, params *TermGetRequestQueryParams) (TermGetResponseBody, error)
+	List(ctx context.Context, params *TermsGetRequestQueryParams) (TermsGetResponseBody, error)
+	Create(ctx context.Context, body TermPostRequestBody) (TermPostResponseBody, error)
+}
+
+func (c *Client) Terms() TermService {
+	return termService{client: c}
+}
+
+type termService struct {
+	client *Client
+}
+
+func (s termService) Get(ctx context.Context, id int, params *TermGetRequestQueryParams) (TermGetResponseBody, error) {
+	req := s.client.NewTermGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s termService) List(ctx context.Context, params *TermsGetRequestQueryParams) (TermsGetResponseBody, error) {
+	req := s.client.NewTermsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s termService) Create(ctx context.Context, body TermPostRequestBody) (TermPostResponseBody, error) {
+	req := s.client.NewTermPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// TimeBillService handles timeBill records
+type TimeBillService interface {
+	Get(ctx context.Context, id int, params *TimeBillGetRequestQueryParams) (TimeBillGetResponseBody, error)
+	List(ctx context.Context, params *TimeBillsGetRequestQueryParams) (TimeBillsGetResponseBody, error)
+	Create(ctx context.Context, body TimeBillPostRequestBody) (TimeBillPostResponseBody, error)
+}
+
+func (c *Client) TimeBills() TimeBillService {
+	return timeBillService{client: c}
+}
+
+type timeBillService struct {
+	client *Client
+}
+
+func (s timeBillService) Get(ctx context.Context, id int, params *TimeBillGetRequestQueryParams) (TimeBillGetResponseBody, error) {
+	req := s.client.NewTimeBillGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s timeBillService) List(ctx context.Context, params *TimeBillsGetRequestQueryParams) (TimeBillsGetResponseBody, error) {
+	req := s.client.NewTimeBillsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s timeBillService) Create(ctx context.Context, body TimeBillPostRequestBody) (TimeBillPostResponseBody, error) {
+	req := s.client.NewTimeBillPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// TransferOrderService handles transferOrder records
+type TransferOrderService interface {
+	Get(ctx context.Context, id int, params *TransferOrderGetRequestQueryParams) (TransferOrderGetResponseBody, error)
+	List(ctx context.Context, params *TransferOrdersGetRequestQueryParams) (TransferOrdersGetResponseBody, error)
+	Create(ctx context.Context, body TransferOrderPostRequestBody) (TransferOrderPostResponseBody, error)
+	Update(ctx context.Context, id int, body TransferOrderPatchRequestBody, params *TransferOrderPatchRequestQueryParams) (TransferOrderPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (TransferOrderDeleteResponseBody, error)
+}
+
+func (c *Client) TransferOrders() TransferOrderService {
+	return transferOrderService{client: c}
+}
+
+type transferOrderService struct {
+	client *Client
+}
+
+func (s transferOrderService) Get(ctx context.Context, id int, params *TransferOrderGetRequestQueryParams) (TransferOrderGetResponseBody, error) {
+	req := s.client.NewTransferOrderGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s transferOrderService) List(ctx context.Context, params *TransferOrdersGetRequestQueryParams) (TransferOrdersGetResponseBody, error) {
+	req := s.client.NewTransferOrdersGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s transferOrderService) Create(ctx context.Context, body TransferOrderPostRequestBody) (TransferOrderPostResponseBody, error) {
+	req := s.client.NewTransferOrderPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s transferOrderService) Update(ctx context.Context, id int, body TransferOrderPatchRequestBody, params *TransferOrderPatchRequestQueryParams) (TransferOrderPatchResponseBody, error) {
+	req := s.client.NewTransferOrderPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s transferOrderService) Delete(ctx context.Context, id int) (TransferOrderDeleteResponseBody, error) {
+	req := s.client.NewTransferOrderDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// UnitsTypeService handles unitsType records
+type UnitsTypeService interface {
+	List(ctx context.Context, params *UnitsTypeGetRequestQueryParams) (UnitsTypeGetResponseBody, error)
+}
+
+func (c *Client) UnitsTypes() UnitsTypeService {
+	return unitsTypeService{client: c}
+}
+
+type unitsTypeService struct {
+	client *Client
+}
+
+func (s unitsTypeService) List(ctx context.Context, params *UnitsTypeGetRequestQueryParams) (UnitsTypeGetResponseBody, error) {
+	req := s.client.NewUnitsTypeGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+// UsageService handles usage records
+type UsageService interface {
+	Get(ctx context.Context, id int, params *UsageGetRequestQueryParams) (UsageGetResponseBody, error)
+	List(ctx context.Context, params *UsagesGetRequestQueryParams) (UsagesGetResponseBody, error)
+	Create(ctx context.Context, body UsagePostRequestBody) (UsagePostResponseBody, error)
+	Update(ctx context.Context, id int, body UsagePatchRequestBody, params *UsagePatchRequestQueryParams) (UsagePatchResponseBody, error)
+	Delete(ctx context.Context, id int) (UsageDeleteResponseBody, error)
+}
+
+func (c *Client) Usages() UsageService {
+	return usageService{client: c}
+}
+
+type usageService struct {
+	client *Client
+}
+
+func (s usageService) Get(ctx context.Context, id int, params *UsageGetRequestQueryParams) (UsageGetResponseBody, error) {
+	req := s.client.NewUsageGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s usageService) List(ctx context.Context, params *UsagesGetRequestQueryParams) (UsagesGetResponseBody, error) {
+	req := s.client.NewUsagesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s usageService) Create(ctx context.Context, body UsagePostRequestBody) (UsagePostResponseBody, error) {
+	req := s.client.NewUsagePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s usageService) Update(ctx context.Context, id int, body UsagePatchRequestBody, params *UsagePatchRequestQueryParams) (UsagePatchResponseBody, error) {
+	req := s.client.NewUsagePatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s usageService) Delete(ctx context.Context, id int) (UsageDeleteResponseBody, error) {
+	req := s.client.NewUsageDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// VendorReturnAuthorizationService handles vendorReturnAuthorization records
+type VendorReturnAuthorizationService interface {
+	Get(ctx context.Context, id int, params *VendorReturnAuthorizationGetRequestQueryParams) (VendorReturnAuthorizationGetResponseBody, error)
+	List(ctx context.Context, params *VendorReturnAuthorizationsGetRequestQueryParams) (VendorReturnAuthorizationsGetResponseBody, error)
+	Create(ctx context.Context, body VendorReturnAuthorizationPostRequestBody) (VendorReturnAuthorizationPostResponseBody, error)
+	Update(ctx context.Context, id int, body VendorReturnAuthorizationPatchRequestBody, params *VendorReturnAuthorizationPatchRequestQueryParams) (VendorReturnAuthorizationPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (VendorReturnAuthorizationDeleteResponseBody, error)
+}
+
+func (c *Client) VendorReturnAuthorizations() VendorReturnAuthorizationService {
+	return vendorReturnAuthorizationService{client: c}
+}
+
+type vendorReturnAuthorizationService struct {
+	client *Client
+}
+
+func (s vendorReturnAuthorizationService) Get(ctx context.Context, id int, params *VendorReturnAuthorizationGetRequestQueryParams) (VendorReturnAuthorizationGetResponseBody, error) {
+	req := s.client.NewVendorReturnAuthorizationGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s vendorReturnAuthorizationService) List(ctx context.Context, params *VendorReturnAuthorizationsGetRequestQueryParams) (VendorReturnAuthorizationsGetResponseBody, error) {
+	req := s.client.NewVendorReturnAuthorizationsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s vendorReturnAuthorizationService) Create(ctx context.Context, body VendorReturnAuthorizationPostRequestBody) (VendorReturnAuthorizationPostResponseBody, error) {
+	req := s.client.NewVendorReturnAuthorizationPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s vendorReturnAuthorizationService) Update(ctx context.Context, id int, body VendorReturnAuthorizationPatchRequestBody, params *VendorReturnAuthorizationPatchRequestQueryParams) (VendorReturnAuthorizationPatchResponseBody, error) {
+	req := s.client.NewVendorReturnAuthorizationPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s vendorReturnAuthorizationService) Delete(ctx context.Context, id int) (VendorReturnAuthorizationDeleteResponseBody, error) {
+	req := s.client.NewVendorReturnAuthorizationDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// WorkOrderCloseService handles workOrderClose records
+type WorkOrderCloseService interface {
+	Get(ctx context.Context, id int, params *WorkOrderCloseGetRequestQueryParams) (WorkOrderCloseGetResponseBody, error)
+	List(ctx context.Context, params *WorkOrderClosesGetRequestQueryParams) (WorkOrderClosesGetResponseBody, error)
+	Create(ctx context.Context, body WorkOrderClosePostRequestBody) (WorkOrderClosePostResponseBody, error)
+}
+
+func (c *Client) WorkOrderCloses() WorkOrderCloseService {
+	return workOrderCloseService{client: c}
+}
+
+type workOrderCloseService struct {
+	client *Client
+}
+
+func (s workOrderCloseService) Get(ctx context.Context, id int, params *WorkOrderCloseGetRequestQueryParams) (WorkOrderCloseGetResponseBody, error) {
+	req := s.client.NewWorkOrderCloseGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderCloseService) List(ctx context.Context, params *WorkOrderClosesGetRequestQueryParams) (WorkOrderClosesGetResponseBody, error) {
+	req := s.client.NewWorkOrderClosesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderCloseService) Create(ctx context.Context, body WorkOrderClosePostRequestBody) (WorkOrderClosePostResponseBody, error) {
+	req := s.client.NewWorkOrderClosePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// WorkOrderCompletionService handles workOrderCompletion records
+type WorkOrderCompletionService interface {
+	Get(ctx context.Context, id int, params *WorkOrderCompletionGetRequestQueryParams) (WorkOrderCompletionGetResponseBody, error)
+	List(ctx context.Context, params *WorkOrderCompletionsGetRequestQueryParams) (WorkOrderCompletionsGetResponseBody, error)
+	Create(ctx context.Context, body WorkOrderCompletionPostRequestBody) (WorkOrderCompletionPostResponseBody, error)
+}
+
+func (c *Client) WorkOrderCompletions() WorkOrderCompletionService {
+	return workOrderCompletionService{client: c}
+}
+
+type workOrderCompletionService struct {
+	client *Client
+}
+
+func (s workOrderCompletionService) Get(ctx context.Context, id int, params *WorkOrderCompletionGetRequestQueryParams) (WorkOrderCompletionGetResponseBody, error) {
+	req := s.client.NewWorkOrderCompletionGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderCompletionService) List(ctx context.Context, params *WorkOrderCompletionsGetRequestQueryParams) (WorkOrderCompletionsGetResponseBody, error) {
+	req := s.client.NewWorkOrderCompletionsGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderCompletionService) Create(ctx context.Context, body WorkOrderCompletionPostRequestBody) (WorkOrderCompletionPostResponseBody, error) {
+	req := s.client.NewWorkOrderCompletionPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// WorkOrderService handles workOrder records
+type WorkOrderService interface {
+	Get(ctx context.Context, id int, params *WorkOrderGetRequestQueryParams) (WorkOrderGetResponseBody, error)
+	List(ctx context.Context, params *WorkOrdersGetRequestQueryParams) (WorkOrdersGetResponseBody, error)
+	Create(ctx context.Context, body WorkOrderPostRequestBody) (WorkOrderPostResponseBody, error)
+	Update(ctx context.Context, id int, body WorkOrderPatchRequestBody, params *WorkOrderPatchRequestQueryParams) (WorkOrderPatchResponseBody, error)
+	Delete(ctx context.Context, id int) (WorkOrderDeleteResponseBody, error)
+}
+
+func (c *Client) WorkOrders() WorkOrderService {
+	return workOrderService{client: c}
+}
+
+type workOrderService struct {
+	client *Client
+}
+
+func (s workOrderService) Get(ctx context.Context, id int, params *WorkOrderGetRequestQueryParams) (WorkOrderGetResponseBody, error) {
+	req := s.client.NewWorkOrderGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderService) List(ctx context.Context, params *WorkOrdersGetRequestQueryParams) (WorkOrdersGetResponseBody, error) {
+	req := s.client.NewWorkOrdersGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderService) Create(ctx context.Context, body WorkOrderPostRequestBody) (WorkOrderPostResponseBody, error) {
+	req := s.client.NewWorkOrderPostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s workOrderService) Update(ctx context.Context, id int, body WorkOrderPatchRequestBody, params *WorkOrderPatchRequestQueryParams) (WorkOrderPatchResponseBody, error) {
+	req := s.client.NewWorkOrderPatchRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+func (s workOrderService) Delete(ctx context.Context, id int) (WorkOrderDeleteResponseBody, error) {
+	req := s.client.NewWorkOrderDeleteRequest()
+	req.PathParams().ID = id
+	return req.Do(ctx)
+}
+
+// WorkOrderIssueService handles workOrderIssue records
+type WorkOrderIssueService interface {
+	Get(ctx context.Context, id int, params *WorkOrderIssueGetRequestQueryParams) (WorkOrderIssueGetResponseBody, error)
+	List(ctx context.Context, params *WorkOrderIssuesGetRequestQueryParams) (WorkOrderIssuesGetResponseBody, error)
+	Create(ctx context.Context, body WorkOrderIssuePostRequestBody) (WorkOrderIssuePostResponseBody, error)
+}
+
+func (c *Client) WorkOrderIssues() WorkOrderIssueService {
+	return workOrderIssueService{client: c}
+}
+
+type workOrderIssueService struct {
+	client *Client
+}
+
+func (s workOrderIssueService) Get(ctx context.Context, id int, params *WorkOrderIssueGetRequestQueryParams) (WorkOrderIssueGetResponseBody, error) {
+	req := s.client.NewWorkOrderIssueGetRequest()
+	req.PathParams().ID = id
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderIssueService) List(ctx context.Context, params *WorkOrderIssuesGetRequestQueryParams) (WorkOrderIssuesGetResponseBody, error) {
+	req := s.client.NewWorkOrderIssuesGetRequest()
+	if params != nil {
+		*req.QueryParams() = *params
+	}
+	return req.Do(ctx)
+}
+
+func (s workOrderIssueService) Create(ctx context.Context, body WorkOrderIssuePostRequestBody) (WorkOrderIssuePostResponseBody, error) {
+	req := s.client.NewWorkOrderIssuePostRequest()
+	req.SetRequestBody(body)
+	return req.Do(ctx)
+}
+
+// SuiteQLService runs SuiteQL queries
+type SuiteQLService interface {
+	// Query returns a single page of results; limit 0 uses the NetSuite
+	// default (1000)
+	Query(ctx context.Context, q string, limit, offset int) (SuiteqlPostResponseBody, error)
+}
+
+func (c *Client) SuiteQL() SuiteQLService {
+	return suiteQLService{client: c}
+}
+
+type suiteQLService struct {
+	client *Client
+}
+
+func (s suiteQLService) Query(ctx context.Context, q string, limit, offset int) (SuiteqlPostResponseBody, error) {
+	req := s.client.NewSuiteqlPostRequest()
+	req.RequestBody().Q = q
+	req.QueryParams().Limit = limit
+	req.QueryParams().Offset = offset
+	return req.Do(ctx)
+}
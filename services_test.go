@@ -0,0 +1,56 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+type fakeCustomers struct {
+	netsuite.CustomerService
+	ids []int
+}
+
+func (f *fakeCustomers) Get(ctx context.Context, id int, params *netsuite.CustomerGetRequestQueryParams) (netsuite.CustomerGetResponseBody, error) {
+	f.ids = append(f.ids, id)
+	resp := netsuite.CustomerGetResponseBody{}
+	resp.CompanyName = "Fake"
+	return resp, nil
+}
+
+func companyName(customers netsuite.CustomerService, id int) (string, error) {
+	resp, err := customers.Get(context.Background(), id, nil)
+	return resp.CompanyName, err
+}
+
+func TestServices(t *testing.T) {
+	fake := &fakeCustomers{}
+	name, err := companyName(fake, 1)
+	if err != nil || name != "Fake" || len(fake.ids) != 1 {
+		t.Errorf("unexpected result from fake: %q, %v", name, err)
+	}
+
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddRecord("customer", "1", map[string]interface{}{"companyName": "Omniboost"})
+	srv.AddSuiteQL("from customer", map[string]string{"id": "1"})
+
+	var api netsuite.API = srv.Client()
+	name, err = companyName(api.Customers(), 1)
+	if err != nil || name != "Omniboost" {
+		t.Errorf("unexpected result from server: %q, %v", name, err)
+	}
+
+	resp, err := api.SuiteQL().Query(context.Background(), "SELECT id FROM customer", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := []map[string]string{}
+	json.Unmarshal(resp.Items, &items)
+	if len(items) != 1 || items[0]["id"] != "1" {
+		t.Errorf("unexpected items: %s", resp.Items)
+	}
+}
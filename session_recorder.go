@@ -0,0 +1,426 @@
+package netsuite
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	SessionFormatHAR    = "har"
+	SessionFormatNDJSON = "ndjson"
+
+	// DefaultSessionMaxBodySize is the number of body bytes kept per request
+	// and response
+	DefaultSessionMaxBodySize = 1 << 20
+)
+
+var (
+	// SessionRedactedHeaders are replaced by "REDACTED" in recorded sessions
+	SessionRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+	// SessionRedactedParams are the query params replaced by "REDACTED"
+	SessionRedactedParams = []string{"oauth_signature", "oauth_token", "oauth_consumer_key", "access_token", "client_assertion"}
+
+	sessionBodyRedactions = []*regexp.Regexp{
+		// oauth 2.0 token requests and responses
+		regexp.MustCompile(`("(?:access_token|refresh_token|client_assertion|client_secret)"\s*:\s*")[^"]*(")`),
+		regexp.MustCompile(`((?:^|&)(?:access_token|refresh_token|client_assertion|client_secret)=)[^&]*()`),
+		// soap token passport
+		regexp.MustCompile(`(<(?:\w+:)?(?:signature|token|consumerKey)\b[^>]*>)[^<]*(</)`),
+	}
+)
+
+// SessionEntry is a single recorded request/response pair
+type SessionEntry struct {
+	Started        time.Time     `json:"started"`
+	Duration       time.Duration `json:"duration"`
+	Method         string        `json:"method"`
+	URL            string        `json:"url"`
+	RequestHeader  http.Header   `json:"request_header"`
+	RequestBody    string        `json:"request_body,omitempty"`
+	Status         int           `json:"status,omitempty"`
+	ResponseHeader http.Header   `json:"response_header,omitempty"`
+	ResponseBody   string        `json:"response_body,omitempty"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// SessionRecorder captures the traffic of a client (see Client.SetRecorder)
+// as HAR or NDJSON, e.g. to attach to a NetSuite support case. Credentials are
+// redacted before anything is written.
+type SessionRecorder struct {
+	// MaxBodySize limits the number of bytes recorded per body
+	MaxBodySize int
+	// Redact is called with every entry after the default redaction, to
+	// remove anything else that shouldn't leave the building
+	Redact func(*SessionEntry)
+
+	mu      sync.Mutex
+	format  string
+	w       io.Writer
+	entries []SessionEntry
+}
+
+// NewHARRecorder returns a recorder that writes a HAR 1.2 file to w when it's
+// closed.
+func NewHARRecorder(w io.Writer) *SessionRecorder {
+	return &SessionRecorder{MaxBodySize: DefaultSessionMaxBodySize, format: SessionFormatHAR, w: w}
+}
+
+// NewNDJSONRecorder returns a recorder that writes every entry to w as a line
+// of json as soon as it's complete.
+func NewNDJSONRecorder(w io.Writer) *SessionRecorder {
+	return &SessionRecorder{MaxBodySize: DefaultSessionMaxBodySize, format: SessionFormatNDJSON, w: w}
+}
+
+// SetRecorder records the traffic of the client, including the restlet and
+// soap clients built on it. The http client is replaced by a copy whose
+// transport records, so call SetRecorder after SetHTTPClient. A nil recorder
+// is ignored.
+func (c *Client) SetRecorder(r *SessionRecorder) {
+	if r == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	hc := *c.http
+	hc.Transport = r.Transport(hc.Transport)
+	c.http = &hc
+}
+
+// Record redacts the entry and adds it to the session.
+func (r *SessionRecorder) Record(e SessionEntry) error {
+	RedactSessionEntry(&e)
+	if r.Redact != nil {
+		r.Redact(&e)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.format == SessionFormatHAR {
+		r.entries = append(r.entries, e)
+		return nil
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(append(b, '\n'))
+	return err
+}
+
+// Entries returns the entries recorded so far (HAR only).
+func (r *SessionRecorder) Entries() []SessionEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]SessionEntry{}, r.entries...)
+}
+
+// Close writes the HAR file. It's a no-op for NDJSON.
+func (r *SessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.format != SessionFormatHAR {
+		return nil
+	}
+
+	enc := json.NewEncoder(r.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(newHAR(r.entries))
+}
+
+// Transport returns a RoundTripper that records the requests sent through
+// next (http.DefaultTransport when nil). An entry is recorded when the
+// response body is closed.
+func (r *SessionRecorder) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &recordingTransport{recorder: r, next: next}
+}
+
+type recordingTransport struct {
+	recorder *SessionRecorder
+	next     http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	e := SessionEntry{
+		Started:       time.Now(),
+		Method:        req.Method,
+		URL:           req.URL.String(),
+		RequestHeader: req.Header.Clone(),
+	}
+
+	reqBody := &cappedBuffer{max: t.recorder.MaxBodySize}
+	if req.Body != nil && req.Body != http.NoBody {
+		req = req.Clone(req.Context())
+		req.Body = &teeReadCloser{r: io.TeeReader(req.Body, reqBody), c: req.Body}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		e.Duration = time.Since(e.Started)
+		e.RequestBody = reqBody.String()
+		e.Error = err.Error()
+		t.recorder.Record(e)
+		return resp, err
+	}
+
+	e.Status = resp.StatusCode
+	e.ResponseHeader = resp.Header.Clone()
+
+	respBody := &cappedBuffer{max: t.recorder.MaxBodySize}
+	var once sync.Once
+	resp.Body = &teeReadCloser{
+		r: io.TeeReader(resp.Body, respBody),
+		c: resp.Body,
+		onClose: func() {
+			once.Do(func() {
+				e.Duration = time.Since(e.Started)
+				e.RequestBody = reqBody.String()
+				e.ResponseBody = respBody.String()
+				t.recorder.Record(e)
+			})
+		},
+	}
+	return resp, nil
+}
+
+type teeReadCloser struct {
+	r       io.Reader
+	c       io.Closer
+	onClose func()
+}
+
+func (t *teeReadCloser) Read(p []byte) (int, error) {
+	return t.r.Read(p)
+}
+
+func (t *teeReadCloser) Close() error {
+	err := t.c.Close()
+	if t.onClose != nil {
+		t.onClose()
+	}
+	return err
+}
+
+// cappedBuffer keeps the first max bytes written to it and discards the rest.
+type cappedBuffer struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if room := b.max - b.buf.Len(); room < len(p) {
+		b.truncated = true
+		if room > 0 {
+			b.buf.Write(p[:room])
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *cappedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.truncated {
+		return b.buf.String() + "...(truncated)"
+	}
+	return b.buf.String()
+}
+
+// RedactSessionEntry replaces the credentials in the headers, query params and
+// bodies of e by "REDACTED".
+func RedactSessionEntry(e *SessionEntry) {
+	for _, h := range SessionRedactedHeaders {
+		for _, header := range []http.Header{e.RequestHeader, e.ResponseHeader} {
+			if header.Get(h) != "" {
+				header.Set(h, "REDACTED")
+			}
+		}
+	}
+
+	if u, err := url.Parse(e.URL); err == nil {
+		q := u.Query()
+		redacted := false
+		for _, p := range SessionRedactedParams {
+			if q.Get(p) != "" {
+				q.Set(p, "REDACTED")
+				redacted = true
+			}
+		}
+		if redacted {
+			u.RawQuery = q.Encode()
+			e.URL = u.String()
+		}
+	}
+
+	e.RequestBody = redactSessionBody(e.RequestBody)
+	e.ResponseBody = redactSessionBody(e.ResponseBody)
+}
+
+func redactSessionBody(body string) string {
+	for _, re := range sessionBodyRedactions {
+		body = re.ReplaceAllString(body, "${1}REDACTED${2}")
+	}
+	return body
+}
+
+// HAR 1.2, see http://www.softwareishard.com/blog/har-12-spec/
+
+type har struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+func newHAR(entries []SessionEntry) har {
+	h := har{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "go-netsuite-rest", Version: libraryVersion},
+		Entries: make([]harEntry, len(entries)),
+	}}
+
+	for i, e := range entries {
+		ms := float64(e.Duration) / float64(time.Millisecond)
+		he := harEntry{
+			StartedDateTime: e.Started.Format(time.RFC3339Nano),
+			Time:            ms,
+			Request: harRequest{
+				Method:      e.Method,
+				URL:         e.URL,
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []harNameValue{},
+				Headers:     harHeaders(e.RequestHeader),
+				QueryString: []harNameValue{},
+				HeadersSize: -1,
+				BodySize:    len(e.RequestBody),
+			},
+			Response: harResponse{
+				Status:      e.Status,
+				StatusText:  http.StatusText(e.Status),
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []harNameValue{},
+				Headers:     harHeaders(e.ResponseHeader),
+				Content: harContent{
+					Size:     len(e.ResponseBody),
+					MimeType: e.ResponseHeader.Get("Content-Type"),
+					Text:     e.ResponseBody,
+				},
+				HeadersSize: -1,
+				BodySize:    len(e.ResponseBody),
+			},
+			Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+			Comment: e.Error,
+		}
+
+		if u, err := url.Parse(e.URL); err == nil {
+			for k, vv := range u.Query() {
+				for _, v := range vv {
+					he.Request.QueryString = append(he.Request.QueryString, harNameValue{Name: k, Value: v})
+				}
+			}
+		}
+
+		if e.RequestBody != "" {
+			he.Request.PostData = &harPostData{
+				MimeType: e.RequestHeader.Get("Content-Type"),
+				Text:     e.RequestBody,
+			}
+		}
+
+		h.Log.Entries[i] = he
+	}
+
+	return h
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	hh := []harNameValue{}
+	for k, vv := range header {
+		for _, v := range vv {
+			hh = append(hh, harNameValue{Name: k, Value: v})
+		}
+	}
+	sort.SliceStable(hh, func(i, j int) bool { return hh[i].Name < hh[j].Name })
+	return hh
+}
@@ -0,0 +1,118 @@
+package netsuite_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestSessionRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		w.Header().Set("Set-Cookie", "JSESSIONID=secret")
+		w.Write([]byte(`{"id":"1","name":"Net 30"}`))
+	}))
+	defer server.Close()
+
+	buf := new(bytes.Buffer)
+	rec := netsuite.NewHARRecorder(buf)
+
+	c := netsuite.NewClient()
+	c.SetBaseURL(server.URL)
+	c.SetRecorder(rec)
+	c.SetBeforeRequestDo(func(_ *http.Client, req *http.Request, _ interface{}) {
+		req.Header.Set("Authorization", "Bearer secret")
+	})
+
+	req := c.NewTermGetRequest()
+	req.PathParams().ID = 1
+	_, err := req.Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("credentials weren't redacted: %s", buf.String())
+	}
+
+	har := struct {
+		Log struct {
+			Entries []struct {
+				Request struct {
+					Method string
+					URL    string
+				}
+				Response struct {
+					Status  int
+					Content struct {
+						Text string
+					}
+				}
+			}
+		}
+	}{}
+	err = json.Unmarshal(buf.Bytes(), &har)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(har.Log.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(har.Log.Entries))
+	}
+	e := har.Log.Entries[0]
+	if e.Request.Method != http.MethodGet || !strings.HasSuffix(e.Request.URL, "/term/1") {
+		t.Errorf("unexpected request: %+v", e.Request)
+	}
+	if e.Response.Status != http.StatusOK || e.Response.Content.Text != `{"id":"1","name":"Net 30"}` {
+		t.Errorf("unexpected response: %+v", e.Response)
+	}
+}
+
+func TestSessionRecorderNDJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"secret","expires_in":"3600"}`))
+	}))
+	defer server.Close()
+
+	buf := new(bytes.Buffer)
+	rec := netsuite.NewNDJSONRecorder(buf)
+	hc := &http.Client{Transport: rec.Transport(nil)}
+
+	for i := 0; i < 2; i++ {
+		resp, err := hc.Post(server.URL+"?oauth_token=secret", "application/x-www-form-urlencoded", strings.NewReader("grant_type=client_credentials&client_assertion=secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("credentials weren't redacted: %s", buf.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+
+	e := netsuite.SessionEntry{}
+	err := json.Unmarshal([]byte(lines[0]), &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.RequestBody != "grant_type=client_credentials&client_assertion=REDACTED" {
+		t.Errorf("unexpected request body %q", e.RequestBody)
+	}
+}
@@ -27,7 +27,7 @@ func TestMain(m *testing.M) {
 	contentLanguage := os.Getenv("CONTENT_LANGUAGE")
 	debug := os.Getenv("DEBUG")
 
-	client = netsuite.NewClient(nil)
+	client = netsuite.NewClient()
 	if authType == "oauth" {
 		oauthConfig := netsuite.NewOauth2Config(companyID)
 		oauthConfig.ClientID = clientID
@@ -48,7 +48,7 @@ func TestMain(m *testing.M) {
 		// get http client with automatic oauth logic
 		httpClient := oauthConfig.Client(context.Background(), token)
 
-		client = netsuite.NewClient(httpClient)
+		client = netsuite.NewClient(netsuite.WithHTTPClient(httpClient))
 		client.SetCompanyID(companyID)
 	} else if authType == "token" {
 		client.SetUseTokenAuth(true)
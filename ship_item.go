@@ -0,0 +1,12 @@
+package netsuite
+
+import "fmt"
+
+// NewShipItemsByNameRequest returns a list request filtered on the item id of
+// the shipping item so carrier services can be mapped without hardcoding
+// internal ids.
+func (c *Client) NewShipItemsByNameRequest(name string) ShipItemsGetRequest {
+	req := c.NewShipItemsGetRequest()
+	req.QueryParams().Q = fmt.Sprintf("itemId IS %q", name)
+	return req
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewShipItemGetRequest() ShipItemGetRequest {
+	r := ShipItemGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ShipItemGetRequest struct {
+	client      *Client
+	queryParams *ShipItemGetRequestQueryParams
+	pathParams  *ShipItemGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ShipItemGetRequestBody
+}
+
+func (r ShipItemGetRequest) NewQueryParams() *ShipItemGetRequestQueryParams {
+	return &ShipItemGetRequestQueryParams{}
+}
+
+type ShipItemGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p ShipItemGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ShipItemGetRequest) QueryParams() *ShipItemGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ShipItemGetRequest) NewPathParams() *ShipItemGetRequestPathParams {
+	return &ShipItemGetRequestPathParams{}
+}
+
+type ShipItemGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *ShipItemGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *ShipItemGetRequest) PathParams() *ShipItemGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ShipItemGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ShipItemGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ShipItemGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ShipItemGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ShipItemGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ShipItemGetRequest) NewRequestBody() ShipItemGetRequestBody {
+	return ShipItemGetRequestBody{}
+}
+
+type ShipItemGetRequestBody struct {
+}
+
+func (r *ShipItemGetRequest) RequestBody() *ShipItemGetRequestBody {
+	return nil
+}
+
+func (r *ShipItemGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ShipItemGetRequest) SetRequestBody(body ShipItemGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ShipItemGetRequest) NewResponseBody() *ShipItemGetResponseBody {
+	return &ShipItemGetResponseBody{}
+}
+
+type ShipItemGetResponseBody struct {
+	Links Links `json:"links"`
+	ShipItem
+}
+
+func (r *ShipItemGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/shipItem/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *ShipItemGetRequest) Do(ctx context.Context) (ShipItemGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
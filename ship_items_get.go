@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewShipItemsGetRequest() ShipItemsGetRequest {
+	r := ShipItemsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type ShipItemsGetRequest struct {
+	client      *Client
+	queryParams *ShipItemsGetRequestQueryParams
+	pathParams  *ShipItemsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody ShipItemsGetRequestBody
+}
+
+func (r ShipItemsGetRequest) NewQueryParams() *ShipItemsGetRequestQueryParams {
+	return &ShipItemsGetRequestQueryParams{}
+}
+
+type ShipItemsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p ShipItemsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *ShipItemsGetRequest) QueryParams() *ShipItemsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r ShipItemsGetRequest) NewPathParams() *ShipItemsGetRequestPathParams {
+	return &ShipItemsGetRequestPathParams{}
+}
+
+type ShipItemsGetRequestPathParams struct {
+}
+
+func (p *ShipItemsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *ShipItemsGetRequest) PathParams() *ShipItemsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *ShipItemsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *ShipItemsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *ShipItemsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *ShipItemsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *ShipItemsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r ShipItemsGetRequest) NewRequestBody() ShipItemsGetRequestBody {
+	return ShipItemsGetRequestBody{}
+}
+
+type ShipItemsGetRequestBody struct {
+}
+
+func (r *ShipItemsGetRequest) RequestBody() *ShipItemsGetRequestBody {
+	return nil
+}
+
+func (r *ShipItemsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *ShipItemsGetRequest) SetRequestBody(body ShipItemsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *ShipItemsGetRequest) NewResponseBody() *ShipItemsGetResponseBody {
+	return &ShipItemsGetResponseBody{}
+}
+
+type ShipItemsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *ShipItemsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/shipItem", r.PathParams())
+	return &u, err
+}
+
+func (r *ShipItemsGetRequest) Do(ctx context.Context) (ShipItemsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,124 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// AttachReference links AttachedRecord (e.g. a file or contact) to AttachTo
+// (e.g. a transaction or entity). ContactRole is only used when attaching
+// contacts.
+type AttachReference struct {
+	AttachTo       RecordRef
+	AttachedRecord RecordRef
+	ContactRole    *RecordRef
+}
+
+type attachReference struct {
+	Type           string     `xml:"xsi:type,attr"`
+	AttachTo       RecordRef  `xml:"urn:core_2021_2.platform.webservices.netsuite.com attachTo"`
+	AttachedRecord *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com attachedRecord,omitempty"`
+	Contact        *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com contact,omitempty"`
+	ContactRole    *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com contactRole,omitempty"`
+}
+
+type detachReference struct {
+	Type           string     `xml:"xsi:type,attr"`
+	DetachFrom     RecordRef  `xml:"urn:core_2021_2.platform.webservices.netsuite.com detachFrom"`
+	DetachedRecord *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com detachedRecord,omitempty"`
+	Contact        *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com contact,omitempty"`
+}
+
+type attach struct {
+	XMLName   xml.Name        `xml:"urn:messages_2021_2.platform.webservices.netsuite.com attach"`
+	Reference attachReference `xml:"attachReference"`
+}
+
+type detach struct {
+	XMLName   xml.Name        `xml:"urn:messages_2021_2.platform.webservices.netsuite.com detach"`
+	Reference detachReference `xml:"detachReference"`
+}
+
+type writeResponse struct {
+	WriteResponse struct {
+		Status  Status    `xml:"status"`
+		BaseRef RecordRef `xml:"baseRef"`
+	} `xml:"writeResponse"`
+}
+
+// Attach links the record to AttachTo.
+func (c *Client) Attach(ctx context.Context, ref AttachReference) error {
+	op := attach{}
+	op.Reference.AttachTo = ref.AttachTo
+	if ref.AttachedRecord.Type == "contact" {
+		op.Reference.Type = "core:AttachContactReference"
+		op.Reference.Contact = &ref.AttachedRecord
+		op.Reference.ContactRole = ref.ContactRole
+	} else {
+		op.Reference.Type = "core:AttachBasicReference"
+		op.Reference.AttachedRecord = &ref.AttachedRecord
+	}
+
+	resp := writeResponse{}
+	err := c.call(ctx, "attach", op, &resp)
+	if err != nil {
+		return err
+	}
+	return resp.WriteResponse.Status.Err()
+}
+
+// Detach removes the link between the record and AttachTo.
+func (c *Client) Detach(ctx context.Context, ref AttachReference) error {
+	op := detach{}
+	op.Reference.DetachFrom = ref.AttachTo
+	if ref.AttachedRecord.Type == "contact" {
+		op.Reference.Type = "core:DetachContactReference"
+		op.Reference.Contact = &ref.AttachedRecord
+	} else {
+		op.Reference.Type = "core:DetachBasicReference"
+		op.Reference.DetachedRecord = &ref.AttachedRecord
+	}
+
+	resp := writeResponse{}
+	err := c.call(ctx, "detach", op, &resp)
+	if err != nil {
+		return err
+	}
+	return resp.WriteResponse.Status.Err()
+}
+
+// AttachFile attaches the file cabinet file to the record, e.g. a PDF to an
+// invoice.
+func (c *Client) AttachFile(ctx context.Context, fileID string, recordType, recordID string) error {
+	return c.Attach(ctx, AttachReference{
+		AttachTo:       RecordRef{Type: recordType, InternalID: recordID},
+		AttachedRecord: RecordRef{Type: "file", InternalID: fileID},
+	})
+}
+
+func (c *Client) DetachFile(ctx context.Context, fileID string, recordType, recordID string) error {
+	return c.Detach(ctx, AttachReference{
+		AttachTo:       RecordRef{Type: recordType, InternalID: recordID},
+		AttachedRecord: RecordRef{Type: "file", InternalID: fileID},
+	})
+}
+
+// AttachContact attaches the contact to the entity or transaction with the
+// (optional) contact role.
+func (c *Client) AttachContact(ctx context.Context, contactID, contactRoleID string, recordType, recordID string) error {
+	ref := AttachReference{
+		AttachTo:       RecordRef{Type: recordType, InternalID: recordID},
+		AttachedRecord: RecordRef{Type: "contact", InternalID: contactID},
+	}
+	if contactRoleID != "" {
+		ref.ContactRole = &RecordRef{InternalID: contactRoleID}
+	}
+	return c.Attach(ctx, ref)
+}
+
+func (c *Client) DetachContact(ctx context.Context, contactID string, recordType, recordID string) error {
+	return c.Detach(ctx, AttachReference{
+		AttachTo:       RecordRef{Type: recordType, InternalID: recordID},
+		AttachedRecord: RecordRef{Type: "contact", InternalID: contactID},
+	})
+}
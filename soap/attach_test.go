@@ -0,0 +1,64 @@
+package soap_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const attachSuccess = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <attachResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <writeResponse>
+        <platformCore:status isSuccess="true" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com"/>
+        <baseRef internalId="1" type="invoice"/>
+      </writeResponse>
+    </attachResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`
+
+func TestAttachFile(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body := string(b)
+		for _, s := range []string{
+			`<attachReference xsi:type="core:AttachBasicReference">`,
+			`<attachTo xmlns="urn:core_2021_2.platform.webservices.netsuite.com" internalId="1" type="invoice"></attachTo>`,
+			`<attachedRecord xmlns="urn:core_2021_2.platform.webservices.netsuite.com" internalId="1234" type="file"></attachedRecord>`,
+		} {
+			if !strings.Contains(body, s) {
+				t.Errorf("expected request to contain %s, got %s", s, body)
+			}
+		}
+		w.Write([]byte(attachSuccess))
+	})
+
+	err := client.AttachFile(context.Background(), "1234", "invoice", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAttachContact(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body := string(b)
+		for _, s := range []string{
+			`<attachReference xsi:type="core:AttachContactReference">`,
+			`<contact xmlns="urn:core_2021_2.platform.webservices.netsuite.com" internalId="7" type="contact"></contact>`,
+			`<contactRole xmlns="urn:core_2021_2.platform.webservices.netsuite.com" internalId="-10"></contactRole>`,
+		} {
+			if !strings.Contains(body, s) {
+				t.Errorf("expected request to contain %s, got %s", s, body)
+			}
+		}
+		w.Write([]byte(attachSuccess))
+	})
+
+	err := client.AttachContact(context.Background(), "7", "-10", "customer", "12")
+	if err != nil {
+		t.Error(err)
+	}
+}
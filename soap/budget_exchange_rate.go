@@ -0,0 +1,54 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+type BudgetExchangeRate struct {
+	Period         RecordRef `xml:"period"`
+	FromSubsidiary RecordRef `xml:"fromSubsidiary"`
+	ToSubsidiary   RecordRef `xml:"toSubsidiary"`
+	CurrentRate    float64   `xml:"currentRate"`
+	AverageRate    float64   `xml:"averageRate"`
+	HistoricalRate float64   `xml:"historicalRate"`
+}
+
+type BudgetExchangeRates []BudgetExchangeRate
+
+type getBudgetExchangeRate struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com getBudgetExchangeRate"`
+	Filter  struct {
+		Period         *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com period"`
+		FromSubsidiary *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com fromSubsidiary,omitempty"`
+		ToSubsidiary   *RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com toSubsidiary,omitempty"`
+	} `xml:"budgetExchangeRateFilter"`
+}
+
+type getBudgetExchangeRateResponse struct {
+	Result struct {
+		Status                 Status              `xml:"status"`
+		BudgetExchangeRateList BudgetExchangeRates `xml:"budgetExchangeRateList>budgetExchangeRate"`
+	} `xml:"getBudgetExchangeRateResult"`
+}
+
+// GetBudgetExchangeRate returns the budget exchange rates of the accounting
+// period. The subsidiaries are optional and narrow down the rates returned.
+func (c *Client) GetBudgetExchangeRate(ctx context.Context, periodID, fromSubsidiaryID, toSubsidiaryID string) (BudgetExchangeRates, error) {
+	op := getBudgetExchangeRate{}
+	op.Filter.Period = &RecordRef{InternalID: periodID}
+	if fromSubsidiaryID != "" {
+		op.Filter.FromSubsidiary = &RecordRef{InternalID: fromSubsidiaryID}
+	}
+	if toSubsidiaryID != "" {
+		op.Filter.ToSubsidiary = &RecordRef{InternalID: toSubsidiaryID}
+	}
+
+	resp := getBudgetExchangeRateResponse{}
+	err := c.call(ctx, "getBudgetExchangeRate", op, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result.BudgetExchangeRateList, resp.Result.Status.Err()
+}
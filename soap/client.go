@@ -0,0 +1,273 @@
+// Package soap implements the SuiteTalk SOAP operations that aren't available
+// over REST (item availability, budget exchange rates, attach/detach and the
+// file cabinet), with the token based auth credentials of a netsuite.Client.
+package soap
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+const (
+	Version = "2021_2"
+
+	NamespaceSOAP     = "http://schemas.xmlsoap.org/soap/envelope/"
+	NamespaceXSI      = "http://www.w3.org/2001/XMLSchema-instance"
+	NamespaceMessages = "urn:messages_" + Version + ".platform.webservices.netsuite.com"
+	NamespaceCore     = "urn:core_" + Version + ".platform.webservices.netsuite.com"
+)
+
+var (
+	BaseURL = "https://{{.account_id}}.suitetalk.api.netsuite.com/services/NetSuitePort_" + Version
+)
+
+// Client manages communication with the SuiteTalk SOAP web services
+type Client struct {
+	netsuite *netsuite.Client
+	baseURL  string
+}
+
+// NewClient returns a SOAP client that reuses the account, token based auth
+// credentials and http client of c. SOAP web services don't support OAuth 2.0.
+func NewClient(c *netsuite.Client) *Client {
+	return &Client{
+		netsuite: c,
+		baseURL:  BaseURL,
+	}
+}
+
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = baseURL
+}
+
+func (c Client) BaseURL() (*url.URL, error) {
+	tmpl, err := template.New("host").Parse(c.baseURL)
+	if err != nil {
+		return &url.URL{}, err
+	}
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, map[string]interface{}{"account_id": strings.ToLower(strings.Replace(c.netsuite.CompanyID(), "_", "-", -1))})
+	if err != nil {
+		return &url.URL{}, err
+	}
+	return url.Parse(buf.String())
+}
+
+type envelope struct {
+	XMLName xml.Name `xml:"soapenv:Envelope"`
+	SOAP    string   `xml:"xmlns:soapenv,attr"`
+	XSI     string   `xml:"xmlns:xsi,attr"`
+	Core    string   `xml:"xmlns:core,attr"`
+	Header  struct {
+		TokenPassport TokenPassport
+	} `xml:"soapenv:Header"`
+	Body struct {
+		Content interface{}
+	} `xml:"soapenv:Body"`
+}
+
+type TokenPassport struct {
+	XMLName     xml.Name  `xml:"urn:messages_2021_2.platform.webservices.netsuite.com tokenPassport"`
+	Account     string    `xml:"urn:core_2021_2.platform.webservices.netsuite.com account"`
+	ConsumerKey string    `xml:"urn:core_2021_2.platform.webservices.netsuite.com consumerKey"`
+	Token       string    `xml:"urn:core_2021_2.platform.webservices.netsuite.com token"`
+	Nonce       string    `xml:"urn:core_2021_2.platform.webservices.netsuite.com nonce"`
+	Timestamp   int64     `xml:"urn:core_2021_2.platform.webservices.netsuite.com timestamp"`
+	Signature   Signature `xml:"urn:core_2021_2.platform.webservices.netsuite.com signature"`
+}
+
+type Signature struct {
+	Algorithm string `xml:"algorithm,attr"`
+	Value     string `xml:",chardata"`
+}
+
+// NewTokenPassport signs a token passport with the token based auth
+// credentials of the client.
+func (c *Client) NewTokenPassport() (TokenPassport, error) {
+	if !c.netsuite.UseTokenAuth() {
+		return TokenPassport{}, errors.New("SOAP web services require token based auth")
+	}
+
+	tp := TokenPassport{
+		Account:     strings.ToUpper(c.netsuite.CompanyID()),
+		ConsumerKey: c.netsuite.ClientID(),
+		Token:       c.netsuite.TokenID(),
+		Nonce:       netsuite.GenerateNonce(),
+		Timestamp:   time.Now().Unix(),
+	}
+
+	base := strings.Join([]string{tp.Account, tp.ConsumerKey, tp.Token, tp.Nonce, strconv.FormatInt(tp.Timestamp, 10)}, "&")
+	key := c.netsuite.ClientSecret() + "&" + c.netsuite.TokenSecret()
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(base))
+
+	tp.Signature = Signature{
+		Algorithm: "HMAC_SHA256",
+		Value:     base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+	}
+	return tp, nil
+}
+
+// NewRequest wraps the operation in a signed SOAP envelope.
+func (c *Client) NewRequest(ctx context.Context, action string, operation interface{}) (*http.Request, error) {
+	buf, err := c.marshalEnvelope(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newHTTPRequest(ctx, action, buf)
+}
+
+func (c *Client) marshalEnvelope(operation interface{}) (*bytes.Buffer, error) {
+	tp, err := c.NewTokenPassport()
+	if err != nil {
+		return nil, err
+	}
+
+	env := envelope{
+		SOAP: NamespaceSOAP,
+		XSI:  NamespaceXSI,
+		Core: NamespaceCore,
+	}
+	env.Header.TokenPassport = tp
+	env.Body.Content = operation
+
+	buf := bytes.NewBufferString(xml.Header)
+	err = xml.NewEncoder(buf).Encode(env)
+	return buf, err
+}
+
+func (c *Client) newHTTPRequest(ctx context.Context, action string, body io.Reader) (*http.Request, error) {
+	u, err := c.BaseURL()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := http.NewRequest(http.MethodPost, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx != nil {
+		r = r.WithContext(ctx)
+	}
+
+	r.Header.Add("Content-Type", "text/xml; charset=utf-8")
+	r.Header.Add("SOAPAction", action)
+	r.Header.Add("User-Agent", c.netsuite.UserAgent())
+	return r, nil
+}
+
+type responseEnvelope struct {
+	Body struct {
+		Fault   *Fault  `xml:"Fault"`
+		Content decoder `xml:",any"`
+	} `xml:"Body"`
+}
+
+// decoder decodes the element it's unmarshaled from into v, keeping the
+// namespaces declared on the ancestors of the element.
+type decoder struct {
+	v interface{}
+}
+
+func (d *decoder) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	if d.v == nil {
+		return dec.Skip()
+	}
+	return dec.DecodeElement(d.v, &start)
+}
+
+// Do sends the request and decodes the content of the SOAP body into v. SOAP
+// faults are returned as *Fault.
+func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	return c.do(req, v, nil)
+}
+
+// do is Do with an optional filter the response body is read through before
+// it's decoded.
+func (c *Client) do(req *http.Request, v interface{}, filter func(io.Reader) io.Reader) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	if err := c.netsuite.WaitForRateLimit(req.Context()); err != nil {
+		return nil, err
+	}
+
+	release, err := c.netsuite.AcquireConcurrency(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	dump := c.netsuite.ShouldDump()
+	if dump {
+		c.netsuite.DumpRequest(req)
+	}
+
+	start := time.Now()
+	httpResp, err := c.netsuite.HTTPClient().Do(req)
+	if err != nil {
+		c.netsuite.RecordRequest(req, nil, err, time.Since(start))
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if dump {
+		c.netsuite.DumpResponse(httpResp)
+	}
+
+	// error responses are small: keep the body around for the error message
+	var body io.Reader = httpResp.Body
+	var data []byte
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		data, err = ioutil.ReadAll(httpResp.Body)
+		if err != nil {
+			return httpResp, err
+		}
+		body = bytes.NewReader(data)
+	}
+	if filter != nil {
+		body = filter(body)
+	}
+
+	env := responseEnvelope{}
+	env.Body.Content.v = v
+	err = xml.NewDecoder(body).Decode(&env)
+	if err != nil && data != nil {
+		err = fmt.Errorf("%s: %s", httpResp.Status, data)
+	} else if err == nil && env.Body.Fault != nil {
+		env.Body.Fault.Response = httpResp
+		err = env.Body.Fault
+	}
+
+	c.netsuite.RecordRequest(req, httpResp, err, time.Since(start))
+	return httpResp, err
+}
+
+func (c *Client) call(ctx context.Context, action string, operation interface{}, v interface{}) error {
+	req, err := c.NewRequest(ctx, action, operation)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(req, v)
+	return err
+}
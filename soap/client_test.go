@@ -0,0 +1,146 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/soap"
+)
+
+func newClient(t *testing.T, handler http.HandlerFunc) *soap.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := netsuite.NewClient()
+	c.SetCompanyID("1234567_SB1")
+	c.SetUseTokenAuth(true)
+	c.SetClientID("consumer-key")
+	c.SetClientSecret("consumer-secret")
+	c.SetTokenID("token")
+	c.SetTokenSecret("token-secret")
+
+	client := soap.NewClient(c)
+	client.SetBaseURL(server.URL)
+	return client
+}
+
+func TestGetItemAvailability(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body := string(b)
+		if r.Header.Get("SOAPAction") != "getItemAvailability" {
+			t.Errorf("unexpected SOAPAction %s", r.Header.Get("SOAPAction"))
+		}
+		for _, s := range []string{
+			`<account xmlns="urn:core_2021_2.platform.webservices.netsuite.com">1234567_SB1</account>`,
+			`<signature xmlns="urn:core_2021_2.platform.webservices.netsuite.com" algorithm="HMAC_SHA256">`,
+			`<item xmlns="urn:core_2021_2.platform.webservices.netsuite.com"><recordRef xmlns="urn:core_2021_2.platform.webservices.netsuite.com" internalId="12"></recordRef></item>`,
+		} {
+			if !strings.Contains(body, s) {
+				t.Errorf("expected request to contain %s, got %s", s, body)
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <getItemAvailabilityResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <platformCore:getItemAvailabilityResult xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com">
+        <platformCore:status isSuccess="true"/>
+        <platformCore:itemAvailabilityList>
+          <platformCore:itemAvailability>
+            <platformCore:item internalId="12"><platformCore:name>Widget</platformCore:name></platformCore:item>
+            <platformCore:locationId internalId="1"><platformCore:name>Amsterdam</platformCore:name></platformCore:locationId>
+            <platformCore:quantityOnHand>10.0</platformCore:quantityOnHand>
+            <platformCore:quantityAvailable>7.0</platformCore:quantityAvailable>
+          </platformCore:itemAvailability>
+        </platformCore:itemAvailabilityList>
+      </platformCore:getItemAvailabilityResult>
+    </getItemAvailabilityResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`))
+	})
+
+	availability, err := client.GetItemAvailability(context.Background(), []string{"12"}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(availability) != 1 || availability[0].Item.Name != "Widget" || availability[0].LocationID.InternalID != "1" || availability[0].QuantityAvailable != 7 {
+		t.Errorf("unexpected availability: %+v", availability)
+	}
+}
+
+func TestAttachStatusError(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), `<attachReference xsi:type="core:AttachBasicReference">`) {
+			t.Errorf("expected basic attach reference, got %s", b)
+		}
+
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <attachResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <writeResponse>
+        <platformCore:status isSuccess="false" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com">
+          <platformCore:statusDetail type="ERROR">
+            <platformCore:code>INVALID_KEY_OR_REF</platformCore:code>
+            <platformCore:message>Invalid attachedRecord reference key 99.</platformCore:message>
+          </platformCore:statusDetail>
+        </platformCore:status>
+      </writeResponse>
+    </attachResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`))
+	})
+
+	err := client.Attach(context.Background(), soap.AttachReference{
+		AttachTo:       soap.RecordRef{Type: "invoice", InternalID: "1"},
+		AttachedRecord: soap.RecordRef{Type: "file", InternalID: "99"},
+	})
+	statusErr := &soap.StatusError{}
+	if !errors.As(err, &statusErr) || statusErr.Status.StatusDetails[0].Code != "INVALID_KEY_OR_REF" {
+		t.Errorf("expected INVALID_KEY_OR_REF, got %v", err)
+	}
+}
+
+func TestFault(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <soapenv:Fault>
+      <faultcode>soapenv:Server.userException</faultcode>
+      <faultstring>Ambiguous authentication</faultstring>
+      <detail>
+        <platformFaults:invalidCredentialsFault xmlns:platformFaults="urn:faults_2021_2.platform.webservices.netsuite.com">
+          <platformFaults:code>INVALID_LOGIN_ATTEMPT</platformFaults:code>
+          <platformFaults:message>Ambiguous authentication</platformFaults:message>
+        </platformFaults:invalidCredentialsFault>
+      </detail>
+    </soapenv:Fault>
+  </soapenv:Body>
+</soapenv:Envelope>`))
+	})
+
+	_, err := client.GetBudgetExchangeRate(context.Background(), "1", "", "")
+	fault := &soap.Fault{}
+	if !errors.As(err, &fault) || fault.Code() != "INVALID_LOGIN_ATTEMPT" {
+		t.Errorf("expected INVALID_LOGIN_ATTEMPT fault, got %v", err)
+	}
+}
+
+func TestRequiresTokenAuth(t *testing.T) {
+	_, err := soap.NewClient(netsuite.NewClient()).NewTokenPassport()
+	if err == nil {
+		t.Error("expected error without token based auth")
+	}
+}
@@ -0,0 +1,95 @@
+package soap
+
+import (
+	"context"
+	"encoding/base64"
+)
+
+const (
+	FileTypeCSV        = "_CSV"
+	FileTypeExcel      = "_EXCEL"
+	FileTypeGIF        = "_GIFIMAGE"
+	FileTypeHTML       = "_HTMLDOC"
+	FileTypeJavaScript = "_JAVASCRIPT"
+	FileTypeJPG        = "_JPGIMAGE"
+	FileTypeJSON       = "_JSON"
+	FileTypeMessageRFC = "_MESSAGERFC"
+	FileTypeMisc       = "_MISCBINARY"
+	FileTypeMiscText   = "_MISCTEXT"
+	FileTypePDF        = "_PDF"
+	FileTypePlainText  = "_PLAINTEXT"
+	FileTypePNG        = "_PNGIMAGE"
+	FileTypeWord       = "_WORD"
+	FileTypeXML        = "_XMLDOC"
+	FileTypeZip        = "_ZIP"
+)
+
+// File is a file cabinet file
+type File struct {
+	InternalID    string `xml:"internalId,attr,omitempty"`
+	ExternalID    string `xml:"externalId,attr,omitempty"`
+	Name          string `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com name,omitempty"`
+	MediaTypeName string `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com mediaTypeName,omitempty"`
+	FileType      string `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com fileType,omitempty"`
+	// Content is the base64 encoded file content, see SetContent and Bytes
+	Content          string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com content,omitempty"`
+	Folder           *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com folder,omitempty"`
+	FileSize         float64    `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com fileSize,omitempty"`
+	URL              string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com url,omitempty"`
+	TextFileEncoding string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com textFileEncoding,omitempty"`
+	Description      string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com description,omitempty"`
+	IsOnline         *bool      `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com isOnline,omitempty"`
+	IsInactive       *bool      `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com isInactive,omitempty"`
+	IsPrivate        *bool      `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com isPrivate,omitempty"`
+	Owner            *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com owner,omitempty"`
+	CreatedDate      string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com createdDate,omitempty"`
+	LastModifiedDate string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com lastModifiedDate,omitempty"`
+}
+
+// SetContent base64 encodes b as the content of the file.
+func (f *File) SetContent(b []byte) {
+	f.Content = base64.StdEncoding.EncodeToString(b)
+}
+
+// Bytes returns the decoded content of the file.
+func (f File) Bytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(f.Content)
+}
+
+func fileRecord(f File) record {
+	// read-only fields can't be sent
+	f.FileSize = 0
+	f.URL = ""
+	f.CreatedDate = ""
+	f.LastModifiedDate = ""
+	f.MediaTypeName = ""
+	return record{
+		Type:        "fileCabinet:File",
+		FileCabinet: NamespaceFileCabinet,
+		Record:      f,
+	}
+}
+
+// AddFile uploads the file to the file cabinet and returns its internal id.
+// Name, Folder and the content are required; NetSuite derives the file type
+// from the extension when FileType is empty.
+func (c *Client) AddFile(ctx context.Context, f File) (string, error) {
+	return c.addRecord(ctx, fileRecord(f))
+}
+
+// GetFile returns the file including its content.
+func (c *Client) GetFile(ctx context.Context, internalID string) (File, error) {
+	f := File{}
+	err := c.getRecord(ctx, "file", internalID, &f)
+	return f, err
+}
+
+// UpdateFile updates the fields of the file that are set, e.g. to move or
+// rename it or to replace its content.
+func (c *Client) UpdateFile(ctx context.Context, f File) error {
+	return c.updateRecord(ctx, fileRecord(f))
+}
+
+func (c *Client) DeleteFile(ctx context.Context, internalID string) error {
+	return c.deleteRecord(ctx, "file", internalID)
+}
@@ -0,0 +1,94 @@
+package soap_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/soap"
+)
+
+func TestAddFile(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body := string(b)
+		for _, s := range []string{
+			`<record xmlns="urn:messages_2021_2.platform.webservices.netsuite.com" xsi:type="fileCabinet:File" xmlns:fileCabinet="urn:filecabinet_2021_2.documents.webservices.netsuite.com">`,
+			`<content xmlns="urn:filecabinet_2021_2.documents.webservices.netsuite.com">JVBERi0=</content>`,
+			`<folder xmlns="urn:filecabinet_2021_2.documents.webservices.netsuite.com" internalId="5"></folder>`,
+		} {
+			if !strings.Contains(body, s) {
+				t.Errorf("expected request to contain %s, got %s", s, body)
+			}
+		}
+
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <addResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <writeResponse>
+        <platformCore:status isSuccess="true" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com"/>
+        <baseRef internalId="1234" type="file" xsi:type="platformCore:RecordRef" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"/>
+      </writeResponse>
+    </addResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`))
+	})
+
+	f := soap.File{
+		Name:     "INV0001.pdf",
+		FileType: soap.FileTypePDF,
+		Folder:   &soap.RecordRef{InternalID: "5"},
+	}
+	f.SetContent([]byte("%PDF-"))
+
+	id, err := client.AddFile(context.Background(), f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "1234" {
+		t.Errorf("expected id 1234, got %s", id)
+	}
+}
+
+func TestGetFile(t *testing.T) {
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), `<baseRef xsi:type="core:RecordRef" internalId="1234" type="file"></baseRef>`) {
+			t.Errorf("unexpected request: %s", b)
+		}
+
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <getResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <readResponse>
+        <platformCore:status isSuccess="true" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com"/>
+        <record internalId="1234" xsi:type="docFileCab:File" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:docFileCab="urn:filecabinet_2021_2.documents.webservices.netsuite.com">
+          <docFileCab:name>INV0001.pdf</docFileCab:name>
+          <docFileCab:fileType>_PDF</docFileCab:fileType>
+          <docFileCab:content>JVBERi0=</docFileCab:content>
+          <docFileCab:folder internalId="5" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com">
+            <platformCore:name>Invoices</platformCore:name>
+          </docFileCab:folder>
+          <docFileCab:fileSize>5.0</docFileCab:fileSize>
+        </record>
+      </readResponse>
+    </getResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`))
+	})
+
+	f, err := client.GetFile(context.Background(), "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.InternalID != "1234" || f.Name != "INV0001.pdf" || f.Folder.Name != "Invoices" || f.FileSize != 5 || string(b) != "%PDF-" {
+		t.Errorf("unexpected file: %+v", f)
+	}
+}
@@ -0,0 +1,48 @@
+package soap
+
+import (
+	"context"
+)
+
+// Folder is a file cabinet folder
+type Folder struct {
+	InternalID  string `xml:"internalId,attr,omitempty"`
+	ExternalID  string `xml:"externalId,attr,omitempty"`
+	Name        string `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com name,omitempty"`
+	Description string `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com description,omitempty"`
+	// Parent is empty for top level folders
+	Parent     *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com parent,omitempty"`
+	FolderType string     `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com folderType,omitempty"`
+	IsInactive *bool      `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com isInactive,omitempty"`
+	IsPrivate  *bool      `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com isPrivate,omitempty"`
+	Department *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com department,omitempty"`
+	Class      *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com class,omitempty"`
+	Subsidiary *RecordRef `xml:"urn:filecabinet_2021_2.documents.webservices.netsuite.com subsidiary,omitempty"`
+}
+
+func folderRecord(f Folder) record {
+	return record{
+		Type:        "fileCabinet:Folder",
+		FileCabinet: NamespaceFileCabinet,
+		Record:      f,
+	}
+}
+
+// AddFolder creates the folder and returns its internal id.
+func (c *Client) AddFolder(ctx context.Context, f Folder) (string, error) {
+	return c.addRecord(ctx, folderRecord(f))
+}
+
+func (c *Client) GetFolder(ctx context.Context, internalID string) (Folder, error) {
+	f := Folder{}
+	err := c.getRecord(ctx, "folder", internalID, &f)
+	return f, err
+}
+
+func (c *Client) UpdateFolder(ctx context.Context, f Folder) error {
+	return c.updateRecord(ctx, folderRecord(f))
+}
+
+func (c *Client) DeleteFolder(ctx context.Context, internalID string) error {
+	return c.deleteRecord(ctx, "folder", internalID)
+}
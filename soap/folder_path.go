@@ -0,0 +1,81 @@
+package soap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EnsureFolderPath makes sure all folders of the path (e.g.
+// "/Invoices/2024/05") exist, creating the missing ones, and returns the
+// internal id of the last folder. Existing folders are looked up by name and
+// parent with SuiteQL.
+func (c *Client) EnsureFolderPath(ctx context.Context, path string) (string, error) {
+	names := []string{}
+	for _, name := range strings.Split(path, "/") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", errors.New("folder path is empty")
+	}
+
+	parentID := ""
+	for _, name := range names {
+		id, err := c.findFolder(ctx, name, parentID)
+		if err != nil {
+			return "", err
+		}
+
+		if id == "" {
+			folder := Folder{Name: name}
+			if parentID != "" {
+				folder.Parent = &RecordRef{InternalID: parentID}
+			}
+
+			id, err = c.AddFolder(ctx, folder)
+			statusErr := &StatusError{}
+			if errors.As(err, &statusErr) {
+				// created in the meantime by someone else?
+				if existingID, ferr := c.findFolder(ctx, name, parentID); ferr == nil && existingID != "" {
+					id, err = existingID, nil
+				}
+			}
+			if err != nil {
+				return "", err
+			}
+		}
+
+		parentID = id
+	}
+
+	return parentID, nil
+}
+
+func (c *Client) findFolder(ctx context.Context, name string, parentID string) (string, error) {
+	q := fmt.Sprintf("SELECT id FROM mediaitemfolder WHERE name = '%s'", strings.Replace(name, "'", "''", -1))
+	if parentID == "" {
+		q += " AND parent IS NULL"
+	} else {
+		q += fmt.Sprintf(" AND parent = '%s'", strings.Replace(parentID, "'", "''", -1))
+	}
+
+	r := c.netsuite.NewSuiteqlPostRequest()
+	r.RequestBody().Q = q
+	resp, err := r.Do(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	items := []struct {
+		ID string `json:"id"`
+	}{}
+	err = json.Unmarshal(resp.Items, &items)
+	if err != nil || len(items) == 0 {
+		return "", err
+	}
+	return items[0].ID, nil
+}
@@ -0,0 +1,75 @@
+package soap_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/soap"
+)
+
+func TestEnsureFolderPath(t *testing.T) {
+	// Invoices (1) and 2024 (2) exist, 05 has to be created
+	existing := map[string]string{
+		"Invoices|": "1",
+		"2024|1":    "2",
+	}
+	created := []string{}
+
+	queryRe := regexp.MustCompile(`name = '([^']*)' AND parent (?:IS NULL|= '(\d+)')`)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/query/v1/suiteql", func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Q string `json:"q"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		m := queryRe.FindStringSubmatch(body.Q)
+		if m == nil {
+			t.Errorf("unexpected query: %s", body.Q)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oracle.resource+json")
+		if id, ok := existing[m[1]+"|"+m[2]]; ok {
+			fmt.Fprintf(w, `{"count":1,"items":[{"id":"%s"}]}`, id)
+			return
+		}
+		w.Write([]byte(`{"count":0,"items":[]}`))
+	})
+	mux.HandleFunc("/soap", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		m := regexp.MustCompile(`<name[^>]*>([^<]*)</name><parent[^>]*internalId="(\d+)"`).FindSubmatch(b)
+		if m == nil {
+			t.Errorf("unexpected add: %s", b)
+			return
+		}
+		created = append(created, string(m[1])+"|"+string(m[2]))
+
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><addResponse><writeResponse><status isSuccess="true"/><baseRef internalId="3" type="folder"/></writeResponse></addResponse></soapenv:Body></soapenv:Envelope>`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := netsuite.NewClient(
+		netsuite.WithBaseURL(server.URL),
+		netsuite.WithAccountID("1234567"),
+		netsuite.WithTokenAuth("ck", "cs", "tid", "ts"),
+	)
+	client := soap.NewClient(c)
+	client.SetBaseURL(server.URL + "/soap")
+
+	id, err := client.EnsureFolderPath(context.Background(), "/Invoices/2024/05")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != "3" || len(created) != 1 || created[0] != "05|2" {
+		t.Errorf("expected 05 to be created in 2024, got id %s and %v", id, created)
+	}
+}
@@ -0,0 +1,59 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"time"
+)
+
+type ItemAvailability struct {
+	Item                   RecordRef `xml:"item"`
+	LastQtyAvailableChange string    `xml:"lastQtyAvailableChange"`
+	LocationID             RecordRef `xml:"locationId"`
+	QuantityOnHand         float64   `xml:"quantityOnHand"`
+	OnHandValueMli         float64   `xml:"onHandValueMli"`
+	ReorderPoint           float64   `xml:"reorderPoint"`
+	PreferredStockLevel    float64   `xml:"preferredStockLevel"`
+	QuantityOnOrder        float64   `xml:"quantityOnOrder"`
+	QuantityCommitted      float64   `xml:"quantityCommitted"`
+	QuantityBackOrdered    float64   `xml:"quantityBackOrdered"`
+	QuantityAvailable      float64   `xml:"quantityAvailable"`
+}
+
+type ItemAvailabilities []ItemAvailability
+
+type getItemAvailability struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com getItemAvailability"`
+	Filter  struct {
+		Item                   RecordRefList `xml:"urn:core_2021_2.platform.webservices.netsuite.com item"`
+		LastQtyAvailableChange *time.Time    `xml:"urn:core_2021_2.platform.webservices.netsuite.com lastQtyAvailableChange,omitempty"`
+	} `xml:"itemAvailabilityFilter"`
+}
+
+type getItemAvailabilityResponse struct {
+	Result struct {
+		Status               Status             `xml:"status"`
+		ItemAvailabilityList ItemAvailabilities `xml:"itemAvailabilityList>itemAvailability"`
+	} `xml:"getItemAvailabilityResult"`
+}
+
+// GetItemAvailability returns the availability per location of the items.
+// When lastQtyAvailableChange isn't zero only items of which the quantity
+// changed since are returned.
+func (c *Client) GetItemAvailability(ctx context.Context, itemIDs []string, lastQtyAvailableChange time.Time) (ItemAvailabilities, error) {
+	op := getItemAvailability{}
+	for _, id := range itemIDs {
+		op.Filter.Item.RecordRefs = append(op.Filter.Item.RecordRefs, RecordRef{InternalID: id})
+	}
+	if !lastQtyAvailableChange.IsZero() {
+		op.Filter.LastQtyAvailableChange = &lastQtyAvailableChange
+	}
+
+	resp := getItemAvailabilityResponse{}
+	err := c.call(ctx, "getItemAvailability", op, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result.ItemAvailabilityList, resp.Result.Status.Err()
+}
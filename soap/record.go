@@ -0,0 +1,100 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	NamespaceFileCabinet = "urn:filecabinet_" + Version + ".documents.webservices.netsuite.com"
+)
+
+// record wraps a record for add and update; Type is the xsi:type of the record
+// (e.g. "fileCabinet:File") and Record the record struct.
+type record struct {
+	Type        string
+	FileCabinet string
+	Record      interface{}
+}
+
+// MarshalXML encodes the fields of the record struct in a <record> element.
+func (r record) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start = xml.StartElement{
+		Name: xml.Name{Space: NamespaceMessages, Local: "record"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xsi:type"}, Value: r.Type}},
+	}
+	if r.FileCabinet != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:fileCabinet"}, Value: r.FileCabinet})
+	}
+	return e.EncodeElement(r.Record, start)
+}
+
+type add struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com add"`
+	Record  record
+}
+
+type update struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com update"`
+	Record  record
+}
+
+type baseRef struct {
+	Type       string `xml:"xsi:type,attr"`
+	InternalID string `xml:"internalId,attr"`
+	RecordType string `xml:"type,attr"`
+}
+
+type get struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com get"`
+	BaseRef baseRef  `xml:"baseRef"`
+}
+
+type del struct {
+	XMLName xml.Name `xml:"urn:messages_2021_2.platform.webservices.netsuite.com delete"`
+	BaseRef baseRef  `xml:"baseRef"`
+}
+
+func (c *Client) addRecord(ctx context.Context, r record) (string, error) {
+	resp := writeResponse{}
+	err := c.call(ctx, "add", add{Record: r}, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.WriteResponse.BaseRef.InternalID, resp.WriteResponse.Status.Err()
+}
+
+func (c *Client) updateRecord(ctx context.Context, r record) error {
+	resp := writeResponse{}
+	err := c.call(ctx, "update", update{Record: r}, &resp)
+	if err != nil {
+		return err
+	}
+	return resp.WriteResponse.Status.Err()
+}
+
+// getRecord decodes the record into v, a pointer to the record struct.
+func (c *Client) getRecord(ctx context.Context, recordType, internalID string, v interface{}) error {
+	resp := struct {
+		ReadResponse struct {
+			Status Status  `xml:"status"`
+			Record decoder `xml:"record"`
+		} `xml:"readResponse"`
+	}{}
+	resp.ReadResponse.Record.v = v
+	err := c.call(ctx, "get", get{BaseRef: baseRef{Type: "core:RecordRef", InternalID: internalID, RecordType: recordType}}, &resp)
+	if err != nil {
+		return err
+	}
+
+	return resp.ReadResponse.Status.Err()
+}
+
+func (c *Client) deleteRecord(ctx context.Context, recordType, internalID string) error {
+	resp := writeResponse{}
+	err := c.call(ctx, "delete", del{BaseRef: baseRef{Type: "core:RecordRef", InternalID: internalID, RecordType: recordType}}, &resp)
+	if err != nil {
+		return err
+	}
+	return resp.WriteResponse.Status.Err()
+}
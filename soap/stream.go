@@ -0,0 +1,269 @@
+package soap
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// ProgressFunc is called with the number of bytes of file content transferred
+// so far.
+type ProgressFunc func(transferred int64)
+
+// AddFileFrom uploads the file with the content read from r. The content is
+// base64 encoded while it's sent, so it's never held in memory as a whole.
+func (c *Client) AddFileFrom(ctx context.Context, f File, r io.Reader, progress ProgressFunc) (string, error) {
+	resp := writeResponse{}
+	err := c.streamFile(ctx, "add", f, r, progress, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.WriteResponse.BaseRef.InternalID, resp.WriteResponse.Status.Err()
+}
+
+// UpdateFileFrom replaces the content of the file with the content read from
+// r.
+func (c *Client) UpdateFileFrom(ctx context.Context, f File, r io.Reader, progress ProgressFunc) error {
+	resp := writeResponse{}
+	err := c.streamFile(ctx, "update", f, r, progress, &resp)
+	if err != nil {
+		return err
+	}
+	return resp.WriteResponse.Status.Err()
+}
+
+// GetFileTo returns the file and writes its decoded content to w while the
+// response is read. The Content of the returned file is left empty.
+func (c *Client) GetFileTo(ctx context.Context, internalID string, w io.Writer, progress ProgressFunc) (File, error) {
+	f := File{}
+	req, err := c.NewRequest(ctx, "get", get{BaseRef: baseRef{Type: "core:RecordRef", InternalID: internalID, RecordType: "file"}})
+	if err != nil {
+		return f, err
+	}
+
+	resp := struct {
+		ReadResponse struct {
+			Status Status  `xml:"status"`
+			Record decoder `xml:"record"`
+		} `xml:"readResponse"`
+	}{}
+	resp.ReadResponse.Record.v = &f
+
+	content := &base64Writer{w: &progressWriter{w: w, progress: progress}}
+	_, err = c.do(req, &resp, func(r io.Reader) io.Reader {
+		return &contentFilter{r: bufio.NewReader(r), w: content}
+	})
+	if err != nil {
+		return f, err
+	}
+
+	return f, resp.ReadResponse.Status.Err()
+}
+
+func (c *Client) streamFile(ctx context.Context, action string, f File, r io.Reader, progress ProgressFunc, v interface{}) error {
+	// marshal the envelope with a placeholder and stream the content in its
+	// place
+	placeholder := "content-" + netsuite.GenerateNonce()
+	f.Content = placeholder
+
+	var op interface{} = add{Record: fileRecord(f)}
+	if action == "update" {
+		op = update{Record: fileRecord(f)}
+	}
+
+	buf, err := c.marshalEnvelope(op)
+	if err != nil {
+		return err
+	}
+	b := buf.Bytes()
+	i := bytes.Index(b, []byte(placeholder))
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		enc := base64.NewEncoder(base64.StdEncoding, pw)
+		_, err := io.Copy(enc, &progressReader{r: r, progress: progress})
+		if err == nil {
+			err = enc.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	body := io.MultiReader(bytes.NewReader(b[:i]), pr, bytes.NewReader(b[i+len(placeholder):]))
+	req, err := c.newHTTPRequest(ctx, action, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(req, v)
+	return err
+}
+
+type progressReader struct {
+	r        io.Reader
+	n        int64
+	progress ProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 && r.progress != nil {
+		r.n += int64(n)
+		r.progress(r.n)
+	}
+	return n, err
+}
+
+type progressWriter struct {
+	w        io.Writer
+	n        int64
+	progress ProgressFunc
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if n > 0 && w.progress != nil {
+		w.n += int64(n)
+		w.progress(w.n)
+	}
+	return n, err
+}
+
+// base64Writer decodes the base64 written to it into w.
+type base64Writer struct {
+	w   io.Writer
+	buf []byte
+}
+
+func (w *base64Writer) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b == '\n' || b == '\r' || b == ' ' || b == '\t' {
+			continue
+		}
+		w.buf = append(w.buf, b)
+	}
+
+	// decode complete quantums only
+	n := len(w.buf) / 4 * 4
+	if n == 0 {
+		return len(p), nil
+	}
+
+	dst := make([]byte, base64.StdEncoding.DecodedLen(n))
+	m, err := base64.StdEncoding.Decode(dst, w.buf[:n])
+	if err != nil {
+		return 0, err
+	}
+	w.buf = append(w.buf[:0], w.buf[n:]...)
+
+	_, err = w.w.Write(dst[:m])
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *base64Writer) Close() error {
+	if len(w.buf) > 0 {
+		return base64.CorruptInputError(len(w.buf))
+	}
+	return nil
+}
+
+// contentFilter passes the xml read from r on, except for the text of
+// <content> elements which is written to w instead.
+type contentFilter struct {
+	r         *bufio.Reader
+	w         io.WriteCloser
+	inContent bool
+	// tag holds the bytes since the last '<' outside of content
+	tag     []byte
+	pending []byte
+	err     error
+}
+
+func (f *contentFilter) Read(p []byte) (int, error) {
+	for len(f.pending) == 0 {
+		if f.err != nil {
+			return 0, f.err
+		}
+		f.fill()
+	}
+
+	n := copy(p, f.pending)
+	f.pending = f.pending[n:]
+	return n, nil
+}
+
+func (f *contentFilter) fill() {
+	if f.inContent {
+		chunk, err := f.r.ReadSlice('<')
+		data := chunk
+		if err == nil {
+			data = chunk[:len(chunk)-1]
+		}
+
+		_, werr := f.w.Write(data)
+		if werr != nil {
+			f.err = werr
+			return
+		}
+
+		switch err {
+		case nil:
+			f.inContent = false
+			f.tag = append(f.tag[:0], '<')
+			f.pending = []byte{'<'}
+			f.err = f.w.Close()
+		case bufio.ErrBufferFull:
+		default:
+			f.err = err
+		}
+		return
+	}
+
+	chunk, err := f.r.ReadSlice('>')
+	f.pending = append(f.pending[:0], chunk...)
+
+	if i := bytes.LastIndexByte(chunk, '<'); i >= 0 {
+		f.tag = append(f.tag[:0], chunk[i:]...)
+	} else if len(f.tag) > 0 && len(f.tag) < 1024 {
+		f.tag = append(f.tag, chunk...)
+	}
+
+	if err == bufio.ErrBufferFull {
+		return
+	}
+	if err != nil {
+		f.err = err
+		return
+	}
+
+	f.inContent = isContentStart(f.tag)
+	f.tag = f.tag[:0]
+}
+
+// isContentStart reports whether tag (from '<' up to and including '>') opens
+// a content element.
+func isContentStart(tag []byte) bool {
+	if len(tag) < 3 || tag[0] != '<' || tag[len(tag)-1] != '>' {
+		return false
+	}
+	tag = tag[1 : len(tag)-1]
+	if len(tag) == 0 || tag[0] == '/' || tag[0] == '?' || tag[0] == '!' || tag[len(tag)-1] == '/' {
+		return false
+	}
+
+	name := tag
+	if i := bytes.IndexAny(name, " \t\r\n"); i >= 0 {
+		name = name[:i]
+	}
+	if i := bytes.LastIndexByte(name, ':'); i >= 0 {
+		name = name[i+1:]
+	}
+	return string(name) == "content"
+}
@@ -0,0 +1,81 @@
+package soap_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/omniboost/go-netsuite-rest/soap"
+)
+
+func TestAddFileFrom(t *testing.T) {
+	content := make([]byte, 100*1024)
+	rand.Read(content)
+
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		m := regexp.MustCompile(`<content xmlns="[^"]+">([^<]*)</content>`).FindSubmatch(b)
+		if m == nil {
+			t.Errorf("expected content element, got %s", b)
+		} else if string(m[1]) != base64.StdEncoding.EncodeToString(content) {
+			t.Error("unexpected content")
+		}
+
+		w.Write([]byte(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><addResponse><writeResponse><status isSuccess="true"/><baseRef internalId="1234" type="file"/></writeResponse></addResponse></soapenv:Body></soapenv:Envelope>`))
+	})
+
+	var transferred int64
+	id, err := client.AddFileFrom(context.Background(), soap.File{Name: "large.bin"}, bytes.NewReader(content), func(n int64) {
+		transferred = n
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "1234" || transferred != int64(len(content)) {
+		t.Errorf("unexpected id %s or progress %d", id, transferred)
+	}
+}
+
+func TestGetFileTo(t *testing.T) {
+	content := make([]byte, 100*1024)
+	rand.Read(content)
+
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <getResponse xmlns="urn:messages_2021_2.platform.webservices.netsuite.com">
+      <readResponse>
+        <platformCore:status isSuccess="true" xmlns:platformCore="urn:core_2021_2.platform.webservices.netsuite.com"/>
+        <record internalId="1234" xmlns:docFileCab="urn:filecabinet_2021_2.documents.webservices.netsuite.com">
+          <docFileCab:name>large.bin</docFileCab:name>
+          <docFileCab:content>%s</docFileCab:content>
+          <docFileCab:fileSize>102400.0</docFileCab:fileSize>
+        </record>
+      </readResponse>
+    </getResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`, base64.StdEncoding.EncodeToString(content))
+	})
+
+	buf := new(bytes.Buffer)
+	var transferred int64
+	f, err := client.GetFileTo(context.Background(), "1234", buf, func(n int64) {
+		transferred = n
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if f.Name != "large.bin" || f.FileSize != 102400 || f.Content != "" {
+		t.Errorf("unexpected file: %+v", f)
+	}
+	if !bytes.Equal(buf.Bytes(), content) || transferred != int64(len(content)) {
+		t.Errorf("unexpected content of %d bytes (progress %d)", buf.Len(), transferred)
+	}
+}
@@ -0,0 +1,99 @@
+package soap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RecordRef references a record by internal id (or external id)
+type RecordRef struct {
+	InternalID string `xml:"internalId,attr,omitempty"`
+	ExternalID string `xml:"externalId,attr,omitempty"`
+	Type       string `xml:"type,attr,omitempty"`
+	Name       string `xml:"urn:core_2021_2.platform.webservices.netsuite.com name,omitempty"`
+}
+
+func NewRecordRef(recordType, internalID string) *RecordRef {
+	return &RecordRef{Type: recordType, InternalID: internalID}
+}
+
+type Status struct {
+	IsSuccess     bool           `xml:"isSuccess,attr"`
+	StatusDetails []StatusDetail `xml:"statusDetail"`
+}
+
+type StatusDetail struct {
+	Type    string `xml:"type,attr"`
+	Code    string `xml:"code"`
+	Message string `xml:"message"`
+}
+
+// Err returns the status as *StatusError when the operation wasn't successful.
+func (s Status) Err() error {
+	if s.IsSuccess {
+		return nil
+	}
+	return &StatusError{Status: s}
+}
+
+// StatusError is returned when NetSuite processed the request but the
+// operation failed
+type StatusError struct {
+	Status Status
+}
+
+func (e *StatusError) Error() string {
+	errors := []string{}
+	for _, d := range e.Status.StatusDetails {
+		if d.Type == "ERROR" || d.Type == "" {
+			errors = append(errors, fmt.Sprintf("%s: %s", d.Code, d.Message))
+		}
+	}
+	if len(errors) == 0 {
+		return "operation failed"
+	}
+	return strings.Join(errors, "\r\n")
+}
+
+// Fault is a SOAP fault, e.g. an invalid login or an invalid request
+type Fault struct {
+	// HTTP response that caused this error
+	Response *http.Response `xml:"-"`
+
+	FaultCode   string `xml:"faultcode"`
+	FaultString string `xml:"faultstring"`
+	// Detail holds the NetSuite faults, e.g. invalidCredentialsFault
+	Detail struct {
+		Faults []FaultDetail `xml:",any"`
+	} `xml:"detail"`
+}
+
+type FaultDetail struct {
+	XMLName xml.Name
+	Code    string `xml:"code"`
+	Message string `xml:"message"`
+}
+
+// Code returns the NetSuite error code of the fault, e.g.
+// INVALID_LOGIN_ATTEMPT.
+func (f *Fault) Code() string {
+	for _, d := range f.Detail.Faults {
+		if d.Code != "" {
+			return d.Code
+		}
+	}
+	return ""
+}
+
+func (f *Fault) Error() string {
+	if code := f.Code(); code != "" {
+		return fmt.Sprintf("%s: %s", code, f.FaultString)
+	}
+	return fmt.Sprintf("%s: %s", f.FaultCode, f.FaultString)
+}
+
+type RecordRefList struct {
+	RecordRefs []RecordRef `xml:"urn:core_2021_2.platform.webservices.netsuite.com recordRef"`
+}
@@ -0,0 +1,109 @@
+package netsuite
+
+import (
+	"encoding/json"
+
+	"github.com/cydev/zero"
+)
+
+// SublistLine is implemented by sublist lines that can be identified by their
+// line key (usually the "line" or "id" property NetSuite returns).
+type SublistLine interface {
+	LineID() string
+}
+
+// Sublist is a NetSuite sublist ({"items": [...]}) with line level
+// operations.
+//
+// NetSuite merges sublist lines on PATCH by their line key: lines without a key
+// are added and lines with a key are updated. Removing a line is only possible
+// by sending the complete sublist and listing the sublist in the "replace"
+// query parameter. RemoveLineByID marks the sublist for replacement, use
+// NeedsReplace to find out whether the sublist should be added to
+// QueryParams().Replace of the patch request.
+type Sublist[T SublistLine] struct {
+	Links        Links `json:"links,omitempty"`
+	Items        []T   `json:"items"`
+	TotalResults int   `json:"totalResults,omitempty"`
+
+	replace bool
+}
+
+func (s Sublist[T]) IsEmpty() bool {
+	if s.replace {
+		return false
+	}
+	return zero.IsZero(s.Items)
+}
+
+// MarshalJSON only writes the items wrapper: links and totalResults are read
+// only and rejected by NetSuite on writes.
+func (s Sublist[T]) MarshalJSON() ([]byte, error) {
+	items := s.Items
+	if items == nil {
+		items = []T{}
+	}
+
+	return json.Marshal(struct {
+		Items []T `json:"items"`
+	}{Items: items})
+}
+
+// AddLine appends a new line to the sublist. New lines should not have a line
+// key set.
+func (s *Sublist[T]) AddLine(line T) {
+	s.Items = append(s.Items, line)
+}
+
+// Line returns the line with the given line key.
+func (s Sublist[T]) Line(id string) (T, bool) {
+	i := s.index(id)
+	if i == -1 {
+		var empty T
+		return empty, false
+	}
+	return s.Items[i], true
+}
+
+// UpdateLineByID replaces the line with the given line key. It returns false
+// when no such line exists.
+func (s *Sublist[T]) UpdateLineByID(id string, line T) bool {
+	i := s.index(id)
+	if i == -1 {
+		return false
+	}
+	s.Items[i] = line
+	return true
+}
+
+// RemoveLineByID removes the line with the given line key and marks the
+// sublist for replacement. It returns false when no such line exists.
+func (s *Sublist[T]) RemoveLineByID(id string) bool {
+	i := s.index(id)
+	if i == -1 {
+		return false
+	}
+	s.Items = append(s.Items[:i], s.Items[i+1:]...)
+	s.replace = true
+	return true
+}
+
+// SetReplace marks the sublist to replace all existing lines on PATCH.
+func (s *Sublist[T]) SetReplace(replace bool) {
+	s.replace = replace
+}
+
+// NeedsReplace reports whether the sublist has to be listed in the "replace"
+// query parameter of the patch request for the changes to be applied.
+func (s Sublist[T]) NeedsReplace() bool {
+	return s.replace
+}
+
+func (s Sublist[T]) index(id string) int {
+	for i, l := range s.Items {
+		if l.LineID() == id {
+			return i
+		}
+	}
+	return -1
+}
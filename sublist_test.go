@@ -0,0 +1,85 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+type sublistTestLine struct {
+	Line int    `json:"line,omitempty"`
+	Memo string `json:"memo,omitempty"`
+}
+
+func (l sublistTestLine) LineID() string {
+	return strconv.Itoa(l.Line)
+}
+
+func TestSublist(t *testing.T) {
+	sublist := netsuite.Sublist[sublistTestLine]{}
+	err := json.Unmarshal([]byte(`{"links":[],"items":[{"line":1,"memo":"a"},{"line":2,"memo":"b"}],"totalResults":2}`), &sublist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sublist.AddLine(sublistTestLine{Memo: "c"})
+	sublist.UpdateLineByID("1", sublistTestLine{Line: 1, Memo: "A"})
+	if sublist.NeedsReplace() {
+		t.Error("expected sublist without removed lines to be merged")
+	}
+
+	if !sublist.RemoveLineByID("2") {
+		t.Error("expected line 2 to be removed")
+	}
+	if !sublist.NeedsReplace() {
+		t.Error("expected sublist with removed lines to be replaced")
+	}
+
+	b, err := json.Marshal(sublist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"items":[{"line":1,"memo":"A"},{"memo":"c"}]}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, string(b))
+	}
+}
+
+func TestSublistInvoiceRoundTrip(t *testing.T) {
+	invoice := netsuite.Invoice{}
+	err := json.Unmarshal([]byte(`{"id":"7","item":{"links":[{"rel":"self","href":"https://x/invoice/7/item"}],"totalResults":1,"items":[{"links":[{"rel":"self","href":"https://x/invoice/7/item/1"}],"line":1,"description":"Room"}]}}`), &invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line, ok := invoice.Item.Line("1"); !ok || line.Description != "Room" {
+		t.Fatalf("expected line 1, got %+v", line)
+	}
+
+	b, err := json.Marshal(invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	props := map[string]json.RawMessage{}
+	json.Unmarshal(b, &props)
+	item := map[string]interface{}{}
+	json.Unmarshal(props["item"], &item)
+	if _, ok := item["links"]; ok || item["totalResults"] != nil {
+		t.Errorf("expected only the items of the sublist, got %s", props["item"])
+	}
+	if strings.Contains(string(props["item"]), "links") {
+		t.Errorf("expected no line links, got %s", props["item"])
+	}
+
+	je := netsuite.JournalEntry{}
+	b, err = json.Marshal(je)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"line"`) {
+		t.Errorf("expected the empty line sublist to be left out, got %s", b)
+	}
+}
@@ -0,0 +1,81 @@
+package netsuite
+
+// Subrecords (the inventory detail of a line, the billing and shipping address
+// of a transaction) are written nested in their parent record. NetSuite
+// rejects the read only links and totalResults of a subrecord on writes, so
+// InventoryDetail, InventoryDetailAssignments, InventoryDetailAssignment and
+// AddressBookAddress leave them out when marshaled: a subrecord that was read
+// can be sent back as is.
+//
+// NetSuite replaces a subrecord as a whole on PATCH, so an update should
+// contain every assignment of the inventory detail, not just the changed
+// ones.
+
+// NewInventoryDetail returns the inventory detail of a line with the given
+// assignments; its quantity is the sum of the assignments.
+func NewInventoryDetail(assignments ...InventoryDetailAssignment) InventoryDetail {
+	d := InventoryDetail{}
+	for _, a := range assignments {
+		d.Assign(a)
+	}
+	return d
+}
+
+// Assign adds an assignment to the inventory detail and adds its quantity to
+// the quantity of the detail.
+func (i *InventoryDetail) Assign(a InventoryDetailAssignment) {
+	i.InventoryAssignment.Items = append(i.InventoryAssignment.Items, a)
+	i.Quantity += a.Quantity
+}
+
+// Assignments returns the assignments of the inventory detail.
+func (i InventoryDetail) Assignments() InventoryDetailAssignmentItems {
+	return i.InventoryAssignment.Items
+}
+
+// ReceiptLot assigns quantity to a new or existing lot number, on lines that
+// receive inventory (item receipts, positive adjustments).
+func ReceiptLot(lot string, quantity float64) InventoryDetailAssignment {
+	return InventoryDetailAssignment{ReceiptInventoryNumber: lot, Quantity: quantity}
+}
+
+// ReceiptSerials assigns one unit to each of the serial numbers, on lines that
+// receive inventory.
+func ReceiptSerials(serials ...string) []InventoryDetailAssignment {
+	assignments := make([]InventoryDetailAssignment, len(serials))
+	for i, s := range serials {
+		assignments[i] = ReceiptLot(s, 1)
+	}
+	return assignments
+}
+
+// IssueLot assigns quantity to an existing lot number, referenced by the
+// internal id of the inventory number, on lines that issue inventory (item
+// fulfillments, negative adjustments).
+func IssueLot(inventoryNumberID string, quantity float64) InventoryDetailAssignment {
+	return InventoryDetailAssignment{IssueInventoryNumber: NewRecordRef(inventoryNumberID), Quantity: quantity}
+}
+
+// IssueSerials assigns one unit to each of the existing serial numbers,
+// referenced by the internal ids of their inventory numbers, on lines that
+// issue inventory.
+func IssueSerials(inventoryNumberIDs ...string) []InventoryDetailAssignment {
+	assignments := make([]InventoryDetailAssignment, len(inventoryNumberIDs))
+	for i, id := range inventoryNumberIDs {
+		assignments[i] = IssueLot(id, 1)
+	}
+	return assignments
+}
+
+// InBin returns a copy of the assignment placed in the bin with the given
+// internal id.
+func (i InventoryDetailAssignment) InBin(binID string) InventoryDetailAssignment {
+	i.BinNumber = NewRecordRef(binID)
+	return i
+}
+
+// BinAssignment assigns quantity to the bin with the given internal id, for
+// items that use bins but no lot or serial numbers.
+func BinAssignment(binID string, quantity float64) InventoryDetailAssignment {
+	return InventoryDetailAssignment{BinNumber: NewRecordRef(binID), Quantity: quantity}
+}
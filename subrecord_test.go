@@ -0,0 +1,89 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestInventoryDetailMarshal(t *testing.T) {
+	detail := netsuite.InventoryDetail{}
+	err := json.Unmarshal([]byte(`{
+		"links": [{"rel": "self", "href": "https://example.com/inventoryDetail"}],
+		"inventoryAssignment": {
+			"links": [],
+			"items": [{"links": [], "receiptInventoryNumber": "LOT1", "quantity": 2, "binNumber": {"links": [], "id": "5", "refName": "A-1"}}],
+			"totalResults": 1
+		},
+		"quantity": 2
+	}`), &detail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	detail.Assign(netsuite.ReceiptLot("LOT2", 3).InBin("6"))
+
+	b, err := json.Marshal(detail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"inventoryAssignment":{"items":[{"binNumber":{"id":"5"},"quantity":2,"receiptInventoryNumber":"LOT1"},{"binNumber":{"id":"6"},"quantity":3,"receiptInventoryNumber":"LOT2"}]},"quantity":5}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+func TestNewInventoryDetail(t *testing.T) {
+	detail := netsuite.NewInventoryDetail(netsuite.IssueSerials("101", "102")...)
+	if detail.Quantity != 2 || len(detail.Assignments()) != 2 {
+		t.Fatalf("expected 2 serials, got %+v", detail)
+	}
+
+	line := netsuite.TransferOrderItem{InventoryDetail: detail}
+	b, err := json.Marshal(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]json.RawMessage{}
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"inventoryAssignment":{"items":[{"issueInventoryNumber":{"id":"101"},"quantity":1},{"issueInventoryNumber":{"id":"102"},"quantity":1}]},"quantity":2}`
+	if string(got["inventoryDetail"]) != want {
+		t.Errorf("expected %s, got %s", want, got["inventoryDetail"])
+	}
+}
+
+func TestTransactionAddressMarshal(t *testing.T) {
+	invoice := netsuite.Invoice{}
+	err := json.Unmarshal([]byte(`{
+		"billingAddress": {"links": [{"rel": "self", "href": "https://example.com/billingAddress"}], "addr1": "Main St 1", "addrText": "Main St 1\nAmsterdam", "city": "Amsterdam", "override": false}
+	}`), &invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.BillingAddress.City != "Amsterdam" {
+		t.Fatalf("expected billing address to be read, got %+v", invoice.BillingAddress)
+	}
+
+	b, err := json.Marshal(invoice.BillingAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"addr1":"Main St 1","city":"Amsterdam"}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+
+	invoice.ShippingAddress = netsuite.AddressBookAddress{AddrText: "Dock 4", Override: true}
+	b, err = json.Marshal(invoice.ShippingAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `{"addrText":"Dock 4","override":true}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionDeleteRequest() SubscriptionDeleteRequest {
+	r := SubscriptionDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionDeleteRequest struct {
+	client      *Client
+	queryParams *SubscriptionDeleteRequestQueryParams
+	pathParams  *SubscriptionDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionDeleteRequestBody
+}
+
+func (r SubscriptionDeleteRequest) NewQueryParams() *SubscriptionDeleteRequestQueryParams {
+	return &SubscriptionDeleteRequestQueryParams{}
+}
+
+type SubscriptionDeleteRequestQueryParams struct {
+}
+
+func (p SubscriptionDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionDeleteRequest) QueryParams() *SubscriptionDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionDeleteRequest) NewPathParams() *SubscriptionDeleteRequestPathParams {
+	return &SubscriptionDeleteRequestPathParams{}
+}
+
+type SubscriptionDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionDeleteRequest) PathParams() *SubscriptionDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionDeleteRequest) NewRequestBody() SubscriptionDeleteRequestBody {
+	return SubscriptionDeleteRequestBody{}
+}
+
+type SubscriptionDeleteRequestBody struct {
+}
+
+func (r *SubscriptionDeleteRequest) RequestBody() *SubscriptionDeleteRequestBody {
+	return nil
+}
+
+func (r *SubscriptionDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SubscriptionDeleteRequest) SetRequestBody(body SubscriptionDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionDeleteRequest) NewResponseBody() *SubscriptionDeleteResponseBody {
+	return &SubscriptionDeleteResponseBody{}
+}
+
+type SubscriptionDeleteResponseBody struct {
+}
+
+func (r *SubscriptionDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscription/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionDeleteRequest) Do(ctx context.Context) (SubscriptionDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
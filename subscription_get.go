@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionGetRequest() SubscriptionGetRequest {
+	r := SubscriptionGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionGetRequest struct {
+	client      *Client
+	queryParams *SubscriptionGetRequestQueryParams
+	pathParams  *SubscriptionGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionGetRequestBody
+}
+
+func (r SubscriptionGetRequest) NewQueryParams() *SubscriptionGetRequestQueryParams {
+	return &SubscriptionGetRequestQueryParams{}
+}
+
+type SubscriptionGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p SubscriptionGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionGetRequest) QueryParams() *SubscriptionGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionGetRequest) NewPathParams() *SubscriptionGetRequestPathParams {
+	return &SubscriptionGetRequestPathParams{}
+}
+
+type SubscriptionGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionGetRequest) PathParams() *SubscriptionGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionGetRequest) NewRequestBody() SubscriptionGetRequestBody {
+	return SubscriptionGetRequestBody{}
+}
+
+type SubscriptionGetRequestBody struct {
+}
+
+func (r *SubscriptionGetRequest) RequestBody() *SubscriptionGetRequestBody {
+	return nil
+}
+
+func (r *SubscriptionGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SubscriptionGetRequest) SetRequestBody(body SubscriptionGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionGetRequest) NewResponseBody() *SubscriptionGetResponseBody {
+	return &SubscriptionGetResponseBody{}
+}
+
+type SubscriptionGetResponseBody struct {
+	Links Links `json:"links"`
+	Subscription
+}
+
+func (r *SubscriptionGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscription/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionGetRequest) Do(ctx context.Context) (SubscriptionGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
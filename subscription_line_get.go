@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionLineGetRequest() SubscriptionLineGetRequest {
+	r := SubscriptionLineGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionLineGetRequest struct {
+	client      *Client
+	queryParams *SubscriptionLineGetRequestQueryParams
+	pathParams  *SubscriptionLineGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionLineGetRequestBody
+}
+
+func (r SubscriptionLineGetRequest) NewQueryParams() *SubscriptionLineGetRequestQueryParams {
+	return &SubscriptionLineGetRequestQueryParams{}
+}
+
+type SubscriptionLineGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p SubscriptionLineGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionLineGetRequest) QueryParams() *SubscriptionLineGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionLineGetRequest) NewPathParams() *SubscriptionLineGetRequestPathParams {
+	return &SubscriptionLineGetRequestPathParams{}
+}
+
+type SubscriptionLineGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionLineGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionLineGetRequest) PathParams() *SubscriptionLineGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLineGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLineGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionLineGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionLineGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionLineGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionLineGetRequest) NewRequestBody() SubscriptionLineGetRequestBody {
+	return SubscriptionLineGetRequestBody{}
+}
+
+type SubscriptionLineGetRequestBody struct {
+}
+
+func (r *SubscriptionLineGetRequest) RequestBody() *SubscriptionLineGetRequestBody {
+	return nil
+}
+
+func (r *SubscriptionLineGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SubscriptionLineGetRequest) SetRequestBody(body SubscriptionLineGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionLineGetRequest) NewResponseBody() *SubscriptionLineGetResponseBody {
+	return &SubscriptionLineGetResponseBody{}
+}
+
+type SubscriptionLineGetResponseBody struct {
+	Links Links `json:"links"`
+	SubscriptionLine
+}
+
+func (r *SubscriptionLineGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscriptionLine/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionLineGetRequest) Do(ctx context.Context) (SubscriptionLineGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionLinePatchRequest() SubscriptionLinePatchRequest {
+	r := SubscriptionLinePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionLinePatchRequest struct {
+	client      *Client
+	queryParams *SubscriptionLinePatchRequestQueryParams
+	pathParams  *SubscriptionLinePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionLinePatchRequestBody
+}
+
+func (r SubscriptionLinePatchRequest) NewQueryParams() *SubscriptionLinePatchRequestQueryParams {
+	return &SubscriptionLinePatchRequestQueryParams{}
+}
+
+type SubscriptionLinePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p SubscriptionLinePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionLinePatchRequest) QueryParams() *SubscriptionLinePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionLinePatchRequest) NewPathParams() *SubscriptionLinePatchRequestPathParams {
+	return &SubscriptionLinePatchRequestPathParams{}
+}
+
+type SubscriptionLinePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionLinePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionLinePatchRequest) PathParams() *SubscriptionLinePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLinePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLinePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionLinePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionLinePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionLinePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionLinePatchRequest) NewRequestBody() SubscriptionLinePatchRequestBody {
+	return SubscriptionLinePatchRequestBody{}
+}
+
+type SubscriptionLinePatchRequestBody struct {
+	SubscriptionLine
+}
+
+func (r *SubscriptionLinePatchRequest) RequestBody() *SubscriptionLinePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *SubscriptionLinePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *SubscriptionLinePatchRequest) SetRequestBody(body SubscriptionLinePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionLinePatchRequest) NewResponseBody() *SubscriptionLinePatchResponseBody {
+	return &SubscriptionLinePatchResponseBody{}
+}
+
+type SubscriptionLinePatchResponseBody struct {
+}
+
+func (r *SubscriptionLinePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscriptionLine/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionLinePatchRequest) Do(ctx context.Context) (SubscriptionLinePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
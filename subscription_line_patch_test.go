@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSubscriptionLinePatch(t *testing.T) {
+	req := client.NewSubscriptionLinePatchRequest()
+	req.PathParams().ID = 57
+	req.RequestBody().IncludeInRenewal = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
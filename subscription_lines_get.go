@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionLinesGetRequest() SubscriptionLinesGetRequest {
+	r := SubscriptionLinesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionLinesGetRequest struct {
+	client      *Client
+	queryParams *SubscriptionLinesGetRequestQueryParams
+	pathParams  *SubscriptionLinesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionLinesGetRequestBody
+}
+
+func (r SubscriptionLinesGetRequest) NewQueryParams() *SubscriptionLinesGetRequestQueryParams {
+	return &SubscriptionLinesGetRequestQueryParams{}
+}
+
+type SubscriptionLinesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p SubscriptionLinesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionLinesGetRequest) QueryParams() *SubscriptionLinesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionLinesGetRequest) NewPathParams() *SubscriptionLinesGetRequestPathParams {
+	return &SubscriptionLinesGetRequestPathParams{}
+}
+
+type SubscriptionLinesGetRequestPathParams struct {
+}
+
+func (p *SubscriptionLinesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SubscriptionLinesGetRequest) PathParams() *SubscriptionLinesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLinesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionLinesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionLinesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionLinesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionLinesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionLinesGetRequest) NewRequestBody() SubscriptionLinesGetRequestBody {
+	return SubscriptionLinesGetRequestBody{}
+}
+
+type SubscriptionLinesGetRequestBody struct {
+}
+
+func (r *SubscriptionLinesGetRequest) RequestBody() *SubscriptionLinesGetRequestBody {
+	return nil
+}
+
+func (r *SubscriptionLinesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SubscriptionLinesGetRequest) SetRequestBody(body SubscriptionLinesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionLinesGetRequest) NewResponseBody() *SubscriptionLinesGetResponseBody {
+	return &SubscriptionLinesGetResponseBody{}
+}
+
+type SubscriptionLinesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *SubscriptionLinesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscriptionLine", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionLinesGetRequest) Do(ctx context.Context) (SubscriptionLinesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
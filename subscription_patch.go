@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionPatchRequest() SubscriptionPatchRequest {
+	r := SubscriptionPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionPatchRequest struct {
+	client      *Client
+	queryParams *SubscriptionPatchRequestQueryParams
+	pathParams  *SubscriptionPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionPatchRequestBody
+}
+
+func (r SubscriptionPatchRequest) NewQueryParams() *SubscriptionPatchRequestQueryParams {
+	return &SubscriptionPatchRequestQueryParams{}
+}
+
+type SubscriptionPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p SubscriptionPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionPatchRequest) QueryParams() *SubscriptionPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionPatchRequest) NewPathParams() *SubscriptionPatchRequestPathParams {
+	return &SubscriptionPatchRequestPathParams{}
+}
+
+type SubscriptionPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionPatchRequest) PathParams() *SubscriptionPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionPatchRequest) NewRequestBody() SubscriptionPatchRequestBody {
+	return SubscriptionPatchRequestBody{}
+}
+
+type SubscriptionPatchRequestBody struct {
+	Subscription
+}
+
+func (r *SubscriptionPatchRequest) RequestBody() *SubscriptionPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *SubscriptionPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *SubscriptionPatchRequest) SetRequestBody(body SubscriptionPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionPatchRequest) NewResponseBody() *SubscriptionPatchResponseBody {
+	return &SubscriptionPatchResponseBody{}
+}
+
+type SubscriptionPatchResponseBody struct {
+}
+
+func (r *SubscriptionPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscription/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionPatchRequest) Do(ctx context.Context) (SubscriptionPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
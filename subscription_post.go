@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionPostRequest() SubscriptionPostRequest {
+	r := SubscriptionPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionPostRequest struct {
+	client      *Client
+	queryParams *SubscriptionPostRequestQueryParams
+	pathParams  *SubscriptionPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionPostRequestBody
+}
+
+func (r SubscriptionPostRequest) NewQueryParams() *SubscriptionPostRequestQueryParams {
+	return &SubscriptionPostRequestQueryParams{}
+}
+
+type SubscriptionPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p SubscriptionPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionPostRequest) QueryParams() *SubscriptionPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionPostRequest) NewPathParams() *SubscriptionPostRequestPathParams {
+	return &SubscriptionPostRequestPathParams{}
+}
+
+type SubscriptionPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SubscriptionPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SubscriptionPostRequest) PathParams() *SubscriptionPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionPostRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionPostRequest) NewRequestBody() SubscriptionPostRequestBody {
+	return SubscriptionPostRequestBody{}
+}
+
+type SubscriptionPostRequestBody struct {
+	Subscription
+}
+
+func (r *SubscriptionPostRequest) RequestBody() *SubscriptionPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *SubscriptionPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *SubscriptionPostRequest) SetRequestBody(body SubscriptionPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionPostRequest) NewResponseBody() *SubscriptionPostResponseBody {
+	return &SubscriptionPostResponseBody{}
+}
+
+type SubscriptionPostResponseBody struct {
+}
+
+func (r *SubscriptionPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscription", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionPostRequest) Do(ctx context.Context) (SubscriptionPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,24 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSubscriptionPost(t *testing.T) {
+	req := client.NewSubscriptionPostRequest()
+	req.RequestBody().Subscription.ID = "70202"
+	req.RequestBody().BillingAccount.ID = "5"
+	req.RequestBody().SubscriptionPlan.ID = "204"
+	req.RequestBody().PriceBook.ID = "3"
+	req.RequestBody().InitialTerm.ID = "1"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSubscriptionsGetRequest() SubscriptionsGetRequest {
+	r := SubscriptionsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SubscriptionsGetRequest struct {
+	client      *Client
+	queryParams *SubscriptionsGetRequestQueryParams
+	pathParams  *SubscriptionsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SubscriptionsGetRequestBody
+}
+
+func (r SubscriptionsGetRequest) NewQueryParams() *SubscriptionsGetRequestQueryParams {
+	return &SubscriptionsGetRequestQueryParams{}
+}
+
+type SubscriptionsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p SubscriptionsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SubscriptionsGetRequest) QueryParams() *SubscriptionsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SubscriptionsGetRequest) NewPathParams() *SubscriptionsGetRequestPathParams {
+	return &SubscriptionsGetRequestPathParams{}
+}
+
+type SubscriptionsGetRequestPathParams struct {
+}
+
+func (p *SubscriptionsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SubscriptionsGetRequest) PathParams() *SubscriptionsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SubscriptionsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SubscriptionsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SubscriptionsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubscriptionsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SubscriptionsGetRequest) NewRequestBody() SubscriptionsGetRequestBody {
+	return SubscriptionsGetRequestBody{}
+}
+
+type SubscriptionsGetRequestBody struct {
+}
+
+func (r *SubscriptionsGetRequest) RequestBody() *SubscriptionsGetRequestBody {
+	return nil
+}
+
+func (r *SubscriptionsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SubscriptionsGetRequest) SetRequestBody(body SubscriptionsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SubscriptionsGetRequest) NewResponseBody() *SubscriptionsGetResponseBody {
+	return &SubscriptionsGetResponseBody{}
+}
+
+type SubscriptionsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *SubscriptionsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/subscription", r.PathParams())
+	return &u, err
+}
+
+func (r *SubscriptionsGetRequest) Do(ctx context.Context) (SubscriptionsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *SubsidiaryGetRequest) Method() string {
 	return r.method
 }
 
+func (r *SubsidiaryGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SubsidiaryGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r SubsidiaryGetRequest) NewRequestBody() SubsidiaryGetRequestBody {
 	return SubsidiaryGetRequestBody{}
 }
@@ -115,9 +124,9 @@ func (r *SubsidiaryGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *SubsidiaryGetRequest) Do() (SubsidiaryGetResponseBody, error) {
+func (r *SubsidiaryGetRequest) Do(ctx context.Context) (SubsidiaryGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
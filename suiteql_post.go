@@ -2,6 +2,7 @@ package netsuite
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -90,6 +91,14 @@ func (r *SuiteqlPostRequest) Method() string {
 	return r.method
 }
 
+func (r *SuiteqlPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SuiteqlPostRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r SuiteqlPostRequest) NewRequestBody() SuiteqlPostRequestBody {
 	return SuiteqlPostRequestBody{}
 }
@@ -118,7 +127,7 @@ type SuiteqlPostResponseBody struct {
 	Links        Links           `json:"links"`
 	Count        int             `json:"count"`
 	HasMore      bool            `json:"hasMore"`
-	Items        json.RawMessage `json::"items"`
+	Items        json.RawMessage `json:"items"`
 	Offset       int             `json:"offset"`
 	TotalResults int             `json:"totalResults"`
 }
@@ -128,10 +137,14 @@ func (r SuiteqlPostResponseBody) ToCustomers(client *Client) (Customers, error)
 
 	reader := bytes.NewReader(r.Items)
 	dec := json.NewDecoder(reader)
-	if client.disallowUnknownFields {
+	if client.DisallowUnknownFields() {
 		dec.DisallowUnknownFields()
 	}
 	err := dec.Decode(&items)
+	if err == nil && client.DisallowUnknownFields() {
+		// customers decode themselves to capture their custom fields
+		err = checkUnknownFields(r.Items, &items)
+	}
 	return items, err
 }
 
@@ -140,7 +153,7 @@ func (r *SuiteqlPostResponseBody) ToClassifications(client *Client) (Classificat
 
 	reader := bytes.NewReader(r.Items)
 	dec := json.NewDecoder(reader)
-	if client.disallowUnknownFields {
+	if client.DisallowUnknownFields() {
 		dec.DisallowUnknownFields()
 	}
 	err := dec.Decode(&items)
@@ -152,7 +165,7 @@ func (r *SuiteqlPostResponseBody) ToDepartments(client *Client) (Departments, er
 
 	reader := bytes.NewReader(r.Items)
 	dec := json.NewDecoder(reader)
-	if client.disallowUnknownFields {
+	if client.DisallowUnknownFields() {
 		dec.DisallowUnknownFields()
 	}
 	err := dec.Decode(&items)
@@ -176,7 +189,19 @@ func (r *SuiteqlPostResponseBody) ToLocations(client *Client) (Locations, error)
 
 	reader := bytes.NewReader(r.Items)
 	dec := json.NewDecoder(reader)
-	if client.disallowUnknownFields {
+	if client.DisallowUnknownFields() {
+		dec.DisallowUnknownFields()
+	}
+	err := dec.Decode(&items)
+	return items, err
+}
+
+func (r *SuiteqlPostResponseBody) ToCurrencyRates(client *Client) (CurrencyRates, error) {
+	items := CurrencyRates{}
+
+	reader := bytes.NewReader(r.Items)
+	dec := json.NewDecoder(reader)
+	if client.DisallowUnknownFields() {
 		dec.DisallowUnknownFields()
 	}
 	err := dec.Decode(&items)
@@ -188,9 +213,9 @@ func (r *SuiteqlPostRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *SuiteqlPostRequest) Do() (SuiteqlPostResponseBody, error) {
+func (r *SuiteqlPostRequest) Do(ctx context.Context) (SuiteqlPostResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
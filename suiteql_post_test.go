@@ -1,6 +1,7 @@
 package netsuite_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -18,7 +19,7 @@ func TestSuiteqlPost(t *testing.T) {
 	req.RequestBody().Q = "SELECT * FROM classification"
 
 	// req.RequestBody().Q = "SELECT * FROM classification where name like '%196%'"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -44,7 +45,7 @@ func TestSuiteqlPostCustomers(t *testing.T) {
 	req.RequestBody().Q = "SELECT * FROM customer where id IN(2607)"
 
 	// req.RequestBody().Q = "SELECT * FROM classification where name like '%196%'"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -65,7 +66,7 @@ func TestSuiteqlPostAddresses(t *testing.T) {
 	req.QueryParams().Limit = 12
 	req.RequestBody().Q = "SELECT * FROM EntityAddress where nkey IN (458404, 458304)"
 
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -85,7 +86,7 @@ func TestSuiteqlPostLocations(t *testing.T) {
 	req := client.NewSuiteqlPostRequest()
 	req.QueryParams().Limit = 100
 	req.RequestBody().Q = "SELECT * FROM location"
-	resp, err := req.Do()
+	resp, err := req.Do(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -100,3 +101,23 @@ func TestSuiteqlPostLocations(t *testing.T) {
 
 	log.Fatalf("%+v", customers[1])
 }
+
+func TestSuiteqlPostCurrencyRates(t *testing.T) {
+	req := client.NewSuiteqlPostRequest()
+	req.QueryParams().Limit = 100
+	req.RequestBody().Q = "SELECT * FROM currencyrate WHERE effectivedate >= '01.01.2022'"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	rates, err := resp.ToCurrencyRates(client)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(rates) == 0 {
+		t.Fatal("expected currency rates")
+	}
+	t.Logf("%+v", rates[0])
+}
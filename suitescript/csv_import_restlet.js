@@ -0,0 +1,65 @@
+/**
+ * RESTlet used by netsuite.CSVImport (csv_import.go) to upload CSV files to
+ * the File Cabinet, start saved CSV imports and report their status.
+ *
+ * Deploy it as a RESTlet and pass the script and deployment id to
+ * Client.NewCSVImport.
+ *
+ * @NApiVersion 2.1
+ * @NScriptType Restlet
+ */
+define(['N/file', 'N/task'], (file, task) => {
+    const error = (code, message) => ({ error: { code, message } });
+
+    const actions = {
+        upload: (body) => {
+            const f = file.create({
+                name: body.name,
+                fileType: file.Type.CSV,
+                contents: body.contents,
+                folder: body.folderId,
+            });
+            return { fileId: String(f.save()) };
+        },
+
+        submit: (body) => {
+            if (!body.mappingId) {
+                return error('SSS_MISSING_REQD_ARGUMENT', 'mappingId is required');
+            }
+
+            const t = task.create({ taskType: task.TaskType.CSV_IMPORT });
+            t.mappingId = body.mappingId;
+            t.importFile = file.load({ id: body.fileId });
+            return { taskId: t.submit() };
+        },
+
+        status: (body) => {
+            const s = task.checkStatus({ taskId: body.taskId });
+            return {
+                taskId: body.taskId,
+                status: String(s.status),
+                percentCompleted: s.getPercentageCompleted ? s.getPercentageCompleted() : 0,
+                // the response file is only available through the CSV import
+                // job status page; return its id when it has been stored
+                responseFileId: s.responseFileId ? String(s.responseFileId) : '',
+            };
+        },
+
+        file: (body) => ({ contents: file.load({ id: body.fileId }).getContents() }),
+    };
+
+    const post = (body) => {
+        const action = actions[body.action];
+        if (!action) {
+            return error('INVALID_ACTION', `unknown action ${body.action}`);
+        }
+
+        try {
+            return action(body);
+        } catch (e) {
+            return error(e.name || 'UNEXPECTED_ERROR', e.message);
+        }
+    };
+
+    return { post };
+});
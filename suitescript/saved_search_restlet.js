@@ -0,0 +1,71 @@
+/**
+ * RESTlet used by restlet.Script.SavedSearch (restlet/saved_search.go) to run
+ * saved searches.
+ *
+ * POST {"searchId": "customsearch_open_orders", "pageSize": 1000, "pageIndex": 0,
+ *       "filters": [{"name": "trandate", "operator": "onorafter", "values": ["1/1/2021"]}]}
+ *
+ * responds with
+ *
+ * {"pageIndex": 0, "pageCount": 3, "count": 2345,
+ *  "columns": [{"key": "customer.email", "name": "email", "join": "customer", "label": "Email", "summary": ""}],
+ *  "results": [{"id": "12", "recordType": "salesorder",
+ *               "values": {"customer.email": {"value": "info@example.com", "text": ""}}}]}
+ *
+ * Values are stored under the column name, prefixed with "{join}." for joined
+ * columns. Errors are returned as {"error": {"code": "...", "message": "..."}}.
+ *
+ * @NApiVersion 2.1
+ * @NScriptType Restlet
+ */
+define(['N/search', 'N/error'], (search, error) => {
+    const columnKey = (c) => (c.join ? `${c.join}.${c.name}` : c.name);
+
+    const post = (body) => {
+        if (!body.searchId) {
+            throw error.create({ name: 'SSS_MISSING_REQD_ARGUMENT', message: 'searchId is required' });
+        }
+
+        const s = search.load({ id: body.searchId });
+        (body.filters || []).forEach((f) => {
+            s.filters.push(search.createFilter({
+                name: f.name,
+                join: f.join || null,
+                operator: f.operator,
+                values: f.values || [],
+            }));
+        });
+
+        const columns = s.columns.map((c) => ({
+            key: columnKey(c),
+            name: c.name,
+            join: c.join || '',
+            label: c.label || '',
+            summary: c.summary || '',
+        }));
+
+        const paged = s.runPaged({ pageSize: body.pageSize || 1000 });
+        const pageIndex = body.pageIndex || 0;
+        const response = {
+            pageIndex,
+            pageCount: paged.pageRanges.length,
+            count: paged.count,
+            columns,
+            results: [],
+        };
+        if (pageIndex >= paged.pageRanges.length) {
+            return response;
+        }
+
+        paged.fetch({ index: pageIndex }).data.forEach((r) => {
+            const values = {};
+            s.columns.forEach((c) => {
+                values[columnKey(c)] = { value: r.getValue(c), text: r.getText(c) || '' };
+            });
+            response.results.push({ id: r.id, recordType: r.recordType, values });
+        });
+        return response;
+    };
+
+    return { post };
+});
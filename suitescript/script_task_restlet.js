@@ -0,0 +1,49 @@
+/**
+ * RESTlet used by restlet.Script.SubmitScriptTask and ScriptTaskStatus
+ * (restlet/script_task.go) to start map/reduce and scheduled scripts and report
+ * their status.
+ *
+ * POST {"taskType": "MAP_REDUCE", "scriptId": "customscript_mr_sync",
+ *       "deploymentId": "customdeploy_mr_sync", "params": {"custscript_date": "1/1/2021"}}
+ *   -> {"taskId": "MAPREDUCETASK_..."}
+ *
+ * GET ?taskId=MAPREDUCETASK_...
+ *   -> {"taskId": "...", "scriptId": "...", "deploymentId": "...",
+ *       "status": "PROCESSING", "stage": "MAP", "percentCompleted": 40}
+ *
+ * @NApiVersion 2.1
+ * @NScriptType Restlet
+ */
+define(['N/task', 'N/error'], (task, error) => {
+    const get = (params) => {
+        if (!params.taskId) {
+            throw error.create({ name: 'SSS_MISSING_REQD_ARGUMENT', message: 'taskId is required' });
+        }
+
+        const s = task.checkStatus({ taskId: params.taskId });
+        return {
+            taskId: params.taskId,
+            scriptId: s.scriptId || '',
+            deploymentId: s.deploymentId || '',
+            status: String(s.status),
+            stage: s.stage ? String(s.stage) : '',
+            percentCompleted: s.getPercentageCompleted ? s.getPercentageCompleted() : 0,
+        };
+    };
+
+    const post = (body) => {
+        if (!task.TaskType[body.taskType] || !['MAP_REDUCE', 'SCHEDULED_SCRIPT'].includes(body.taskType)) {
+            throw error.create({ name: 'INVALID_TASK_TYPE', message: `unsupported task type ${body.taskType}` });
+        }
+
+        const t = task.create({
+            taskType: task.TaskType[body.taskType],
+            scriptId: body.scriptId,
+            deploymentId: body.deploymentId || null,
+            params: body.params || {},
+        });
+        return { taskId: t.submit() };
+    };
+
+    return { get, post };
+});
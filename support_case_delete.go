@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSupportCaseDeleteRequest() SupportCaseDeleteRequest {
+	r := SupportCaseDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SupportCaseDeleteRequest struct {
+	client      *Client
+	queryParams *SupportCaseDeleteRequestQueryParams
+	pathParams  *SupportCaseDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SupportCaseDeleteRequestBody
+}
+
+func (r SupportCaseDeleteRequest) NewQueryParams() *SupportCaseDeleteRequestQueryParams {
+	return &SupportCaseDeleteRequestQueryParams{}
+}
+
+type SupportCaseDeleteRequestQueryParams struct {
+}
+
+func (p SupportCaseDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SupportCaseDeleteRequest) QueryParams() *SupportCaseDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SupportCaseDeleteRequest) NewPathParams() *SupportCaseDeleteRequestPathParams {
+	return &SupportCaseDeleteRequestPathParams{}
+}
+
+type SupportCaseDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SupportCaseDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SupportCaseDeleteRequest) PathParams() *SupportCaseDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SupportCaseDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SupportCaseDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SupportCaseDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *SupportCaseDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SupportCaseDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SupportCaseDeleteRequest) NewRequestBody() SupportCaseDeleteRequestBody {
+	return SupportCaseDeleteRequestBody{}
+}
+
+type SupportCaseDeleteRequestBody struct {
+}
+
+func (r *SupportCaseDeleteRequest) RequestBody() *SupportCaseDeleteRequestBody {
+	return nil
+}
+
+func (r *SupportCaseDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SupportCaseDeleteRequest) SetRequestBody(body SupportCaseDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SupportCaseDeleteRequest) NewResponseBody() *SupportCaseDeleteResponseBody {
+	return &SupportCaseDeleteResponseBody{}
+}
+
+type SupportCaseDeleteResponseBody struct {
+}
+
+func (r *SupportCaseDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/supportCase/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SupportCaseDeleteRequest) Do(ctx context.Context) (SupportCaseDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSupportCaseGetRequest() SupportCaseGetRequest {
+	r := SupportCaseGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SupportCaseGetRequest struct {
+	client      *Client
+	queryParams *SupportCaseGetRequestQueryParams
+	pathParams  *SupportCaseGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SupportCaseGetRequestBody
+}
+
+func (r SupportCaseGetRequest) NewQueryParams() *SupportCaseGetRequestQueryParams {
+	return &SupportCaseGetRequestQueryParams{}
+}
+
+type SupportCaseGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p SupportCaseGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SupportCaseGetRequest) QueryParams() *SupportCaseGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SupportCaseGetRequest) NewPathParams() *SupportCaseGetRequestPathParams {
+	return &SupportCaseGetRequestPathParams{}
+}
+
+type SupportCaseGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SupportCaseGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SupportCaseGetRequest) PathParams() *SupportCaseGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SupportCaseGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SupportCaseGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SupportCaseGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SupportCaseGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SupportCaseGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SupportCaseGetRequest) NewRequestBody() SupportCaseGetRequestBody {
+	return SupportCaseGetRequestBody{}
+}
+
+type SupportCaseGetRequestBody struct {
+}
+
+func (r *SupportCaseGetRequest) RequestBody() *SupportCaseGetRequestBody {
+	return nil
+}
+
+func (r *SupportCaseGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SupportCaseGetRequest) SetRequestBody(body SupportCaseGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SupportCaseGetRequest) NewResponseBody() *SupportCaseGetResponseBody {
+	return &SupportCaseGetResponseBody{}
+}
+
+type SupportCaseGetResponseBody struct {
+	Links Links `json:"links"`
+	SupportCase
+}
+
+func (r *SupportCaseGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/supportCase/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SupportCaseGetRequest) Do(ctx context.Context) (SupportCaseGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
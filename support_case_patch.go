@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSupportCasePatchRequest() SupportCasePatchRequest {
+	r := SupportCasePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SupportCasePatchRequest struct {
+	client      *Client
+	queryParams *SupportCasePatchRequestQueryParams
+	pathParams  *SupportCasePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SupportCasePatchRequestBody
+}
+
+func (r SupportCasePatchRequest) NewQueryParams() *SupportCasePatchRequestQueryParams {
+	return &SupportCasePatchRequestQueryParams{}
+}
+
+type SupportCasePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p SupportCasePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SupportCasePatchRequest) QueryParams() *SupportCasePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SupportCasePatchRequest) NewPathParams() *SupportCasePatchRequestPathParams {
+	return &SupportCasePatchRequestPathParams{}
+}
+
+type SupportCasePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SupportCasePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SupportCasePatchRequest) PathParams() *SupportCasePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SupportCasePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *SupportCasePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SupportCasePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SupportCasePatchRequest) NewRequestBody() SupportCasePatchRequestBody {
+	return SupportCasePatchRequestBody{}
+}
+
+type SupportCasePatchRequestBody struct {
+	SupportCase
+}
+
+func (r *SupportCasePatchRequest) RequestBody() *SupportCasePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *SupportCasePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *SupportCasePatchRequest) SetRequestBody(body SupportCasePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SupportCasePatchRequest) NewResponseBody() *SupportCasePatchResponseBody {
+	return &SupportCasePatchResponseBody{}
+}
+
+type SupportCasePatchResponseBody struct {
+}
+
+func (r *SupportCasePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/supportCase/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *SupportCasePatchRequest) Do(ctx context.Context) (SupportCasePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSupportCasePatch(t *testing.T) {
+	req := client.NewSupportCasePatchRequest()
+	req.PathParams().ID = 4512
+	req.RequestBody().Assigned.ID = "1642"
+	req.RequestBody().OutgoingMessage = "We are shipping a replacement."
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
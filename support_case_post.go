@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSupportCasePostRequest() SupportCasePostRequest {
+	r := SupportCasePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SupportCasePostRequest struct {
+	client      *Client
+	queryParams *SupportCasePostRequestQueryParams
+	pathParams  *SupportCasePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SupportCasePostRequestBody
+}
+
+func (r SupportCasePostRequest) NewQueryParams() *SupportCasePostRequestQueryParams {
+	return &SupportCasePostRequestQueryParams{}
+}
+
+type SupportCasePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p SupportCasePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SupportCasePostRequest) QueryParams() *SupportCasePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SupportCasePostRequest) NewPathParams() *SupportCasePostRequestPathParams {
+	return &SupportCasePostRequestPathParams{}
+}
+
+type SupportCasePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *SupportCasePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *SupportCasePostRequest) PathParams() *SupportCasePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SupportCasePostRequest) Method() string {
+	return r.method
+}
+
+func (r *SupportCasePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SupportCasePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SupportCasePostRequest) NewRequestBody() SupportCasePostRequestBody {
+	return SupportCasePostRequestBody{}
+}
+
+type SupportCasePostRequestBody struct {
+	SupportCase
+}
+
+func (r *SupportCasePostRequest) RequestBody() *SupportCasePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *SupportCasePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *SupportCasePostRequest) SetRequestBody(body SupportCasePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SupportCasePostRequest) NewResponseBody() *SupportCasePostResponseBody {
+	return &SupportCasePostResponseBody{}
+}
+
+type SupportCasePostResponseBody struct {
+}
+
+func (r *SupportCasePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/supportCase", r.PathParams())
+	return &u, err
+}
+
+func (r *SupportCasePostRequest) Do(ctx context.Context) (SupportCasePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestSupportCasePost(t *testing.T) {
+	req := client.NewSupportCasePostRequest()
+	req.RequestBody().Title = "Replacement request"
+	req.RequestBody().Company.ID = "70202"
+	req.RequestBody().IncomingMessage = "The unit arrived damaged."
+	req.RequestBody().Priority.ID = "2"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewSupportCasesGetRequest() SupportCasesGetRequest {
+	r := SupportCasesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type SupportCasesGetRequest struct {
+	client      *Client
+	queryParams *SupportCasesGetRequestQueryParams
+	pathParams  *SupportCasesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody SupportCasesGetRequestBody
+}
+
+func (r SupportCasesGetRequest) NewQueryParams() *SupportCasesGetRequestQueryParams {
+	return &SupportCasesGetRequestQueryParams{}
+}
+
+type SupportCasesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p SupportCasesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *SupportCasesGetRequest) QueryParams() *SupportCasesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r SupportCasesGetRequest) NewPathParams() *SupportCasesGetRequestPathParams {
+	return &SupportCasesGetRequestPathParams{}
+}
+
+type SupportCasesGetRequestPathParams struct {
+}
+
+func (p *SupportCasesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *SupportCasesGetRequest) PathParams() *SupportCasesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *SupportCasesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *SupportCasesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *SupportCasesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *SupportCasesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r SupportCasesGetRequest) NewRequestBody() SupportCasesGetRequestBody {
+	return SupportCasesGetRequestBody{}
+}
+
+type SupportCasesGetRequestBody struct {
+}
+
+func (r *SupportCasesGetRequest) RequestBody() *SupportCasesGetRequestBody {
+	return nil
+}
+
+func (r *SupportCasesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *SupportCasesGetRequest) SetRequestBody(body SupportCasesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *SupportCasesGetRequest) NewResponseBody() *SupportCasesGetResponseBody {
+	return &SupportCasesGetResponseBody{}
+}
+
+type SupportCasesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *SupportCasesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/supportCase", r.PathParams())
+	return &u, err
+}
+
+func (r *SupportCasesGetRequest) Do(ctx context.Context) (SupportCasesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
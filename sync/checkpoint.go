@@ -0,0 +1,236 @@
+package sync
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	gosync "sync"
+	"time"
+)
+
+// Checkpoint is how far a pipeline got
+type Checkpoint struct {
+	// HighWaterMark is the last modification of the records handled so far
+	HighWaterMark time.Time `json:"highWaterMark"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// CheckpointStore keeps the checkpoints of pipelines by name.
+type CheckpointStore interface {
+	// Load returns the checkpoint, or a zero checkpoint when there is none
+	Load(ctx context.Context, name string) (Checkpoint, error)
+	Save(ctx context.Context, name string, cp Checkpoint) error
+}
+
+// MemoryStore keeps the checkpoints in memory, e.g. for tests.
+type MemoryStore struct {
+	mu          gosync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: map[string]Checkpoint{}}
+}
+
+func (s *MemoryStore) Load(ctx context.Context, name string) (Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[name], nil
+}
+
+func (s *MemoryStore) Save(ctx context.Context, name string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[name] = cp
+	return nil
+}
+
+// FileStore keeps the checkpoints in a json file, keyed by name.
+type FileStore struct {
+	mu   gosync.Mutex
+	path string
+}
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Load(ctx context.Context, name string) (Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	return checkpoints[name], err
+}
+
+func (s *FileStore) Save(ctx context.Context, name string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[name] = cp
+
+	b, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so a crash never leaves a truncated
+	// file behind
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(b)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+func (s *FileStore) read() (map[string]Checkpoint, error) {
+	checkpoints := map[string]Checkpoint{}
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return checkpoints, err
+	}
+	err = json.Unmarshal(b, &checkpoints)
+	if err != nil {
+		return checkpoints, fmt.Errorf("%s: %w", s.path, err)
+	}
+	return checkpoints, nil
+}
+
+// SQLStore keeps the checkpoints in a table of a database:
+//
+//	CREATE TABLE netsuite_checkpoints (
+//		name VARCHAR(255) PRIMARY KEY,
+//		checkpoint TEXT NOT NULL
+//	)
+type SQLStore struct {
+	db    *sql.DB
+	table string
+	// Placeholder returns the nth (1 based) bind parameter, ? by default;
+	// use DollarPlaceholder for PostgreSQL
+	Placeholder func(n int) string
+}
+
+func NewSQLStore(db *sql.DB, table string) *SQLStore {
+	return &SQLStore{db: db, table: table}
+}
+
+// DollarPlaceholder returns the PostgreSQL bind parameters $1, $2...
+func DollarPlaceholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (s *SQLStore) placeholder(n int) string {
+	if s.Placeholder == nil {
+		return "?"
+	}
+	return s.Placeholder(n)
+}
+
+func (s *SQLStore) Load(ctx context.Context, name string) (Checkpoint, error) {
+	cp := Checkpoint{}
+	q := fmt.Sprintf("SELECT checkpoint FROM %s WHERE name = %s", s.table, s.placeholder(1))
+
+	var data string
+	err := s.db.QueryRowContext(ctx, q, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cp, nil
+	}
+	if err != nil {
+		return cp, err
+	}
+	err = json.Unmarshal([]byte(data), &cp)
+	return cp, err
+}
+
+func (s *SQLStore) Save(ctx context.Context, name string, cp Checkpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	// update or insert, as upserts aren't portable
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET checkpoint = %s WHERE name = %s", s.table, s.placeholder(1), s.placeholder(2)), string(b), name)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (name, checkpoint) VALUES (%s, %s)", s.table, s.placeholder(1), s.placeholder(2)), name, string(b))
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// KVStore keeps the checkpoints in a key value store like Redis, through a
+// get and set function, e.g. with go-redis:
+//
+//	store := sync.NewKVStore("netsuite:checkpoint:",
+//		func(ctx context.Context, key string) (string, bool, error) {
+//			v, err := rdb.Get(ctx, key).Result()
+//			if err == redis.Nil {
+//				return "", false, nil
+//			}
+//			return v, err == nil, err
+//		},
+//		func(ctx context.Context, key, value string) error {
+//			return rdb.Set(ctx, key, value, 0).Err()
+//		},
+//	)
+type KVStore struct {
+	prefix string
+	get    func(ctx context.Context, key string) (string, bool, error)
+	set    func(ctx context.Context, key, value string) error
+}
+
+func NewKVStore(prefix string, get func(ctx context.Context, key string) (string, bool, error), set func(ctx context.Context, key, value string) error) *KVStore {
+	return &KVStore{prefix: prefix, get: get, set: set}
+}
+
+func (s *KVStore) Load(ctx context.Context, name string) (Checkpoint, error) {
+	cp := Checkpoint{}
+	v, ok, err := s.get(ctx, s.prefix+name)
+	if err != nil || !ok {
+		return cp, err
+	}
+	err = json.Unmarshal([]byte(v), &cp)
+	return cp, err
+}
+
+func (s *KVStore) Save(ctx context.Context, name string, cp Checkpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return s.set(ctx, s.prefix+name, string(b))
+}
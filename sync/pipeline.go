@@ -0,0 +1,177 @@
+// Package sync runs incremental extractions of NetSuite records: it polls the
+// records changed since the last run (see netsuite.Client.Changes), fetches
+// them in batches and keeps a checkpoint, so a run that fails is picked up
+// where it stopped:
+//
+//	p := &sync.Pipeline[netsuite.Invoice]{
+//		Client:     client,
+//		RecordType: "invoice",
+//		Store:      sync.NewFileStore("checkpoints.json"),
+//		Handle: func(ctx context.Context, invoices []netsuite.Invoice) error {
+//			return warehouse.Upsert(ctx, invoices)
+//		},
+//	}
+//	err := p.Poll(ctx, 5*time.Minute, func(r sync.Result, err error) {
+//		log.Printf("synced %d invoices: %v", r.Records, err)
+//	})
+//
+// Requests are rate limited by the rate limiter of the client, or by the
+// RateLimiter of the pipeline.
+package sync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+// DefaultBatchSize is the number of records passed to Handle at once
+const DefaultBatchSize = 100
+
+// Pipeline extracts the records of a record type incrementally.
+type Pipeline[T any] struct {
+	Client     *netsuite.Client
+	RecordType string
+	// Name identifies the checkpoint in the store, defaults to the record
+	// type
+	Name  string
+	Store CheckpointStore
+	// Handle is called with every batch of changed records, oldest first.
+	// The checkpoint only moves past a batch when Handle succeeds, so it
+	// should be idempotent.
+	Handle func(ctx context.Context, records []T) error
+
+	// BatchSize defaults to DefaultBatchSize
+	BatchSize int
+	// Start is where the first run starts, the zero time extracts all
+	// records
+	Start time.Time
+	// Changes configures how the changed records are found
+	Changes netsuite.ChangesOptions
+	// Get configures how the records are fetched, e.g. with their sublists
+	Get netsuite.GetOptions
+	// RateLimiter is waited on before every request, instead of the rate
+	// limiter of the client
+	RateLimiter netsuite.RateLimiter
+}
+
+// Result is the outcome of a run
+type Result struct {
+	// Records is the number of records handled
+	Records int
+	// Skipped is the number of changed records that were deleted before
+	// they could be fetched
+	Skipped       int
+	HighWaterMark time.Time
+}
+
+func (p *Pipeline[T]) name() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return p.RecordType
+}
+
+// Run handles the records changed since the checkpoint once.
+func (p *Pipeline[T]) Run(ctx context.Context) (Result, error) {
+	result := Result{}
+	if p.Client == nil || p.Store == nil || p.Handle == nil {
+		return result, errors.New("sync: pipeline needs a client, store and handler")
+	}
+
+	client := p.Client
+	if p.RateLimiter != nil {
+		client = client.With(netsuite.WithRateLimiter(p.RateLimiter))
+	}
+
+	cp, err := p.Store.Load(ctx, p.name())
+	if err != nil {
+		return result, err
+	}
+	since := cp.HighWaterMark
+	if since.IsZero() {
+		since = p.Start
+	}
+	result.HighWaterMark = since
+
+	changes, err := client.Changes(ctx, p.RecordType, since, &p.Changes)
+	if err != nil {
+		return result, err
+	}
+
+	batchSize := p.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	svc := netsuite.NewService[T](client, p.RecordType)
+	batch := make([]T, 0, batchSize)
+	pending := 0
+	for i, change := range changes.Changes {
+		record, err := svc.Get(ctx, change.ID, &p.Get)
+		if netsuite.IsNotFound(err) {
+			result.Skipped++
+		} else if err != nil {
+			return result, err
+		} else {
+			batch = append(batch, record)
+		}
+
+		// skipped records count towards the batch, so they're checkpointed
+		// as well
+		pending++
+		last := i == len(changes.Changes)-1
+		if pending < batchSize && !last {
+			continue
+		}
+		pending = 0
+
+		if len(batch) > 0 {
+			err = p.Handle(ctx, batch)
+			if err != nil {
+				return result, err
+			}
+			result.Records += len(batch)
+			batch = batch[:0]
+		}
+
+		// records modified in the same second as the last one of the batch
+		// are fetched again by the next run
+		hwm := change.LastModified
+		if last {
+			hwm = changes.HighWaterMark
+		}
+		err = p.Store.Save(ctx, p.name(), Checkpoint{HighWaterMark: hwm, UpdatedAt: time.Now()})
+		if err != nil {
+			return result, err
+		}
+		result.HighWaterMark = hwm
+	}
+
+	return result, nil
+}
+
+// Poll runs the pipeline every interval until ctx is done. onResult, if set,
+// is called after every run; a failed run is retried at the next interval.
+func (p *Pipeline[T]) Poll(ctx context.Context, interval time.Duration, onResult func(Result, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := p.Run(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if onResult != nil {
+			onResult(result, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
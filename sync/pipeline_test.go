@@ -0,0 +1,101 @@
+package sync_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+	"github.com/omniboost/go-netsuite-rest/sync"
+)
+
+func TestPipeline(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from transaction",
+		map[string]interface{}{"id": "1", "lastmodified": "2024-03-01 10:00:00"},
+		map[string]interface{}{"id": "2", "lastmodified": "2024-03-01 10:01:00"},
+		map[string]interface{}{"id": "3", "lastmodified": "2024-03-01 10:02:00"},
+	)
+	srv.AddRecord("salesOrder", "1", map[string]interface{}{"tranId": "SO1"})
+	srv.AddRecord("salesOrder", "3", map[string]interface{}{"tranId": "SO3"})
+
+	store := sync.NewFileStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+	batches := [][]map[string]interface{}{}
+	fail := true
+	p := &sync.Pipeline[map[string]interface{}]{
+		Client:     srv.Client(),
+		RecordType: "salesOrder",
+		Store:      store,
+		BatchSize:  1,
+		Handle: func(ctx context.Context, records []map[string]interface{}) error {
+			if records[0]["tranId"] == "SO3" && fail {
+				return errors.New("warehouse unavailable")
+			}
+			batches = append(batches, append([]map[string]interface{}{}, records...))
+			return nil
+		},
+	}
+
+	ctx := context.Background()
+	result, err := p.Run(ctx)
+	if err == nil {
+		t.Fatal("expected the failing handler to stop the run")
+	}
+	// record 2 was deleted in the meantime
+	if result.Records != 1 || result.Skipped != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	cp, err := store.Load(ctx, "salesOrder")
+	if expected := time.Date(2024, 3, 1, 10, 1, 0, 0, time.UTC); err != nil || !cp.HighWaterMark.Equal(expected) {
+		t.Errorf("expected the checkpoint after the handled batches %s, got %s (%v)", expected, cp.HighWaterMark, err)
+	}
+
+	fail = false
+	result, err = p.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2024, 3, 1, 10, 2, 0, 0, time.UTC); !result.HighWaterMark.Equal(expected) {
+		t.Errorf("expected high-water mark %s, got %s", expected, result.HighWaterMark)
+	}
+	if last := batches[len(batches)-1]; last[0]["tranId"] != "SO3" {
+		t.Errorf("expected the last batch to be SO3, got %v", last)
+	}
+}
+
+func TestKVStore(t *testing.T) {
+	kv := map[string]string{}
+	store := sync.NewKVStore("checkpoint:",
+		func(ctx context.Context, key string) (string, bool, error) {
+			v, ok := kv[key]
+			return v, ok, nil
+		},
+		func(ctx context.Context, key, value string) error {
+			kv[key] = value
+			return nil
+		},
+	)
+
+	ctx := context.Background()
+	cp, err := store.Load(ctx, "customer")
+	if err != nil || !cp.HighWaterMark.IsZero() {
+		t.Fatalf("expected an empty checkpoint, got %+v (%v)", cp, err)
+	}
+
+	hwm := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	err = store.Save(ctx, "customer", sync.Checkpoint{HighWaterMark: hwm})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp, err = store.Load(ctx, "customer")
+	if err != nil || !cp.HighWaterMark.Equal(hwm) {
+		t.Errorf("expected %s, got %+v (%v)", hwm, cp, err)
+	}
+	if _, ok := kv["checkpoint:customer"]; !ok {
+		t.Errorf("expected the checkpoint under its prefixed key, got %v", kv)
+	}
+}
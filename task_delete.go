@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaskDeleteRequest() TaskDeleteRequest {
+	r := TaskDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaskDeleteRequest struct {
+	client      *Client
+	queryParams *TaskDeleteRequestQueryParams
+	pathParams  *TaskDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaskDeleteRequestBody
+}
+
+func (r TaskDeleteRequest) NewQueryParams() *TaskDeleteRequestQueryParams {
+	return &TaskDeleteRequestQueryParams{}
+}
+
+type TaskDeleteRequestQueryParams struct {
+}
+
+func (p TaskDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaskDeleteRequest) QueryParams() *TaskDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaskDeleteRequest) NewPathParams() *TaskDeleteRequestPathParams {
+	return &TaskDeleteRequestPathParams{}
+}
+
+type TaskDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaskDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaskDeleteRequest) PathParams() *TaskDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaskDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaskDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaskDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *TaskDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaskDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaskDeleteRequest) NewRequestBody() TaskDeleteRequestBody {
+	return TaskDeleteRequestBody{}
+}
+
+type TaskDeleteRequestBody struct {
+}
+
+func (r *TaskDeleteRequest) RequestBody() *TaskDeleteRequestBody {
+	return nil
+}
+
+func (r *TaskDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaskDeleteRequest) SetRequestBody(body TaskDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaskDeleteRequest) NewResponseBody() *TaskDeleteResponseBody {
+	return &TaskDeleteResponseBody{}
+}
+
+type TaskDeleteResponseBody struct {
+}
+
+func (r *TaskDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/task/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaskDeleteRequest) Do(ctx context.Context) (TaskDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
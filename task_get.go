@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaskGetRequest() TaskGetRequest {
+	r := TaskGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaskGetRequest struct {
+	client      *Client
+	queryParams *TaskGetRequestQueryParams
+	pathParams  *TaskGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaskGetRequestBody
+}
+
+func (r TaskGetRequest) NewQueryParams() *TaskGetRequestQueryParams {
+	return &TaskGetRequestQueryParams{}
+}
+
+type TaskGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaskGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaskGetRequest) QueryParams() *TaskGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaskGetRequest) NewPathParams() *TaskGetRequestPathParams {
+	return &TaskGetRequestPathParams{}
+}
+
+type TaskGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaskGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaskGetRequest) PathParams() *TaskGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaskGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaskGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaskGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaskGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaskGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaskGetRequest) NewRequestBody() TaskGetRequestBody {
+	return TaskGetRequestBody{}
+}
+
+type TaskGetRequestBody struct {
+}
+
+func (r *TaskGetRequest) RequestBody() *TaskGetRequestBody {
+	return nil
+}
+
+func (r *TaskGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaskGetRequest) SetRequestBody(body TaskGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaskGetRequest) NewResponseBody() *TaskGetResponseBody {
+	return &TaskGetResponseBody{}
+}
+
+type TaskGetResponseBody struct {
+	Links Links `json:"links"`
+	Task
+}
+
+func (r *TaskGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/task/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaskGetRequest) Do(ctx context.Context) (TaskGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaskPatchRequest() TaskPatchRequest {
+	r := TaskPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaskPatchRequest struct {
+	client      *Client
+	queryParams *TaskPatchRequestQueryParams
+	pathParams  *TaskPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaskPatchRequestBody
+}
+
+func (r TaskPatchRequest) NewQueryParams() *TaskPatchRequestQueryParams {
+	return &TaskPatchRequestQueryParams{}
+}
+
+type TaskPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p TaskPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaskPatchRequest) QueryParams() *TaskPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaskPatchRequest) NewPathParams() *TaskPatchRequestPathParams {
+	return &TaskPatchRequestPathParams{}
+}
+
+type TaskPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaskPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaskPatchRequest) PathParams() *TaskPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaskPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaskPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaskPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *TaskPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaskPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaskPatchRequest) NewRequestBody() TaskPatchRequestBody {
+	return TaskPatchRequestBody{}
+}
+
+type TaskPatchRequestBody struct {
+	Task
+}
+
+func (r *TaskPatchRequest) RequestBody() *TaskPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *TaskPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TaskPatchRequest) SetRequestBody(body TaskPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaskPatchRequest) NewResponseBody() *TaskPatchResponseBody {
+	return &TaskPatchResponseBody{}
+}
+
+type TaskPatchResponseBody struct {
+}
+
+func (r *TaskPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/task/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaskPatchRequest) Do(ctx context.Context) (TaskPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaskPostRequest() TaskPostRequest {
+	r := TaskPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaskPostRequest struct {
+	client      *Client
+	queryParams *TaskPostRequestQueryParams
+	pathParams  *TaskPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaskPostRequestBody
+}
+
+func (r TaskPostRequest) NewQueryParams() *TaskPostRequestQueryParams {
+	return &TaskPostRequestQueryParams{}
+}
+
+type TaskPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaskPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaskPostRequest) QueryParams() *TaskPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaskPostRequest) NewPathParams() *TaskPostRequestPathParams {
+	return &TaskPostRequestPathParams{}
+}
+
+type TaskPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaskPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaskPostRequest) PathParams() *TaskPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaskPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaskPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaskPostRequest) Method() string {
+	return r.method
+}
+
+func (r *TaskPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaskPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaskPostRequest) NewRequestBody() TaskPostRequestBody {
+	return TaskPostRequestBody{}
+}
+
+type TaskPostRequestBody struct {
+	Task
+}
+
+func (r *TaskPostRequest) RequestBody() *TaskPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *TaskPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TaskPostRequest) SetRequestBody(body TaskPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaskPostRequest) NewResponseBody() *TaskPostResponseBody {
+	return &TaskPostResponseBody{}
+}
+
+type TaskPostResponseBody struct {
+}
+
+func (r *TaskPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/task", r.PathParams())
+	return &u, err
+}
+
+func (r *TaskPostRequest) Do(ctx context.Context) (TaskPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestTaskPost(t *testing.T) {
+	req := client.NewTaskPostRequest()
+	req.RequestBody().Title = "Send renewal quote"
+	req.RequestBody().Assigned.ID = "1642"
+	req.RequestBody().Company.ID = "70202"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTasksGetRequest() TasksGetRequest {
+	r := TasksGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TasksGetRequest struct {
+	client      *Client
+	queryParams *TasksGetRequestQueryParams
+	pathParams  *TasksGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TasksGetRequestBody
+}
+
+func (r TasksGetRequest) NewQueryParams() *TasksGetRequestQueryParams {
+	return &TasksGetRequestQueryParams{}
+}
+
+type TasksGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TasksGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TasksGetRequest) QueryParams() *TasksGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TasksGetRequest) NewPathParams() *TasksGetRequestPathParams {
+	return &TasksGetRequestPathParams{}
+}
+
+type TasksGetRequestPathParams struct {
+}
+
+func (p *TasksGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TasksGetRequest) PathParams() *TasksGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TasksGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TasksGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TasksGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TasksGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TasksGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TasksGetRequest) NewRequestBody() TasksGetRequestBody {
+	return TasksGetRequestBody{}
+}
+
+type TasksGetRequestBody struct {
+}
+
+func (r *TasksGetRequest) RequestBody() *TasksGetRequestBody {
+	return nil
+}
+
+func (r *TasksGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TasksGetRequest) SetRequestBody(body TasksGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TasksGetRequest) NewResponseBody() *TasksGetResponseBody {
+	return &TasksGetResponseBody{}
+}
+
+type TasksGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TasksGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/task", r.PathParams())
+	return &u, err
+}
+
+func (r *TasksGetRequest) Do(ctx context.Context) (TasksGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
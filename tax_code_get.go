@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxCodeGetRequest() TaxCodeGetRequest {
+	r := TaxCodeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxCodeGetRequest struct {
+	client      *Client
+	queryParams *TaxCodeGetRequestQueryParams
+	pathParams  *TaxCodeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxCodeGetRequestBody
+}
+
+func (r TaxCodeGetRequest) NewQueryParams() *TaxCodeGetRequestQueryParams {
+	return &TaxCodeGetRequestQueryParams{}
+}
+
+type TaxCodeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaxCodeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxCodeGetRequest) QueryParams() *TaxCodeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxCodeGetRequest) NewPathParams() *TaxCodeGetRequestPathParams {
+	return &TaxCodeGetRequestPathParams{}
+}
+
+type TaxCodeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaxCodeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaxCodeGetRequest) PathParams() *TaxCodeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxCodeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxCodeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxCodeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxCodeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxCodeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxCodeGetRequest) NewRequestBody() TaxCodeGetRequestBody {
+	return TaxCodeGetRequestBody{}
+}
+
+type TaxCodeGetRequestBody struct {
+}
+
+func (r *TaxCodeGetRequest) RequestBody() *TaxCodeGetRequestBody {
+	return nil
+}
+
+func (r *TaxCodeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxCodeGetRequest) SetRequestBody(body TaxCodeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxCodeGetRequest) NewResponseBody() *TaxCodeGetResponseBody {
+	return &TaxCodeGetResponseBody{}
+}
+
+type TaxCodeGetResponseBody struct {
+	Links Links `json:"links"`
+	TaxCode
+}
+
+func (r *TaxCodeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/salesTaxItem/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxCodeGetRequest) Do(ctx context.Context) (TaxCodeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
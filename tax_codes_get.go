@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxCodesGetRequest() TaxCodesGetRequest {
+	r := TaxCodesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxCodesGetRequest struct {
+	client      *Client
+	queryParams *TaxCodesGetRequestQueryParams
+	pathParams  *TaxCodesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxCodesGetRequestBody
+}
+
+func (r TaxCodesGetRequest) NewQueryParams() *TaxCodesGetRequestQueryParams {
+	return &TaxCodesGetRequestQueryParams{}
+}
+
+type TaxCodesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TaxCodesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxCodesGetRequest) QueryParams() *TaxCodesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxCodesGetRequest) NewPathParams() *TaxCodesGetRequestPathParams {
+	return &TaxCodesGetRequestPathParams{}
+}
+
+type TaxCodesGetRequestPathParams struct {
+}
+
+func (p *TaxCodesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TaxCodesGetRequest) PathParams() *TaxCodesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxCodesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxCodesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxCodesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxCodesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxCodesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxCodesGetRequest) NewRequestBody() TaxCodesGetRequestBody {
+	return TaxCodesGetRequestBody{}
+}
+
+type TaxCodesGetRequestBody struct {
+}
+
+func (r *TaxCodesGetRequest) RequestBody() *TaxCodesGetRequestBody {
+	return nil
+}
+
+func (r *TaxCodesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxCodesGetRequest) SetRequestBody(body TaxCodesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxCodesGetRequest) NewResponseBody() *TaxCodesGetResponseBody {
+	return &TaxCodesGetResponseBody{}
+}
+
+type TaxCodesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TaxCodesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/salesTaxItem", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxCodesGetRequest) Do(ctx context.Context) (TaxCodesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxGroupGetRequest() TaxGroupGetRequest {
+	r := TaxGroupGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxGroupGetRequest struct {
+	client      *Client
+	queryParams *TaxGroupGetRequestQueryParams
+	pathParams  *TaxGroupGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxGroupGetRequestBody
+}
+
+func (r TaxGroupGetRequest) NewQueryParams() *TaxGroupGetRequestQueryParams {
+	return &TaxGroupGetRequestQueryParams{}
+}
+
+type TaxGroupGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaxGroupGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxGroupGetRequest) QueryParams() *TaxGroupGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxGroupGetRequest) NewPathParams() *TaxGroupGetRequestPathParams {
+	return &TaxGroupGetRequestPathParams{}
+}
+
+type TaxGroupGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaxGroupGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaxGroupGetRequest) PathParams() *TaxGroupGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxGroupGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxGroupGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxGroupGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxGroupGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxGroupGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxGroupGetRequest) NewRequestBody() TaxGroupGetRequestBody {
+	return TaxGroupGetRequestBody{}
+}
+
+type TaxGroupGetRequestBody struct {
+}
+
+func (r *TaxGroupGetRequest) RequestBody() *TaxGroupGetRequestBody {
+	return nil
+}
+
+func (r *TaxGroupGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxGroupGetRequest) SetRequestBody(body TaxGroupGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxGroupGetRequest) NewResponseBody() *TaxGroupGetResponseBody {
+	return &TaxGroupGetResponseBody{}
+}
+
+type TaxGroupGetResponseBody struct {
+	Links Links `json:"links"`
+	TaxGroup
+}
+
+func (r *TaxGroupGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/taxGroup/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxGroupGetRequest) Do(ctx context.Context) (TaxGroupGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxGroupsGetRequest() TaxGroupsGetRequest {
+	r := TaxGroupsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxGroupsGetRequest struct {
+	client      *Client
+	queryParams *TaxGroupsGetRequestQueryParams
+	pathParams  *TaxGroupsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxGroupsGetRequestBody
+}
+
+func (r TaxGroupsGetRequest) NewQueryParams() *TaxGroupsGetRequestQueryParams {
+	return &TaxGroupsGetRequestQueryParams{}
+}
+
+type TaxGroupsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TaxGroupsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxGroupsGetRequest) QueryParams() *TaxGroupsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxGroupsGetRequest) NewPathParams() *TaxGroupsGetRequestPathParams {
+	return &TaxGroupsGetRequestPathParams{}
+}
+
+type TaxGroupsGetRequestPathParams struct {
+}
+
+func (p *TaxGroupsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TaxGroupsGetRequest) PathParams() *TaxGroupsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxGroupsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxGroupsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxGroupsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxGroupsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxGroupsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxGroupsGetRequest) NewRequestBody() TaxGroupsGetRequestBody {
+	return TaxGroupsGetRequestBody{}
+}
+
+type TaxGroupsGetRequestBody struct {
+}
+
+func (r *TaxGroupsGetRequest) RequestBody() *TaxGroupsGetRequestBody {
+	return nil
+}
+
+func (r *TaxGroupsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxGroupsGetRequest) SetRequestBody(body TaxGroupsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxGroupsGetRequest) NewResponseBody() *TaxGroupsGetResponseBody {
+	return &TaxGroupsGetResponseBody{}
+}
+
+type TaxGroupsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TaxGroupsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/taxGroup", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxGroupsGetRequest) Do(ctx context.Context) (TaxGroupsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
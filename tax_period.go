@@ -0,0 +1,125 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Contains reports whether the date of t falls within the period.
+func (p TaxPeriod) Contains(t time.Time) bool {
+	d := NewDate(t.Year(), t.Month(), t.Day())
+	return !d.Before(p.StartDate.Time) && !d.After(p.EndDate.Time)
+}
+
+// Months returns the monthly periods.
+func (tt TaxPeriods) Months() TaxPeriods {
+	return tt.filter(func(p TaxPeriod) bool { return !bool(p.IsQuarter) && !bool(p.IsYear) })
+}
+
+// Quarters returns the quarterly periods.
+func (tt TaxPeriods) Quarters() TaxPeriods {
+	return tt.filter(func(p TaxPeriod) bool { return bool(p.IsQuarter) })
+}
+
+// Years returns the yearly periods.
+func (tt TaxPeriods) Years() TaxPeriods {
+	return tt.filter(func(p TaxPeriod) bool { return bool(p.IsYear) })
+}
+
+func (tt TaxPeriods) filter(keep func(TaxPeriod) bool) TaxPeriods {
+	periods := TaxPeriods{}
+	for _, p := range tt {
+		if keep(p) {
+			periods = append(periods, p)
+		}
+	}
+	return periods
+}
+
+// ForDate returns the first period the date of t falls in. Filter the periods
+// first to get the month or the quarter:
+//
+//	quarter, ok := periods.Quarters().ForDate(invoice.TranDate.Time)
+func (tt TaxPeriods) ForDate(t time.Time) (TaxPeriod, bool) {
+	for _, p := range tt {
+		if p.Contains(t) {
+			return p, true
+		}
+	}
+	return TaxPeriod{}, false
+}
+
+// Default returns the default fiscal calendar.
+func (ff FiscalCalendars) Default() (FiscalCalendar, bool) {
+	for _, f := range ff {
+		if f.IsDefault {
+			return f, true
+		}
+	}
+	return FiscalCalendar{}, false
+}
+
+// QueryTaxPeriods returns the active tax periods (months, quarters and years)
+// that overlap the dates from and to, ordered by start date, to bucket the
+// transactions of a VAT or GST return by tax period. fiscalCalendar limits the
+// periods to a fiscal calendar (internal id), for accounts with a calendar
+// per subsidiary; empty returns the periods of all calendars.
+func (c *Client) QueryTaxPeriods(ctx context.Context, from, to time.Time, fiscalCalendar string) (TaxPeriods, error) {
+	conditions := []string{
+		"isinactive = 'F'",
+		fmt.Sprintf("enddate >= TO_DATE('%s', 'YYYY-MM-DD')", from.Format("2006-01-02")),
+		fmt.Sprintf("startdate <= TO_DATE('%s', 'YYYY-MM-DD')", to.Format("2006-01-02")),
+	}
+	if fiscalCalendar != "" {
+		conditions = append(conditions, fmt.Sprintf("fiscalcalendar = '%s'", strings.Replace(fiscalCalendar, "'", "''", -1)))
+	}
+
+	req := c.NewSuiteqlPostRequest()
+	req.RequestBody().Q = "SELECT id, periodname, TO_CHAR(startdate, 'YYYY-MM-DD') AS startdate, TO_CHAR(enddate, 'YYYY-MM-DD') AS enddate, " +
+		"closed, isposting, isadjust, isquarter, isyear, isinactive, parent, fiscalcalendar " +
+		"FROM taxperiod WHERE " + strings.Join(conditions, " AND ") + " ORDER BY startdate, id"
+	req.QueryParams().Limit = 1000
+
+	periods := TaxPeriods{}
+	for {
+		resp, err := req.Do(ctx)
+		if err != nil {
+			return periods, err
+		}
+
+		rows := []accountingPeriodRow{}
+		err = json.Unmarshal(resp.Items, &rows)
+		if err != nil {
+			return periods, err
+		}
+		for _, row := range rows {
+			p, err := row.accountingPeriod()
+			if err != nil {
+				return periods, err
+			}
+			periods = append(periods, TaxPeriod{
+				Closed:         p.Closed,
+				EndDate:        p.EndDate,
+				FiscalCalendar: p.FiscalCalendar,
+				ID:             p.ID,
+				IsAdjust:       p.IsAdjust,
+				IsInactive:     p.IsInactive,
+				IsPosting:      p.IsPosting,
+				IsQuarter:      p.IsQuarter,
+				IsYear:         p.IsYear,
+				Parent:         p.Parent,
+				PeriodName:     p.PeriodName,
+				RefName:        p.RefName,
+				StartDate:      p.StartDate,
+			})
+		}
+
+		if !resp.HasMore || resp.Count == 0 {
+			return periods, nil
+		}
+		req.QueryParams().Offset = resp.Offset + resp.Count
+	}
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxPeriodGetRequest() TaxPeriodGetRequest {
+	r := TaxPeriodGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxPeriodGetRequest struct {
+	client      *Client
+	queryParams *TaxPeriodGetRequestQueryParams
+	pathParams  *TaxPeriodGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxPeriodGetRequestBody
+}
+
+func (r TaxPeriodGetRequest) NewQueryParams() *TaxPeriodGetRequestQueryParams {
+	return &TaxPeriodGetRequestQueryParams{}
+}
+
+type TaxPeriodGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaxPeriodGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxPeriodGetRequest) QueryParams() *TaxPeriodGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxPeriodGetRequest) NewPathParams() *TaxPeriodGetRequestPathParams {
+	return &TaxPeriodGetRequestPathParams{}
+}
+
+type TaxPeriodGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaxPeriodGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaxPeriodGetRequest) PathParams() *TaxPeriodGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxPeriodGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxPeriodGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxPeriodGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxPeriodGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxPeriodGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxPeriodGetRequest) NewRequestBody() TaxPeriodGetRequestBody {
+	return TaxPeriodGetRequestBody{}
+}
+
+type TaxPeriodGetRequestBody struct {
+}
+
+func (r *TaxPeriodGetRequest) RequestBody() *TaxPeriodGetRequestBody {
+	return nil
+}
+
+func (r *TaxPeriodGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxPeriodGetRequest) SetRequestBody(body TaxPeriodGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxPeriodGetRequest) NewResponseBody() *TaxPeriodGetResponseBody {
+	return &TaxPeriodGetResponseBody{}
+}
+
+type TaxPeriodGetResponseBody struct {
+	Links Links `json:"links"`
+	TaxPeriod
+}
+
+func (r *TaxPeriodGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/taxPeriod/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxPeriodGetRequest) Do(ctx context.Context) (TaxPeriodGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
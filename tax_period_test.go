@@ -0,0 +1,62 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+	"github.com/omniboost/go-netsuite-rest/netsuitetest"
+)
+
+func TestQueryTaxPeriods(t *testing.T) {
+	srv := netsuitetest.NewServer()
+	defer srv.Close()
+	srv.AddSuiteQL("from taxperiod",
+		map[string]interface{}{"id": "10", "periodname": "FY 2024", "startdate": "2024-01-01", "enddate": "2024-12-31", "isyear": "T", "isquarter": "F", "closed": "F", "fiscalcalendar": "1"},
+		map[string]interface{}{"id": "11", "periodname": "Q1 2024", "startdate": "2024-01-01", "enddate": "2024-03-31", "isyear": "F", "isquarter": "T", "closed": "T", "parent": "10", "fiscalcalendar": "1"},
+		map[string]interface{}{"id": "12", "periodname": "Jan 2024", "startdate": "2024-01-01", "enddate": "2024-01-31", "isyear": "F", "isquarter": "F", "closed": "T", "parent": "11", "fiscalcalendar": "1"},
+		map[string]interface{}{"id": "13", "periodname": "Feb 2024", "startdate": "2024-02-01", "enddate": "2024-02-29", "isyear": "F", "isquarter": "F", "closed": "T", "parent": "11", "fiscalcalendar": "1"},
+	)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	periods, err := srv.Client().QueryTaxPeriods(context.Background(), from, to, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 4 {
+		t.Fatalf("expected 4 periods, got %d", len(periods))
+	}
+
+	date := time.Date(2024, 2, 29, 18, 0, 0, 0, time.UTC)
+	if month, ok := periods.Months().ForDate(date); !ok || month.PeriodName != "Feb 2024" {
+		t.Errorf("expected Feb 2024, got %+v", month)
+	}
+	if quarter, ok := periods.Quarters().ForDate(date); !ok || quarter.ID != "11" || !bool(quarter.Closed) {
+		t.Errorf("expected the closed Q1 2024, got %+v", quarter)
+	}
+	if year, ok := periods.Years().ForDate(date); !ok || year.ID != "10" {
+		t.Errorf("expected FY 2024, got %+v", year)
+	}
+	if _, ok := periods.Months().ForDate(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("expected no month for March")
+	}
+
+	body := struct{ Q string }{}
+	json.Unmarshal(srv.Requests()[0].Body, &body)
+	for _, e := range []string{"enddate >= TO_DATE('2024-01-01', 'YYYY-MM-DD')", "startdate <= TO_DATE('2024-03-31', 'YYYY-MM-DD')", "fiscalcalendar = '1'"} {
+		if !strings.Contains(body.Q, e) {
+			t.Errorf("expected %q in query %s", e, body.Q)
+		}
+	}
+}
+
+func TestFiscalCalendarsDefault(t *testing.T) {
+	calendars := netsuite.FiscalCalendars{{ID: "1", Name: "Standard"}, {ID: "2", Name: "April", IsDefault: true}}
+	if c, ok := calendars.Default(); !ok || c.ID != "2" {
+		t.Errorf("expected calendar 2, got %+v", c)
+	}
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxPeriodsGetRequest() TaxPeriodsGetRequest {
+	r := TaxPeriodsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxPeriodsGetRequest struct {
+	client      *Client
+	queryParams *TaxPeriodsGetRequestQueryParams
+	pathParams  *TaxPeriodsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxPeriodsGetRequestBody
+}
+
+func (r TaxPeriodsGetRequest) NewQueryParams() *TaxPeriodsGetRequestQueryParams {
+	return &TaxPeriodsGetRequestQueryParams{}
+}
+
+type TaxPeriodsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TaxPeriodsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxPeriodsGetRequest) QueryParams() *TaxPeriodsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxPeriodsGetRequest) NewPathParams() *TaxPeriodsGetRequestPathParams {
+	return &TaxPeriodsGetRequestPathParams{}
+}
+
+type TaxPeriodsGetRequestPathParams struct {
+}
+
+func (p *TaxPeriodsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TaxPeriodsGetRequest) PathParams() *TaxPeriodsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxPeriodsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxPeriodsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxPeriodsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxPeriodsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxPeriodsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxPeriodsGetRequest) NewRequestBody() TaxPeriodsGetRequestBody {
+	return TaxPeriodsGetRequestBody{}
+}
+
+type TaxPeriodsGetRequestBody struct {
+}
+
+func (r *TaxPeriodsGetRequest) RequestBody() *TaxPeriodsGetRequestBody {
+	return nil
+}
+
+func (r *TaxPeriodsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxPeriodsGetRequest) SetRequestBody(body TaxPeriodsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxPeriodsGetRequest) NewResponseBody() *TaxPeriodsGetResponseBody {
+	return &TaxPeriodsGetResponseBody{}
+}
+
+type TaxPeriodsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TaxPeriodsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/taxPeriod", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxPeriodsGetRequest) Do(ctx context.Context) (TaxPeriodsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
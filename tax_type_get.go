@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTaxTypeGetRequest() TaxTypeGetRequest {
+	r := TaxTypeGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TaxTypeGetRequest struct {
+	client      *Client
+	queryParams *TaxTypeGetRequestQueryParams
+	pathParams  *TaxTypeGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TaxTypeGetRequestBody
+}
+
+func (r TaxTypeGetRequest) NewQueryParams() *TaxTypeGetRequestQueryParams {
+	return &TaxTypeGetRequestQueryParams{}
+}
+
+type TaxTypeGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TaxTypeGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TaxTypeGetRequest) QueryParams() *TaxTypeGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TaxTypeGetRequest) NewPathParams() *TaxTypeGetRequestPathParams {
+	return &TaxTypeGetRequestPathParams{}
+}
+
+type TaxTypeGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TaxTypeGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TaxTypeGetRequest) PathParams() *TaxTypeGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TaxTypeGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TaxTypeGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TaxTypeGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TaxTypeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TaxTypeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TaxTypeGetRequest) NewRequestBody() TaxTypeGetRequestBody {
+	return TaxTypeGetRequestBody{}
+}
+
+type TaxTypeGetRequestBody struct {
+}
+
+func (r *TaxTypeGetRequest) RequestBody() *TaxTypeGetRequestBody {
+	return nil
+}
+
+func (r *TaxTypeGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TaxTypeGetRequest) SetRequestBody(body TaxTypeGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TaxTypeGetRequest) NewResponseBody() *TaxTypeGetResponseBody {
+	return &TaxTypeGetResponseBody{}
+}
+
+type TaxTypeGetResponseBody struct {
+	Links Links `json:"links"`
+	TaxType
+}
+
+func (r *TaxTypeGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/taxType/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TaxTypeGetRequest) Do(ctx context.Context) (TaxTypeGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTermGetRequest() TermGetRequest {
+	r := TermGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TermGetRequest struct {
+	client      *Client
+	queryParams *TermGetRequestQueryParams
+	pathParams  *TermGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TermGetRequestBody
+}
+
+func (r TermGetRequest) NewQueryParams() *TermGetRequestQueryParams {
+	return &TermGetRequestQueryParams{}
+}
+
+type TermGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TermGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TermGetRequest) QueryParams() *TermGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TermGetRequest) NewPathParams() *TermGetRequestPathParams {
+	return &TermGetRequestPathParams{}
+}
+
+type TermGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TermGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TermGetRequest) PathParams() *TermGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TermGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TermGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TermGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TermGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TermGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TermGetRequest) NewRequestBody() TermGetRequestBody {
+	return TermGetRequestBody{}
+}
+
+type TermGetRequestBody struct {
+}
+
+func (r *TermGetRequest) RequestBody() *TermGetRequestBody {
+	return nil
+}
+
+func (r *TermGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TermGetRequest) SetRequestBody(body TermGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TermGetRequest) NewResponseBody() *TermGetResponseBody {
+	return &TermGetResponseBody{}
+}
+
+type TermGetResponseBody struct {
+	Links Links `json:"links"`
+	Term
+}
+
+func (r *TermGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/term/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TermGetRequest) Do(ctx context.Context) (TermGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTermPostRequest() TermPostRequest {
+	r := TermPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TermPostRequest struct {
+	client      *Client
+	queryParams *TermPostRequestQueryParams
+	pathParams  *TermPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TermPostRequestBody
+}
+
+func (r TermPostRequest) NewQueryParams() *TermPostRequestQueryParams {
+	return &TermPostRequestQueryParams{}
+}
+
+type TermPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TermPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TermPostRequest) QueryParams() *TermPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TermPostRequest) NewPathParams() *TermPostRequestPathParams {
+	return &TermPostRequestPathParams{}
+}
+
+type TermPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TermPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TermPostRequest) PathParams() *TermPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TermPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TermPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TermPostRequest) Method() string {
+	return r.method
+}
+
+func (r *TermPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TermPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TermPostRequest) NewRequestBody() TermPostRequestBody {
+	return TermPostRequestBody{}
+}
+
+type TermPostRequestBody struct {
+	Term
+}
+
+func (r *TermPostRequest) RequestBody() *TermPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *TermPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TermPostRequest) SetRequestBody(body TermPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TermPostRequest) NewResponseBody() *TermPostResponseBody {
+	return &TermPostResponseBody{}
+}
+
+type TermPostResponseBody struct {
+}
+
+func (r *TermPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/term", r.PathParams())
+	return &u, err
+}
+
+func (r *TermPostRequest) Do(ctx context.Context) (TermPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
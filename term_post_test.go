@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestTermPost(t *testing.T) {
+	req := client.NewTermPostRequest()
+	req.RequestBody().Name = "Net 45 2%/10"
+	req.RequestBody().DaysUntilNetDue = 45
+	req.RequestBody().DiscountPercent = 2
+	req.RequestBody().DaysUntilExpiry = 10
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
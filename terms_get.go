@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTermsGetRequest() TermsGetRequest {
+	r := TermsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TermsGetRequest struct {
+	client      *Client
+	queryParams *TermsGetRequestQueryParams
+	pathParams  *TermsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TermsGetRequestBody
+}
+
+func (r TermsGetRequest) NewQueryParams() *TermsGetRequestQueryParams {
+	return &TermsGetRequestQueryParams{}
+}
+
+type TermsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TermsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TermsGetRequest) QueryParams() *TermsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TermsGetRequest) NewPathParams() *TermsGetRequestPathParams {
+	return &TermsGetRequestPathParams{}
+}
+
+type TermsGetRequestPathParams struct {
+}
+
+func (p *TermsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TermsGetRequest) PathParams() *TermsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TermsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TermsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TermsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TermsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TermsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TermsGetRequest) NewRequestBody() TermsGetRequestBody {
+	return TermsGetRequestBody{}
+}
+
+type TermsGetRequestBody struct {
+}
+
+func (r *TermsGetRequest) RequestBody() *TermsGetRequestBody {
+	return nil
+}
+
+func (r *TermsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TermsGetRequest) SetRequestBody(body TermsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TermsGetRequest) NewResponseBody() *TermsGetResponseBody {
+	return &TermsGetResponseBody{}
+}
+
+type TermsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TermsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/term", r.PathParams())
+	return &u, err
+}
+
+func (r *TermsGetRequest) Do(ctx context.Context) (TermsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTimeBillGetRequest() TimeBillGetRequest {
+	r := TimeBillGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TimeBillGetRequest struct {
+	client      *Client
+	queryParams *TimeBillGetRequestQueryParams
+	pathParams  *TimeBillGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TimeBillGetRequestBody
+}
+
+func (r TimeBillGetRequest) NewQueryParams() *TimeBillGetRequestQueryParams {
+	return &TimeBillGetRequestQueryParams{}
+}
+
+type TimeBillGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TimeBillGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TimeBillGetRequest) QueryParams() *TimeBillGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TimeBillGetRequest) NewPathParams() *TimeBillGetRequestPathParams {
+	return &TimeBillGetRequestPathParams{}
+}
+
+type TimeBillGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TimeBillGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TimeBillGetRequest) PathParams() *TimeBillGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TimeBillGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TimeBillGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TimeBillGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TimeBillGetRequest) NewRequestBody() TimeBillGetRequestBody {
+	return TimeBillGetRequestBody{}
+}
+
+type TimeBillGetRequestBody struct {
+}
+
+func (r *TimeBillGetRequest) RequestBody() *TimeBillGetRequestBody {
+	return nil
+}
+
+func (r *TimeBillGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TimeBillGetRequest) SetRequestBody(body TimeBillGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TimeBillGetRequest) NewResponseBody() *TimeBillGetResponseBody {
+	return &TimeBillGetResponseBody{}
+}
+
+type TimeBillGetResponseBody struct {
+	Links Links `json:"links"`
+	TimeBill
+}
+
+func (r *TimeBillGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/timeBill/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TimeBillGetRequest) Do(ctx context.Context) (TimeBillGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTimeBillPostRequest() TimeBillPostRequest {
+	r := TimeBillPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TimeBillPostRequest struct {
+	client      *Client
+	queryParams *TimeBillPostRequestQueryParams
+	pathParams  *TimeBillPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TimeBillPostRequestBody
+}
+
+func (r TimeBillPostRequest) NewQueryParams() *TimeBillPostRequestQueryParams {
+	return &TimeBillPostRequestQueryParams{}
+}
+
+type TimeBillPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TimeBillPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TimeBillPostRequest) QueryParams() *TimeBillPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TimeBillPostRequest) NewPathParams() *TimeBillPostRequestPathParams {
+	return &TimeBillPostRequestPathParams{}
+}
+
+type TimeBillPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TimeBillPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TimeBillPostRequest) PathParams() *TimeBillPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TimeBillPostRequest) Method() string {
+	return r.method
+}
+
+func (r *TimeBillPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TimeBillPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TimeBillPostRequest) NewRequestBody() TimeBillPostRequestBody {
+	return TimeBillPostRequestBody{}
+}
+
+type TimeBillPostRequestBody struct {
+	TimeBill
+}
+
+func (r *TimeBillPostRequest) RequestBody() *TimeBillPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *TimeBillPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TimeBillPostRequest) SetRequestBody(body TimeBillPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TimeBillPostRequest) NewResponseBody() *TimeBillPostResponseBody {
+	return &TimeBillPostResponseBody{}
+}
+
+type TimeBillPostResponseBody struct {
+}
+
+func (r *TimeBillPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/timeBill", r.PathParams())
+	return &u, err
+}
+
+func (r *TimeBillPostRequest) Do(ctx context.Context) (TimeBillPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
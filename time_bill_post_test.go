@@ -0,0 +1,28 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestTimeBillPost(t *testing.T) {
+	req := client.NewTimeBillPostRequest()
+	req.RequestBody().Employee.ID = "1642"
+	req.RequestBody().TimeBill.ID = "70202"
+	req.RequestBody().Item.ID = "131"
+	req.RequestBody().Hours = 7.5
+	req.RequestBody().IsBillable = true
+	req.RequestBody().TranDate = netsuite.Date{Time: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTimeBillsGetRequest() TimeBillsGetRequest {
+	r := TimeBillsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TimeBillsGetRequest struct {
+	client      *Client
+	queryParams *TimeBillsGetRequestQueryParams
+	pathParams  *TimeBillsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TimeBillsGetRequestBody
+}
+
+func (r TimeBillsGetRequest) NewQueryParams() *TimeBillsGetRequestQueryParams {
+	return &TimeBillsGetRequestQueryParams{}
+}
+
+type TimeBillsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TimeBillsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TimeBillsGetRequest) QueryParams() *TimeBillsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TimeBillsGetRequest) NewPathParams() *TimeBillsGetRequestPathParams {
+	return &TimeBillsGetRequestPathParams{}
+}
+
+type TimeBillsGetRequestPathParams struct {
+}
+
+func (p *TimeBillsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TimeBillsGetRequest) PathParams() *TimeBillsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TimeBillsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TimeBillsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TimeBillsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TimeBillsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TimeBillsGetRequest) NewRequestBody() TimeBillsGetRequestBody {
+	return TimeBillsGetRequestBody{}
+}
+
+type TimeBillsGetRequestBody struct {
+}
+
+func (r *TimeBillsGetRequest) RequestBody() *TimeBillsGetRequestBody {
+	return nil
+}
+
+func (r *TimeBillsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TimeBillsGetRequest) SetRequestBody(body TimeBillsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TimeBillsGetRequest) NewResponseBody() *TimeBillsGetResponseBody {
+	return &TimeBillsGetResponseBody{}
+}
+
+type TimeBillsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TimeBillsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/timeBill", r.PathParams())
+	return &u, err
+}
+
+func (r *TimeBillsGetRequest) Do(ctx context.Context) (TimeBillsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTransferOrderDeleteRequest() TransferOrderDeleteRequest {
+	r := TransferOrderDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransferOrderDeleteRequest struct {
+	client      *Client
+	queryParams *TransferOrderDeleteRequestQueryParams
+	pathParams  *TransferOrderDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransferOrderDeleteRequestBody
+}
+
+func (r TransferOrderDeleteRequest) NewQueryParams() *TransferOrderDeleteRequestQueryParams {
+	return &TransferOrderDeleteRequestQueryParams{}
+}
+
+type TransferOrderDeleteRequestQueryParams struct {
+}
+
+func (p TransferOrderDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransferOrderDeleteRequest) QueryParams() *TransferOrderDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransferOrderDeleteRequest) NewPathParams() *TransferOrderDeleteRequestPathParams {
+	return &TransferOrderDeleteRequestPathParams{}
+}
+
+type TransferOrderDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TransferOrderDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TransferOrderDeleteRequest) PathParams() *TransferOrderDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransferOrderDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *TransferOrderDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransferOrderDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransferOrderDeleteRequest) NewRequestBody() TransferOrderDeleteRequestBody {
+	return TransferOrderDeleteRequestBody{}
+}
+
+type TransferOrderDeleteRequestBody struct {
+}
+
+func (r *TransferOrderDeleteRequest) RequestBody() *TransferOrderDeleteRequestBody {
+	return nil
+}
+
+func (r *TransferOrderDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TransferOrderDeleteRequest) SetRequestBody(body TransferOrderDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransferOrderDeleteRequest) NewResponseBody() *TransferOrderDeleteResponseBody {
+	return &TransferOrderDeleteResponseBody{}
+}
+
+type TransferOrderDeleteResponseBody struct {
+}
+
+func (r *TransferOrderDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/transferOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TransferOrderDeleteRequest) Do(ctx context.Context) (TransferOrderDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTransferOrderGetRequest() TransferOrderGetRequest {
+	r := TransferOrderGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransferOrderGetRequest struct {
+	client      *Client
+	queryParams *TransferOrderGetRequestQueryParams
+	pathParams  *TransferOrderGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransferOrderGetRequestBody
+}
+
+func (r TransferOrderGetRequest) NewQueryParams() *TransferOrderGetRequestQueryParams {
+	return &TransferOrderGetRequestQueryParams{}
+}
+
+type TransferOrderGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TransferOrderGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransferOrderGetRequest) QueryParams() *TransferOrderGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransferOrderGetRequest) NewPathParams() *TransferOrderGetRequestPathParams {
+	return &TransferOrderGetRequestPathParams{}
+}
+
+type TransferOrderGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TransferOrderGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TransferOrderGetRequest) PathParams() *TransferOrderGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransferOrderGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TransferOrderGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransferOrderGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransferOrderGetRequest) NewRequestBody() TransferOrderGetRequestBody {
+	return TransferOrderGetRequestBody{}
+}
+
+type TransferOrderGetRequestBody struct {
+}
+
+func (r *TransferOrderGetRequest) RequestBody() *TransferOrderGetRequestBody {
+	return nil
+}
+
+func (r *TransferOrderGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TransferOrderGetRequest) SetRequestBody(body TransferOrderGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransferOrderGetRequest) NewResponseBody() *TransferOrderGetResponseBody {
+	return &TransferOrderGetResponseBody{}
+}
+
+type TransferOrderGetResponseBody struct {
+	Links Links `json:"links"`
+	TransferOrder
+}
+
+func (r *TransferOrderGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/transferOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TransferOrderGetRequest) Do(ctx context.Context) (TransferOrderGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTransferOrderPatchRequest() TransferOrderPatchRequest {
+	r := TransferOrderPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransferOrderPatchRequest struct {
+	client      *Client
+	queryParams *TransferOrderPatchRequestQueryParams
+	pathParams  *TransferOrderPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransferOrderPatchRequestBody
+}
+
+func (r TransferOrderPatchRequest) NewQueryParams() *TransferOrderPatchRequestQueryParams {
+	return &TransferOrderPatchRequestQueryParams{}
+}
+
+type TransferOrderPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p TransferOrderPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransferOrderPatchRequest) QueryParams() *TransferOrderPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransferOrderPatchRequest) NewPathParams() *TransferOrderPatchRequestPathParams {
+	return &TransferOrderPatchRequestPathParams{}
+}
+
+type TransferOrderPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TransferOrderPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TransferOrderPatchRequest) PathParams() *TransferOrderPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransferOrderPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *TransferOrderPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransferOrderPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransferOrderPatchRequest) NewRequestBody() TransferOrderPatchRequestBody {
+	return TransferOrderPatchRequestBody{}
+}
+
+type TransferOrderPatchRequestBody struct {
+	TransferOrder
+}
+
+func (r *TransferOrderPatchRequest) RequestBody() *TransferOrderPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *TransferOrderPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TransferOrderPatchRequest) SetRequestBody(body TransferOrderPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransferOrderPatchRequest) NewResponseBody() *TransferOrderPatchResponseBody {
+	return &TransferOrderPatchResponseBody{}
+}
+
+type TransferOrderPatchResponseBody struct {
+}
+
+func (r *TransferOrderPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/transferOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TransferOrderPatchRequest) Do(ctx context.Context) (TransferOrderPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestTransferOrderPatch(t *testing.T) {
+	req := client.NewTransferOrderPatchRequest()
+	req.PathParams().ID = 5120
+	req.RequestBody().Memo = "Rush"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTransferOrderPostRequest() TransferOrderPostRequest {
+	r := TransferOrderPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransferOrderPostRequest struct {
+	client      *Client
+	queryParams *TransferOrderPostRequestQueryParams
+	pathParams  *TransferOrderPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransferOrderPostRequestBody
+}
+
+func (r TransferOrderPostRequest) NewQueryParams() *TransferOrderPostRequestQueryParams {
+	return &TransferOrderPostRequestQueryParams{}
+}
+
+type TransferOrderPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p TransferOrderPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransferOrderPostRequest) QueryParams() *TransferOrderPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransferOrderPostRequest) NewPathParams() *TransferOrderPostRequestPathParams {
+	return &TransferOrderPostRequestPathParams{}
+}
+
+type TransferOrderPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *TransferOrderPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *TransferOrderPostRequest) PathParams() *TransferOrderPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrderPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransferOrderPostRequest) Method() string {
+	return r.method
+}
+
+func (r *TransferOrderPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransferOrderPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransferOrderPostRequest) NewRequestBody() TransferOrderPostRequestBody {
+	return TransferOrderPostRequestBody{}
+}
+
+type TransferOrderPostRequestBody struct {
+	TransferOrder
+}
+
+func (r *TransferOrderPostRequest) RequestBody() *TransferOrderPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *TransferOrderPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *TransferOrderPostRequest) SetRequestBody(body TransferOrderPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransferOrderPostRequest) NewResponseBody() *TransferOrderPostResponseBody {
+	return &TransferOrderPostResponseBody{}
+}
+
+type TransferOrderPostResponseBody struct {
+}
+
+func (r *TransferOrderPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/transferOrder", r.PathParams())
+	return &u, err
+}
+
+func (r *TransferOrderPostRequest) Do(ctx context.Context) (TransferOrderPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,32 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestTransferOrderPost(t *testing.T) {
+	req := client.NewTransferOrderPostRequest()
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Location.ID = "5"
+	req.RequestBody().TransferLocation.ID = "6"
+	req.RequestBody().Item = netsuite.TransferOrderItems{
+		Items: netsuite.TransferOrderItemItems{
+			{
+				Item:     netsuite.RecordRef{ID: "131"},
+				Quantity: 10,
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
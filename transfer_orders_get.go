@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewTransferOrdersGetRequest() TransferOrdersGetRequest {
+	r := TransferOrdersGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransferOrdersGetRequest struct {
+	client      *Client
+	queryParams *TransferOrdersGetRequestQueryParams
+	pathParams  *TransferOrdersGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransferOrdersGetRequestBody
+}
+
+func (r TransferOrdersGetRequest) NewQueryParams() *TransferOrdersGetRequestQueryParams {
+	return &TransferOrdersGetRequestQueryParams{}
+}
+
+type TransferOrdersGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p TransferOrdersGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransferOrdersGetRequest) QueryParams() *TransferOrdersGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransferOrdersGetRequest) NewPathParams() *TransferOrdersGetRequestPathParams {
+	return &TransferOrdersGetRequestPathParams{}
+}
+
+type TransferOrdersGetRequestPathParams struct {
+}
+
+func (p *TransferOrdersGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *TransferOrdersGetRequest) PathParams() *TransferOrdersGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrdersGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransferOrdersGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransferOrdersGetRequest) Method() string {
+	return r.method
+}
+
+func (r *TransferOrdersGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransferOrdersGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransferOrdersGetRequest) NewRequestBody() TransferOrdersGetRequestBody {
+	return TransferOrdersGetRequestBody{}
+}
+
+type TransferOrdersGetRequestBody struct {
+}
+
+func (r *TransferOrdersGetRequest) RequestBody() *TransferOrdersGetRequestBody {
+	return nil
+}
+
+func (r *TransferOrdersGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *TransferOrdersGetRequest) SetRequestBody(body TransferOrdersGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransferOrdersGetRequest) NewResponseBody() *TransferOrdersGetResponseBody {
+	return &TransferOrdersGetResponseBody{}
+}
+
+type TransferOrdersGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *TransferOrdersGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/transferOrder", r.PathParams())
+	return &u, err
+}
+
+func (r *TransferOrdersGetRequest) Do(ctx context.Context) (TransferOrdersGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
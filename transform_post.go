@@ -0,0 +1,151 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+// NewTransformPostRequest transforms a record into another record type, e.g. a
+// transfer order into an item fulfillment. The request body holds the fields
+// to set on the new record; leave it empty to use the defaults NetSuite
+// copies over from the source record.
+func (c *Client) NewTransformPostRequest() TransformPostRequest {
+	r := TransformPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type TransformPostRequest struct {
+	client      *Client
+	queryParams *TransformPostRequestQueryParams
+	pathParams  *TransformPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody TransformPostRequestBody
+}
+
+func (r TransformPostRequest) NewQueryParams() *TransformPostRequestQueryParams {
+	return &TransformPostRequestQueryParams{}
+}
+
+type TransformPostRequestQueryParams struct {
+}
+
+func (p TransformPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *TransformPostRequest) QueryParams() *TransformPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r TransformPostRequest) NewPathParams() *TransformPostRequestPathParams {
+	return &TransformPostRequestPathParams{}
+}
+
+type TransformPostRequestPathParams struct {
+	RecordType string `schema:"record_type"`
+	ID         int    `schema:"id"`
+	TargetType string `schema:"target_type"`
+}
+
+func (p *TransformPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"record_type": p.RecordType,
+		"id":          strconv.Itoa(p.ID),
+		"target_type": p.TargetType,
+	}
+}
+
+func (r *TransformPostRequest) PathParams() *TransformPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *TransformPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *TransformPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *TransformPostRequest) Method() string {
+	return r.method
+}
+
+func (r *TransformPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *TransformPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r TransformPostRequest) NewRequestBody() TransformPostRequestBody {
+	return struct{}{}
+}
+
+type TransformPostRequestBody interface{}
+
+func (r *TransformPostRequest) RequestBody() *TransformPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *TransformPostRequest) RequestBodyInterface() interface{} {
+	return r.requestBody
+}
+
+func (r *TransformPostRequest) SetRequestBody(body TransformPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *TransformPostRequest) NewResponseBody() *TransformPostResponseBody {
+	return &TransformPostResponseBody{}
+}
+
+type TransformPostResponseBody struct {
+}
+
+func (r *TransformPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/{{.record_type}}/{{.id}}/!transform/{{.target_type}}", r.PathParams())
+	return &u, err
+}
+
+func (r *TransformPostRequest) Do(ctx context.Context) (TransformPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,50 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestTransformPost(t *testing.T) {
+	req := client.NewTransformPostRequest()
+	req.PathParams().RecordType = "transferOrder"
+	req.PathParams().ID = 5120
+	req.PathParams().TargetType = "itemFulfillment"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestTransformPostReturnAuthorizationToCreditMemo(t *testing.T) {
+	req := client.NewTransformPostRequest()
+	req.PathParams().RecordType = "returnAuthorization"
+	req.PathParams().ID = 8120
+	req.PathParams().TargetType = "creditMemo"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
+
+func TestTransformPostVendorReturnAuthorizationToVendorCredit(t *testing.T) {
+	req := client.NewTransformPostRequest()
+	req.PathParams().RecordType = "vendorReturnAuthorization"
+	req.PathParams().ID = 8220
+	req.PathParams().TargetType = "vendorCredit"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
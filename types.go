@@ -1,6 +1,11 @@
 package netsuite
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/cydev/zero"
 	"github.com/omniboost/go-netsuite-rest/omitempty"
 )
@@ -47,12 +52,12 @@ type JournalEntry struct {
 	// CustbodyVatrepTaxperiodTrn      string `json:"custbody_vatrep_taxperiod_trn"`
 	// CustbodyVatrepTrnenabled        Bool   `json:"custbody_vatrep_trnenabled"`
 	CustomForm             CustomForm `json:"customForm,omitempty"`
-	ExchangeRate           float64    `json:"exchangeRate,omitempty"`
+	ExchangeRate           Decimal    `json:"exchangeRate,omitempty"`
 	ExcludeFromGLNumbering Bool       `json:"excludeFromGLNumbering,omitempty"`
 	ID                     string     `json:"id,omitempty"`
 	IsReversal             Bool       `json:"isReversal,omitempty"`
 	// LastModifiedDate       Date             `json:"lastModifiedDate,omitempty"`
-	Lines         JournalEntryLine `json:"line"`
+	Lines         JournalEntryLine `json:"line,omitempty"`
 	Memo          string           `json:"memo"`
 	PostingPeriod PostingPeriod    `json:"postingPeriod,omitempty"`
 	RefName       string           `json:"refName,omitempty"`
@@ -62,70 +67,46 @@ type JournalEntry struct {
 	TranID        string           `json:"tranId,omitempty"`
 	Void          Bool             `json:"void,omitempty"`
 	// CustBody4              string           `json:"custbody4"`
-}
-
-func (j JournalEntry) MarshalJSON() ([]byte, error) {
-	return omitempty.MarshalJSON(j)
-}
-
-func (j JournalEntry) IsEmpty() bool {
-	return zero.IsZero(j)
-}
 
-type JournalEntryLine struct {
-	Links        Links                    `json:"links,omitempty"`
-	Items        JournalEntryLineElements `json:"items"`
-	TotalResults int                      `json:"totalResults,omitempty"`
+	CustomFields CustomFields `json:"-"`
 }
 
-type Currency struct {
-	Links   Links  `json:"links"`
-	ID      string `json:"id"`
-	RefName string `json:"refName"`
+func (j JournalEntry) MarshalJSON() ([]byte, error) {
+	b, err := omitempty.MarshalJSON(j)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, j.CustomFields)
 }
 
-func (c Currency) IsEmpty() bool {
-	return zero.IsZero(c)
-}
+func (j *JournalEntry) UnmarshalJSON(data []byte) error {
+	type alias JournalEntry
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
 
-type PostingPeriod struct {
-	Links   Links  `json:"links"`
-	ID      string `json:"id"`
-	RefName string `json:"refName"`
+	*j = JournalEntry(a)
+	j.CustomFields = cf
+	return nil
 }
 
-func (p PostingPeriod) IsEmpty() bool {
-	return zero.IsZero(p)
+func (j JournalEntry) IsEmpty() bool {
+	return zero.IsZero(j)
 }
 
-type Subsidiary struct {
-	Links   Links  `json:"links,omitempty"`
-	ID      string `json:"id,omitempty"`
-	RefName string `json:"refName,omitempty"`
-}
+type JournalEntryLine = Sublist[JournalEntryLineElement]
 
-func (s Subsidiary) IsEmpty() bool {
-	return zero.IsZero(s)
-}
+type Currency = RecordRef
 
-type AccountingBook struct {
-	Links   Links  `json:"links,omitempty"`
-	ID      string `json:"id,omitempty"`
-	RefName string `json:"refName,omitempty"`
-}
+type PostingPeriod = RecordRef
 
-func (a AccountingBook) IsEmpty() bool {
-	return zero.IsZero(a)
-}
+type Subsidiary = RecordRef
 
-type CustomForm struct {
-	ID      string `json:"id"`
-	RefName string `json:"refName"`
-}
+type AccountingBook = RecordRef
 
-func (c CustomForm) IsEmpty() bool {
-	return zero.IsZero(c)
-}
+type CustomForm = RecordRef
 
 type JournalEntryLineElements []JournalEntryLineElement
 
@@ -133,11 +114,11 @@ type JournalEntryLineElement struct {
 	Links               Links     `json:"links,omitempty"`
 	Account             Account   `json:"Account,omitempty"`
 	Cleared             Bool      `json:"cleared,omitempty"`
-	Credit              float64   `json:"credit,omitempty"`
+	Credit              Decimal   `json:"credit,omitempty"`
 	Custcol2663Isperson Bool      `json:"custcol_2663_isperson,omitempty"`
 	Eliminate           Bool      `json:"eliminate,omitempty"`
 	Line                int       `json:"line,omitempty"`
-	Debit               float64   `json:"debit,omitempty"`
+	Debit               Decimal   `json:"debit,omitempty"`
 	Memo                string    `json:"memo"`
 	Department          RecordRef `json:"Department,omitempty"`
 	Class               RecordRef `json:"Class,omitempty"`
@@ -146,10 +127,38 @@ type JournalEntryLineElement struct {
 	CustCol3            string    `json:"custcol3,omitempty"`
 	CustCol4            string    `json:"custcol4,omitempty"`
 	CustCol5            string    `json:"custcol5,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
 }
 
+func (j JournalEntryLineElement) LineID() string {
+	if j.Line == 0 {
+		return ""
+	}
+	return strconv.Itoa(j.Line)
+}
+
+// MarshalJSON leaves out the read only links.
 func (j JournalEntryLineElement) MarshalJSON() ([]byte, error) {
-	return omitempty.MarshalJSON(j)
+	j.Links = nil
+	b, err := omitempty.MarshalJSON(j)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, j.CustomFields)
+}
+
+func (j *JournalEntryLineElement) UnmarshalJSON(data []byte) error {
+	type alias JournalEntryLineElement
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*j = JournalEntryLineElement(a)
+	j.CustomFields = cf
+	return nil
 }
 
 type Accounts []Account
@@ -181,7 +190,6 @@ type Invoice struct {
 	// AmountPaid              float64 `json:"amountPaid"`
 	// AmountRemaining         float64 `json:"amountRemaining"`
 	// AmountRemainingTotalBox float64 `json:"amountRemainingTotalBox"`
-	// BillingAddress          Address `json:"billingAddress"`
 	// CreatedDate Date `json:"createdDate"`
 	// Currency    struct {
 	// 	Links   Links  `json:"links"`
@@ -233,17 +241,13 @@ type Invoice struct {
 	// } `json:"custbody_ste_transaction_type"`
 	CustomForm CustomForm `json:"customForm"`
 	DueDate    Date       `json:"dueDate,omitempty"`
-	Entity     struct {
-		Links   Links  `json:"links,omitempty"`
-		ID      string `json:"id"`
-		RefName string `json:"refName,omitempty"`
-	} `json:"entity"`
+	Entity     RecordRef  `json:"entity,omitempty"`
 	// EstGrossProfit         float64     `json:"estGrossProfit"`
 	// EstGrossProfitPercent  float64     `json:"estGrossProfitPercent"`
 	// ExchangeRate           float64     `json:"exchangeRate"`
 	// ExcludeFromGLNumbering Bool        `json:"excludeFromGLNumbering"`
 	ID   string      `json:"id"`
-	Item InvoiceItem `json:"item"`
+	Item InvoiceItem `json:"item,omitempty"`
 	// LastModifiedDate       Date        `json:"lastModifiedDate"`
 	// Location InvoiceLocation `json:"location"`
 	Memo string `json:"memo"`
@@ -262,14 +266,13 @@ type Invoice struct {
 	// ShipDate           Date    `json:"shipDate"`
 	// ShipIsResidential  Bool    `json:"shipIsResidential"`
 	// ShipOverride       Bool    `json:"shipOverride"`
-	// ShippingAddress    Address `json:"shippingAddress"`
 	// Status struct {
 	// 	ID      string `json:"id"`
 	// 	RefName string `json:"refName"`
 	// } `json:"status"`
 	Subsidiary          Subsidiary `json:"subsidiary"`
 	SubsidiaryTaxRegNum string     `json:"subsidiaryTaxRegNum,omitempty"`
-	Subtotal            float64    `json:"subtotal,omitempty"`
+	Subtotal            Decimal    `json:"subtotal,omitempty"`
 	// TaxDetails           InvoiceTaxDetails `json:"taxDetails,omitempty"`
 	// TaxDetailsOverride   Bool    `json:"taxDetailsOverride"`
 	// TaxPointDate         Date    `json:"taxPointDate"`
@@ -286,20 +289,38 @@ type Invoice struct {
 	TranID     string    `json:"tranId"`
 	Department RecordRef `json:"Department,omitempty"`
 	Class      RecordRef `json:"Class,omitempty"`
+
+	BillingAddress  AddressBookAddress `json:"billingAddress,omitempty"`
+	ShippingAddress AddressBookAddress `json:"shippingAddress,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
 }
 
 func (i Invoice) MarshalJSON() ([]byte, error) {
-	return omitempty.MarshalJSON(i)
+	b, err := omitempty.MarshalJSON(i)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, i.CustomFields)
+}
+
+func (i *Invoice) UnmarshalJSON(data []byte) error {
+	type alias Invoice
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*i = Invoice(a)
+	i.CustomFields = cf
+	return nil
 }
 
 type Customers []Customer
 
 type Customer struct {
-	AddressBook struct {
-		Links        Links     `json:"links"`
-		Items        []Address `json:"items"`
-		TotalResults int       `json:"totalResults"`
-	} `json:"addressBook"`
+	AddressBook AddressBook `json:"addressBook,omitempty"`
 	// Aging  float64 `json:"aging"`
 	// Aging1 float64 `json:"aging1"`
 	// Aging2 float64 `json:"aging2"`
@@ -379,11 +400,7 @@ type Customer struct {
 	// } `json:"emailPreference"`
 	// EmailTransactions Bool   `json:"emailTransactions,omitempty"`
 	// EntityID          string `json:"entityId,omitempty"`
-	// EntityStatus      struct {
-	// 	Links   Links  `json:"links"`
-	// 	ID      string `json:"id"`
-	// 	RefName string `json:"refName"`
-	// } `json:"entityStatus"`
+	EntityStatus *RecordRef `json:"entityStatus,omitempty"`
 	// FaxTransactions          Bool `json:"faxTransactions,omitempty"`
 	FirstName string `json:"firstName"`
 	// GlobalSubscriptionStatus struct {
@@ -419,6 +436,7 @@ type Customer struct {
 	// 	RefName string `json:"refName"`
 	// } `json:"receivablesAccount"`
 	// ShipComplete Bool `json:"shipComplete"`
+	Stage *RecordRef `json:"stage,omitempty"`
 	// ShippingCarrier struct {
 	ID string `json:"id,omitempty"`
 	// 	RefName string `json:"refName"`
@@ -449,20 +467,40 @@ type Customer struct {
 	DefaultShippingAddress string `json:"defaultshippingaddress"`
 	Parent                 string `json:"parent"`
 	CustomerNumber         string `json:"custentity_nch_customer_number"`
+
+	CustomFields CustomFields `json:"-"`
 }
 
-type InvoiceItem struct {
-	Links        Links            `json:"links"`
-	TotalResults int              `json:"totalResults"`
-	Items        InvoiceItemItems `json:"items"`
+func (c Customer) MarshalJSON() ([]byte, error) {
+	type alias Customer
+	b, err := json.Marshal(alias(c))
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, c.CustomFields)
 }
 
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	type alias Customer
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*c = Customer(a)
+	c.CustomFields = cf
+	return nil
+}
+
+type InvoiceItem = Sublist[InvoiceItemItem]
+
 type InvoiceItemItems []InvoiceItemItem
 
 type InvoiceItemItem struct {
-	Links   Links   `json:"links"`
+	Links   Links   `json:"links,omitempty"`
 	Account Account `json:"account"`
-	Amount  float64 `json:"amount"`
+	Amount  Decimal `json:"amount"`
 	// CostEstimate     float64 `json:"costEstimate"`
 	// CostEstimateRate float64 `json:"costEstimateRate"`
 	// CostEstimateType struct {
@@ -493,8 +531,8 @@ type InvoiceItemItem struct {
 	// } `json:"inventoryDetail"`
 	Item        InvoiceItemItemItem `json:"item"`
 	ItemSubType string              `json:"itemSubType"`
-	ItemType    string              `json:"itemType"`
-	// Line        int                 `json:"line"`
+	ItemType    ItemType            `json:"itemType"`
+	Line        int                 `json:"line,omitempty"`
 	// Marginal Bool `json:"marginal"`
 	// Price struct {
 	// 	Links   Links  `json:"links"`
@@ -503,59 +541,67 @@ type InvoiceItemItem struct {
 	// } `json:"price"`
 	// PrintItems          Bool    `json:"printItems"`
 	// Quantity            float64 `json:"quantity"`
-	TaxAmount           float64 `json:"taxAmount"`
+	TaxAmount           Decimal `json:"taxAmount"`
 	TaxDetailsReference string  `json:"taxDetailsReference"`
 	// Units               string  `json:"units"`
 	// CustCol2 string `json:"custcol2"`
 	// CustCol3 string `json:"custcol3"`
-}
 
-type InvoiceTaxDetails struct {
-	Links Links `json:"links"`
-	Items []struct {
-		Links     Links   `json:"links"`
-		LineName  string  `json:"lineName"`
-		LineType  string  `json:"lineType"`
-		NetAmount float64 `json:"netAmount"`
-		TaxAmount float64 `json:"taxAmount"`
-		TaxBasis  float64 `json:"taxBasis"`
-		TaxCode   struct {
-			Links   Links  `json:"links"`
-			ID      string `json:"id"`
-			RefName string `json:"refName"`
-		} `json:"taxCode"`
-		TaxDetailsReference struct {
-			ID      string `json:"id"`
-			RefName string `json:"refName"`
-		} `json:"taxDetailsReference"`
-		TaxRate float64 `json:"taxRate"`
-		TaxType string  `json:"taxType"`
-	} `json:"items"`
-	TotalResults int `json:"totalResults"`
+	CustomFields CustomFields `json:"-"`
 }
 
-type InvoiceItemItemItem struct {
-	// Links   Links  `json:"links"`
-	ID      int    `json:"id"`
-	RefName string `json:"refName"`
+func (i InvoiceItemItem) LineID() string {
+	if i.Line == 0 {
+		return ""
+	}
+	return strconv.Itoa(i.Line)
 }
 
-type InvoiceLocation struct {
-	Links   Links  `json:"links"`
-	ID      int    `json:"id"`
-	RefName string `json:"refName"`
+// MarshalJSON leaves out the read only links.
+func (i InvoiceItemItem) MarshalJSON() ([]byte, error) {
+	type alias InvoiceItemItem
+	i.Links = nil
+	b, err := json.Marshal(alias(i))
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, i.CustomFields)
 }
 
-type RecordRef struct {
-	ID string `json:"id"`
-	// ExternalID string `json:"externalId"`
-	// InternalID string `json:"id"`
+func (i *InvoiceItemItem) UnmarshalJSON(data []byte) error {
+	type alias InvoiceItemItem
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*i = InvoiceItemItem(a)
+	i.CustomFields = cf
+	return nil
 }
 
-func (r RecordRef) IsEmpty() bool {
-	return zero.IsZero(r)
+type InvoiceTaxDetails struct {
+	Links Links `json:"links"`
+	Items []struct {
+		Links               Links     `json:"links"`
+		LineName            string    `json:"lineName"`
+		LineType            string    `json:"lineType"`
+		NetAmount           Decimal   `json:"netAmount"`
+		TaxAmount           Decimal   `json:"taxAmount"`
+		TaxBasis            Decimal   `json:"taxBasis"`
+		TaxCode             RecordRef `json:"taxCode"`
+		TaxDetailsReference RecordRef `json:"taxDetailsReference"`
+		TaxRate             float64   `json:"taxRate"`
+		TaxType             string    `json:"taxType"`
+	} `json:"items"`
+	TotalResults int `json:"totalResults"`
 }
 
+type InvoiceItemItemItem = RecordRef
+
+type InvoiceLocation = RecordRef
+
 type Classifications []Classification
 
 type Classification struct {
@@ -626,3 +672,2969 @@ type Location struct {
 	Subsidiary             string        `json:"subsidiary"`
 	Externalid             string        `json:"externalid,omitempty"`
 }
+
+type Employees []Employee
+
+type Employee struct {
+	Approver                   RecordRef     `json:"approver,omitempty"`
+	Class                      RecordRef     `json:"class,omitempty"`
+	Currency                   Currency      `json:"currency,omitempty"`
+	CustomForm                 CustomForm    `json:"customForm,omitempty"`
+	DateCreated                Date          `json:"dateCreated,omitempty"`
+	Department                 RecordRef     `json:"department,omitempty"`
+	Email                      string        `json:"email,omitempty"`
+	EmployeeStatus             RecordRef     `json:"employeeStatus,omitempty"`
+	EmployeeType               RecordRef     `json:"employeeType,omitempty"`
+	EntityID                   string        `json:"entityId,omitempty"`
+	ExpenseLimit               Decimal       `json:"expenseLimit,omitempty"`
+	ExternalID                 string        `json:"externalId,omitempty"`
+	FirstName                  string        `json:"firstName,omitempty"`
+	GiveAccess                 Bool          `json:"giveAccess,omitempty"`
+	HireDate                   Date          `json:"hireDate,omitempty"`
+	ID                         string        `json:"id,omitempty"`
+	Initials                   string        `json:"initials,omitempty"`
+	IsInactive                 Bool          `json:"isInactive,omitempty"`
+	IsSalesRep                 Bool          `json:"isSalesRep,omitempty"`
+	IsSupportRep               Bool          `json:"isSupportRep,omitempty"`
+	LastModifiedDate           Date          `json:"lastModifiedDate,omitempty"`
+	LastName                   string        `json:"lastName,omitempty"`
+	Location                   RecordRef     `json:"location,omitempty"`
+	MiddleName                 string        `json:"middleName,omitempty"`
+	MobilePhone                string        `json:"mobilePhone,omitempty"`
+	Phone                      string        `json:"phone,omitempty"`
+	PurchaseOrderApprovalLimit Decimal       `json:"purchaseOrderApprovalLimit,omitempty"`
+	PurchaseOrderApprover      RecordRef     `json:"purchaseOrderApprover,omitempty"`
+	PurchaseOrderLimit         Decimal       `json:"purchaseOrderLimit,omitempty"`
+	ReleaseDate                Date          `json:"releaseDate,omitempty"`
+	Roles                      EmployeeRoles `json:"roles,omitempty"`
+	SendEmail                  Bool          `json:"sendEmail,omitempty"`
+	Subsidiary                 Subsidiary    `json:"subsidiary,omitempty"`
+	Supervisor                 RecordRef     `json:"supervisor,omitempty"`
+	TimeApprover               RecordRef     `json:"timeApprover,omitempty"`
+	Title                      string        `json:"title,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
+}
+
+func (e Employee) MarshalJSON() ([]byte, error) {
+	b, err := omitempty.MarshalJSON(e)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, e.CustomFields)
+}
+
+func (e *Employee) UnmarshalJSON(data []byte) error {
+	type alias Employee
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*e = Employee(a)
+	e.CustomFields = cf
+	return nil
+}
+
+func (e Employee) IsEmpty() bool {
+	return zero.IsZero(e)
+}
+
+type EmployeeRoles struct {
+	Links        Links             `json:"links,omitempty"`
+	Items        EmployeeRoleItems `json:"items"`
+	TotalResults int               `json:"totalResults,omitempty"`
+}
+
+func (e EmployeeRoles) IsEmpty() bool {
+	return zero.IsZero(e)
+}
+
+type EmployeeRoleItems []EmployeeRole
+
+type EmployeeRole struct {
+	Links        Links     `json:"links,omitempty"`
+	SelectedRole RecordRef `json:"selectedRole"`
+}
+
+type Contacts []Contact
+
+type Contact struct {
+	AddressBook              AddressBook          `json:"addressBook,omitempty"`
+	Assistant                RecordRef            `json:"assistant,omitempty"`
+	Category                 RecordRef            `json:"category,omitempty"`
+	Comments                 string               `json:"comments,omitempty"`
+	Company                  RecordRef            `json:"company,omitempty"`
+	ContactSource            RecordRef            `json:"contactSource,omitempty"`
+	CustomForm               CustomForm           `json:"customForm,omitempty"`
+	DateCreated              Date                 `json:"dateCreated,omitempty"`
+	Email                    string               `json:"email,omitempty"`
+	EntityID                 string               `json:"entityId,omitempty"`
+	ExternalID               string               `json:"externalId,omitempty"`
+	Fax                      string               `json:"fax,omitempty"`
+	FirstName                string               `json:"firstName,omitempty"`
+	GlobalSubscriptionStatus RecordRef            `json:"globalSubscriptionStatus,omitempty"`
+	HomePhone                string               `json:"homePhone,omitempty"`
+	ID                       string               `json:"id,omitempty"`
+	IsInactive               Bool                 `json:"isInactive,omitempty"`
+	IsPrivate                Bool                 `json:"isPrivate,omitempty"`
+	LastModifiedDate         Date                 `json:"lastModifiedDate,omitempty"`
+	LastName                 string               `json:"lastName,omitempty"`
+	MiddleName               string               `json:"middleName,omitempty"`
+	MobilePhone              string               `json:"mobilePhone,omitempty"`
+	OfficePhone              string               `json:"officePhone,omitempty"`
+	Phone                    string               `json:"phone,omitempty"`
+	Salutation               string               `json:"salutation,omitempty"`
+	Subscriptions            ContactSubscriptions `json:"subscriptions,omitempty"`
+	Subsidiary               Subsidiary           `json:"subsidiary,omitempty"`
+	Supervisor               RecordRef            `json:"supervisor,omitempty"`
+	Title                    string               `json:"title,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
+}
+
+func (c Contact) MarshalJSON() ([]byte, error) {
+	b, err := omitempty.MarshalJSON(c)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, c.CustomFields)
+}
+
+func (c *Contact) UnmarshalJSON(data []byte) error {
+	type alias Contact
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*c = Contact(a)
+	c.CustomFields = cf
+	return nil
+}
+
+func (c Contact) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type ContactSubscriptions struct {
+	Links        Links                    `json:"links,omitempty"`
+	Items        ContactSubscriptionItems `json:"items"`
+	TotalResults int                      `json:"totalResults,omitempty"`
+}
+
+func (c ContactSubscriptions) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type ContactSubscriptionItems []ContactSubscription
+
+type ContactSubscription struct {
+	Links            Links     `json:"links,omitempty"`
+	LastModifiedDate Date      `json:"lastModifiedDate,omitempty"`
+	Subscribed       Bool      `json:"subscribed"`
+	Subscription     RecordRef `json:"subscription"`
+}
+
+func (c ContactSubscription) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+// AddressBook is the addressBook sublist of entities, keyed by the address
+// book line id.
+type AddressBook struct {
+	Sublist[AddressBookItem]
+}
+
+type AddressBookItems []AddressBookItem
+
+type AddressBookItem struct {
+	Links                  Links              `json:"links,omitempty"`
+	AddressBookAddress     AddressBookAddress `json:"addressBookAddress,omitempty"`
+	AddressBookAddressText string             `json:"addressBookAddress_text,omitempty"`
+	DefaultBilling         Bool               `json:"defaultBilling"`
+	DefaultShipping        Bool               `json:"defaultShipping"`
+	ID                     int                `json:"id,omitempty"`
+	InternalID             int                `json:"internalId,omitempty"`
+	IsResidential          Bool               `json:"isResidential"`
+	Label                  string             `json:"label,omitempty"`
+}
+
+// MarshalJSON leaves out the read only links and address text.
+func (a AddressBookItem) MarshalJSON() ([]byte, error) {
+	a.Links = nil
+	a.AddressBookAddressText = ""
+	return omitempty.MarshalJSON(a)
+}
+
+func (a AddressBookItem) LineID() string {
+	if a.ID == 0 {
+		return ""
+	}
+	return strconv.Itoa(a.ID)
+}
+
+// AddressBookAddress is the address subrecord, of address book lines and of
+// the billing and shipping address of transactions. AddrText is computed by
+// NetSuite unless Override is set.
+type AddressBookAddress struct {
+	Links     Links     `json:"links,omitempty"`
+	Addr1     string    `json:"addr1,omitempty"`
+	Addr2     string    `json:"addr2,omitempty"`
+	Addr3     string    `json:"addr3,omitempty"`
+	Addressee string    `json:"addressee,omitempty"`
+	AddrPhone string    `json:"addrPhone,omitempty"`
+	AddrText  string    `json:"addrText,omitempty"`
+	Attention string    `json:"attention,omitempty"`
+	City      string    `json:"city,omitempty"`
+	Country   RecordRef `json:"country,omitempty"`
+	Override  Bool      `json:"override,omitempty"`
+	State     string    `json:"state,omitempty"`
+	Zip       string    `json:"zip,omitempty"`
+}
+
+// MarshalJSON leaves out the read only links and, unless Override is set,
+// the computed address text.
+func (a AddressBookAddress) MarshalJSON() ([]byte, error) {
+	a.Links = nil
+	if !a.Override {
+		a.AddrText = ""
+	}
+	return omitempty.MarshalJSON(a)
+}
+
+func (a AddressBookAddress) IsEmpty() bool {
+	return zero.IsZero(a)
+}
+
+type CurrencyRecords []CurrencyRecord
+
+type CurrencyRecord struct {
+	CurrencyPrecision      RecordRef `json:"currencyPrecision,omitempty"`
+	DisplaySymbol          string    `json:"displaySymbol,omitempty"`
+	ExchangeRate           Decimal   `json:"exchangeRate,omitempty"`
+	ExternalID             string    `json:"externalId,omitempty"`
+	FormatSample           string    `json:"formatSample,omitempty"`
+	FxRateUpdateTimezone   RecordRef `json:"fxRateUpdateTimezone,omitempty"`
+	ID                     string    `json:"id,omitempty"`
+	IncludeInFxRateUpdates Bool      `json:"includeInFxRateUpdates,omitempty"`
+	IsBaseCurrency         Bool      `json:"isBaseCurrency,omitempty"`
+	IsInactive             Bool      `json:"isInactive,omitempty"`
+	Locale                 RecordRef `json:"locale,omitempty"`
+	Name                   string    `json:"name,omitempty"`
+	OverrideCurrencyFormat Bool      `json:"overrideCurrencyFormat,omitempty"`
+	RefName                string    `json:"refName,omitempty"`
+	Symbol                 string    `json:"symbol,omitempty"`
+	SymbolPlacement        RecordRef `json:"symbolPlacement,omitempty"`
+}
+
+func (c CurrencyRecord) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CurrencyRecord) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type ConsolidatedExchangeRates []ConsolidatedExchangeRate
+
+type ConsolidatedExchangeRate struct {
+	AccountingBook AccountingBook `json:"accountingBook,omitempty"`
+	AverageRate    Decimal        `json:"averageRate,omitempty"`
+	CurrentRate    Decimal        `json:"currentRate,omitempty"`
+	ExternalID     string         `json:"externalId,omitempty"`
+	FromCurrency   Currency       `json:"fromCurrency,omitempty"`
+	FromSubsidiary Subsidiary     `json:"fromSubsidiary,omitempty"`
+	HistoricalRate Decimal        `json:"historicalRate,omitempty"`
+	ID             string         `json:"id,omitempty"`
+	IsDerived      Bool           `json:"isDerived,omitempty"`
+	IsPeriodClosed Bool           `json:"isPeriodClosed,omitempty"`
+	PostingPeriod  PostingPeriod  `json:"postingPeriod,omitempty"`
+	RefName        string         `json:"refName,omitempty"`
+	ToCurrency     Currency       `json:"toCurrency,omitempty"`
+	ToSubsidiary   Subsidiary     `json:"toSubsidiary,omitempty"`
+}
+
+func (c ConsolidatedExchangeRate) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c ConsolidatedExchangeRate) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CurrencyRates []CurrencyRate
+
+type CurrencyRate struct {
+	Links               Links  `json:"links"`
+	BaseCurrency        string `json:"basecurrency"`
+	EffectiveDate       string `json:"effectivedate"`
+	ExchangeRate        string `json:"exchangerate"`
+	ID                  string `json:"id"`
+	Lastmodifieddate    string `json:"lastmodifieddate,omitempty"`
+	TransactionCurrency string `json:"transactioncurrency"`
+	UpdateMethod        string `json:"updatemethod,omitempty"`
+}
+
+func (c CurrencyRate) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type Terms []Term
+
+type Term struct {
+	DateDriven                Bool      `json:"dateDriven,omitempty"`
+	DayDiscountExpires        int       `json:"dayDiscountExpires,omitempty"`
+	DayOfMonthNetDue          int       `json:"dayOfMonthNetDue,omitempty"`
+	DaysUntilExpiry           int       `json:"daysUntilExpiry,omitempty"`
+	DaysUntilNetDue           int       `json:"daysUntilNetDue,omitempty"`
+	DiscountPercent           float64   `json:"discountPercent,omitempty"`
+	DiscountPercentDateDriven float64   `json:"discountPercentDateDriven,omitempty"`
+	DueNextMonthIfWithinDays  int       `json:"dueNextMonthIfWithinDays,omitempty"`
+	ExternalID                string    `json:"externalId,omitempty"`
+	ID                        string    `json:"id,omitempty"`
+	Installment               Bool      `json:"installment,omitempty"`
+	IsInactive                Bool      `json:"isInactive,omitempty"`
+	Name                      string    `json:"name,omitempty"`
+	Preferred                 Bool      `json:"preferred,omitempty"`
+	RecurrenceCount           int       `json:"recurrenceCount,omitempty"`
+	RecurrenceFrequency       RecordRef `json:"recurrenceFrequency,omitempty"`
+	RefName                   string    `json:"refName,omitempty"`
+	RepeatEvery               int       `json:"repeatEvery,omitempty"`
+	SplitEvenly               Bool      `json:"splitEvenly,omitempty"`
+}
+
+func (t Term) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t Term) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type PriceLevels []PriceLevel
+
+type PriceLevel struct {
+	DiscountPct          float64 `json:"discountPct,omitempty"`
+	ExternalID           string  `json:"externalId,omitempty"`
+	ID                   string  `json:"id,omitempty"`
+	IsInactive           Bool    `json:"isInactive,omitempty"`
+	IsOnline             Bool    `json:"isOnline,omitempty"`
+	Name                 string  `json:"name,omitempty"`
+	RefName              string  `json:"refName,omitempty"`
+	UpdateExistingPrices Bool    `json:"updateExistingPrices,omitempty"`
+}
+
+func (p PriceLevel) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PriceLevel) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+// ItemPrices is the pricing matrix of an item: one entry per price level,
+// currency and quantity break.
+type ItemPrices []ItemPrice
+
+type ItemPrice struct {
+	Links          Links             `json:"links,omitempty"`
+	CurrencyPage   Currency          `json:"currencyPage,omitempty"`
+	Price          Decimal           `json:"price"`
+	PriceLevel     RecordRef         `json:"priceLevel,omitempty"`
+	PriceLevelName string            `json:"priceLevelName,omitempty"`
+	Quantity       ItemPriceQuantity `json:"quantity,omitempty"`
+}
+
+func (p ItemPrice) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+type ItemPriceQuantity struct {
+	Value string `json:"value"`
+}
+
+func (q ItemPriceQuantity) IsEmpty() bool {
+	return zero.IsZero(q)
+}
+
+// Price returns the price for the given price level and currency at the
+// given quantity. The entry with the highest quantity break not exceeding
+// quantity is used.
+func (pp ItemPrices) Price(priceLevelID, currencyID string, quantity float64) (Decimal, bool) {
+	found := false
+	price := Decimal{}
+	breakQty := 0.0
+	for _, p := range pp {
+		if p.PriceLevel.ID != priceLevelID || p.CurrencyPage.ID != currencyID {
+			continue
+		}
+
+		qty, err := strconv.ParseFloat(p.Quantity.Value, 64)
+		if err != nil {
+			qty = 0
+		}
+
+		if qty > quantity {
+			continue
+		}
+
+		if !found || qty >= breakQty {
+			found = true
+			price = p.Price
+			breakQty = qty
+		}
+	}
+
+	return price, found
+}
+
+type CustomRecords []CustomRecord
+
+// CustomRecord is a record of a custom record type (customrecord_*). The
+// fields differ per record type so they are kept in a map keyed by their
+// script id.
+type CustomRecord struct {
+	Links  Links                  `json:"links,omitempty"`
+	ID     string                 `json:"id,omitempty"`
+	Fields map[string]interface{} `json:"-"`
+}
+
+func (r *CustomRecord) UnmarshalJSON(data []byte) error {
+	fields := map[string]interface{}{}
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	type alias CustomRecord
+	a := alias{}
+	err = json.Unmarshal(data, &a)
+	if err != nil {
+		return err
+	}
+
+	delete(fields, "links")
+	delete(fields, "id")
+
+	*r = CustomRecord(a)
+	r.Fields = fields
+	return nil
+}
+
+// isExtraField reports that every property is a field of the record: the
+// fields of a custom record type aren't known up front.
+func (r CustomRecord) isExtraField(name string) bool {
+	return true
+}
+
+func (r CustomRecord) MarshalJSON() ([]byte, error) {
+	fields := map[string]interface{}{}
+	for k, v := range r.Fields {
+		fields[k] = v
+	}
+	return json.Marshal(fields)
+}
+
+func (r CustomRecord) IsEmpty() bool {
+	return r.ID == "" && len(r.Fields) == 0
+}
+
+func (r CustomRecord) Get(field string) interface{} {
+	return r.Fields[field]
+}
+
+func (r *CustomRecord) Set(field string, value interface{}) {
+	if r.Fields == nil {
+		r.Fields = map[string]interface{}{}
+	}
+	r.Fields[field] = value
+}
+
+// Decimal returns a numeric field as a Decimal.
+func (r CustomRecord) Decimal(field string) (Decimal, error) {
+	return DecimalFromValue(r.Fields[field])
+}
+
+func (r CustomRecord) String(field string) string {
+	switch v := r.Fields[field].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+type ExpenseReports []ExpenseReport
+
+type ExpenseReport struct {
+	AccountingApproval    Bool                  `json:"accountingApproval,omitempty"`
+	Account               Account               `json:"account,omitempty"`
+	Advance               Decimal               `json:"advance,omitempty"`
+	Amount                Decimal               `json:"amount,omitempty"`
+	ApprovalStatus        RecordRef             `json:"approvalStatus,omitempty"`
+	Class                 RecordRef             `json:"class,omitempty"`
+	Complete              Bool                  `json:"complete,omitempty"`
+	CreatedDate           Date                  `json:"createdDate,omitempty"`
+	CustomForm            CustomForm            `json:"customForm,omitempty"`
+	Department            RecordRef             `json:"department,omitempty"`
+	DueDate               Date                  `json:"dueDate,omitempty"`
+	Entity                RecordRef             `json:"entity,omitempty"`
+	Expense               ExpenseReportExpenses `json:"expense,omitempty"`
+	ExpenseReportCurrency Currency              `json:"expenseReportCurrency,omitempty"`
+	ExternalID            string                `json:"externalId,omitempty"`
+	ID                    string                `json:"id,omitempty"`
+	LastModifiedDate      Date                  `json:"lastModifiedDate,omitempty"`
+	Location              RecordRef             `json:"location,omitempty"`
+	Memo                  string                `json:"memo,omitempty"`
+	NextApprover          RecordRef             `json:"nextApprover,omitempty"`
+	PostingPeriod         PostingPeriod         `json:"postingPeriod,omitempty"`
+	RefName               string                `json:"refName,omitempty"`
+	Status                RecordRef             `json:"status,omitempty"`
+	Subsidiary            Subsidiary            `json:"subsidiary,omitempty"`
+	SupervisorApproval    Bool                  `json:"supervisorApproval,omitempty"`
+	Total                 float64               `json:"total,omitempty"`
+	TranDate              Date                  `json:"tranDate,omitempty"`
+	TranID                string                `json:"tranId,omitempty"`
+	UseMultiCurrency      Bool                  `json:"useMultiCurrency,omitempty"`
+}
+
+func (e ExpenseReport) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(e)
+}
+
+func (e ExpenseReport) IsEmpty() bool {
+	return zero.IsZero(e)
+}
+
+type ExpenseReportExpenses struct {
+	Links        Links                     `json:"links,omitempty"`
+	Items        ExpenseReportExpenseItems `json:"items"`
+	TotalResults int                       `json:"totalResults,omitempty"`
+}
+
+func (e ExpenseReportExpenses) IsEmpty() bool {
+	return zero.IsZero(e)
+}
+
+type ExpenseReportExpenseItems []ExpenseReportExpense
+
+type ExpenseReportExpense struct {
+	Links         Links     `json:"links,omitempty"`
+	Amount        Decimal   `json:"amount,omitempty"`
+	Category      RecordRef `json:"category,omitempty"`
+	Class         RecordRef `json:"class,omitempty"`
+	Currency      Currency  `json:"currency,omitempty"`
+	Customer      RecordRef `json:"customer,omitempty"`
+	Department    RecordRef `json:"department,omitempty"`
+	ExchangeRate  Decimal   `json:"exchangeRate,omitempty"`
+	ExpenseDate   Date      `json:"expenseDate,omitempty"`
+	ExpMediaItem  RecordRef `json:"expMediaItem,omitempty"`
+	ForeignAmount Decimal   `json:"foreignAmount,omitempty"`
+	GrossAmt      Decimal   `json:"grossAmt,omitempty"`
+	IsBillable    Bool      `json:"isBillable,omitempty"`
+	Line          int       `json:"line,omitempty"`
+	Location      RecordRef `json:"location,omitempty"`
+	Memo          string    `json:"memo,omitempty"`
+	Quantity      float64   `json:"quantity,omitempty"`
+	Rate          Decimal   `json:"rate,omitempty"`
+	Receipt       Bool      `json:"receipt,omitempty"`
+	Tax1Amt       Decimal   `json:"tax1Amt,omitempty"`
+	TaxCode       RecordRef `json:"taxCode,omitempty"`
+}
+
+func (e ExpenseReportExpense) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(e)
+}
+
+type TimeBills []TimeBill
+
+type TimeBill struct {
+	ApprovalStatus     RecordRef  `json:"approvalStatus,omitempty"`
+	CaseTaskEvent      RecordRef  `json:"caseTaskEvent,omitempty"`
+	Class              RecordRef  `json:"class,omitempty"`
+	CustomForm         CustomForm `json:"customForm,omitempty"`
+	Customer           RecordRef  `json:"customer,omitempty"`
+	DateCreated        Date       `json:"dateCreated,omitempty"`
+	Department         RecordRef  `json:"department,omitempty"`
+	Employee           RecordRef  `json:"employee,omitempty"`
+	ExternalID         string     `json:"externalId,omitempty"`
+	Hours              float64    `json:"hours,omitempty"`
+	ID                 string     `json:"id,omitempty"`
+	IsBillable         Bool       `json:"isBillable,omitempty"`
+	Item               RecordRef  `json:"item,omitempty"`
+	LastModifiedDate   Date       `json:"lastModifiedDate,omitempty"`
+	Location           RecordRef  `json:"location,omitempty"`
+	Memo               string     `json:"memo,omitempty"`
+	PayrollItem        RecordRef  `json:"payrollItem,omitempty"`
+	Price              RecordRef  `json:"price,omitempty"`
+	Rate               Decimal    `json:"rate,omitempty"`
+	RefName            string     `json:"refName,omitempty"`
+	Status             RecordRef  `json:"status,omitempty"`
+	Subsidiary         Subsidiary `json:"subsidiary,omitempty"`
+	SupervisorApproval Bool       `json:"supervisorApproval,omitempty"`
+	TimeType           RecordRef  `json:"timeType,omitempty"`
+	TranDate           Date       `json:"tranDate,omitempty"`
+}
+
+func (t TimeBill) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TimeBill) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type Jobs []Job
+
+// Job is a project record. NetSuite names the record type "job" in the REST
+// API even when Project Management is enabled.
+type Job struct {
+	AllowAllResourcesForTasks Bool       `json:"allowAllResourcesForTasks,omitempty"`
+	AllowExpenses             Bool       `json:"allowExpenses,omitempty"`
+	AllowTime                 Bool       `json:"allowTime,omitempty"`
+	CalculatedEndDate         Date       `json:"calculatedEndDate,omitempty"`
+	CompanyName               string     `json:"companyName,omitempty"`
+	Currency                  Currency   `json:"currency,omitempty"`
+	CustomForm                CustomForm `json:"customForm,omitempty"`
+	DateCreated               Date       `json:"dateCreated,omitempty"`
+	EndDate                   Date       `json:"endDate,omitempty"`
+	EntityID                  string     `json:"entityId,omitempty"`
+	EntityStatus              RecordRef  `json:"entityStatus,omitempty"`
+	EstimatedCost             Decimal    `json:"estimatedCost,omitempty"`
+	EstimatedLaborCost        Decimal    `json:"estimatedLaborCost,omitempty"`
+	EstimatedRevenue          Decimal    `json:"estimatedRevenue,omitempty"`
+	EstimatedTimeOverride     float64    `json:"estimatedTimeOverride,omitempty"`
+	ExternalID                string     `json:"externalId,omitempty"`
+	ID                        string     `json:"id,omitempty"`
+	IsInactive                Bool       `json:"isInactive,omitempty"`
+	JobBillingType            RecordRef  `json:"jobBillingType,omitempty"`
+	JobType                   RecordRef  `json:"jobType,omitempty"`
+	LastModifiedDate          Date       `json:"lastModifiedDate,omitempty"`
+	LimitTimeToAssignees      Bool       `json:"limitTimeToAssignees,omitempty"`
+	Parent                    RecordRef  `json:"parent,omitempty"`
+	PercentComplete           float64    `json:"percentComplete,omitempty"`
+	PercentCompleteOverride   float64    `json:"percentCompleteOverride,omitempty"`
+	ProjectedEndDate          Date       `json:"projectedEndDate,omitempty"`
+	ProjectManager            RecordRef  `json:"projectManager,omitempty"`
+	StartDate                 Date       `json:"startDate,omitempty"`
+	Subsidiary                Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (j Job) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(j)
+}
+
+func (j Job) IsEmpty() bool {
+	return zero.IsZero(j)
+}
+
+type ProjectTasks []ProjectTask
+
+type ProjectTask struct {
+	ActualWork          float64    `json:"actualWork,omitempty"`
+	Company             RecordRef  `json:"company,omitempty"`
+	ConstraintType      RecordRef  `json:"constraintType,omitempty"`
+	CustomForm          CustomForm `json:"customForm,omitempty"`
+	EndDate             Date       `json:"endDate,omitempty"`
+	EstimatedWork       float64    `json:"estimatedWork,omitempty"`
+	EventID             string     `json:"eventId,omitempty"`
+	ExternalID          string     `json:"externalId,omitempty"`
+	FinishByDate        Date       `json:"finishByDate,omitempty"`
+	ID                  string     `json:"id,omitempty"`
+	IsMilestone         Bool       `json:"isMilestone,omitempty"`
+	LastModifiedDate    Date       `json:"lastModifiedDate,omitempty"`
+	Message             string     `json:"message,omitempty"`
+	NonBillableTask     Bool       `json:"nonBillableTask,omitempty"`
+	Parent              RecordRef  `json:"parent,omitempty"`
+	PercentTimeComplete float64    `json:"percentTimeComplete,omitempty"`
+	Priority            RecordRef  `json:"priority,omitempty"`
+	RemainingWork       float64    `json:"remainingWork,omitempty"`
+	StartDate           Date       `json:"startDate,omitempty"`
+	Status              RecordRef  `json:"status,omitempty"`
+	Title               string     `json:"title,omitempty"`
+}
+
+func (t ProjectTask) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t ProjectTask) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type BillingAccounts []BillingAccount
+
+type BillingAccount struct {
+	BillingSchedule   RecordRef  `json:"billingSchedule,omitempty"`
+	CashSaleForm      RecordRef  `json:"cashSaleForm,omitempty"`
+	Class             RecordRef  `json:"class,omitempty"`
+	CreatedBy         RecordRef  `json:"createdBy,omitempty"`
+	CreatedDate       Date       `json:"createdDate,omitempty"`
+	Currency          Currency   `json:"currency,omitempty"`
+	Customer          RecordRef  `json:"customer,omitempty"`
+	CustomerDefault   Bool       `json:"customerDefault,omitempty"`
+	CustomForm        CustomForm `json:"customForm,omitempty"`
+	Department        RecordRef  `json:"department,omitempty"`
+	ExternalID        string     `json:"externalId,omitempty"`
+	Frequency         RecordRef  `json:"frequency,omitempty"`
+	ID                string     `json:"id,omitempty"`
+	IDNumber          string     `json:"idNumber,omitempty"`
+	Inactive          Bool       `json:"inactive,omitempty"`
+	InvoiceForm       RecordRef  `json:"invoiceForm,omitempty"`
+	LastBillCycleDate Date       `json:"lastBillCycleDate,omitempty"`
+	LastBillDate      Date       `json:"lastBillDate,omitempty"`
+	Location          RecordRef  `json:"location,omitempty"`
+	Memo              string     `json:"memo,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	NextBillCycleDate Date       `json:"nextBillCycleDate,omitempty"`
+	RefName           string     `json:"refName,omitempty"`
+	StartDate         Date       `json:"startDate,omitempty"`
+	Subsidiary        Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (b BillingAccount) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+func (b BillingAccount) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type Subscriptions []Subscription
+
+type Subscription struct {
+	AdvanceRenewalPeriodNumber int        `json:"advanceRenewalPeriodNumber,omitempty"`
+	AutoRenewal                Bool       `json:"autoRenewal,omitempty"`
+	BillingAccount             RecordRef  `json:"billingAccount,omitempty"`
+	BillingSchedule            RecordRef  `json:"billingSchedule,omitempty"`
+	Class                      RecordRef  `json:"class,omitempty"`
+	Currency                   Currency   `json:"currency,omitempty"`
+	Customer                   RecordRef  `json:"customer,omitempty"`
+	CustomForm                 CustomForm `json:"customForm,omitempty"`
+	DefaultRenewalMethod       RecordRef  `json:"defaultRenewalMethod,omitempty"`
+	DefaultRenewalPlan         RecordRef  `json:"defaultRenewalPlan,omitempty"`
+	DefaultRenewalTerm         RecordRef  `json:"defaultRenewalTerm,omitempty"`
+	Department                 RecordRef  `json:"department,omitempty"`
+	EndDate                    Date       `json:"endDate,omitempty"`
+	EstimatedRevRecEndDate     Date       `json:"estimatedRevRecEndDate,omitempty"`
+	ExternalID                 string     `json:"externalId,omitempty"`
+	ID                         string     `json:"id,omitempty"`
+	IDNumber                   string     `json:"idNumber,omitempty"`
+	InitialTerm                RecordRef  `json:"initialTerm,omitempty"`
+	LastBillCycleDate          Date       `json:"lastBillCycleDate,omitempty"`
+	LastBillDate               Date       `json:"lastBillDate,omitempty"`
+	Location                   RecordRef  `json:"location,omitempty"`
+	Memo                       string     `json:"memo,omitempty"`
+	Name                       string     `json:"name,omitempty"`
+	NextBillCycleDate          Date       `json:"nextBillCycleDate,omitempty"`
+	NextRenewalStartDate       Date       `json:"nextRenewalStartDate,omitempty"`
+	PriceBook                  RecordRef  `json:"priceBook,omitempty"`
+	RefName                    string     `json:"refName,omitempty"`
+	StartDate                  Date       `json:"startDate,omitempty"`
+	Status                     RecordRef  `json:"status,omitempty"`
+	SubscriptionPlan           RecordRef  `json:"subscriptionPlan,omitempty"`
+	Subsidiary                 Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (s Subscription) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(s)
+}
+
+func (s Subscription) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+
+type SubscriptionLines []SubscriptionLine
+
+type SubscriptionLine struct {
+	BillingMode            RecordRef `json:"billingMode,omitempty"`
+	EndDate                Date      `json:"endDate,omitempty"`
+	ExternalID             string    `json:"externalId,omitempty"`
+	ID                     string    `json:"id,omitempty"`
+	IncludeInRenewal       Bool      `json:"includeInRenewal,omitempty"`
+	IsIncluded             Bool      `json:"isIncluded,omitempty"`
+	Item                   RecordRef `json:"item,omitempty"`
+	LineNumber             int       `json:"lineNumber,omitempty"`
+	RefName                string    `json:"refName,omitempty"`
+	RevenueAllocationGroup string    `json:"revenueAllocationGroup,omitempty"`
+	StartDate              Date      `json:"startDate,omitempty"`
+	Status                 RecordRef `json:"status,omitempty"`
+	Subscription           RecordRef `json:"subscription,omitempty"`
+	SubscriptionLineType   RecordRef `json:"subscriptionLineType,omitempty"`
+}
+
+func (s SubscriptionLine) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(s)
+}
+
+func (s SubscriptionLine) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+
+type Usages []Usage
+
+type Usage struct {
+	Customer              RecordRef  `json:"customer,omitempty"`
+	CustomForm            CustomForm `json:"customForm,omitempty"`
+	ExternalID            string     `json:"externalId,omitempty"`
+	ID                    string     `json:"id,omitempty"`
+	Item                  RecordRef  `json:"item,omitempty"`
+	Memo                  string     `json:"memo,omitempty"`
+	RefName               string     `json:"refName,omitempty"`
+	Subscription          RecordRef  `json:"subscription,omitempty"`
+	SubscriptionLine      RecordRef  `json:"subscriptionLine,omitempty"`
+	SubscriptionPlan      RecordRef  `json:"subscriptionPlan,omitempty"`
+	UsageDate             Date       `json:"usageDate,omitempty"`
+	UsageQuantity         float64    `json:"usageQuantity,omitempty"`
+	UsageSubscriptionLine RecordRef  `json:"usageSubscriptionLine,omitempty"`
+}
+
+func (u Usage) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(u)
+}
+
+func (u Usage) IsEmpty() bool {
+	return zero.IsZero(u)
+}
+
+type RevenueArrangements []RevenueArrangement
+
+type RevenueArrangement struct {
+	Compliant        Bool                              `json:"compliant,omitempty"`
+	CreatedDate      Date                              `json:"createdDate,omitempty"`
+	Currency         Currency                          `json:"currency,omitempty"`
+	ExchangeRate     Decimal                           `json:"exchangeRate,omitempty"`
+	ExternalID       string                            `json:"externalId,omitempty"`
+	ID               string                            `json:"id,omitempty"`
+	LastModifiedDate Date                              `json:"lastModifiedDate,omitempty"`
+	Memo             string                            `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod                     `json:"postingPeriod,omitempty"`
+	RefName          string                            `json:"refName,omitempty"`
+	RevenueElement   RevenueArrangementRevenueElements `json:"revenueElement,omitempty"`
+	Subsidiary       Subsidiary                        `json:"subsidiary,omitempty"`
+	TranDate         Date                              `json:"tranDate,omitempty"`
+	TranID           string                            `json:"tranId,omitempty"`
+}
+
+func (r RevenueArrangement) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func (r RevenueArrangement) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type RevenueArrangementRevenueElements struct {
+	Links        Links           `json:"links,omitempty"`
+	Items        RevenueElements `json:"items"`
+	TotalResults int             `json:"totalResults,omitempty"`
+}
+
+func (r RevenueArrangementRevenueElements) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type RevenueElements []RevenueElement
+
+type RevenueElement struct {
+	Links                  Links      `json:"links,omitempty"`
+	AllocationAmount       Decimal    `json:"allocationAmount,omitempty"`
+	CalculatedAmount       Decimal    `json:"calculatedAmount,omitempty"`
+	Currency               Currency   `json:"currency,omitempty"`
+	DeferralAccount        Account    `json:"deferralAccount,omitempty"`
+	ElementDate            Date       `json:"elementDate,omitempty"`
+	Entity                 RecordRef  `json:"entity,omitempty"`
+	ExternalID             string     `json:"externalId,omitempty"`
+	FairValue              Decimal    `json:"fairValue,omitempty"`
+	ForecastEndDate        Date       `json:"forecastEndDate,omitempty"`
+	ForecastStartDate      Date       `json:"forecastStartDate,omitempty"`
+	ID                     string     `json:"id,omitempty"`
+	Item                   RecordRef  `json:"item,omitempty"`
+	Quantity               float64    `json:"quantity,omitempty"`
+	RecognitionAccount     Account    `json:"recognitionAccount,omitempty"`
+	RefName                string     `json:"refName,omitempty"`
+	RevenueAllocationGroup string     `json:"revenueAllocationGroup,omitempty"`
+	RevenueAmount          Decimal    `json:"revenueAmount,omitempty"`
+	RevenueArrangement     RecordRef  `json:"revenueArrangement,omitempty"`
+	RevenueRecognitionRule RecordRef  `json:"revenueRecognitionRule,omitempty"`
+	RevRecEndDate          Date       `json:"revRecEndDate,omitempty"`
+	RevRecStartDate        Date       `json:"revRecStartDate,omitempty"`
+	SalesAmount            Decimal    `json:"salesAmount,omitempty"`
+	Source                 RecordRef  `json:"source,omitempty"`
+	Subsidiary             Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (r RevenueElement) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func (r RevenueElement) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type RevenuePlans []RevenuePlan
+
+type RevenuePlan struct {
+	Amount                 Decimal                    `json:"amount,omitempty"`
+	Comments               string                     `json:"comments,omitempty"`
+	CreatedFrom            RecordRef                  `json:"createdFrom,omitempty"`
+	DeferralAccount        Account                    `json:"deferralAccount,omitempty"`
+	ExternalID             string                     `json:"externalId,omitempty"`
+	HoldRevenueRecognition Bool                       `json:"holdRevenueRecognition,omitempty"`
+	ID                     string                     `json:"id,omitempty"`
+	Item                   RecordRef                  `json:"item,omitempty"`
+	PlannedRevenue         RevenuePlanPlannedRevenues `json:"plannedRevenue,omitempty"`
+	RecognitionAccount     Account                    `json:"recognitionAccount,omitempty"`
+	RecognitionMethod      RecordRef                  `json:"recognitionMethod,omitempty"`
+	RecordNumber           string                     `json:"recordNumber,omitempty"`
+	RefName                string                     `json:"refName,omitempty"`
+	RevenuePlanType        RecordRef                  `json:"revenuePlanType,omitempty"`
+	RevRecEndDate          Date                       `json:"revRecEndDate,omitempty"`
+	RevRecStartDate        Date                       `json:"revRecStartDate,omitempty"`
+	Status                 RecordRef                  `json:"status,omitempty"`
+}
+
+func (r RevenuePlan) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func (r RevenuePlan) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type RevenuePlanPlannedRevenues struct {
+	Links        Links                          `json:"links,omitempty"`
+	Items        RevenuePlanPlannedRevenueItems `json:"items"`
+	TotalResults int                            `json:"totalResults,omitempty"`
+}
+
+func (r RevenuePlanPlannedRevenues) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type RevenuePlanPlannedRevenueItems []RevenuePlanPlannedRevenue
+
+type RevenuePlanPlannedRevenue struct {
+	Links         Links         `json:"links,omitempty"`
+	Amount        Decimal       `json:"amount,omitempty"`
+	DateExecuted  Date          `json:"dateExecuted,omitempty"`
+	IsRecognized  Bool          `json:"isRecognized,omitempty"`
+	Journal       RecordRef     `json:"journal,omitempty"`
+	PlannedPeriod PostingPeriod `json:"plannedPeriod,omitempty"`
+	PostingPeriod PostingPeriod `json:"postingPeriod,omitempty"`
+}
+
+func (r RevenuePlanPlannedRevenue) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+type InventoryAdjustments []InventoryAdjustment
+
+type InventoryAdjustment struct {
+	Account             Account                      `json:"account,omitempty"`
+	AdjLocation         RecordRef                    `json:"adjLocation,omitempty"`
+	Class               RecordRef                    `json:"class,omitempty"`
+	CreatedDate         Date                         `json:"createdDate,omitempty"`
+	Customer            RecordRef                    `json:"customer,omitempty"`
+	CustomForm          CustomForm                   `json:"customForm,omitempty"`
+	Department          RecordRef                    `json:"department,omitempty"`
+	EstimatedTotalValue Decimal                      `json:"estimatedTotalValue,omitempty"`
+	ExternalID          string                       `json:"externalId,omitempty"`
+	ID                  string                       `json:"id,omitempty"`
+	Inventory           InventoryAdjustmentInventory `json:"inventory,omitempty"`
+	LastModifiedDate    Date                         `json:"lastModifiedDate,omitempty"`
+	Memo                string                       `json:"memo,omitempty"`
+	PostingPeriod       PostingPeriod                `json:"postingPeriod,omitempty"`
+	RefName             string                       `json:"refName,omitempty"`
+	Subsidiary          Subsidiary                   `json:"subsidiary,omitempty"`
+	TranDate            Date                         `json:"tranDate,omitempty"`
+	TranID              string                       `json:"tranId,omitempty"`
+}
+
+func (i InventoryAdjustment) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(i)
+}
+
+func (i InventoryAdjustment) IsEmpty() bool {
+	return zero.IsZero(i)
+}
+
+type InventoryAdjustmentInventory struct {
+	Links        Links                             `json:"links,omitempty"`
+	Items        InventoryAdjustmentInventoryItems `json:"items"`
+	TotalResults int                               `json:"totalResults,omitempty"`
+}
+
+func (i InventoryAdjustmentInventory) IsEmpty() bool {
+	return zero.IsZero(i)
+}
+
+type InventoryAdjustmentInventoryItems []InventoryAdjustmentInventoryItem
+
+type InventoryAdjustmentInventoryItem struct {
+	Links           Links           `json:"links,omitempty"`
+	AdjustQtyBy     float64         `json:"adjustQtyBy,omitempty"`
+	Class           RecordRef       `json:"class,omitempty"`
+	CurrentValue    Decimal         `json:"currentValue,omitempty"`
+	Department      RecordRef       `json:"department,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	InventoryDetail InventoryDetail `json:"inventoryDetail,omitempty"`
+	Item            RecordRef       `json:"item,omitempty"`
+	Line            int             `json:"line,omitempty"`
+	Location        RecordRef       `json:"location,omitempty"`
+	Memo            string          `json:"memo,omitempty"`
+	NewQuantity     float64         `json:"newQuantity,omitempty"`
+	QuantityOnHand  float64         `json:"quantityOnHand,omitempty"`
+	UnitCost        Decimal         `json:"unitCost,omitempty"`
+	Units           RecordRef       `json:"units,omitempty"`
+}
+
+func (i InventoryAdjustmentInventoryItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(i)
+}
+
+// InventoryDetail is the inventory detail subrecord of a transaction line. It
+// assigns the line quantity to bins, lot numbers and serial numbers.
+type InventoryDetail struct {
+	Links               Links                      `json:"links,omitempty"`
+	InventoryAssignment InventoryDetailAssignments `json:"inventoryAssignment,omitempty"`
+	Item                RecordRef                  `json:"item,omitempty"`
+	Location            RecordRef                  `json:"location,omitempty"`
+	Quantity            float64                    `json:"quantity,omitempty"`
+	Unit                RecordRef                  `json:"unit,omitempty"`
+}
+
+// MarshalJSON leaves out the read only links.
+func (i InventoryDetail) MarshalJSON() ([]byte, error) {
+	i.Links = nil
+	return omitempty.MarshalJSON(i)
+}
+
+func (i InventoryDetail) IsEmpty() bool {
+	return zero.IsZero(i)
+}
+
+type InventoryDetailAssignments = Sublist[InventoryDetailAssignment]
+
+type InventoryDetailAssignmentItems []InventoryDetailAssignment
+
+type InventoryDetailAssignment struct {
+	Links                  Links     `json:"links,omitempty"`
+	BinNumber              RecordRef `json:"binNumber,omitempty"`
+	ExpirationDate         Date      `json:"expirationDate,omitempty"`
+	InventoryStatus        RecordRef `json:"inventoryStatus,omitempty"`
+	IssueInventoryNumber   RecordRef `json:"issueInventoryNumber,omitempty"`
+	Quantity               float64   `json:"quantity,omitempty"`
+	ReceiptInventoryNumber string    `json:"receiptInventoryNumber,omitempty"`
+	ToBinNumber            RecordRef `json:"toBinNumber,omitempty"`
+	ToInventoryStatus      RecordRef `json:"toInventoryStatus,omitempty"`
+}
+
+// MarshalJSON leaves out the read only links.
+func (i InventoryDetailAssignment) MarshalJSON() ([]byte, error) {
+	i.Links = nil
+	return omitempty.MarshalJSON(i)
+}
+
+// LineID returns no key: assignments are identified by their inventory
+// number and bin, not by a line key.
+func (i InventoryDetailAssignment) LineID() string {
+	return ""
+}
+
+type TransferOrders []TransferOrder
+
+type TransferOrder struct {
+	Class                     RecordRef          `json:"class,omitempty"`
+	CreatedDate               Date               `json:"createdDate,omitempty"`
+	Currency                  Currency           `json:"currency,omitempty"`
+	CustomForm                CustomForm         `json:"customForm,omitempty"`
+	Department                RecordRef          `json:"department,omitempty"`
+	Employee                  RecordRef          `json:"employee,omitempty"`
+	ExternalID                string             `json:"externalId,omitempty"`
+	Firmed                    Bool               `json:"firmed,omitempty"`
+	ID                        string             `json:"id,omitempty"`
+	IncoTerm                  RecordRef          `json:"incoTerm,omitempty"`
+	Item                      TransferOrderItems `json:"item,omitempty"`
+	LastModifiedDate          Date               `json:"lastModifiedDate,omitempty"`
+	Location                  RecordRef          `json:"location,omitempty"`
+	Memo                      string             `json:"memo,omitempty"`
+	OrderStatus               RecordRef          `json:"orderStatus,omitempty"`
+	RefName                   string             `json:"refName,omitempty"`
+	ShipDate                  Date               `json:"shipDate,omitempty"`
+	ShipMethod                RecordRef          `json:"shipMethod,omitempty"`
+	Status                    RecordRef          `json:"status,omitempty"`
+	Subsidiary                Subsidiary         `json:"subsidiary,omitempty"`
+	Total                     float64            `json:"total,omitempty"`
+	TranDate                  Date               `json:"tranDate,omitempty"`
+	TranID                    string             `json:"tranId,omitempty"`
+	TransferLocation          RecordRef          `json:"transferLocation,omitempty"`
+	UseItemCostAsTransferCost Bool               `json:"useItemCostAsTransferCost,omitempty"`
+}
+
+func (t TransferOrder) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TransferOrder) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TransferOrderItems struct {
+	Links        Links                  `json:"links,omitempty"`
+	Items        TransferOrderItemItems `json:"items"`
+	TotalResults int                    `json:"totalResults,omitempty"`
+}
+
+func (t TransferOrderItems) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TransferOrderItemItems []TransferOrderItem
+
+type TransferOrderItem struct {
+	Links               Links           `json:"links,omitempty"`
+	Amount              Decimal         `json:"amount,omitempty"`
+	Description         string          `json:"description,omitempty"`
+	ExpectedReceiptDate Date            `json:"expectedReceiptDate,omitempty"`
+	ExpectedShipDate    Date            `json:"expectedShipDate,omitempty"`
+	InventoryDetail     InventoryDetail `json:"inventoryDetail,omitempty"`
+	Item                RecordRef       `json:"item,omitempty"`
+	Line                int             `json:"line,omitempty"`
+	Quantity            float64         `json:"quantity,omitempty"`
+	QuantityCommitted   float64         `json:"quantityCommitted,omitempty"`
+	QuantityFulfilled   float64         `json:"quantityFulfilled,omitempty"`
+	QuantityReceived    float64         `json:"quantityReceived,omitempty"`
+	Rate                Decimal         `json:"rate,omitempty"`
+	Units               RecordRef       `json:"units,omitempty"`
+}
+
+func (t TransferOrderItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+type Checks []Check
+
+type Check struct {
+	Account          Account       `json:"account,omitempty"`
+	Address          string        `json:"address,omitempty"`
+	Class            RecordRef     `json:"class,omitempty"`
+	CreatedDate      Date          `json:"createdDate,omitempty"`
+	Currency         Currency      `json:"currency,omitempty"`
+	CustomForm       CustomForm    `json:"customForm,omitempty"`
+	Department       RecordRef     `json:"department,omitempty"`
+	Entity           RecordRef     `json:"entity,omitempty"`
+	ExchangeRate     Decimal       `json:"exchangeRate,omitempty"`
+	Expense          CheckExpenses `json:"expense,omitempty"`
+	ExternalID       string        `json:"externalId,omitempty"`
+	ID               string        `json:"id,omitempty"`
+	Item             CheckItems    `json:"item,omitempty"`
+	LastModifiedDate Date          `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef     `json:"location,omitempty"`
+	Memo             string        `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod `json:"postingPeriod,omitempty"`
+	RefName          string        `json:"refName,omitempty"`
+	Subsidiary       Subsidiary    `json:"subsidiary,omitempty"`
+	ToBePrinted      Bool          `json:"toBePrinted,omitempty"`
+	TranDate         Date          `json:"tranDate,omitempty"`
+	TranID           string        `json:"tranId,omitempty"`
+	UserTotal        Decimal       `json:"userTotal,omitempty"`
+	Voided           Bool          `json:"voided,omitempty"`
+}
+
+func (c Check) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c Check) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CheckExpenses struct {
+	Links        Links             `json:"links,omitempty"`
+	Items        CheckExpenseItems `json:"items"`
+	TotalResults int               `json:"totalResults,omitempty"`
+}
+
+func (c CheckExpenses) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CheckExpenseItems []CheckExpense
+
+type CheckExpense struct {
+	Links      Links     `json:"links,omitempty"`
+	Account    Account   `json:"account,omitempty"`
+	Amount     Decimal   `json:"amount,omitempty"`
+	Class      RecordRef `json:"class,omitempty"`
+	Customer   RecordRef `json:"customer,omitempty"`
+	Department RecordRef `json:"department,omitempty"`
+	IsBillable Bool      `json:"isBillable,omitempty"`
+	Line       int       `json:"line,omitempty"`
+	Location   RecordRef `json:"location,omitempty"`
+	Memo       string    `json:"memo,omitempty"`
+	TaxCode    RecordRef `json:"taxCode,omitempty"`
+}
+
+func (c CheckExpense) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+type CheckItems struct {
+	Links        Links          `json:"links,omitempty"`
+	Items        CheckItemItems `json:"items"`
+	TotalResults int            `json:"totalResults,omitempty"`
+}
+
+func (c CheckItems) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CheckItemItems []CheckItem
+
+type CheckItem struct {
+	Links       Links     `json:"links,omitempty"`
+	Amount      Decimal   `json:"amount,omitempty"`
+	Class       RecordRef `json:"class,omitempty"`
+	Customer    RecordRef `json:"customer,omitempty"`
+	Department  RecordRef `json:"department,omitempty"`
+	Description string    `json:"description,omitempty"`
+	IsBillable  Bool      `json:"isBillable,omitempty"`
+	Item        RecordRef `json:"item,omitempty"`
+	Line        int       `json:"line,omitempty"`
+	Location    RecordRef `json:"location,omitempty"`
+	Quantity    float64   `json:"quantity,omitempty"`
+	Rate        Decimal   `json:"rate,omitempty"`
+	TaxCode     RecordRef `json:"taxCode,omitempty"`
+	Units       RecordRef `json:"units,omitempty"`
+}
+
+func (c CheckItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+type Deposits []Deposit
+
+type Deposit struct {
+	Account          Account         `json:"account,omitempty"`
+	CashBack         DepositCashBack `json:"cashBack,omitempty"`
+	Class            RecordRef       `json:"class,omitempty"`
+	CreatedDate      Date            `json:"createdDate,omitempty"`
+	Currency         Currency        `json:"currency,omitempty"`
+	CustomForm       CustomForm      `json:"customForm,omitempty"`
+	Department       RecordRef       `json:"department,omitempty"`
+	ExchangeRate     Decimal         `json:"exchangeRate,omitempty"`
+	ExternalID       string          `json:"externalId,omitempty"`
+	ID               string          `json:"id,omitempty"`
+	LastModifiedDate Date            `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef       `json:"location,omitempty"`
+	Memo             string          `json:"memo,omitempty"`
+	Other            DepositOther    `json:"other,omitempty"`
+	Payment          DepositPayments `json:"payment,omitempty"`
+	PostingPeriod    PostingPeriod   `json:"postingPeriod,omitempty"`
+	RefName          string          `json:"refName,omitempty"`
+	Subsidiary       Subsidiary      `json:"subsidiary,omitempty"`
+	Total            float64         `json:"total,omitempty"`
+	TranDate         Date            `json:"tranDate,omitempty"`
+	TranID           string          `json:"tranId,omitempty"`
+}
+
+func (d Deposit) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(d)
+}
+
+func (d Deposit) IsEmpty() bool {
+	return zero.IsZero(d)
+}
+
+type DepositPayments struct {
+	Links        Links               `json:"links,omitempty"`
+	Items        DepositPaymentItems `json:"items"`
+	TotalResults int                 `json:"totalResults,omitempty"`
+}
+
+func (d DepositPayments) IsEmpty() bool {
+	return zero.IsZero(d)
+}
+
+type DepositPaymentItems []DepositPayment
+
+type DepositPayment struct {
+	Links             Links     `json:"links,omitempty"`
+	Currency          Currency  `json:"currency,omitempty"`
+	Deposit           Bool      `json:"deposit"`
+	DocNumber         string    `json:"docNumber,omitempty"`
+	Entity            RecordRef `json:"entity,omitempty"`
+	ID                int       `json:"id,omitempty"`
+	LineID            int       `json:"lineId,omitempty"`
+	Memo              string    `json:"memo,omitempty"`
+	PaymentAmount     Decimal   `json:"paymentAmount,omitempty"`
+	PaymentMethod     RecordRef `json:"paymentMethod,omitempty"`
+	RefNum            string    `json:"refNum,omitempty"`
+	TransactionAmount Decimal   `json:"transactionAmount,omitempty"`
+	Type              RecordRef `json:"type,omitempty"`
+}
+
+func (d DepositPayment) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(d)
+}
+
+type DepositOther struct {
+	Links        Links             `json:"links,omitempty"`
+	Items        DepositOtherItems `json:"items"`
+	TotalResults int               `json:"totalResults,omitempty"`
+}
+
+func (d DepositOther) IsEmpty() bool {
+	return zero.IsZero(d)
+}
+
+type DepositOtherItems []DepositOtherItem
+
+type DepositOtherItem struct {
+	Links         Links     `json:"links,omitempty"`
+	Account       Account   `json:"account,omitempty"`
+	Amount        Decimal   `json:"amount,omitempty"`
+	Class         RecordRef `json:"class,omitempty"`
+	Department    RecordRef `json:"department,omitempty"`
+	Entity        RecordRef `json:"entity,omitempty"`
+	Location      RecordRef `json:"location,omitempty"`
+	Memo          string    `json:"memo,omitempty"`
+	PaymentMethod RecordRef `json:"paymentMethod,omitempty"`
+	RefNum        string    `json:"refNum,omitempty"`
+}
+
+func (d DepositOtherItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(d)
+}
+
+type DepositCashBack struct {
+	Links        Links                `json:"links,omitempty"`
+	Items        DepositCashBackItems `json:"items"`
+	TotalResults int                  `json:"totalResults,omitempty"`
+}
+
+func (d DepositCashBack) IsEmpty() bool {
+	return zero.IsZero(d)
+}
+
+type DepositCashBackItems []DepositCashBackItem
+
+type DepositCashBackItem struct {
+	Links      Links     `json:"links,omitempty"`
+	Account    Account   `json:"account,omitempty"`
+	Amount     Decimal   `json:"amount,omitempty"`
+	Class      RecordRef `json:"class,omitempty"`
+	Department RecordRef `json:"department,omitempty"`
+	Location   RecordRef `json:"location,omitempty"`
+	Memo       string    `json:"memo,omitempty"`
+}
+
+func (d DepositCashBackItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(d)
+}
+
+type CreditCardCharges []CreditCardCharge
+
+type CreditCardCharge struct {
+	Account          Account            `json:"account,omitempty"`
+	Class            RecordRef          `json:"class,omitempty"`
+	CreatedDate      Date               `json:"createdDate,omitempty"`
+	Currency         Currency           `json:"currency,omitempty"`
+	CustomForm       CustomForm         `json:"customForm,omitempty"`
+	Department       RecordRef          `json:"department,omitempty"`
+	Entity           RecordRef          `json:"entity,omitempty"`
+	ExchangeRate     Decimal            `json:"exchangeRate,omitempty"`
+	Expense          CreditCardExpenses `json:"expense,omitempty"`
+	ExternalID       string             `json:"externalId,omitempty"`
+	ID               string             `json:"id,omitempty"`
+	Item             CreditCardItems    `json:"item,omitempty"`
+	LastModifiedDate Date               `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef          `json:"location,omitempty"`
+	Memo             string             `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod      `json:"postingPeriod,omitempty"`
+	RefName          string             `json:"refName,omitempty"`
+	Subsidiary       Subsidiary         `json:"subsidiary,omitempty"`
+	TranDate         Date               `json:"tranDate,omitempty"`
+	TranID           string             `json:"tranId,omitempty"`
+	UserTotal        Decimal            `json:"userTotal,omitempty"`
+}
+
+func (c CreditCardCharge) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CreditCardCharge) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CreditCardRefunds []CreditCardRefund
+
+type CreditCardRefund struct {
+	Account          Account            `json:"account,omitempty"`
+	Class            RecordRef          `json:"class,omitempty"`
+	CreatedDate      Date               `json:"createdDate,omitempty"`
+	Currency         Currency           `json:"currency,omitempty"`
+	CustomForm       CustomForm         `json:"customForm,omitempty"`
+	Department       RecordRef          `json:"department,omitempty"`
+	Entity           RecordRef          `json:"entity,omitempty"`
+	ExchangeRate     Decimal            `json:"exchangeRate,omitempty"`
+	Expense          CreditCardExpenses `json:"expense,omitempty"`
+	ExternalID       string             `json:"externalId,omitempty"`
+	ID               string             `json:"id,omitempty"`
+	Item             CreditCardItems    `json:"item,omitempty"`
+	LastModifiedDate Date               `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef          `json:"location,omitempty"`
+	Memo             string             `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod      `json:"postingPeriod,omitempty"`
+	RefName          string             `json:"refName,omitempty"`
+	Subsidiary       Subsidiary         `json:"subsidiary,omitempty"`
+	TranDate         Date               `json:"tranDate,omitempty"`
+	TranID           string             `json:"tranId,omitempty"`
+	UserTotal        Decimal            `json:"userTotal,omitempty"`
+}
+
+func (c CreditCardRefund) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CreditCardRefund) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CreditCardExpenses struct {
+	Links        Links                  `json:"links,omitempty"`
+	Items        CreditCardExpenseItems `json:"items"`
+	TotalResults int                    `json:"totalResults,omitempty"`
+}
+
+func (c CreditCardExpenses) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CreditCardExpenseItems []CreditCardExpense
+
+type CreditCardExpense struct {
+	Links      Links     `json:"links,omitempty"`
+	Account    Account   `json:"account,omitempty"`
+	Amount     Decimal   `json:"amount,omitempty"`
+	Category   RecordRef `json:"category,omitempty"`
+	Class      RecordRef `json:"class,omitempty"`
+	Customer   RecordRef `json:"customer,omitempty"`
+	Department RecordRef `json:"department,omitempty"`
+	IsBillable Bool      `json:"isBillable,omitempty"`
+	Line       int       `json:"line,omitempty"`
+	Location   RecordRef `json:"location,omitempty"`
+	Memo       string    `json:"memo,omitempty"`
+	TaxCode    RecordRef `json:"taxCode,omitempty"`
+}
+
+func (c CreditCardExpense) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+type CreditCardItems struct {
+	Links        Links               `json:"links,omitempty"`
+	Items        CreditCardItemItems `json:"items"`
+	TotalResults int                 `json:"totalResults,omitempty"`
+}
+
+func (c CreditCardItems) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CreditCardItemItems []CreditCardItem
+
+type CreditCardItem struct {
+	Links       Links     `json:"links,omitempty"`
+	Amount      Decimal   `json:"amount,omitempty"`
+	Class       RecordRef `json:"class,omitempty"`
+	Customer    RecordRef `json:"customer,omitempty"`
+	Department  RecordRef `json:"department,omitempty"`
+	Description string    `json:"description,omitempty"`
+	IsBillable  Bool      `json:"isBillable,omitempty"`
+	Item        RecordRef `json:"item,omitempty"`
+	Line        int       `json:"line,omitempty"`
+	Location    RecordRef `json:"location,omitempty"`
+	Quantity    float64   `json:"quantity,omitempty"`
+	Rate        Decimal   `json:"rate,omitempty"`
+	TaxCode     RecordRef `json:"taxCode,omitempty"`
+	Units       RecordRef `json:"units,omitempty"`
+}
+
+func (c CreditCardItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+type ReturnAuthorizations []ReturnAuthorization
+
+type ReturnAuthorization struct {
+	BillingAddress   AddressBookAddress       `json:"billingAddress,omitempty"`
+	Class            RecordRef                `json:"class,omitempty"`
+	CreatedDate      Date                     `json:"createdDate,omitempty"`
+	CreatedFrom      RecordRef                `json:"createdFrom,omitempty"`
+	Currency         Currency                 `json:"currency,omitempty"`
+	CustomForm       CustomForm               `json:"customForm,omitempty"`
+	Department       RecordRef                `json:"department,omitempty"`
+	DiscountItem     RecordRef                `json:"discountItem,omitempty"`
+	Entity           RecordRef                `json:"entity,omitempty"`
+	ExchangeRate     Decimal                  `json:"exchangeRate,omitempty"`
+	ExternalID       string                   `json:"externalId,omitempty"`
+	ID               string                   `json:"id,omitempty"`
+	Item             ReturnAuthorizationItems `json:"item,omitempty"`
+	LastModifiedDate Date                     `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef                `json:"location,omitempty"`
+	Memo             string                   `json:"memo,omitempty"`
+	OrderStatus      RecordRef                `json:"orderStatus,omitempty"`
+	OtherRefNum      string                   `json:"otherRefNum,omitempty"`
+	RefName          string                   `json:"refName,omitempty"`
+	SalesRep         RecordRef                `json:"salesRep,omitempty"`
+	ShippingAddress  AddressBookAddress       `json:"shippingAddress,omitempty"`
+	Status           RecordRef                `json:"status,omitempty"`
+	Subsidiary       Subsidiary               `json:"subsidiary,omitempty"`
+	Subtotal         Decimal                  `json:"subtotal,omitempty"`
+	TaxTotal         Decimal                  `json:"taxTotal,omitempty"`
+	Total            float64                  `json:"total,omitempty"`
+	TranDate         Date                     `json:"tranDate,omitempty"`
+	TranID           string                   `json:"tranId,omitempty"`
+}
+
+func (r ReturnAuthorization) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+func (r ReturnAuthorization) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type ReturnAuthorizationItems struct {
+	Links        Links                        `json:"links,omitempty"`
+	Items        ReturnAuthorizationItemItems `json:"items"`
+	TotalResults int                          `json:"totalResults,omitempty"`
+}
+
+func (r ReturnAuthorizationItems) IsEmpty() bool {
+	return zero.IsZero(r)
+}
+
+type ReturnAuthorizationItemItems []ReturnAuthorizationItem
+
+type ReturnAuthorizationItem struct {
+	Links            Links           `json:"links,omitempty"`
+	Amount           Decimal         `json:"amount,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	InventoryDetail  InventoryDetail `json:"inventoryDetail,omitempty"`
+	IsClosed         Bool            `json:"isClosed,omitempty"`
+	Item             RecordRef       `json:"item,omitempty"`
+	Line             int             `json:"line,omitempty"`
+	Location         RecordRef       `json:"location,omitempty"`
+	Price            RecordRef       `json:"price,omitempty"`
+	Quantity         float64         `json:"quantity,omitempty"`
+	QuantityBilled   float64         `json:"quantityBilled,omitempty"`
+	QuantityReceived float64         `json:"quantityReceived,omitempty"`
+	Rate             Decimal         `json:"rate,omitempty"`
+	TaxCode          RecordRef       `json:"taxCode,omitempty"`
+	Units            RecordRef       `json:"units,omitempty"`
+}
+
+func (r ReturnAuthorizationItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(r)
+}
+
+type VendorReturnAuthorizations []VendorReturnAuthorization
+
+type VendorReturnAuthorization struct {
+	Class            RecordRef                      `json:"class,omitempty"`
+	CreatedDate      Date                           `json:"createdDate,omitempty"`
+	CreatedFrom      RecordRef                      `json:"createdFrom,omitempty"`
+	Currency         Currency                       `json:"currency,omitempty"`
+	CustomForm       CustomForm                     `json:"customForm,omitempty"`
+	Department       RecordRef                      `json:"department,omitempty"`
+	Entity           RecordRef                      `json:"entity,omitempty"`
+	ExchangeRate     Decimal                        `json:"exchangeRate,omitempty"`
+	ExternalID       string                         `json:"externalId,omitempty"`
+	ID               string                         `json:"id,omitempty"`
+	Item             VendorReturnAuthorizationItems `json:"item,omitempty"`
+	LastModifiedDate Date                           `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef                      `json:"location,omitempty"`
+	Memo             string                         `json:"memo,omitempty"`
+	OrderStatus      RecordRef                      `json:"orderStatus,omitempty"`
+	RefName          string                         `json:"refName,omitempty"`
+	Status           RecordRef                      `json:"status,omitempty"`
+	Subsidiary       Subsidiary                     `json:"subsidiary,omitempty"`
+	Total            float64                        `json:"total,omitempty"`
+	TranDate         Date                           `json:"tranDate,omitempty"`
+	TranID           string                         `json:"tranId,omitempty"`
+	UserTotal        Decimal                        `json:"userTotal,omitempty"`
+}
+
+func (v VendorReturnAuthorization) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(v)
+}
+
+func (v VendorReturnAuthorization) IsEmpty() bool {
+	return zero.IsZero(v)
+}
+
+type VendorReturnAuthorizationItems struct {
+	Links        Links                              `json:"links,omitempty"`
+	Items        VendorReturnAuthorizationItemItems `json:"items"`
+	TotalResults int                                `json:"totalResults,omitempty"`
+}
+
+func (v VendorReturnAuthorizationItems) IsEmpty() bool {
+	return zero.IsZero(v)
+}
+
+type VendorReturnAuthorizationItemItems []VendorReturnAuthorizationItem
+
+type VendorReturnAuthorizationItem struct {
+	Links             Links           `json:"links,omitempty"`
+	Amount            Decimal         `json:"amount,omitempty"`
+	Description       string          `json:"description,omitempty"`
+	InventoryDetail   InventoryDetail `json:"inventoryDetail,omitempty"`
+	IsClosed          Bool            `json:"isClosed,omitempty"`
+	Item              RecordRef       `json:"item,omitempty"`
+	Line              int             `json:"line,omitempty"`
+	Location          RecordRef       `json:"location,omitempty"`
+	Quantity          float64         `json:"quantity,omitempty"`
+	QuantityBilled    float64         `json:"quantityBilled,omitempty"`
+	QuantityFulfilled float64         `json:"quantityFulfilled,omitempty"`
+	Rate              Decimal         `json:"rate,omitempty"`
+	TaxCode           RecordRef       `json:"taxCode,omitempty"`
+	Units             RecordRef       `json:"units,omitempty"`
+}
+
+func (v VendorReturnAuthorizationItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(v)
+}
+
+type WorkOrders []WorkOrder
+
+type WorkOrder struct {
+	AssemblyItem            RecordRef           `json:"assemblyItem,omitempty"`
+	BillOfMaterials         RecordRef           `json:"billOfMaterials,omitempty"`
+	BillOfMaterialsRevision RecordRef           `json:"billOfMaterialsRevision,omitempty"`
+	Built                   float64             `json:"built,omitempty"`
+	Class                   RecordRef           `json:"class,omitempty"`
+	CreatedDate             Date                `json:"createdDate,omitempty"`
+	CreatedFrom             RecordRef           `json:"createdFrom,omitempty"`
+	CustomForm              CustomForm          `json:"customForm,omitempty"`
+	Department              RecordRef           `json:"department,omitempty"`
+	EndDate                 Date                `json:"endDate,omitempty"`
+	ExternalID              string              `json:"externalId,omitempty"`
+	Firmed                  Bool                `json:"firmed,omitempty"`
+	ID                      string              `json:"id,omitempty"`
+	Item                    WorkOrderComponents `json:"item,omitempty"`
+	LastModifiedDate        Date                `json:"lastModifiedDate,omitempty"`
+	Location                RecordRef           `json:"location,omitempty"`
+	Memo                    string              `json:"memo,omitempty"`
+	OrderStatus             RecordRef           `json:"orderStatus,omitempty"`
+	Quantity                float64             `json:"quantity,omitempty"`
+	RefName                 string              `json:"refName,omitempty"`
+	StartDate               Date                `json:"startDate,omitempty"`
+	Status                  RecordRef           `json:"status,omitempty"`
+	Subsidiary              Subsidiary          `json:"subsidiary,omitempty"`
+	TranDate                Date                `json:"tranDate,omitempty"`
+	TranID                  string              `json:"tranId,omitempty"`
+	Units                   RecordRef           `json:"units,omitempty"`
+}
+
+func (w WorkOrder) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(w)
+}
+
+func (w WorkOrder) IsEmpty() bool {
+	return zero.IsZero(w)
+}
+
+type WorkOrderComponents struct {
+	Links        Links                   `json:"links,omitempty"`
+	Items        WorkOrderComponentItems `json:"items"`
+	TotalResults int                     `json:"totalResults,omitempty"`
+}
+
+func (w WorkOrderComponents) IsEmpty() bool {
+	return zero.IsZero(w)
+}
+
+type WorkOrderComponentItems []WorkOrderComponent
+
+type WorkOrderComponent struct {
+	Links             Links           `json:"links,omitempty"`
+	BomQuantity       float64         `json:"bomQuantity,omitempty"`
+	ComponentYield    float64         `json:"componentYield,omitempty"`
+	InventoryDetail   InventoryDetail `json:"inventoryDetail,omitempty"`
+	Item              RecordRef       `json:"item,omitempty"`
+	Line              int             `json:"line,omitempty"`
+	Location          RecordRef       `json:"location,omitempty"`
+	Quantity          float64         `json:"quantity,omitempty"`
+	QuantityCommitted float64         `json:"quantityCommitted,omitempty"`
+	Units             RecordRef       `json:"units,omitempty"`
+}
+
+func (w WorkOrderComponent) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(w)
+}
+
+type WorkOrderIssues []WorkOrderIssue
+
+type WorkOrderIssue struct {
+	Class            RecordRef           `json:"class,omitempty"`
+	Component        WorkOrderComponents `json:"component,omitempty"`
+	CreatedDate      Date                `json:"createdDate,omitempty"`
+	CreatedFrom      RecordRef           `json:"createdFrom,omitempty"`
+	CustomForm       CustomForm          `json:"customForm,omitempty"`
+	Department       RecordRef           `json:"department,omitempty"`
+	ExternalID       string              `json:"externalId,omitempty"`
+	ID               string              `json:"id,omitempty"`
+	Item             RecordRef           `json:"item,omitempty"`
+	LastModifiedDate Date                `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef           `json:"location,omitempty"`
+	Memo             string              `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod       `json:"postingPeriod,omitempty"`
+	RefName          string              `json:"refName,omitempty"`
+	Subsidiary       Subsidiary          `json:"subsidiary,omitempty"`
+	TranDate         Date                `json:"tranDate,omitempty"`
+	TranID           string              `json:"tranId,omitempty"`
+}
+
+func (w WorkOrderIssue) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(w)
+}
+
+func (w WorkOrderIssue) IsEmpty() bool {
+	return zero.IsZero(w)
+}
+
+type WorkOrderCompletions []WorkOrderCompletion
+
+type WorkOrderCompletion struct {
+	Class             RecordRef           `json:"class,omitempty"`
+	CompletedQuantity float64             `json:"completedQuantity,omitempty"`
+	Component         WorkOrderComponents `json:"component,omitempty"`
+	CreatedDate       Date                `json:"createdDate,omitempty"`
+	CreatedFrom       RecordRef           `json:"createdFrom,omitempty"`
+	CustomForm        CustomForm          `json:"customForm,omitempty"`
+	Department        RecordRef           `json:"department,omitempty"`
+	EndOperation      RecordRef           `json:"endOperation,omitempty"`
+	ExternalID        string              `json:"externalId,omitempty"`
+	ID                string              `json:"id,omitempty"`
+	InventoryDetail   InventoryDetail     `json:"inventoryDetail,omitempty"`
+	IsBackflush       Bool                `json:"isBackflush,omitempty"`
+	Item              RecordRef           `json:"item,omitempty"`
+	LastModifiedDate  Date                `json:"lastModifiedDate,omitempty"`
+	Location          RecordRef           `json:"location,omitempty"`
+	Memo              string              `json:"memo,omitempty"`
+	PostingPeriod     PostingPeriod       `json:"postingPeriod,omitempty"`
+	Quantity          float64             `json:"quantity,omitempty"`
+	RefName           string              `json:"refName,omitempty"`
+	ScrapQuantity     float64             `json:"scrapQuantity,omitempty"`
+	StartOperation    RecordRef           `json:"startOperation,omitempty"`
+	Subsidiary        Subsidiary          `json:"subsidiary,omitempty"`
+	TranDate          Date                `json:"tranDate,omitempty"`
+	TranID            string              `json:"tranId,omitempty"`
+}
+
+func (w WorkOrderCompletion) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(w)
+}
+
+func (w WorkOrderCompletion) IsEmpty() bool {
+	return zero.IsZero(w)
+}
+
+type WorkOrderCloses []WorkOrderClose
+
+type WorkOrderClose struct {
+	Class            RecordRef     `json:"class,omitempty"`
+	CreatedDate      Date          `json:"createdDate,omitempty"`
+	CreatedFrom      RecordRef     `json:"createdFrom,omitempty"`
+	CustomForm       CustomForm    `json:"customForm,omitempty"`
+	Department       RecordRef     `json:"department,omitempty"`
+	ExternalID       string        `json:"externalId,omitempty"`
+	ID               string        `json:"id,omitempty"`
+	Item             RecordRef     `json:"item,omitempty"`
+	LastModifiedDate Date          `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef     `json:"location,omitempty"`
+	Memo             string        `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod `json:"postingPeriod,omitempty"`
+	RefName          string        `json:"refName,omitempty"`
+	Subsidiary       Subsidiary    `json:"subsidiary,omitempty"`
+	TranDate         Date          `json:"tranDate,omitempty"`
+	TranID           string        `json:"tranId,omitempty"`
+}
+
+func (w WorkOrderClose) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(w)
+}
+
+func (w WorkOrderClose) IsEmpty() bool {
+	return zero.IsZero(w)
+}
+
+type Bins []Bin
+
+type Bin struct {
+	BinNumber  string    `json:"binNumber,omitempty"`
+	ExternalID string    `json:"externalId,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	IsInactive Bool      `json:"isInactive,omitempty"`
+	Location   RecordRef `json:"location,omitempty"`
+	Memo       string    `json:"memo,omitempty"`
+	RefName    string    `json:"refName,omitempty"`
+}
+
+func (b Bin) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+func (b Bin) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BinTransfers []BinTransfer
+
+type BinTransfer struct {
+	CreatedDate      Date                 `json:"createdDate,omitempty"`
+	CustomForm       CustomForm           `json:"customForm,omitempty"`
+	ExternalID       string               `json:"externalId,omitempty"`
+	ID               string               `json:"id,omitempty"`
+	Inventory        BinTransferInventory `json:"inventory,omitempty"`
+	LastModifiedDate Date                 `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef            `json:"location,omitempty"`
+	Memo             string               `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod        `json:"postingPeriod,omitempty"`
+	RefName          string               `json:"refName,omitempty"`
+	Subsidiary       Subsidiary           `json:"subsidiary,omitempty"`
+	TranDate         Date                 `json:"tranDate,omitempty"`
+	TranID           string               `json:"tranId,omitempty"`
+}
+
+func (b BinTransfer) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+func (b BinTransfer) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BinTransferInventory struct {
+	Links        Links                     `json:"links,omitempty"`
+	Items        BinTransferInventoryItems `json:"items"`
+	TotalResults int                       `json:"totalResults,omitempty"`
+}
+
+func (b BinTransferInventory) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BinTransferInventoryItems []BinTransferInventoryItem
+
+type BinTransferInventoryItem struct {
+	Links           Links           `json:"links,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	InventoryDetail InventoryDetail `json:"inventoryDetail,omitempty"`
+	Item            RecordRef       `json:"item,omitempty"`
+	Line            int             `json:"line,omitempty"`
+	Quantity        float64         `json:"quantity,omitempty"`
+	Units           RecordRef       `json:"units,omitempty"`
+}
+
+func (b BinTransferInventoryItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+type BinWorksheets []BinWorksheet
+
+type BinWorksheet struct {
+	CreatedDate      Date              `json:"createdDate,omitempty"`
+	CustomForm       CustomForm        `json:"customForm,omitempty"`
+	ExternalID       string            `json:"externalId,omitempty"`
+	ID               string            `json:"id,omitempty"`
+	Item             BinWorksheetItems `json:"item,omitempty"`
+	LastModifiedDate Date              `json:"lastModifiedDate,omitempty"`
+	Location         RecordRef         `json:"location,omitempty"`
+	Memo             string            `json:"memo,omitempty"`
+	RefName          string            `json:"refName,omitempty"`
+	Subsidiary       Subsidiary        `json:"subsidiary,omitempty"`
+	TranDate         Date              `json:"tranDate,omitempty"`
+	TranID           string            `json:"tranId,omitempty"`
+}
+
+func (b BinWorksheet) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+func (b BinWorksheet) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BinWorksheetItems struct {
+	Links        Links                 `json:"links,omitempty"`
+	Items        BinWorksheetItemItems `json:"items"`
+	TotalResults int                   `json:"totalResults,omitempty"`
+}
+
+func (b BinWorksheetItems) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BinWorksheetItemItems []BinWorksheetItem
+
+type BinWorksheetItem struct {
+	Links           Links           `json:"links,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	InventoryDetail InventoryDetail `json:"inventoryDetail,omitempty"`
+	Item            RecordRef       `json:"item,omitempty"`
+	ItemBinNumbers  string          `json:"itemBinNumbers,omitempty"`
+	Line            int             `json:"line,omitempty"`
+	Quantity        float64         `json:"quantity,omitempty"`
+	Units           RecordRef       `json:"units,omitempty"`
+}
+
+func (b BinWorksheetItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(b)
+}
+
+type Messages []Message
+
+// Message is an email message record. The message text is named Body to
+// avoid a clash with the embedded Message in the request and response bodies.
+type Message struct {
+	Activity         RecordRef `json:"activity,omitempty"`
+	Author           RecordRef `json:"author,omitempty"`
+	AuthorEmail      string    `json:"authorEmail,omitempty"`
+	BCC              string    `json:"bcc,omitempty"`
+	CC               string    `json:"cc,omitempty"`
+	Emailed          Bool      `json:"emailed,omitempty"`
+	Entity           RecordRef `json:"entity,omitempty"`
+	ExternalID       string    `json:"externalId,omitempty"`
+	HasAttachment    Bool      `json:"hasAttachment,omitempty"`
+	ID               string    `json:"id,omitempty"`
+	Incoming         Bool      `json:"incoming,omitempty"`
+	LastModifiedDate Date      `json:"lastModifiedDate,omitempty"`
+	Body             string    `json:"message,omitempty"`
+	MessageDate      Date      `json:"messageDate,omitempty"`
+	Recipient        RecordRef `json:"recipient,omitempty"`
+	RecipientEmail   string    `json:"recipientEmail,omitempty"`
+	RefName          string    `json:"refName,omitempty"`
+	Subject          string    `json:"subject,omitempty"`
+	Transaction      RecordRef `json:"transaction,omitempty"`
+}
+
+func (m Message) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(m)
+}
+
+func (m Message) IsEmpty() bool {
+	return zero.IsZero(m)
+}
+
+type Notes []Note
+
+// Note is a user note attached to an entity, transaction or activity. Body
+// holds the "note" field.
+type Note struct {
+	Activity         RecordRef `json:"activity,omitempty"`
+	Author           RecordRef `json:"author,omitempty"`
+	Body             string    `json:"note,omitempty"`
+	Direction        RecordRef `json:"direction,omitempty"`
+	Entity           RecordRef `json:"entity,omitempty"`
+	ExternalID       string    `json:"externalId,omitempty"`
+	Folder           RecordRef `json:"folder,omitempty"`
+	ID               string    `json:"id,omitempty"`
+	Item             RecordRef `json:"item,omitempty"`
+	LastModifiedDate Date      `json:"lastModifiedDate,omitempty"`
+	NoteDate         Date      `json:"noteDate,omitempty"`
+	NoteType         RecordRef `json:"noteType,omitempty"`
+	Record           string    `json:"record,omitempty"`
+	RecordType       RecordRef `json:"recordType,omitempty"`
+	RefName          string    `json:"refName,omitempty"`
+	Title            string    `json:"title,omitempty"`
+	Transaction      RecordRef `json:"transaction,omitempty"`
+}
+
+func (n Note) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(n)
+}
+
+func (n Note) IsEmpty() bool {
+	return zero.IsZero(n)
+}
+
+type Tasks []Task
+
+type Task struct {
+	AccessLevel      RecordRef  `json:"accessLevel,omitempty"`
+	Assigned         RecordRef  `json:"assigned,omitempty"`
+	Company          RecordRef  `json:"company,omitempty"`
+	CompletedDate    Date       `json:"completedDate,omitempty"`
+	Contact          RecordRef  `json:"contact,omitempty"`
+	CreatedDate      Date       `json:"createdDate,omitempty"`
+	CustomForm       CustomForm `json:"customForm,omitempty"`
+	DueDate          Date       `json:"dueDate,omitempty"`
+	ExternalID       string     `json:"externalId,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	LastModifiedDate Date       `json:"lastModifiedDate,omitempty"`
+	Message          string     `json:"message,omitempty"`
+	Owner            RecordRef  `json:"owner,omitempty"`
+	Priority         RecordRef  `json:"priority,omitempty"`
+	RefName          string     `json:"refName,omitempty"`
+	SendEmail        Bool       `json:"sendEmail,omitempty"`
+	StartDate        Date       `json:"startDate,omitempty"`
+	Status           RecordRef  `json:"status,omitempty"`
+	SupportCase      RecordRef  `json:"supportCase,omitempty"`
+	TimedEvent       Bool       `json:"timedEvent,omitempty"`
+	Title            string     `json:"title,omitempty"`
+	Transaction      RecordRef  `json:"transaction,omitempty"`
+}
+
+func (t Task) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t Task) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type CalendarEvents []CalendarEvent
+
+type CalendarEvent struct {
+	AccessLevel      RecordRef  `json:"accessLevel,omitempty"`
+	AllDayEvent      Bool       `json:"allDayEvent,omitempty"`
+	Company          RecordRef  `json:"company,omitempty"`
+	Contact          RecordRef  `json:"contact,omitempty"`
+	CreatedDate      Date       `json:"createdDate,omitempty"`
+	CustomForm       CustomForm `json:"customForm,omitempty"`
+	EndDate          Date       `json:"endDate,omitempty"`
+	EndTime          string     `json:"endTime,omitempty"`
+	ExternalID       string     `json:"externalId,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	LastModifiedDate Date       `json:"lastModifiedDate,omitempty"`
+	Location         string     `json:"location,omitempty"`
+	Message          string     `json:"message,omitempty"`
+	Organizer        RecordRef  `json:"organizer,omitempty"`
+	Owner            RecordRef  `json:"owner,omitempty"`
+	RefName          string     `json:"refName,omitempty"`
+	StartDate        Date       `json:"startDate,omitempty"`
+	StartTime        string     `json:"startTime,omitempty"`
+	Status           RecordRef  `json:"status,omitempty"`
+	SupportCase      RecordRef  `json:"supportCase,omitempty"`
+	TimedEvent       Bool       `json:"timedEvent,omitempty"`
+	Title            string     `json:"title,omitempty"`
+	Transaction      RecordRef  `json:"transaction,omitempty"`
+}
+
+func (c CalendarEvent) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CalendarEvent) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type PhoneCalls []PhoneCall
+
+type PhoneCall struct {
+	AccessLevel      RecordRef  `json:"accessLevel,omitempty"`
+	Assigned         RecordRef  `json:"assigned,omitempty"`
+	Company          RecordRef  `json:"company,omitempty"`
+	CompletedDate    Date       `json:"completedDate,omitempty"`
+	Contact          RecordRef  `json:"contact,omitempty"`
+	CreatedDate      Date       `json:"createdDate,omitempty"`
+	CustomForm       CustomForm `json:"customForm,omitempty"`
+	EndTime          string     `json:"endTime,omitempty"`
+	ExternalID       string     `json:"externalId,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	LastModifiedDate Date       `json:"lastModifiedDate,omitempty"`
+	Message          string     `json:"message,omitempty"`
+	Owner            RecordRef  `json:"owner,omitempty"`
+	Phone            string     `json:"phone,omitempty"`
+	Priority         RecordRef  `json:"priority,omitempty"`
+	RefName          string     `json:"refName,omitempty"`
+	StartDate        Date       `json:"startDate,omitempty"`
+	StartTime        string     `json:"startTime,omitempty"`
+	Status           RecordRef  `json:"status,omitempty"`
+	SupportCase      RecordRef  `json:"supportCase,omitempty"`
+	TimedEvent       Bool       `json:"timedEvent,omitempty"`
+	Title            string     `json:"title,omitempty"`
+	Transaction      RecordRef  `json:"transaction,omitempty"`
+}
+
+func (p PhoneCall) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PhoneCall) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type SupportCases []SupportCase
+
+type SupportCase struct {
+	Assigned          RecordRef              `json:"assigned,omitempty"`
+	CaseNumber        string                 `json:"caseNumber,omitempty"`
+	Category          RecordRef              `json:"category,omitempty"`
+	Company           RecordRef              `json:"company,omitempty"`
+	Contact           RecordRef              `json:"contact,omitempty"`
+	CreatedDate       Date                   `json:"createdDate,omitempty"`
+	CustomForm        CustomForm             `json:"customForm,omitempty"`
+	Email             string                 `json:"email,omitempty"`
+	EmailForm         Bool                   `json:"emailForm,omitempty"`
+	EscalateTo        SupportCaseEscalations `json:"escalateTo,omitempty"`
+	EscalationMessage string                 `json:"escalationMessage,omitempty"`
+	ExternalID        string                 `json:"externalId,omitempty"`
+	ID                string                 `json:"id,omitempty"`
+	IncomingMessage   string                 `json:"incomingMessage,omitempty"`
+	IsInactive        Bool                   `json:"isInactive,omitempty"`
+	Issue             RecordRef              `json:"issue,omitempty"`
+	Item              RecordRef              `json:"item,omitempty"`
+	LastMessageDate   Date                   `json:"lastMessageDate,omitempty"`
+	LastModifiedDate  Date                   `json:"lastModifiedDate,omitempty"`
+	Origin            RecordRef              `json:"origin,omitempty"`
+	OutgoingMessage   string                 `json:"outgoingMessage,omitempty"`
+	Phone             string                 `json:"phone,omitempty"`
+	Priority          RecordRef              `json:"priority,omitempty"`
+	Profile           RecordRef              `json:"profile,omitempty"`
+	RefName           string                 `json:"refName,omitempty"`
+	StartDate         Date                   `json:"startDate,omitempty"`
+	Status            RecordRef              `json:"status,omitempty"`
+	Subsidiary        Subsidiary             `json:"subsidiary,omitempty"`
+	Title             string                 `json:"title,omitempty"`
+}
+
+func (s SupportCase) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(s)
+}
+
+func (s SupportCase) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+
+type SupportCaseEscalations struct {
+	Links        Links                      `json:"links,omitempty"`
+	Items        SupportCaseEscalationItems `json:"items"`
+	TotalResults int                        `json:"totalResults,omitempty"`
+}
+
+func (s SupportCaseEscalations) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+
+type SupportCaseEscalationItems []SupportCaseEscalation
+
+type SupportCaseEscalation struct {
+	Links      Links     `json:"links,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	EscalateTo RecordRef `json:"escalateTo,omitempty"`
+}
+
+func (s SupportCaseEscalation) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(s)
+}
+
+type PromotionCodes []PromotionCode
+
+type PromotionCode struct {
+	ApplyDiscountTo    RecordRef `json:"applyDiscountTo,omitempty"`
+	Code               string    `json:"code,omitempty"`
+	CodePattern        string    `json:"codePattern,omitempty"`
+	CombinationType    RecordRef `json:"combinationType,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	Discount           RecordRef `json:"discount,omitempty"`
+	DiscountType       RecordRef `json:"discountType,omitempty"`
+	EndDate            Date      `json:"endDate,omitempty"`
+	ExcludeItems       Bool      `json:"excludeItems,omitempty"`
+	ExternalID         string    `json:"externalId,omitempty"`
+	ID                 string    `json:"id,omitempty"`
+	Implementation     RecordRef `json:"implementation,omitempty"`
+	IsInactive         Bool      `json:"isInactive,omitempty"`
+	IsPublic           Bool      `json:"isPublic,omitempty"`
+	MinimumOrderAmount Decimal   `json:"minimumOrderAmount,omitempty"`
+	Name               string    `json:"name,omitempty"`
+	NumberToGenerate   int       `json:"numberToGenerate,omitempty"`
+	Rate               string    `json:"rate,omitempty"`
+	RefName            string    `json:"refName,omitempty"`
+	StartDate          Date      `json:"startDate,omitempty"`
+	UseType            RecordRef `json:"useType,omitempty"`
+}
+
+func (p PromotionCode) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PromotionCode) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+// IsActiveOn reports whether the promotion can be applied on the given day:
+// it is not inactive and the day falls within the start and end date, when
+// set.
+func (p PromotionCode) IsActiveOn(t time.Time) bool {
+	if p.IsInactive {
+		return false
+	}
+
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if !p.StartDate.IsZero() && day.Before(p.StartDate.Time) {
+		return false
+	}
+	if !p.EndDate.IsZero() && day.After(p.EndDate.Time) {
+		return false
+	}
+
+	return true
+}
+
+type CouponCodes []CouponCode
+
+type CouponCode struct {
+	Code       string    `json:"code,omitempty"`
+	DateSent   Date      `json:"dateSent,omitempty"`
+	ExternalID string    `json:"externalId,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	Promotion  RecordRef `json:"promotion,omitempty"`
+	Recipient  RecordRef `json:"recipient,omitempty"`
+	RefName    string    `json:"refName,omitempty"`
+	UseCount   int       `json:"useCount,omitempty"`
+}
+
+func (c CouponCode) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CouponCode) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type GiftCertificates []GiftCertificate
+
+type GiftCertificate struct {
+	AmountRemaining Decimal   `json:"amountRemaining,omitempty"`
+	CreatedDate     Date      `json:"createdDate,omitempty"`
+	Currency        Currency  `json:"currency,omitempty"`
+	Email           string    `json:"email,omitempty"`
+	ExpirationDate  Date      `json:"expirationDate,omitempty"`
+	ExternalID      string    `json:"externalId,omitempty"`
+	GiftCertCode    string    `json:"giftCertCode,omitempty"`
+	ID              string    `json:"id,omitempty"`
+	Item            RecordRef `json:"item,omitempty"`
+	Message         string    `json:"message,omitempty"`
+	Name            string    `json:"name,omitempty"`
+	OriginalAmount  Decimal   `json:"originalAmount,omitempty"`
+	RefName         string    `json:"refName,omitempty"`
+	Sender          string    `json:"sender,omitempty"`
+}
+
+func (g GiftCertificate) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(g)
+}
+
+func (g GiftCertificate) IsEmpty() bool {
+	return zero.IsZero(g)
+}
+
+// IsExpiredOn reports whether the gift certificate has passed its expiration
+// date on the given day. Certificates without expiration date never expire.
+func (g GiftCertificate) IsExpiredOn(t time.Time) bool {
+	if g.ExpirationDate.IsZero() {
+		return false
+	}
+
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.After(g.ExpirationDate.Time)
+}
+
+// CanRedeem reports whether amount can be redeemed from the gift certificate
+// on the given day.
+func (g GiftCertificate) CanRedeem(amount Decimal, t time.Time) bool {
+	return !g.IsExpiredOn(t) && amount.LessThanOrEqual(g.AmountRemaining.Decimal)
+}
+
+type GiftCertificateItems []GiftCertificateItem
+
+type GiftCertificateItem struct {
+	AuthCodesList    string     `json:"authCodesList,omitempty"`
+	Class            RecordRef  `json:"class,omitempty"`
+	CreatedDate      Date       `json:"createdDate,omitempty"`
+	Department       RecordRef  `json:"department,omitempty"`
+	Description      string     `json:"description,omitempty"`
+	DisplayName      string     `json:"displayName,omitempty"`
+	ExternalID       string     `json:"externalId,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	IncomeAccount    Account    `json:"incomeAccount,omitempty"`
+	IsInactive       Bool       `json:"isInactive,omitempty"`
+	ItemID           string     `json:"itemId,omitempty"`
+	LastModifiedDate Date       `json:"lastModifiedDate,omitempty"`
+	LiabilityAccount Account    `json:"liabilityAccount,omitempty"`
+	Location         RecordRef  `json:"location,omitempty"`
+	RefName          string     `json:"refName,omitempty"`
+	Subsidiary       Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (g GiftCertificateItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(g)
+}
+
+func (g GiftCertificateItem) IsEmpty() bool {
+	return zero.IsZero(g)
+}
+
+type Partners []Partner
+
+type Partner struct {
+	AddressBook         AddressBook                `json:"addressBook,omitempty"`
+	Class               RecordRef                  `json:"class,omitempty"`
+	Comments            string                     `json:"comments,omitempty"`
+	CommissionSchedules PartnerCommissionSchedules `json:"commissionSchedules,omitempty"`
+	CompanyName         string                     `json:"companyName,omitempty"`
+	CustomForm          CustomForm                 `json:"customForm,omitempty"`
+	DateCreated         Date                       `json:"dateCreated,omitempty"`
+	Department          RecordRef                  `json:"department,omitempty"`
+	Email               string                     `json:"email,omitempty"`
+	EntityID            string                     `json:"entityId,omitempty"`
+	ExternalID          string                     `json:"externalId,omitempty"`
+	Fax                 string                     `json:"fax,omitempty"`
+	FirstName           string                     `json:"firstName,omitempty"`
+	ID                  string                     `json:"id,omitempty"`
+	IsInactive          Bool                       `json:"isInactive,omitempty"`
+	IsPerson            Bool                       `json:"isPerson,omitempty"`
+	LastModifiedDate    Date                       `json:"lastModifiedDate,omitempty"`
+	LastName            string                     `json:"lastName,omitempty"`
+	Location            RecordRef                  `json:"location,omitempty"`
+	MiddleName          string                     `json:"middleName,omitempty"`
+	Parent              RecordRef                  `json:"parent,omitempty"`
+	PartnerCode         string                     `json:"partnerCode,omitempty"`
+	Phone               string                     `json:"phone,omitempty"`
+	Salutation          string                     `json:"salutation,omitempty"`
+	Subsidiaries        PartnerSubsidiaries        `json:"subsidiaries,omitempty"`
+	Subsidiary          Subsidiary                 `json:"subsidiary,omitempty"`
+	TaxIDNum            string                     `json:"taxIdNum,omitempty"`
+	Title               string                     `json:"title,omitempty"`
+	URL                 string                     `json:"url,omitempty"`
+}
+
+func (p Partner) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p Partner) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type PartnerCommissionSchedules struct {
+	Links        Links                          `json:"links,omitempty"`
+	Items        PartnerCommissionScheduleItems `json:"items"`
+	TotalResults int                            `json:"totalResults,omitempty"`
+}
+
+func (p PartnerCommissionSchedules) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type PartnerCommissionScheduleItems []PartnerCommissionSchedule
+
+type PartnerCommissionSchedule struct {
+	Links         Links     `json:"links,omitempty"`
+	EffectiveDate Date      `json:"effectiveDate,omitempty"`
+	EndDate       Date      `json:"endDate,omitempty"`
+	Schedule      RecordRef `json:"schedule,omitempty"`
+}
+
+func (p PartnerCommissionSchedule) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+type PartnerSubsidiaries struct {
+	Links        Links                  `json:"links,omitempty"`
+	Items        PartnerSubsidiaryItems `json:"items"`
+	TotalResults int                    `json:"totalResults,omitempty"`
+}
+
+func (p PartnerSubsidiaries) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type PartnerSubsidiaryItems []PartnerSubsidiary
+
+type PartnerSubsidiary struct {
+	Links      Links      `json:"links,omitempty"`
+	Subsidiary Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (p PartnerSubsidiary) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+// CustomerStatus is the PATCH body used to move a customer record between the
+// lead, prospect and customer stages. NetSuite derives the stage from the
+// entity status, so that is the only field sent.
+type CustomerStatus struct {
+	EntityStatus RecordRef `json:"entityStatus"`
+}
+
+type Campaigns []Campaign
+
+type Campaign struct {
+	Audience         RecordRef  `json:"audience,omitempty"`
+	BaseCost         Decimal    `json:"baseCost,omitempty"`
+	CampaignID       string     `json:"campaignId,omitempty"`
+	Category         RecordRef  `json:"category,omitempty"`
+	Cost             Decimal    `json:"cost,omitempty"`
+	CustomForm       CustomForm `json:"customForm,omitempty"`
+	EndDate          Date       `json:"endDate,omitempty"`
+	ExpectedRevenue  Decimal    `json:"expectedRevenue,omitempty"`
+	ExternalID       string     `json:"externalId,omitempty"`
+	Family           RecordRef  `json:"family,omitempty"`
+	ID               string     `json:"id,omitempty"`
+	IsInactive       Bool       `json:"isInactive,omitempty"`
+	LastModifiedDate Date       `json:"lastModifiedDate,omitempty"`
+	Message          string     `json:"message,omitempty"`
+	Offer            RecordRef  `json:"offer,omitempty"`
+	Owner            RecordRef  `json:"owner,omitempty"`
+	PromotionCode    RecordRef  `json:"promotionCode,omitempty"`
+	RefName          string     `json:"refName,omitempty"`
+	SearchEngine     RecordRef  `json:"searchEngine,omitempty"`
+	StartDate        Date       `json:"startDate,omitempty"`
+	Title            string     `json:"title,omitempty"`
+	URL              string     `json:"url,omitempty"`
+	Vertical         RecordRef  `json:"vertical,omitempty"`
+}
+
+func (c Campaign) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c Campaign) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type CampaignResponses []CampaignResponse
+
+type CampaignResponse struct {
+	Author        RecordRef `json:"author,omitempty"`
+	CampaignEvent RecordRef `json:"campaignEvent,omitempty"`
+	Channel       RecordRef `json:"channel,omitempty"`
+	Entity        RecordRef `json:"entity,omitempty"`
+	ExternalID    string    `json:"externalId,omitempty"`
+	ID            string    `json:"id,omitempty"`
+	LeadSource    RecordRef `json:"leadSource,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	RefName       string    `json:"refName,omitempty"`
+	Response      RecordRef `json:"response,omitempty"`
+	ResponseDate  Date      `json:"responseDate,omitempty"`
+}
+
+func (c CampaignResponse) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(c)
+}
+
+func (c CampaignResponse) IsEmpty() bool {
+	return zero.IsZero(c)
+}
+
+type PaymentMethods []PaymentMethod
+
+type PaymentMethod struct {
+	Account     Account   `json:"account,omitempty"`
+	ExternalID  string    `json:"externalId,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	IsDebitCard Bool      `json:"isDebitCard,omitempty"`
+	IsInactive  Bool      `json:"isInactive,omitempty"`
+	IsOnline    Bool      `json:"isOnline,omitempty"`
+	MethodType  RecordRef `json:"methodType,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	RefName     string    `json:"refName,omitempty"`
+	UndepFunds  Bool      `json:"undepFunds,omitempty"`
+}
+
+func (p PaymentMethod) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PaymentMethod) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type PaymentCards []PaymentCard
+
+type PaymentCard struct {
+	CardBrand      RecordRef `json:"cardBrand,omitempty"`
+	CardNumber     string    `json:"cardNumber,omitempty"`
+	Entity         RecordRef `json:"entity,omitempty"`
+	ExpirationDate Date      `json:"expirationDate,omitempty"`
+	ExternalID     string    `json:"externalId,omitempty"`
+	ID             string    `json:"id,omitempty"`
+	IsDefault      Bool      `json:"isDefault,omitempty"`
+	IsInactive     Bool      `json:"isInactive,omitempty"`
+	Mask           string    `json:"mask,omitempty"`
+	Memo           string    `json:"memo,omitempty"`
+	NameOnCard     string    `json:"nameOnCard,omitempty"`
+	PaymentMethod  RecordRef `json:"paymentMethod,omitempty"`
+	RefName        string    `json:"refName,omitempty"`
+}
+
+func (p PaymentCard) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PaymentCard) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type PaymentCardTokens []PaymentCardToken
+
+type PaymentCardToken struct {
+	CardBrand           RecordRef `json:"cardBrand,omitempty"`
+	CardExpirationDate  Date      `json:"cardExpirationDate,omitempty"`
+	CardLastFourDigits  string    `json:"cardLastFourDigits,omitempty"`
+	CardNameOnCard      string    `json:"cardNameOnCard,omitempty"`
+	Entity              RecordRef `json:"entity,omitempty"`
+	ExternalID          string    `json:"externalId,omitempty"`
+	ID                  string    `json:"id,omitempty"`
+	IsDefault           Bool      `json:"isDefault,omitempty"`
+	IsInactive          Bool      `json:"isInactive,omitempty"`
+	Mask                string    `json:"mask,omitempty"`
+	Memo                string    `json:"memo,omitempty"`
+	PaymentMethod       RecordRef `json:"paymentMethod,omitempty"`
+	RefName             string    `json:"refName,omitempty"`
+	Token               string    `json:"token,omitempty"`
+	TokenExpirationDate Date      `json:"tokenExpirationDate,omitempty"`
+	TokenFamily         RecordRef `json:"tokenFamily,omitempty"`
+	TokenNamespace      string    `json:"tokenNamespace,omitempty"`
+}
+
+func (p PaymentCardToken) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(p)
+}
+
+func (p PaymentCardToken) IsEmpty() bool {
+	return zero.IsZero(p)
+}
+
+type ShipItems []ShipItem
+
+// ByName returns the shipping item with the given item id or display name.
+// Names are compared case-insensitively.
+func (ss ShipItems) ByName(name string) (ShipItem, bool) {
+	for _, s := range ss {
+		if strings.EqualFold(s.ItemID, name) || strings.EqualFold(s.DisplayName, name) {
+			return s, true
+		}
+	}
+	return ShipItem{}, false
+}
+
+type ShipItem struct {
+	Account            Account    `json:"account,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	DisplayName        string     `json:"displayName,omitempty"`
+	ExternalID         string     `json:"externalId,omitempty"`
+	HandlingAccount    Account    `json:"handlingAccount,omitempty"`
+	ID                 string     `json:"id,omitempty"`
+	IsFreeShippingItem Bool       `json:"isFreeShippingItem,omitempty"`
+	IsInactive         Bool       `json:"isInactive,omitempty"`
+	IsOnline           Bool       `json:"isOnline,omitempty"`
+	ItemID             string     `json:"itemId,omitempty"`
+	MinimumCharge      Decimal    `json:"minimumCharge,omitempty"`
+	RefName            string     `json:"refName,omitempty"`
+	ServiceCode        RecordRef  `json:"serviceCode,omitempty"`
+	ShippingCarrier    RecordRef  `json:"shippingCarrier,omitempty"`
+	ShippingFlatRate   Decimal    `json:"shippingFlatRate,omitempty"`
+	Subsidiary         Subsidiary `json:"subsidiary,omitempty"`
+}
+
+func (s ShipItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(s)
+}
+
+func (s ShipItem) IsEmpty() bool {
+	return zero.IsZero(s)
+}
+
+type TaxCodes []TaxCode
+
+// ForNexus returns the tax codes of the given nexus that are effective on the
+// given day.
+func (tt TaxCodes) ForNexus(nexusID string, t time.Time) TaxCodes {
+	codes := TaxCodes{}
+	for _, c := range tt {
+		if c.Nexus.ID != nexusID || bool(c.IsInactive) || !c.IsEffectiveOn(t) {
+			continue
+		}
+		codes = append(codes, c)
+	}
+	return codes
+}
+
+// TaxCode is a sales tax item as exposed by the salesTaxItem record.
+type TaxCode struct {
+	Country       RecordRef `json:"country,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	EffectiveFrom Date      `json:"effectiveFrom,omitempty"`
+	ExternalID    string    `json:"externalId,omitempty"`
+	ID            string    `json:"id,omitempty"`
+	IsInactive    Bool      `json:"isInactive,omitempty"`
+	ItemID        string    `json:"itemId,omitempty"`
+	Nexus         RecordRef `json:"nexus,omitempty"`
+	Rate          Decimal   `json:"rate,omitempty"`
+	RefName       string    `json:"refName,omitempty"`
+	TaxAccount    Account   `json:"taxAccount,omitempty"`
+	TaxType       RecordRef `json:"taxType,omitempty"`
+	ValidUntil    Date      `json:"validUntil,omitempty"`
+}
+
+func (t TaxCode) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TaxCode) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+// IsEffectiveOn reports whether the given day falls between the effective from
+// and valid until dates of the tax code. Missing dates are unbounded.
+func (t TaxCode) IsEffectiveOn(d time.Time) bool {
+	day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	if !t.EffectiveFrom.IsZero() && day.Before(t.EffectiveFrom.Time) {
+		return false
+	}
+	if !t.ValidUntil.IsZero() && day.After(t.ValidUntil.Time) {
+		return false
+	}
+	return true
+}
+
+type TaxGroups []TaxGroup
+
+type TaxGroup struct {
+	Country     RecordRef        `json:"country,omitempty"`
+	Description string           `json:"description,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	ID          string           `json:"id,omitempty"`
+	IsInactive  Bool             `json:"isInactive,omitempty"`
+	ItemID      string           `json:"itemId,omitempty"`
+	Nexus       RecordRef        `json:"nexus,omitempty"`
+	Rate        Decimal          `json:"rate,omitempty"`
+	RefName     string           `json:"refName,omitempty"`
+	TaxItemList TaxGroupTaxItems `json:"taxItemList,omitempty"`
+}
+
+func (t TaxGroup) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TaxGroup) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TaxGroupTaxItems struct {
+	Links        Links                `json:"links,omitempty"`
+	Items        TaxGroupTaxItemItems `json:"items"`
+	TotalResults int                  `json:"totalResults,omitempty"`
+}
+
+func (t TaxGroupTaxItems) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TaxGroupTaxItemItems []TaxGroupTaxItem
+
+type TaxGroupTaxItem struct {
+	Links   Links     `json:"links,omitempty"`
+	Basis   float64   `json:"basis,omitempty"`
+	Rate    Decimal   `json:"rate,omitempty"`
+	TaxName RecordRef `json:"taxName,omitempty"`
+	TaxType RecordRef `json:"taxType,omitempty"`
+}
+
+func (t TaxGroupTaxItem) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+type TaxTypes []TaxType
+
+type TaxType struct {
+	Country     RecordRef         `json:"country,omitempty"`
+	Description string            `json:"description,omitempty"`
+	ExternalID  string            `json:"externalId,omitempty"`
+	ID          string            `json:"id,omitempty"`
+	IsInactive  Bool              `json:"isInactive,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	NexusesTax  TaxTypeNexusesTax `json:"nexusesTax,omitempty"`
+	RefName     string            `json:"refName,omitempty"`
+}
+
+func (t TaxType) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TaxType) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TaxTypeNexusesTax struct {
+	Links        Links                  `json:"links,omitempty"`
+	Items        TaxTypeNexusesTaxItems `json:"items"`
+	TotalResults int                    `json:"totalResults,omitempty"`
+}
+
+func (t TaxTypeNexusesTax) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type TaxTypeNexusesTaxItems []TaxTypeNexusTax
+
+type TaxTypeNexusTax struct {
+	Links              Links     `json:"links,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	Nexus              RecordRef `json:"nexus,omitempty"`
+	PayablesAccount    Account   `json:"payablesAccount,omitempty"`
+	ReceivablesAccount Account   `json:"receivablesAccount,omitempty"`
+}
+
+func (t TaxTypeNexusTax) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+type Budgets []Budget
+
+// Budget is the budget of an account for a fiscal year, optionally per
+// subsidiary, department, class, location, customer or item. PeriodAmounts
+// holds the amounts of the periods of the year (1 based) as the REST record
+// has them; Lines the amounts per accounting period as SuiteQL returns them,
+// see Client.QueryBudgets.
+type Budget struct {
+	Account        RecordRef      `json:"account,omitempty"`
+	AccountingBook AccountingBook `json:"accountingBook,omitempty"`
+	BudgetType     RecordRef      `json:"budgetType,omitempty"`
+	Category       RecordRef      `json:"category,omitempty"`
+	Class          RecordRef      `json:"class,omitempty"`
+	Currency       Currency       `json:"currency,omitempty"`
+	Customer       RecordRef      `json:"customer,omitempty"`
+	Department     RecordRef      `json:"department,omitempty"`
+	ExternalID     string         `json:"externalId,omitempty"`
+	ID             string         `json:"id,omitempty"`
+	Item           RecordRef      `json:"item,omitempty"`
+	Location       RecordRef      `json:"location,omitempty"`
+	Subsidiary     Subsidiary     `json:"subsidiary,omitempty"`
+	Total          Decimal        `json:"total,omitempty"`
+	Year           RecordRef      `json:"year,omitempty"`
+
+	PeriodAmounts map[int]Decimal `json:"-"`
+	Lines         BudgetLines     `json:"-"`
+}
+
+func (b Budget) IsEmpty() bool {
+	return zero.IsZero(b)
+}
+
+type BudgetLines []BudgetLine
+
+// BudgetLine is the budgeted amount of an accounting period.
+type BudgetLine struct {
+	Period PostingPeriod `json:"period,omitempty"`
+	Amount Decimal       `json:"amount,omitempty"`
+}
+
+type InterCompanyJournalEntries []InterCompanyJournalEntry
+
+// InterCompanyJournalEntry is a journal entry between two subsidiaries. The
+// lines of Subsidiary and of ToSubsidiary (see LineSubsidiary) each balance on
+// their own; NetSuite books the due to/from the other subsidiary.
+type InterCompanyJournalEntry struct {
+	AccountingBook   AccountingBook               `json:"accountingBook,omitempty"`
+	Approved         Bool                         `json:"approved,omitempty"`
+	CreatedDate      Date                         `json:"createdDate,omitempty"`
+	Currency         Currency                     `json:"currency,omitempty"`
+	CustomForm       CustomForm                   `json:"customForm,omitempty"`
+	ExchangeRate     Decimal                      `json:"exchangeRate,omitempty"`
+	ExternalID       string                       `json:"externalId,omitempty"`
+	ID               string                       `json:"id,omitempty"`
+	IsReversal       Bool                         `json:"isReversal,omitempty"`
+	LastModifiedDate Date                         `json:"lastModifiedDate,omitempty"`
+	Lines            InterCompanyJournalEntryLine `json:"line,omitempty"`
+	Memo             string                       `json:"memo,omitempty"`
+	PostingPeriod    PostingPeriod                `json:"postingPeriod,omitempty"`
+	RefName          string                       `json:"refName,omitempty"`
+	ReversalDate     Date                         `json:"reversalDate,omitempty"`
+	Subsidiary       Subsidiary                   `json:"subsidiary,omitempty"`
+	ToSubsidiary     Subsidiary                   `json:"toSubsidiary,omitempty"`
+	TranDate         Date                         `json:"tranDate,omitempty"`
+	TranID           string                       `json:"tranId,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
+}
+
+func (j InterCompanyJournalEntry) MarshalJSON() ([]byte, error) {
+	b, err := omitempty.MarshalJSON(j)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, j.CustomFields)
+}
+
+func (j *InterCompanyJournalEntry) UnmarshalJSON(data []byte) error {
+	type alias InterCompanyJournalEntry
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*j = InterCompanyJournalEntry(a)
+	j.CustomFields = cf
+	return nil
+}
+
+func (j InterCompanyJournalEntry) IsEmpty() bool {
+	return zero.IsZero(j)
+}
+
+type InterCompanyJournalEntryLine = Sublist[InterCompanyJournalEntryLineElement]
+
+type InterCompanyJournalEntryLineElements []InterCompanyJournalEntryLineElement
+
+type InterCompanyJournalEntryLineElement struct {
+	Links      Links     `json:"links,omitempty"`
+	Account    Account   `json:"account,omitempty"`
+	Class      RecordRef `json:"class,omitempty"`
+	Credit     Decimal   `json:"credit,omitempty"`
+	Debit      Decimal   `json:"debit,omitempty"`
+	Department RecordRef `json:"department,omitempty"`
+	// DueToFromSubsidiary is the other subsidiary of the line, set by NetSuite
+	DueToFromSubsidiary Subsidiary `json:"dueToFromSubsidiary,omitempty"`
+	Eliminate           Bool       `json:"eliminate,omitempty"`
+	Entity              RecordRef  `json:"entity,omitempty"`
+	Line                int        `json:"line,omitempty"`
+	// LineSubsidiary is the subsidiary the line books in, the subsidiary or
+	// the to subsidiary of the entry
+	LineSubsidiary Subsidiary `json:"lineSubsidiary,omitempty"`
+	Location       RecordRef  `json:"location,omitempty"`
+	Memo           string     `json:"memo,omitempty"`
+
+	CustomFields CustomFields `json:"-"`
+}
+
+func (j InterCompanyJournalEntryLineElement) LineID() string {
+	if j.Line == 0 {
+		return ""
+	}
+	return strconv.Itoa(j.Line)
+}
+
+// MarshalJSON leaves out the read only links.
+func (j InterCompanyJournalEntryLineElement) MarshalJSON() ([]byte, error) {
+	j.Links = nil
+	b, err := omitempty.MarshalJSON(j)
+	if err != nil {
+		return nil, err
+	}
+	return marshalWithCustomFields(b, j.CustomFields)
+}
+
+func (j *InterCompanyJournalEntryLineElement) UnmarshalJSON(data []byte) error {
+	type alias InterCompanyJournalEntryLineElement
+	a := alias{}
+	cf, err := unmarshalWithCustomFields(data, &a)
+	if err != nil {
+		return err
+	}
+
+	*j = InterCompanyJournalEntryLineElement(a)
+	j.CustomFields = cf
+	return nil
+}
+
+type AccountingPeriods []AccountingPeriod
+
+// AccountingPeriod is a month (posting period), quarter or year of a fiscal
+// calendar.
+type AccountingPeriod struct {
+	AllLocked         Bool      `json:"allLocked,omitempty"`
+	AllowNonGLChanges Bool      `json:"allowNonGLChanges,omitempty"`
+	APLocked          Bool      `json:"apLocked,omitempty"`
+	ARLocked          Bool      `json:"arLocked,omitempty"`
+	Closed            Bool      `json:"closed,omitempty"`
+	ClosedOnDate      Date      `json:"closedOnDate,omitempty"`
+	EndDate           Date      `json:"endDate,omitempty"`
+	ExternalID        string    `json:"externalId,omitempty"`
+	FiscalCalendar    RecordRef `json:"fiscalCalendar,omitempty"`
+	ID                string    `json:"id,omitempty"`
+	IsAdjust          Bool      `json:"isAdjust,omitempty"`
+	IsInactive        Bool      `json:"isInactive,omitempty"`
+	IsPosting         Bool      `json:"isPosting,omitempty"`
+	IsQuarter         Bool      `json:"isQuarter,omitempty"`
+	IsYear            Bool      `json:"isYear,omitempty"`
+	Parent            RecordRef `json:"parent,omitempty"`
+	PeriodName        string    `json:"periodName,omitempty"`
+	RefName           string    `json:"refName,omitempty"`
+	StartDate         Date      `json:"startDate,omitempty"`
+}
+
+func (a AccountingPeriod) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(a)
+}
+
+func (a AccountingPeriod) IsEmpty() bool {
+	return zero.IsZero(a)
+}
+
+type TaxPeriods []TaxPeriod
+
+// TaxPeriod is a month, quarter or year of the tax calendar, the periods tax
+// returns are filed for.
+type TaxPeriod struct {
+	Closed         Bool      `json:"closed,omitempty"`
+	EndDate        Date      `json:"endDate,omitempty"`
+	ExternalID     string    `json:"externalId,omitempty"`
+	FiscalCalendar RecordRef `json:"fiscalCalendar,omitempty"`
+	ID             string    `json:"id,omitempty"`
+	IsAdjust       Bool      `json:"isAdjust,omitempty"`
+	IsInactive     Bool      `json:"isInactive,omitempty"`
+	IsPosting      Bool      `json:"isPosting,omitempty"`
+	IsQuarter      Bool      `json:"isQuarter,omitempty"`
+	IsYear         Bool      `json:"isYear,omitempty"`
+	Parent         RecordRef `json:"parent,omitempty"`
+	PeriodName     string    `json:"periodName,omitempty"`
+	RefName        string    `json:"refName,omitempty"`
+	StartDate      Date      `json:"startDate,omitempty"`
+}
+
+func (t TaxPeriod) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(t)
+}
+
+func (t TaxPeriod) IsEmpty() bool {
+	return zero.IsZero(t)
+}
+
+type FiscalCalendars []FiscalCalendar
+
+// FiscalCalendar is a fiscal calendar of the account. OneWorld accounts can
+// have a fiscal calendar per subsidiary.
+type FiscalCalendar struct {
+	ExternalID string `json:"externalId,omitempty"`
+	// FiscalMonth is the first month of the fiscal year
+	FiscalMonth RecordRef `json:"fiscalMonth,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	IsDefault   Bool      `json:"isDefault,omitempty"`
+	IsInactive  Bool      `json:"isInactive,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	RefName     string    `json:"refName,omitempty"`
+}
+
+func (f FiscalCalendar) MarshalJSON() ([]byte, error) {
+	return omitempty.MarshalJSON(f)
+}
+
+func (f FiscalCalendar) IsEmpty() bool {
+	return zero.IsZero(f)
+}
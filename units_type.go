@@ -1,6 +1,7 @@
 package netsuite
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -84,6 +85,14 @@ func (r *UnitsTypeGetRequest) Method() string {
 	return r.method
 }
 
+func (r *UnitsTypeGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UnitsTypeGetRequest) Headers() http.Header {
+	return r.headers
+}
+
 func (r UnitsTypeGetRequest) NewRequestBody() UnitsTypeGetRequestBody {
 	return UnitsTypeGetRequestBody{}
 }
@@ -130,9 +139,9 @@ func (r *UnitsTypeGetRequest) URL() (*url.URL, error) {
 	return &u, err
 }
 
-func (r *UnitsTypeGetRequest) Do() (UnitsTypeGetResponseBody, error) {
+func (r *UnitsTypeGetRequest) Do(ctx context.Context) (UnitsTypeGetResponseBody, error) {
 	// Create http request
-	req, err := r.client.NewRequest(nil, r)
+	req, err := r.client.NewRequest(ctx, r)
 	if err != nil {
 		return *r.NewResponseBody(), err
 	}
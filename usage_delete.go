@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewUsageDeleteRequest() UsageDeleteRequest {
+	r := UsageDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type UsageDeleteRequest struct {
+	client      *Client
+	queryParams *UsageDeleteRequestQueryParams
+	pathParams  *UsageDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody UsageDeleteRequestBody
+}
+
+func (r UsageDeleteRequest) NewQueryParams() *UsageDeleteRequestQueryParams {
+	return &UsageDeleteRequestQueryParams{}
+}
+
+type UsageDeleteRequestQueryParams struct {
+}
+
+func (p UsageDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UsageDeleteRequest) QueryParams() *UsageDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r UsageDeleteRequest) NewPathParams() *UsageDeleteRequestPathParams {
+	return &UsageDeleteRequestPathParams{}
+}
+
+type UsageDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *UsageDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *UsageDeleteRequest) PathParams() *UsageDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *UsageDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *UsageDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UsageDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *UsageDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UsageDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r UsageDeleteRequest) NewRequestBody() UsageDeleteRequestBody {
+	return UsageDeleteRequestBody{}
+}
+
+type UsageDeleteRequestBody struct {
+}
+
+func (r *UsageDeleteRequest) RequestBody() *UsageDeleteRequestBody {
+	return nil
+}
+
+func (r *UsageDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *UsageDeleteRequest) SetRequestBody(body UsageDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UsageDeleteRequest) NewResponseBody() *UsageDeleteResponseBody {
+	return &UsageDeleteResponseBody{}
+}
+
+type UsageDeleteResponseBody struct {
+}
+
+func (r *UsageDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/usage/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *UsageDeleteRequest) Do(ctx context.Context) (UsageDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewUsageGetRequest() UsageGetRequest {
+	r := UsageGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type UsageGetRequest struct {
+	client      *Client
+	queryParams *UsageGetRequestQueryParams
+	pathParams  *UsageGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody UsageGetRequestBody
+}
+
+func (r UsageGetRequest) NewQueryParams() *UsageGetRequestQueryParams {
+	return &UsageGetRequestQueryParams{}
+}
+
+type UsageGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p UsageGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UsageGetRequest) QueryParams() *UsageGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r UsageGetRequest) NewPathParams() *UsageGetRequestPathParams {
+	return &UsageGetRequestPathParams{}
+}
+
+type UsageGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *UsageGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *UsageGetRequest) PathParams() *UsageGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *UsageGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *UsageGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UsageGetRequest) Method() string {
+	return r.method
+}
+
+func (r *UsageGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UsageGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r UsageGetRequest) NewRequestBody() UsageGetRequestBody {
+	return UsageGetRequestBody{}
+}
+
+type UsageGetRequestBody struct {
+}
+
+func (r *UsageGetRequest) RequestBody() *UsageGetRequestBody {
+	return nil
+}
+
+func (r *UsageGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *UsageGetRequest) SetRequestBody(body UsageGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UsageGetRequest) NewResponseBody() *UsageGetResponseBody {
+	return &UsageGetResponseBody{}
+}
+
+type UsageGetResponseBody struct {
+	Links Links `json:"links"`
+	Usage
+}
+
+func (r *UsageGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/usage/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *UsageGetRequest) Do(ctx context.Context) (UsageGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewUsagePatchRequest() UsagePatchRequest {
+	r := UsagePatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type UsagePatchRequest struct {
+	client      *Client
+	queryParams *UsagePatchRequestQueryParams
+	pathParams  *UsagePatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody UsagePatchRequestBody
+}
+
+func (r UsagePatchRequest) NewQueryParams() *UsagePatchRequestQueryParams {
+	return &UsagePatchRequestQueryParams{}
+}
+
+type UsagePatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p UsagePatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UsagePatchRequest) QueryParams() *UsagePatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r UsagePatchRequest) NewPathParams() *UsagePatchRequestPathParams {
+	return &UsagePatchRequestPathParams{}
+}
+
+type UsagePatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *UsagePatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *UsagePatchRequest) PathParams() *UsagePatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *UsagePatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *UsagePatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UsagePatchRequest) Method() string {
+	return r.method
+}
+
+func (r *UsagePatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UsagePatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r UsagePatchRequest) NewRequestBody() UsagePatchRequestBody {
+	return UsagePatchRequestBody{}
+}
+
+type UsagePatchRequestBody struct {
+	Usage
+}
+
+func (r *UsagePatchRequest) RequestBody() *UsagePatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *UsagePatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *UsagePatchRequest) SetRequestBody(body UsagePatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UsagePatchRequest) NewResponseBody() *UsagePatchResponseBody {
+	return &UsagePatchResponseBody{}
+}
+
+type UsagePatchResponseBody struct {
+}
+
+func (r *UsagePatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/usage/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *UsagePatchRequest) Do(ctx context.Context) (UsagePatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestUsagePatch(t *testing.T) {
+	req := client.NewUsagePatchRequest()
+	req.PathParams().ID = 901
+	req.RequestBody().UsageQuantity = 130
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
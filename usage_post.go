@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewUsagePostRequest() UsagePostRequest {
+	r := UsagePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type UsagePostRequest struct {
+	client      *Client
+	queryParams *UsagePostRequestQueryParams
+	pathParams  *UsagePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody UsagePostRequestBody
+}
+
+func (r UsagePostRequest) NewQueryParams() *UsagePostRequestQueryParams {
+	return &UsagePostRequestQueryParams{}
+}
+
+type UsagePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p UsagePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UsagePostRequest) QueryParams() *UsagePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r UsagePostRequest) NewPathParams() *UsagePostRequestPathParams {
+	return &UsagePostRequestPathParams{}
+}
+
+type UsagePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *UsagePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *UsagePostRequest) PathParams() *UsagePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *UsagePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *UsagePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UsagePostRequest) Method() string {
+	return r.method
+}
+
+func (r *UsagePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UsagePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r UsagePostRequest) NewRequestBody() UsagePostRequestBody {
+	return UsagePostRequestBody{}
+}
+
+type UsagePostRequestBody struct {
+	Usage
+}
+
+func (r *UsagePostRequest) RequestBody() *UsagePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *UsagePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *UsagePostRequest) SetRequestBody(body UsagePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UsagePostRequest) NewResponseBody() *UsagePostResponseBody {
+	return &UsagePostResponseBody{}
+}
+
+type UsagePostResponseBody struct {
+}
+
+func (r *UsagePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/usage", r.PathParams())
+	return &u, err
+}
+
+func (r *UsagePostRequest) Do(ctx context.Context) (UsagePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestUsagePost(t *testing.T) {
+	req := client.NewUsagePostRequest()
+	req.RequestBody().Usage.ID = "70202"
+	req.RequestBody().Subscription.ID = "21"
+	req.RequestBody().SubscriptionLine.ID = "57"
+	req.RequestBody().UsageQuantity = 125
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
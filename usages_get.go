@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewUsagesGetRequest() UsagesGetRequest {
+	r := UsagesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type UsagesGetRequest struct {
+	client      *Client
+	queryParams *UsagesGetRequestQueryParams
+	pathParams  *UsagesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody UsagesGetRequestBody
+}
+
+func (r UsagesGetRequest) NewQueryParams() *UsagesGetRequestQueryParams {
+	return &UsagesGetRequestQueryParams{}
+}
+
+type UsagesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p UsagesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *UsagesGetRequest) QueryParams() *UsagesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r UsagesGetRequest) NewPathParams() *UsagesGetRequestPathParams {
+	return &UsagesGetRequestPathParams{}
+}
+
+type UsagesGetRequestPathParams struct {
+}
+
+func (p *UsagesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *UsagesGetRequest) PathParams() *UsagesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *UsagesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *UsagesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *UsagesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *UsagesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *UsagesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r UsagesGetRequest) NewRequestBody() UsagesGetRequestBody {
+	return UsagesGetRequestBody{}
+}
+
+type UsagesGetRequestBody struct {
+}
+
+func (r *UsagesGetRequest) RequestBody() *UsagesGetRequestBody {
+	return nil
+}
+
+func (r *UsagesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *UsagesGetRequest) SetRequestBody(body UsagesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *UsagesGetRequest) NewResponseBody() *UsagesGetResponseBody {
+	return &UsagesGetResponseBody{}
+}
+
+type UsagesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *UsagesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/usage", r.PathParams())
+	return &u, err
+}
+
+func (r *UsagesGetRequest) Do(ctx context.Context) (UsagesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,208 @@
+package netsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Violation is a single problem found while validating a record body against
+// its schema.
+type Violation struct {
+	// Field is the (dotted) path of the property, e.g. "addressBook.items.0.label"
+	Field string
+	// Rule is the schema rule that was violated: required, readOnly, enum or
+	// maxLength
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Field, v.Message)
+}
+
+// ValidationError is returned when a record body doesn't satisfy its schema.
+// No request has been sent to NetSuite.
+type ValidationError struct {
+	RecordType string
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := []string{}
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.String())
+	}
+	return fmt.Sprintf("invalid %s: %s", e.RecordType, strings.Join(msgs, ", "))
+}
+
+// ValidateCreate checks a body meant for creating a record: required fields
+// have to be present on top of the rules checked by ValidateUpdate.
+func (s JSONSchema) ValidateCreate(body interface{}) ([]Violation, error) {
+	return s.validate(body, true)
+}
+
+// ValidateUpdate checks a body meant for updating a record: read-only fields
+// may not be set, enum values have to be known and strings may not exceed
+// their maximum length.
+func (s JSONSchema) ValidateUpdate(body interface{}) ([]Violation, error) {
+	return s.validate(body, false)
+}
+
+func (s JSONSchema) validate(body interface{}, create bool) ([]Violation, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	props := map[string]interface{}{}
+	err = json.Unmarshal(b, &props)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []Violation{}
+	if create {
+		for _, r := range s.Required {
+			if v, ok := props[r]; !ok || v == nil {
+				violations = append(violations, Violation{
+					Field:   r,
+					Rule:    "required",
+					Message: "is required",
+				})
+			}
+		}
+	}
+
+	return append(violations, s.validateProperties("", props)...), nil
+}
+
+func (s JSONSchema) validateProperties(prefix string, props map[string]interface{}) []Violation {
+	names := []string{}
+	for k := range props {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	violations := []Violation{}
+	for _, name := range names {
+		ps, ok := s.Properties[name]
+		if !ok {
+			continue
+		}
+		violations = append(violations, ps.validateValue(prefix+name, props[name])...)
+	}
+	return violations
+}
+
+func (s JSONSchema) validateValue(field string, value interface{}) []Violation {
+	if s.ReadOnly {
+		return []Violation{{
+			Field:   field,
+			Rule:    "readOnly",
+			Message: "is read-only",
+		}}
+	}
+
+	if len(s.Enum) > 0 && value != nil && !s.inEnum(value) {
+		return []Violation{{
+			Field:   field,
+			Rule:    "enum",
+			Message: fmt.Sprintf("%v is not one of %v", value, s.Enum),
+		}}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if s.MaxLength != nil && utf8.RuneCountInString(v) > *s.MaxLength {
+			return []Violation{{
+				Field:   field,
+				Rule:    "maxLength",
+				Message: fmt.Sprintf("exceeds the maximum length of %d", *s.MaxLength),
+			}}
+		}
+	case map[string]interface{}:
+		return s.validateProperties(field+".", v)
+	case []interface{}:
+		if s.Items == nil {
+			return nil
+		}
+		violations := []Violation{}
+		for i, item := range v {
+			violations = append(violations, s.Items.validateValue(fmt.Sprintf("%s.%d", field, i), item)...)
+		}
+		return violations
+	}
+
+	return nil
+}
+
+func (s JSONSchema) inEnum(value interface{}) bool {
+	for _, e := range s.Enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRequest validates the body of record POST and PATCH requests
+// against the record type's schema.
+func (c *Client) validateRequest(ctx context.Context, req Request) error {
+	method := req.Method()
+	if method != http.MethodPost && method != http.MethodPatch {
+		return nil
+	}
+
+	body := req.RequestBodyInterface()
+	if body == nil {
+		return nil
+	}
+	if _, ok := AsRawBody(body); ok {
+		// already encoded
+		return nil
+	}
+
+	u, err := req.URL()
+	if err != nil {
+		return err
+	}
+
+	// only plain record requests: /record/v1/{type} and /record/v1/{type}/{id}
+	i := strings.Index(u.Path, "/record/v1/")
+	if i == -1 {
+		return nil
+	}
+	parts := strings.Split(strings.Trim(u.Path[i+len("/record/v1/"):], "/"), "/")
+	if len(parts) > 2 || parts[0] == "metadata-catalog" {
+		return nil
+	}
+	recordType := parts[0]
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	schema, err := c.Schema(ctx, recordType)
+	if err != nil {
+		return err
+	}
+
+	var violations []Violation
+	if method == http.MethodPost && len(parts) == 1 {
+		violations, err = schema.ValidateCreate(body)
+	} else {
+		violations, err = schema.ValidateUpdate(body)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{RecordType: recordType, Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package netsuite_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	schema := netsuite.JSONSchema{}
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "readOnly": true},
+			"companyName": {"type": "string", "maxLength": 10},
+			"subsidiary": {"type": "object", "properties": {"id": {"type": "string"}}},
+			"entityStatus": {"type": "object", "properties": {"id": {"type": "string", "enum": ["13", "6"]}}}
+		},
+		"required": ["companyName", "subsidiary"]
+	}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := map[string]interface{}{
+		"id":           "1",
+		"companyName":  "Omniboost B.V.",
+		"entityStatus": map[string]string{"id": "99"},
+	}
+
+	violations, err := schema.ValidateCreate(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := map[string]string{}
+	for _, v := range violations {
+		rules[v.Field] = v.Rule
+	}
+
+	expected := map[string]string{
+		"subsidiary":      "required",
+		"id":              "readOnly",
+		"companyName":     "maxLength",
+		"entityStatus.id": "enum",
+	}
+	for field, rule := range expected {
+		if rules[field] != rule {
+			t.Errorf("expected %s violation on %s, got %v", rule, field, violations)
+		}
+	}
+
+	violations, err = schema.ValidateUpdate(map[string]interface{}{"entityStatus": map[string]string{"id": "13"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("expected no violations, got %v", violations)
+	}
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewVendorReturnAuthorizationDeleteRequest() VendorReturnAuthorizationDeleteRequest {
+	r := VendorReturnAuthorizationDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type VendorReturnAuthorizationDeleteRequest struct {
+	client      *Client
+	queryParams *VendorReturnAuthorizationDeleteRequestQueryParams
+	pathParams  *VendorReturnAuthorizationDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody VendorReturnAuthorizationDeleteRequestBody
+}
+
+func (r VendorReturnAuthorizationDeleteRequest) NewQueryParams() *VendorReturnAuthorizationDeleteRequestQueryParams {
+	return &VendorReturnAuthorizationDeleteRequestQueryParams{}
+}
+
+type VendorReturnAuthorizationDeleteRequestQueryParams struct {
+}
+
+func (p VendorReturnAuthorizationDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) QueryParams() *VendorReturnAuthorizationDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r VendorReturnAuthorizationDeleteRequest) NewPathParams() *VendorReturnAuthorizationDeleteRequestPathParams {
+	return &VendorReturnAuthorizationDeleteRequestPathParams{}
+}
+
+type VendorReturnAuthorizationDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *VendorReturnAuthorizationDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) PathParams() *VendorReturnAuthorizationDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r VendorReturnAuthorizationDeleteRequest) NewRequestBody() VendorReturnAuthorizationDeleteRequestBody {
+	return VendorReturnAuthorizationDeleteRequestBody{}
+}
+
+type VendorReturnAuthorizationDeleteRequestBody struct {
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) RequestBody() *VendorReturnAuthorizationDeleteRequestBody {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) SetRequestBody(body VendorReturnAuthorizationDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) NewResponseBody() *VendorReturnAuthorizationDeleteResponseBody {
+	return &VendorReturnAuthorizationDeleteResponseBody{}
+}
+
+type VendorReturnAuthorizationDeleteResponseBody struct {
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/vendorReturnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *VendorReturnAuthorizationDeleteRequest) Do(ctx context.Context) (VendorReturnAuthorizationDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestVendorReturnAuthorizationDelete(t *testing.T) {
+	req := client.NewVendorReturnAuthorizationDeleteRequest()
+	req.PathParams().ID = 8220
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewVendorReturnAuthorizationGetRequest() VendorReturnAuthorizationGetRequest {
+	r := VendorReturnAuthorizationGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type VendorReturnAuthorizationGetRequest struct {
+	client      *Client
+	queryParams *VendorReturnAuthorizationGetRequestQueryParams
+	pathParams  *VendorReturnAuthorizationGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody VendorReturnAuthorizationGetRequestBody
+}
+
+func (r VendorReturnAuthorizationGetRequest) NewQueryParams() *VendorReturnAuthorizationGetRequestQueryParams {
+	return &VendorReturnAuthorizationGetRequestQueryParams{}
+}
+
+type VendorReturnAuthorizationGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p VendorReturnAuthorizationGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *VendorReturnAuthorizationGetRequest) QueryParams() *VendorReturnAuthorizationGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r VendorReturnAuthorizationGetRequest) NewPathParams() *VendorReturnAuthorizationGetRequestPathParams {
+	return &VendorReturnAuthorizationGetRequestPathParams{}
+}
+
+type VendorReturnAuthorizationGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *VendorReturnAuthorizationGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *VendorReturnAuthorizationGetRequest) PathParams() *VendorReturnAuthorizationGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *VendorReturnAuthorizationGetRequest) Method() string {
+	return r.method
+}
+
+func (r *VendorReturnAuthorizationGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *VendorReturnAuthorizationGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r VendorReturnAuthorizationGetRequest) NewRequestBody() VendorReturnAuthorizationGetRequestBody {
+	return VendorReturnAuthorizationGetRequestBody{}
+}
+
+type VendorReturnAuthorizationGetRequestBody struct {
+}
+
+func (r *VendorReturnAuthorizationGetRequest) RequestBody() *VendorReturnAuthorizationGetRequestBody {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationGetRequest) SetRequestBody(body VendorReturnAuthorizationGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *VendorReturnAuthorizationGetRequest) NewResponseBody() *VendorReturnAuthorizationGetResponseBody {
+	return &VendorReturnAuthorizationGetResponseBody{}
+}
+
+type VendorReturnAuthorizationGetResponseBody struct {
+	Links Links `json:"links"`
+	VendorReturnAuthorization
+}
+
+func (r *VendorReturnAuthorizationGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/vendorReturnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *VendorReturnAuthorizationGetRequest) Do(ctx context.Context) (VendorReturnAuthorizationGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewVendorReturnAuthorizationPatchRequest() VendorReturnAuthorizationPatchRequest {
+	r := VendorReturnAuthorizationPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type VendorReturnAuthorizationPatchRequest struct {
+	client      *Client
+	queryParams *VendorReturnAuthorizationPatchRequestQueryParams
+	pathParams  *VendorReturnAuthorizationPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody VendorReturnAuthorizationPatchRequestBody
+}
+
+func (r VendorReturnAuthorizationPatchRequest) NewQueryParams() *VendorReturnAuthorizationPatchRequestQueryParams {
+	return &VendorReturnAuthorizationPatchRequestQueryParams{}
+}
+
+type VendorReturnAuthorizationPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p VendorReturnAuthorizationPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) QueryParams() *VendorReturnAuthorizationPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r VendorReturnAuthorizationPatchRequest) NewPathParams() *VendorReturnAuthorizationPatchRequestPathParams {
+	return &VendorReturnAuthorizationPatchRequestPathParams{}
+}
+
+type VendorReturnAuthorizationPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *VendorReturnAuthorizationPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) PathParams() *VendorReturnAuthorizationPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r VendorReturnAuthorizationPatchRequest) NewRequestBody() VendorReturnAuthorizationPatchRequestBody {
+	return VendorReturnAuthorizationPatchRequestBody{}
+}
+
+type VendorReturnAuthorizationPatchRequestBody struct {
+	VendorReturnAuthorization
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) RequestBody() *VendorReturnAuthorizationPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) SetRequestBody(body VendorReturnAuthorizationPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) NewResponseBody() *VendorReturnAuthorizationPatchResponseBody {
+	return &VendorReturnAuthorizationPatchResponseBody{}
+}
+
+type VendorReturnAuthorizationPatchResponseBody struct {
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/vendorReturnAuthorization/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *VendorReturnAuthorizationPatchRequest) Do(ctx context.Context) (VendorReturnAuthorizationPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
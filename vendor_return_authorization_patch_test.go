@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestVendorReturnAuthorizationPatch(t *testing.T) {
+	req := client.NewVendorReturnAuthorizationPatchRequest()
+	req.PathParams().ID = 8220
+	req.RequestBody().Memo = "Wrong batch delivered"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
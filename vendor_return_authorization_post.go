@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewVendorReturnAuthorizationPostRequest() VendorReturnAuthorizationPostRequest {
+	r := VendorReturnAuthorizationPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type VendorReturnAuthorizationPostRequest struct {
+	client      *Client
+	queryParams *VendorReturnAuthorizationPostRequestQueryParams
+	pathParams  *VendorReturnAuthorizationPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody VendorReturnAuthorizationPostRequestBody
+}
+
+func (r VendorReturnAuthorizationPostRequest) NewQueryParams() *VendorReturnAuthorizationPostRequestQueryParams {
+	return &VendorReturnAuthorizationPostRequestQueryParams{}
+}
+
+type VendorReturnAuthorizationPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p VendorReturnAuthorizationPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *VendorReturnAuthorizationPostRequest) QueryParams() *VendorReturnAuthorizationPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r VendorReturnAuthorizationPostRequest) NewPathParams() *VendorReturnAuthorizationPostRequestPathParams {
+	return &VendorReturnAuthorizationPostRequestPathParams{}
+}
+
+type VendorReturnAuthorizationPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *VendorReturnAuthorizationPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *VendorReturnAuthorizationPostRequest) PathParams() *VendorReturnAuthorizationPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *VendorReturnAuthorizationPostRequest) Method() string {
+	return r.method
+}
+
+func (r *VendorReturnAuthorizationPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *VendorReturnAuthorizationPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r VendorReturnAuthorizationPostRequest) NewRequestBody() VendorReturnAuthorizationPostRequestBody {
+	return VendorReturnAuthorizationPostRequestBody{}
+}
+
+type VendorReturnAuthorizationPostRequestBody struct {
+	VendorReturnAuthorization
+}
+
+func (r *VendorReturnAuthorizationPostRequest) RequestBody() *VendorReturnAuthorizationPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *VendorReturnAuthorizationPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *VendorReturnAuthorizationPostRequest) SetRequestBody(body VendorReturnAuthorizationPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *VendorReturnAuthorizationPostRequest) NewResponseBody() *VendorReturnAuthorizationPostResponseBody {
+	return &VendorReturnAuthorizationPostResponseBody{}
+}
+
+type VendorReturnAuthorizationPostResponseBody struct {
+}
+
+func (r *VendorReturnAuthorizationPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/vendorReturnAuthorization", r.PathParams())
+	return &u, err
+}
+
+func (r *VendorReturnAuthorizationPostRequest) Do(ctx context.Context) (VendorReturnAuthorizationPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
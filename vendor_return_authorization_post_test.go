@@ -0,0 +1,32 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	netsuite "github.com/omniboost/go-netsuite-rest"
+)
+
+func TestVendorReturnAuthorizationPost(t *testing.T) {
+	req := client.NewVendorReturnAuthorizationPostRequest()
+	req.RequestBody().Entity.ID = "912"
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Item = netsuite.VendorReturnAuthorizationItems{
+		Items: netsuite.VendorReturnAuthorizationItemItems{
+			{
+				Item:     netsuite.RecordRef{ID: "131"},
+				Quantity: 4,
+				Rate:     netsuite.MustDecimal("12.5"),
+			},
+		},
+	}
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewVendorReturnAuthorizationsGetRequest() VendorReturnAuthorizationsGetRequest {
+	r := VendorReturnAuthorizationsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type VendorReturnAuthorizationsGetRequest struct {
+	client      *Client
+	queryParams *VendorReturnAuthorizationsGetRequestQueryParams
+	pathParams  *VendorReturnAuthorizationsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody VendorReturnAuthorizationsGetRequestBody
+}
+
+func (r VendorReturnAuthorizationsGetRequest) NewQueryParams() *VendorReturnAuthorizationsGetRequestQueryParams {
+	return &VendorReturnAuthorizationsGetRequestQueryParams{}
+}
+
+type VendorReturnAuthorizationsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p VendorReturnAuthorizationsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) QueryParams() *VendorReturnAuthorizationsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r VendorReturnAuthorizationsGetRequest) NewPathParams() *VendorReturnAuthorizationsGetRequestPathParams {
+	return &VendorReturnAuthorizationsGetRequestPathParams{}
+}
+
+type VendorReturnAuthorizationsGetRequestPathParams struct {
+}
+
+func (p *VendorReturnAuthorizationsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) PathParams() *VendorReturnAuthorizationsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r VendorReturnAuthorizationsGetRequest) NewRequestBody() VendorReturnAuthorizationsGetRequestBody {
+	return VendorReturnAuthorizationsGetRequestBody{}
+}
+
+type VendorReturnAuthorizationsGetRequestBody struct {
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) RequestBody() *VendorReturnAuthorizationsGetRequestBody {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) SetRequestBody(body VendorReturnAuthorizationsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) NewResponseBody() *VendorReturnAuthorizationsGetResponseBody {
+	return &VendorReturnAuthorizationsGetResponseBody{}
+}
+
+type VendorReturnAuthorizationsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/vendorReturnAuthorization", r.PathParams())
+	return &u, err
+}
+
+func (r *VendorReturnAuthorizationsGetRequest) Do(ctx context.Context) (VendorReturnAuthorizationsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderCloseGetRequest() WorkOrderCloseGetRequest {
+	r := WorkOrderCloseGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderCloseGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderCloseGetRequestQueryParams
+	pathParams  *WorkOrderCloseGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderCloseGetRequestBody
+}
+
+func (r WorkOrderCloseGetRequest) NewQueryParams() *WorkOrderCloseGetRequestQueryParams {
+	return &WorkOrderCloseGetRequestQueryParams{}
+}
+
+type WorkOrderCloseGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderCloseGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderCloseGetRequest) QueryParams() *WorkOrderCloseGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderCloseGetRequest) NewPathParams() *WorkOrderCloseGetRequestPathParams {
+	return &WorkOrderCloseGetRequestPathParams{}
+}
+
+type WorkOrderCloseGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderCloseGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderCloseGetRequest) PathParams() *WorkOrderCloseGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCloseGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCloseGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderCloseGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderCloseGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderCloseGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderCloseGetRequest) NewRequestBody() WorkOrderCloseGetRequestBody {
+	return WorkOrderCloseGetRequestBody{}
+}
+
+type WorkOrderCloseGetRequestBody struct {
+}
+
+func (r *WorkOrderCloseGetRequest) RequestBody() *WorkOrderCloseGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderCloseGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderCloseGetRequest) SetRequestBody(body WorkOrderCloseGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderCloseGetRequest) NewResponseBody() *WorkOrderCloseGetResponseBody {
+	return &WorkOrderCloseGetResponseBody{}
+}
+
+type WorkOrderCloseGetResponseBody struct {
+	Links Links `json:"links"`
+	WorkOrderClose
+}
+
+func (r *WorkOrderCloseGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderClose/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderCloseGetRequest) Do(ctx context.Context) (WorkOrderCloseGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderClosePostRequest() WorkOrderClosePostRequest {
+	r := WorkOrderClosePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderClosePostRequest struct {
+	client      *Client
+	queryParams *WorkOrderClosePostRequestQueryParams
+	pathParams  *WorkOrderClosePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderClosePostRequestBody
+}
+
+func (r WorkOrderClosePostRequest) NewQueryParams() *WorkOrderClosePostRequestQueryParams {
+	return &WorkOrderClosePostRequestQueryParams{}
+}
+
+type WorkOrderClosePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderClosePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderClosePostRequest) QueryParams() *WorkOrderClosePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderClosePostRequest) NewPathParams() *WorkOrderClosePostRequestPathParams {
+	return &WorkOrderClosePostRequestPathParams{}
+}
+
+type WorkOrderClosePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderClosePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderClosePostRequest) PathParams() *WorkOrderClosePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderClosePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderClosePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderClosePostRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderClosePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderClosePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderClosePostRequest) NewRequestBody() WorkOrderClosePostRequestBody {
+	return WorkOrderClosePostRequestBody{}
+}
+
+type WorkOrderClosePostRequestBody struct {
+	WorkOrderClose
+}
+
+func (r *WorkOrderClosePostRequest) RequestBody() *WorkOrderClosePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *WorkOrderClosePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *WorkOrderClosePostRequest) SetRequestBody(body WorkOrderClosePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderClosePostRequest) NewResponseBody() *WorkOrderClosePostResponseBody {
+	return &WorkOrderClosePostResponseBody{}
+}
+
+type WorkOrderClosePostResponseBody struct {
+}
+
+func (r *WorkOrderClosePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderClose", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderClosePostRequest) Do(ctx context.Context) (WorkOrderClosePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,20 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestWorkOrderClosePost(t *testing.T) {
+	req := client.NewWorkOrderClosePostRequest()
+	req.RequestBody().CreatedFrom.ID = "9120"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderClosesGetRequest() WorkOrderClosesGetRequest {
+	r := WorkOrderClosesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderClosesGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderClosesGetRequestQueryParams
+	pathParams  *WorkOrderClosesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderClosesGetRequestBody
+}
+
+func (r WorkOrderClosesGetRequest) NewQueryParams() *WorkOrderClosesGetRequestQueryParams {
+	return &WorkOrderClosesGetRequestQueryParams{}
+}
+
+type WorkOrderClosesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p WorkOrderClosesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderClosesGetRequest) QueryParams() *WorkOrderClosesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderClosesGetRequest) NewPathParams() *WorkOrderClosesGetRequestPathParams {
+	return &WorkOrderClosesGetRequestPathParams{}
+}
+
+type WorkOrderClosesGetRequestPathParams struct {
+}
+
+func (p *WorkOrderClosesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *WorkOrderClosesGetRequest) PathParams() *WorkOrderClosesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderClosesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderClosesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderClosesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderClosesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderClosesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderClosesGetRequest) NewRequestBody() WorkOrderClosesGetRequestBody {
+	return WorkOrderClosesGetRequestBody{}
+}
+
+type WorkOrderClosesGetRequestBody struct {
+}
+
+func (r *WorkOrderClosesGetRequest) RequestBody() *WorkOrderClosesGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderClosesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderClosesGetRequest) SetRequestBody(body WorkOrderClosesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderClosesGetRequest) NewResponseBody() *WorkOrderClosesGetResponseBody {
+	return &WorkOrderClosesGetResponseBody{}
+}
+
+type WorkOrderClosesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *WorkOrderClosesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderClose", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderClosesGetRequest) Do(ctx context.Context) (WorkOrderClosesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderCompletionGetRequest() WorkOrderCompletionGetRequest {
+	r := WorkOrderCompletionGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderCompletionGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderCompletionGetRequestQueryParams
+	pathParams  *WorkOrderCompletionGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderCompletionGetRequestBody
+}
+
+func (r WorkOrderCompletionGetRequest) NewQueryParams() *WorkOrderCompletionGetRequestQueryParams {
+	return &WorkOrderCompletionGetRequestQueryParams{}
+}
+
+type WorkOrderCompletionGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderCompletionGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderCompletionGetRequest) QueryParams() *WorkOrderCompletionGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderCompletionGetRequest) NewPathParams() *WorkOrderCompletionGetRequestPathParams {
+	return &WorkOrderCompletionGetRequestPathParams{}
+}
+
+type WorkOrderCompletionGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderCompletionGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderCompletionGetRequest) PathParams() *WorkOrderCompletionGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderCompletionGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderCompletionGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderCompletionGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderCompletionGetRequest) NewRequestBody() WorkOrderCompletionGetRequestBody {
+	return WorkOrderCompletionGetRequestBody{}
+}
+
+type WorkOrderCompletionGetRequestBody struct {
+}
+
+func (r *WorkOrderCompletionGetRequest) RequestBody() *WorkOrderCompletionGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderCompletionGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderCompletionGetRequest) SetRequestBody(body WorkOrderCompletionGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderCompletionGetRequest) NewResponseBody() *WorkOrderCompletionGetResponseBody {
+	return &WorkOrderCompletionGetResponseBody{}
+}
+
+type WorkOrderCompletionGetResponseBody struct {
+	Links Links `json:"links"`
+	WorkOrderCompletion
+}
+
+func (r *WorkOrderCompletionGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderCompletion/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderCompletionGetRequest) Do(ctx context.Context) (WorkOrderCompletionGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderCompletionPostRequest() WorkOrderCompletionPostRequest {
+	r := WorkOrderCompletionPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderCompletionPostRequest struct {
+	client      *Client
+	queryParams *WorkOrderCompletionPostRequestQueryParams
+	pathParams  *WorkOrderCompletionPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderCompletionPostRequestBody
+}
+
+func (r WorkOrderCompletionPostRequest) NewQueryParams() *WorkOrderCompletionPostRequestQueryParams {
+	return &WorkOrderCompletionPostRequestQueryParams{}
+}
+
+type WorkOrderCompletionPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderCompletionPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderCompletionPostRequest) QueryParams() *WorkOrderCompletionPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderCompletionPostRequest) NewPathParams() *WorkOrderCompletionPostRequestPathParams {
+	return &WorkOrderCompletionPostRequestPathParams{}
+}
+
+type WorkOrderCompletionPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderCompletionPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderCompletionPostRequest) PathParams() *WorkOrderCompletionPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderCompletionPostRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderCompletionPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderCompletionPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderCompletionPostRequest) NewRequestBody() WorkOrderCompletionPostRequestBody {
+	return WorkOrderCompletionPostRequestBody{}
+}
+
+type WorkOrderCompletionPostRequestBody struct {
+	WorkOrderCompletion
+}
+
+func (r *WorkOrderCompletionPostRequest) RequestBody() *WorkOrderCompletionPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *WorkOrderCompletionPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *WorkOrderCompletionPostRequest) SetRequestBody(body WorkOrderCompletionPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderCompletionPostRequest) NewResponseBody() *WorkOrderCompletionPostResponseBody {
+	return &WorkOrderCompletionPostResponseBody{}
+}
+
+type WorkOrderCompletionPostResponseBody struct {
+}
+
+func (r *WorkOrderCompletionPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderCompletion", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderCompletionPostRequest) Do(ctx context.Context) (WorkOrderCompletionPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,22 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestWorkOrderCompletionPost(t *testing.T) {
+	req := client.NewWorkOrderCompletionPostRequest()
+	req.RequestBody().CreatedFrom.ID = "9120"
+	req.RequestBody().Quantity = 25
+	req.RequestBody().IsBackflush = true
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderCompletionsGetRequest() WorkOrderCompletionsGetRequest {
+	r := WorkOrderCompletionsGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderCompletionsGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderCompletionsGetRequestQueryParams
+	pathParams  *WorkOrderCompletionsGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderCompletionsGetRequestBody
+}
+
+func (r WorkOrderCompletionsGetRequest) NewQueryParams() *WorkOrderCompletionsGetRequestQueryParams {
+	return &WorkOrderCompletionsGetRequestQueryParams{}
+}
+
+type WorkOrderCompletionsGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p WorkOrderCompletionsGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderCompletionsGetRequest) QueryParams() *WorkOrderCompletionsGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderCompletionsGetRequest) NewPathParams() *WorkOrderCompletionsGetRequestPathParams {
+	return &WorkOrderCompletionsGetRequestPathParams{}
+}
+
+type WorkOrderCompletionsGetRequestPathParams struct {
+}
+
+func (p *WorkOrderCompletionsGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *WorkOrderCompletionsGetRequest) PathParams() *WorkOrderCompletionsGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionsGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderCompletionsGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderCompletionsGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderCompletionsGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderCompletionsGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderCompletionsGetRequest) NewRequestBody() WorkOrderCompletionsGetRequestBody {
+	return WorkOrderCompletionsGetRequestBody{}
+}
+
+type WorkOrderCompletionsGetRequestBody struct {
+}
+
+func (r *WorkOrderCompletionsGetRequest) RequestBody() *WorkOrderCompletionsGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderCompletionsGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderCompletionsGetRequest) SetRequestBody(body WorkOrderCompletionsGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderCompletionsGetRequest) NewResponseBody() *WorkOrderCompletionsGetResponseBody {
+	return &WorkOrderCompletionsGetResponseBody{}
+}
+
+type WorkOrderCompletionsGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *WorkOrderCompletionsGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderCompletion", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderCompletionsGetRequest) Do(ctx context.Context) (WorkOrderCompletionsGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,144 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderDeleteRequest() WorkOrderDeleteRequest {
+	r := WorkOrderDeleteRequest{
+		client:  c,
+		method:  http.MethodDelete,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderDeleteRequest struct {
+	client      *Client
+	queryParams *WorkOrderDeleteRequestQueryParams
+	pathParams  *WorkOrderDeleteRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderDeleteRequestBody
+}
+
+func (r WorkOrderDeleteRequest) NewQueryParams() *WorkOrderDeleteRequestQueryParams {
+	return &WorkOrderDeleteRequestQueryParams{}
+}
+
+type WorkOrderDeleteRequestQueryParams struct {
+}
+
+func (p WorkOrderDeleteRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderDeleteRequest) QueryParams() *WorkOrderDeleteRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderDeleteRequest) NewPathParams() *WorkOrderDeleteRequestPathParams {
+	return &WorkOrderDeleteRequestPathParams{}
+}
+
+type WorkOrderDeleteRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderDeleteRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderDeleteRequest) PathParams() *WorkOrderDeleteRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderDeleteRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderDeleteRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderDeleteRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderDeleteRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderDeleteRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderDeleteRequest) NewRequestBody() WorkOrderDeleteRequestBody {
+	return WorkOrderDeleteRequestBody{}
+}
+
+type WorkOrderDeleteRequestBody struct {
+}
+
+func (r *WorkOrderDeleteRequest) RequestBody() *WorkOrderDeleteRequestBody {
+	return nil
+}
+
+func (r *WorkOrderDeleteRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderDeleteRequest) SetRequestBody(body WorkOrderDeleteRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderDeleteRequest) NewResponseBody() *WorkOrderDeleteResponseBody {
+	return &WorkOrderDeleteResponseBody{}
+}
+
+type WorkOrderDeleteResponseBody struct {
+}
+
+func (r *WorkOrderDeleteRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderDeleteRequest) Do(ctx context.Context) (WorkOrderDeleteResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
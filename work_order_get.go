@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderGetRequest() WorkOrderGetRequest {
+	r := WorkOrderGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderGetRequestQueryParams
+	pathParams  *WorkOrderGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderGetRequestBody
+}
+
+func (r WorkOrderGetRequest) NewQueryParams() *WorkOrderGetRequestQueryParams {
+	return &WorkOrderGetRequestQueryParams{}
+}
+
+type WorkOrderGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderGetRequest) QueryParams() *WorkOrderGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderGetRequest) NewPathParams() *WorkOrderGetRequestPathParams {
+	return &WorkOrderGetRequestPathParams{}
+}
+
+type WorkOrderGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderGetRequest) PathParams() *WorkOrderGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderGetRequest) NewRequestBody() WorkOrderGetRequestBody {
+	return WorkOrderGetRequestBody{}
+}
+
+type WorkOrderGetRequestBody struct {
+}
+
+func (r *WorkOrderGetRequest) RequestBody() *WorkOrderGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderGetRequest) SetRequestBody(body WorkOrderGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderGetRequest) NewResponseBody() *WorkOrderGetResponseBody {
+	return &WorkOrderGetResponseBody{}
+}
+
+type WorkOrderGetResponseBody struct {
+	Links Links `json:"links"`
+	WorkOrder
+}
+
+func (r *WorkOrderGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderGetRequest) Do(ctx context.Context) (WorkOrderGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
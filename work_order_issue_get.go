@@ -0,0 +1,149 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderIssueGetRequest() WorkOrderIssueGetRequest {
+	r := WorkOrderIssueGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderIssueGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderIssueGetRequestQueryParams
+	pathParams  *WorkOrderIssueGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderIssueGetRequestBody
+}
+
+func (r WorkOrderIssueGetRequest) NewQueryParams() *WorkOrderIssueGetRequestQueryParams {
+	return &WorkOrderIssueGetRequestQueryParams{}
+}
+
+type WorkOrderIssueGetRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderIssueGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderIssueGetRequest) QueryParams() *WorkOrderIssueGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderIssueGetRequest) NewPathParams() *WorkOrderIssueGetRequestPathParams {
+	return &WorkOrderIssueGetRequestPathParams{}
+}
+
+type WorkOrderIssueGetRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderIssueGetRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderIssueGetRequest) PathParams() *WorkOrderIssueGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssueGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssueGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderIssueGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderIssueGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderIssueGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderIssueGetRequest) NewRequestBody() WorkOrderIssueGetRequestBody {
+	return WorkOrderIssueGetRequestBody{}
+}
+
+type WorkOrderIssueGetRequestBody struct {
+}
+
+func (r *WorkOrderIssueGetRequest) RequestBody() *WorkOrderIssueGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderIssueGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderIssueGetRequest) SetRequestBody(body WorkOrderIssueGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderIssueGetRequest) NewResponseBody() *WorkOrderIssueGetResponseBody {
+	return &WorkOrderIssueGetResponseBody{}
+}
+
+type WorkOrderIssueGetResponseBody struct {
+	Links Links `json:"links"`
+	WorkOrderIssue
+}
+
+func (r *WorkOrderIssueGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderIssue/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderIssueGetRequest) Do(ctx context.Context) (WorkOrderIssueGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderIssuePostRequest() WorkOrderIssuePostRequest {
+	r := WorkOrderIssuePostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderIssuePostRequest struct {
+	client      *Client
+	queryParams *WorkOrderIssuePostRequestQueryParams
+	pathParams  *WorkOrderIssuePostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderIssuePostRequestBody
+}
+
+func (r WorkOrderIssuePostRequest) NewQueryParams() *WorkOrderIssuePostRequestQueryParams {
+	return &WorkOrderIssuePostRequestQueryParams{}
+}
+
+type WorkOrderIssuePostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderIssuePostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderIssuePostRequest) QueryParams() *WorkOrderIssuePostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderIssuePostRequest) NewPathParams() *WorkOrderIssuePostRequestPathParams {
+	return &WorkOrderIssuePostRequestPathParams{}
+}
+
+type WorkOrderIssuePostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderIssuePostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderIssuePostRequest) PathParams() *WorkOrderIssuePostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssuePostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssuePostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderIssuePostRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderIssuePostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderIssuePostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderIssuePostRequest) NewRequestBody() WorkOrderIssuePostRequestBody {
+	return WorkOrderIssuePostRequestBody{}
+}
+
+type WorkOrderIssuePostRequestBody struct {
+	WorkOrderIssue
+}
+
+func (r *WorkOrderIssuePostRequest) RequestBody() *WorkOrderIssuePostRequestBody {
+	return &r.requestBody
+}
+
+func (r *WorkOrderIssuePostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *WorkOrderIssuePostRequest) SetRequestBody(body WorkOrderIssuePostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderIssuePostRequest) NewResponseBody() *WorkOrderIssuePostResponseBody {
+	return &WorkOrderIssuePostResponseBody{}
+}
+
+type WorkOrderIssuePostResponseBody struct {
+}
+
+func (r *WorkOrderIssuePostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderIssue", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderIssuePostRequest) Do(ctx context.Context) (WorkOrderIssuePostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
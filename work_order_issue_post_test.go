@@ -0,0 +1,21 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestWorkOrderIssuePost(t *testing.T) {
+	req := client.NewWorkOrderIssuePostRequest()
+	req.RequestBody().CreatedFrom.ID = "9120"
+	req.RequestBody().Location.ID = "5"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
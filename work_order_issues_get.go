@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderIssuesGetRequest() WorkOrderIssuesGetRequest {
+	r := WorkOrderIssuesGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderIssuesGetRequest struct {
+	client      *Client
+	queryParams *WorkOrderIssuesGetRequestQueryParams
+	pathParams  *WorkOrderIssuesGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderIssuesGetRequestBody
+}
+
+func (r WorkOrderIssuesGetRequest) NewQueryParams() *WorkOrderIssuesGetRequestQueryParams {
+	return &WorkOrderIssuesGetRequestQueryParams{}
+}
+
+type WorkOrderIssuesGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p WorkOrderIssuesGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderIssuesGetRequest) QueryParams() *WorkOrderIssuesGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderIssuesGetRequest) NewPathParams() *WorkOrderIssuesGetRequestPathParams {
+	return &WorkOrderIssuesGetRequestPathParams{}
+}
+
+type WorkOrderIssuesGetRequestPathParams struct {
+}
+
+func (p *WorkOrderIssuesGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *WorkOrderIssuesGetRequest) PathParams() *WorkOrderIssuesGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssuesGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderIssuesGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderIssuesGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderIssuesGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderIssuesGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderIssuesGetRequest) NewRequestBody() WorkOrderIssuesGetRequestBody {
+	return WorkOrderIssuesGetRequestBody{}
+}
+
+type WorkOrderIssuesGetRequestBody struct {
+}
+
+func (r *WorkOrderIssuesGetRequest) RequestBody() *WorkOrderIssuesGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrderIssuesGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrderIssuesGetRequest) SetRequestBody(body WorkOrderIssuesGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderIssuesGetRequest) NewResponseBody() *WorkOrderIssuesGetResponseBody {
+	return &WorkOrderIssuesGetResponseBody{}
+}
+
+type WorkOrderIssuesGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *WorkOrderIssuesGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrderIssue", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderIssuesGetRequest) Do(ctx context.Context) (WorkOrderIssuesGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
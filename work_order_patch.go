@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderPatchRequest() WorkOrderPatchRequest {
+	r := WorkOrderPatchRequest{
+		client:  c,
+		method:  http.MethodPatch,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderPatchRequest struct {
+	client      *Client
+	queryParams *WorkOrderPatchRequestQueryParams
+	pathParams  *WorkOrderPatchRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderPatchRequestBody
+}
+
+func (r WorkOrderPatchRequest) NewQueryParams() *WorkOrderPatchRequestQueryParams {
+	return &WorkOrderPatchRequestQueryParams{}
+}
+
+type WorkOrderPatchRequestQueryParams struct {
+	Replace               Fields `schema:"replace,omitempty"`
+	ReplaceSelectedFields bool   `schema:"replaceSelectedFields,omitempty"`
+}
+
+func (p WorkOrderPatchRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderPatchRequest) QueryParams() *WorkOrderPatchRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderPatchRequest) NewPathParams() *WorkOrderPatchRequestPathParams {
+	return &WorkOrderPatchRequestPathParams{}
+}
+
+type WorkOrderPatchRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderPatchRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderPatchRequest) PathParams() *WorkOrderPatchRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderPatchRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderPatchRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderPatchRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderPatchRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderPatchRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderPatchRequest) NewRequestBody() WorkOrderPatchRequestBody {
+	return WorkOrderPatchRequestBody{}
+}
+
+type WorkOrderPatchRequestBody struct {
+	WorkOrder
+}
+
+func (r *WorkOrderPatchRequest) RequestBody() *WorkOrderPatchRequestBody {
+	return &r.requestBody
+}
+
+func (r *WorkOrderPatchRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *WorkOrderPatchRequest) SetRequestBody(body WorkOrderPatchRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderPatchRequest) NewResponseBody() *WorkOrderPatchResponseBody {
+	return &WorkOrderPatchResponseBody{}
+}
+
+type WorkOrderPatchResponseBody struct {
+}
+
+func (r *WorkOrderPatchRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrder/{{.id}}", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderPatchRequest) Do(ctx context.Context) (WorkOrderPatchResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
@@ -0,0 +1,148 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrderPostRequest() WorkOrderPostRequest {
+	r := WorkOrderPostRequest{
+		client:  c,
+		method:  http.MethodPost,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrderPostRequest struct {
+	client      *Client
+	queryParams *WorkOrderPostRequestQueryParams
+	pathParams  *WorkOrderPostRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrderPostRequestBody
+}
+
+func (r WorkOrderPostRequest) NewQueryParams() *WorkOrderPostRequestQueryParams {
+	return &WorkOrderPostRequestQueryParams{}
+}
+
+type WorkOrderPostRequestQueryParams struct {
+	Fields             Fields `schema:"fields,omitempty"`
+	ExpandSubResources bool   `schema:"expandSubResources,omitempty"`
+}
+
+func (p WorkOrderPostRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(Fields{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrderPostRequest) QueryParams() *WorkOrderPostRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrderPostRequest) NewPathParams() *WorkOrderPostRequestPathParams {
+	return &WorkOrderPostRequestPathParams{}
+}
+
+type WorkOrderPostRequestPathParams struct {
+	ID int `schema:"id"`
+}
+
+func (p *WorkOrderPostRequestPathParams) Params() map[string]string {
+	return map[string]string{
+		"id": strconv.Itoa(p.ID),
+	}
+}
+
+func (r *WorkOrderPostRequest) PathParams() *WorkOrderPostRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderPostRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrderPostRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrderPostRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrderPostRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrderPostRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrderPostRequest) NewRequestBody() WorkOrderPostRequestBody {
+	return WorkOrderPostRequestBody{}
+}
+
+type WorkOrderPostRequestBody struct {
+	WorkOrder
+}
+
+func (r *WorkOrderPostRequest) RequestBody() *WorkOrderPostRequestBody {
+	return &r.requestBody
+}
+
+func (r *WorkOrderPostRequest) RequestBodyInterface() interface{} {
+	return &r.requestBody
+}
+
+func (r *WorkOrderPostRequest) SetRequestBody(body WorkOrderPostRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrderPostRequest) NewResponseBody() *WorkOrderPostResponseBody {
+	return &WorkOrderPostResponseBody{}
+}
+
+type WorkOrderPostResponseBody struct {
+}
+
+func (r *WorkOrderPostRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrder", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrderPostRequest) Do(ctx context.Context) (WorkOrderPostResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}
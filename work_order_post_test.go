@@ -0,0 +1,23 @@
+package netsuite_test
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+)
+
+func TestWorkOrderPost(t *testing.T) {
+	req := client.NewWorkOrderPostRequest()
+	req.RequestBody().AssemblyItem.ID = "140"
+	req.RequestBody().Quantity = 25
+	req.RequestBody().Subsidiary.ID = "46"
+	req.RequestBody().Location.ID = "5"
+	resp, err := req.Do(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+
+	b, _ := json.MarshalIndent(resp, "", "  ")
+	log.Println(string(b))
+}
@@ -0,0 +1,155 @@
+package netsuite
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/omniboost/go-netsuite-rest/utils"
+)
+
+func (c *Client) NewWorkOrdersGetRequest() WorkOrdersGetRequest {
+	r := WorkOrdersGetRequest{
+		client:  c,
+		method:  http.MethodGet,
+		headers: http.Header{},
+	}
+
+	r.queryParams = r.NewQueryParams()
+	r.pathParams = r.NewPathParams()
+	r.requestBody = r.NewRequestBody()
+	return r
+}
+
+type WorkOrdersGetRequest struct {
+	client      *Client
+	queryParams *WorkOrdersGetRequestQueryParams
+	pathParams  *WorkOrdersGetRequestPathParams
+	method      string
+	headers     http.Header
+	requestBody WorkOrdersGetRequestBody
+}
+
+func (r WorkOrdersGetRequest) NewQueryParams() *WorkOrdersGetRequestQueryParams {
+	return &WorkOrdersGetRequestQueryParams{}
+}
+
+type WorkOrdersGetRequestQueryParams struct {
+	Q      string `schema:"q,omitempty"`
+	Limit  int    `schema:"limit,omitempty"`
+	Offset int    `schema:"offset,omitempty"`
+}
+
+func (p WorkOrdersGetRequestQueryParams) ToURLValues() (url.Values, error) {
+	encoder := utils.NewSchemaEncoder()
+	encoder.RegisterEncoder(Date{}, utils.EncodeSchemaMarshaler)
+	encoder.RegisterEncoder(DateTime{}, utils.EncodeSchemaMarshaler)
+	params := url.Values{}
+
+	err := encoder.Encode(p, params)
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (r *WorkOrdersGetRequest) QueryParams() *WorkOrdersGetRequestQueryParams {
+	return r.queryParams
+}
+
+func (r WorkOrdersGetRequest) NewPathParams() *WorkOrdersGetRequestPathParams {
+	return &WorkOrdersGetRequestPathParams{}
+}
+
+type WorkOrdersGetRequestPathParams struct {
+}
+
+func (p *WorkOrdersGetRequestPathParams) Params() map[string]string {
+	return map[string]string{}
+}
+
+func (r *WorkOrdersGetRequest) PathParams() *WorkOrdersGetRequestPathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrdersGetRequest) PathParamsInterface() PathParams {
+	return r.pathParams
+}
+
+func (r *WorkOrdersGetRequest) SetMethod(method string) {
+	r.method = method
+}
+
+func (r *WorkOrdersGetRequest) Method() string {
+	return r.method
+}
+
+func (r *WorkOrdersGetRequest) SetHeader(key, value string) {
+	r.headers.Set(key, value)
+}
+
+func (r *WorkOrdersGetRequest) Headers() http.Header {
+	return r.headers
+}
+
+func (r WorkOrdersGetRequest) NewRequestBody() WorkOrdersGetRequestBody {
+	return WorkOrdersGetRequestBody{}
+}
+
+type WorkOrdersGetRequestBody struct {
+}
+
+func (r *WorkOrdersGetRequest) RequestBody() *WorkOrdersGetRequestBody {
+	return nil
+}
+
+func (r *WorkOrdersGetRequest) RequestBodyInterface() interface{} {
+	return nil
+}
+
+func (r *WorkOrdersGetRequest) SetRequestBody(body WorkOrdersGetRequestBody) {
+	r.requestBody = body
+}
+
+func (r *WorkOrdersGetRequest) NewResponseBody() *WorkOrdersGetResponseBody {
+	return &WorkOrdersGetResponseBody{}
+}
+
+type WorkOrdersGetResponseBody struct {
+	Links   Links `json:"links"`
+	Count   int   `json:"count"`
+	HasMore bool  `json:"hasMore"`
+	Items   []struct {
+		Links []struct {
+			Rel  string `json:"rel"`
+			Href string `json:"href"`
+		} `json:"links"`
+		ID string `json:"id"`
+	} `json:"items"`
+	Offset       int `json:"offset"`
+	TotalResults int `json:"totalResults"`
+}
+
+func (r *WorkOrdersGetRequest) URL() (*url.URL, error) {
+	u, err := r.client.GetEndpointURL("/record/v1/workOrder", r.PathParams())
+	return &u, err
+}
+
+func (r *WorkOrdersGetRequest) Do(ctx context.Context) (WorkOrdersGetResponseBody, error) {
+	// Create http request
+	req, err := r.client.NewRequest(ctx, r)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	// Process query parameters
+	err = utils.AddQueryParamsToRequest(r.QueryParams(), req, false)
+	if err != nil {
+		return *r.NewResponseBody(), err
+	}
+
+	responseBody := r.NewResponseBody()
+	_, err = r.client.Do(req, responseBody)
+	return *responseBody, err
+}